@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/you-humble/rocket-maintenance/assembly/internal/config"
@@ -9,8 +11,12 @@ import (
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
+// healthReadHeaderTimeout bounds the requests of the probes.
+const healthReadHeaderTimeout = 5 * time.Second
+
 type app struct {
-	di *di
+	di           *di
+	healthServer *http.Server
 }
 
 func New(ctx context.Context) (*app, error) {
@@ -31,6 +37,7 @@ func (a *app) init(ctx context.Context) error {
 		a.initLogger,
 		a.initCloser,
 		a.initDI,
+		a.initHealthServer,
 	}
 
 	for _, initFn := range inits {
@@ -62,11 +69,43 @@ func (a *app) initDI(_ context.Context) error {
 	return nil
 }
 
+// initHealthServer serves the liveness and readiness probes: the service
+// has no server of its own.
+func (a *app) initHealthServer(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              config.C().Health.Address(),
+		Handler:           a.di.Health(ctx).Handler(),
+		ReadHeaderTimeout: healthReadHeaderTimeout,
+	}
+	closer.AddNamed("Health server", func(ctx context.Context) error {
+		return srv.Shutdown(ctx)
+	})
+
+	a.healthServer = srv
+	return nil
+}
+
 func (a *app) run(ctx context.Context) error {
 	defer gracefulShutdown()
+	// Runs first: the service reports not ready while it stops.
+	defer a.di.Health(ctx).Shutdown()
 
 	errCh := make(chan error)
 
+	go func() {
+		logger.Info(ctx,
+			"🚀 health server listening",
+			logger.String("address", config.C().Health.Address()),
+		)
+		err := a.healthServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			select {
+			case <-ctx.Done():
+			case errCh <- err:
+			}
+		}
+	}()
+
 	go func() {
 		logger.Info(ctx, "🚀 assembly server running")
 		if err := a.di.AssemblyService(ctx).RunOrderPaidConsume(ctx); err != nil {
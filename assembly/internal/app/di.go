@@ -10,6 +10,7 @@ import (
 	"github.com/you-humble/rocket-maintenance/assembly/internal/converter"
 	service "github.com/you-humble/rocket-maintenance/assembly/internal/service/assembly"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	"github.com/you-humble/rocket-maintenance/platform/kafka"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/consumer"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/middleware"
@@ -31,6 +32,8 @@ type di struct {
 	conv service.KafkaConverter
 
 	service AssemblyService
+
+	health *health.Health
 }
 
 func NewDI() *di { return &di{} }
@@ -125,3 +128,17 @@ func (d *di) AssemblyService(ctx context.Context) AssemblyService {
 
 	return d.service
 }
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		cfg := config.C()
+
+		d.health = health.New()
+		d.health.Register("kafka", health.Kafka(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.OrderPaidConsumerConfig(),
+		))
+	}
+
+	return d.health
+}
@@ -15,6 +15,7 @@ var cfg *config
 type config struct {
 	Kafka  Kafka
 	Logger Logger
+	Health HealthServer
 }
 
 func Load(path ...string) error {
@@ -36,9 +37,15 @@ func Load(path ...string) error {
 		return fmt.Errorf("%s Logger: %w", op, err)
 	}
 
+	healthCfg, err := envconfig.NewHealthServerConfig()
+	if err != nil {
+		return fmt.Errorf("%s Health: %w", op, err)
+	}
+
 	cfg = &config{
 		Kafka:  kafkaCfg,
 		Logger: loggerCfg,
+		Health: healthCfg,
 	}
 
 	return nil
@@ -0,0 +1,30 @@
+package envconfig
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type healthServerEnv struct {
+	Host string `env:"HEALTH_HTTP_HOST,required"`
+	Port int    `env:"HEALTH_HTTP_PORT,required"`
+}
+
+type healthServer struct {
+	raw healthServerEnv
+}
+
+func NewHealthServerConfig() (*healthServer, error) {
+	var raw healthServerEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &healthServer{raw: raw}, nil
+}
+
+func (cfg *healthServer) Host() string { return cfg.raw.Host }
+func (cfg *healthServer) Port() int    { return cfg.raw.Port }
+func (cfg *healthServer) Address() string {
+	return fmt.Sprintf("%s:%d", cfg.Host(), cfg.Port())
+}
@@ -1,8 +1,14 @@
 package envconfig
 
 import (
+	"crypto/tls"
+	"time"
+
 	"github.com/IBM/sarama"
 	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/kafka/security"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
 )
 
 type kafkaEnv struct {
@@ -10,10 +16,24 @@ type kafkaEnv struct {
 	OrderPaidTopicName      string   `env:"ORDER_PAID_TOPIC_NAME,required"`
 	OrderAssembledTopicName string   `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
 	ConsumerGroupID         string   `env:"ORDER_PAID_CONSUMER_GROUP_ID,required"`
+
+	TLSEnabled bool   `env:"KAFKA_TLS_ENABLED" envDefault:"false"`
+	TLSCA      string `env:"KAFKA_TLS_CA_FILE"`
+	// With a client certificate the brokers can authenticate the service by
+	// it instead of SASL.
+	TLSCert           string        `env:"KAFKA_TLS_CERT_FILE"`
+	TLSKey            string        `env:"KAFKA_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"KAFKA_TLS_RELOAD_INTERVAL" envDefault:"1m"`
+
+	// SCRAM-SHA-256 or SCRAM-SHA-512; empty turns SASL off.
+	SASLMechanism string `env:"KAFKA_SASL_MECHANISM"`
+	SASLUsername  string `env:"KAFKA_SASL_USERNAME"`
+	SASLPassword  string `env:"KAFKA_SASL_PASSWORD"`
 }
 
 type kafka struct {
-	raw kafkaEnv
+	raw      kafkaEnv
+	security *security.Config
 }
 
 func NewKafkaConfig() (*kafka, error) {
@@ -21,7 +41,30 @@ func NewKafkaConfig() (*kafka, error) {
 	if err := env.Parse(&raw); err != nil {
 		return nil, err
 	}
-	return &kafka{raw: raw}, nil
+
+	var tlsCfg *tls.Config
+	if raw.TLSEnabled {
+		var err error
+		tlsCfg, err = tlsconfig.Client(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, tlsconfig.WithReloadInterval(raw.TLSReloadInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sec, err := security.New(tlsCfg, security.SASL{
+		Mechanism: raw.SASLMechanism,
+		Username:  raw.SASLUsername,
+		Password:  raw.SASLPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka{raw: raw, security: sec}, nil
 }
 
 func (cfg *kafka) Brokers() []string           { return cfg.raw.Brokers }
@@ -35,7 +78,7 @@ func (cfg *kafka) OrderPaidConsumerConfig() *sarama.Config {
 	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 
-	return config
+	return cfg.security.Apply(config)
 }
 
 // Config возвращает конфигурацию для sarama consumer
@@ -44,5 +87,5 @@ func (cfg *kafka) OrderAssembledProducerConfig() *sarama.Config {
 	config.Version = sarama.V4_0_0_0
 	config.Producer.Return.Successes = true
 
-	return config
+	return cfg.security.Apply(config)
 }
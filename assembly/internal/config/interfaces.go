@@ -15,3 +15,9 @@ type Logger interface {
 	Level() string
 	AsJSON() bool
 }
+
+type HealthServer interface {
+	Host() string
+	Port() int
+	Address() string
+}
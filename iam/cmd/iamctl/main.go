@@ -0,0 +1,134 @@
+// Command iamctl manages the users of the iam service. It connects to the
+// iam Postgres with the same environment as the service. Roles are assigned
+// only here, so no API can raise the privileges of a user.
+//
+//	iamctl [-env FILE] [-timeout D] set-role -login LOGIN -role customer|support|admin
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+
+	envconfig "github.com/you-humble/rocket-maintenance/iam/internal/config/env"
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	sessionrepo "github.com/you-humble/rocket-maintenance/iam/internal/repository/session"
+	userrepo "github.com/you-humble/rocket-maintenance/iam/internal/repository/user"
+	service "github.com/you-humble/rocket-maintenance/iam/internal/service/auth"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const usage = `usage: iamctl [-env FILE] [-timeout D] <command> [flags]
+
+commands:
+  set-role -login LOGIN -role customer|support|admin   change the role of a user
+`
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	fs := flag.NewFlagSet("iamctl", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	envFile := fs.String("env", "", "load environment variables from `FILE`")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of a single database call")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	if *envFile != "" {
+		if err := godotenv.Load(*envFile); err != nil {
+			fmt.Fprintf(os.Stderr, "load %s: %v\n", *envFile, err)
+			return 1
+		}
+	}
+
+	logger.SetNopLogger()
+
+	ctx, quit := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer quit()
+
+	switch cmd, cmdArgs := fs.Arg(0), fs.Args()[1:]; cmd {
+	case "set-role":
+		return runSetRole(ctx, *timeout, cmdArgs)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", cmd)
+		fs.Usage()
+		return 2
+	}
+}
+
+func runSetRole(ctx context.Context, timeout time.Duration, args []string) int {
+	fs := flag.NewFlagSet("set-role", flag.ContinueOnError)
+	login := fs.String("login", "", "login or email of the user")
+	role := fs.String("role", "", "new role: customer, support or admin")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *login == "" || !model.Role(*role).Valid() {
+		fmt.Fprintln(os.Stderr, "set-role: want -login and -role customer, support or admin")
+		return 2
+	}
+
+	svc, disconnect, err := connect(ctx, timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "set-role: %v\n", err)
+		return 1
+	}
+	defer disconnect()
+
+	u, err := svc.SetRole(ctx, *login, model.Role(*role))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "set-role: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stdout, "%s (%s) is now %s\n", u.Login, u.ID, u.Role)
+
+	return 0
+}
+
+type userService interface {
+	SetRole(ctx context.Context, login string, role model.Role) (*model.User, error)
+}
+
+func connect(ctx context.Context, timeout time.Duration) (userService, func(), error) {
+	cfg, err := envconfig.NewPostgresConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("postgres config: %w", err)
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.DSN())
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to postgres: %w", err)
+	}
+
+	pctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := pool.Ping(pctx); err != nil {
+		pool.Close()
+		return nil, nil, fmt.Errorf("ping postgres: %w", err)
+	}
+
+	// Sessions and link tokens do not matter to user management.
+	svc := service.NewAuthService(
+		userrepo.NewUserRepository(pool),
+		sessionrepo.NewSessionRepository(pool),
+		nil,
+		0, 0, 0,
+		timeout, timeout,
+	)
+
+	return svc, pool.Close, nil
+}
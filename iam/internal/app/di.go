@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/config"
+	linktokenrepo "github.com/you-humble/rocket-maintenance/iam/internal/repository/linktoken"
+	sessionrepo "github.com/you-humble/rocket-maintenance/iam/internal/repository/session"
+	userrepo "github.com/you-humble/rocket-maintenance/iam/internal/repository/user"
+	service "github.com/you-humble/rocket-maintenance/iam/internal/service/auth"
+	tgrpc "github.com/you-humble/rocket-maintenance/iam/internal/transport/grpc/iam/v1"
+	"github.com/you-humble/rocket-maintenance/platform/closer"
+	"github.com/you-humble/rocket-maintenance/platform/db/migrator"
+	grpcserver "github.com/you-humble/rocket-maintenance/platform/grpc/server"
+	"github.com/you-humble/rocket-maintenance/platform/health"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+type di struct {
+	dbPool   *pgxpool.Pool
+	migrator *migrator.Migrator
+
+	userRepository      service.UserRepository
+	sessionRepository   service.SessionRepository
+	linkTokenRepository service.LinkTokenRepository
+
+	service tgrpc.AuthService
+	handler iampbv1.IAMServiceServer
+
+	server *grpcserver.Server
+	health *health.Health
+}
+
+func NewDI() *di { return &di{} }
+
+func (d *di) DBPool(ctx context.Context) *pgxpool.Pool {
+	if d.dbPool == nil {
+		pool, err := pgxpool.New(ctx, config.C().Postgres.DSN())
+		if err != nil {
+			panic(fmt.Sprintf("failed to create pg pool: %v\n", err))
+		}
+
+		closer.AddNamed("PGX Pool",
+			func(ctx context.Context) error {
+				pool.Close()
+				return nil
+			})
+
+		if err := pool.Ping(ctx); err != nil {
+			panic(fmt.Sprintf("failed to ping db: %v\n", err))
+		}
+
+		d.dbPool = pool
+	}
+
+	return d.dbPool
+}
+
+func (d *di) Migrator(ctx context.Context) *migrator.Migrator {
+	if d.migrator == nil {
+		d.migrator = migrator.NewMigrator(
+			stdlib.OpenDBFromPool(d.DBPool(ctx)),
+			config.C().Postgres.MigrationDirectory(),
+		)
+
+		closer.AddNamed("Migrator",
+			func(ctx context.Context) error {
+				return d.migrator.Close()
+			})
+	}
+
+	return d.migrator
+}
+
+func (d *di) UserRepository(ctx context.Context) service.UserRepository {
+	if d.userRepository == nil {
+		d.userRepository = userrepo.NewUserRepository(d.DBPool(ctx))
+	}
+
+	return d.userRepository
+}
+
+func (d *di) SessionRepository(ctx context.Context) service.SessionRepository {
+	if d.sessionRepository == nil {
+		d.sessionRepository = sessionrepo.NewSessionRepository(d.DBPool(ctx))
+	}
+
+	return d.sessionRepository
+}
+
+func (d *di) LinkTokenRepository(ctx context.Context) service.LinkTokenRepository {
+	if d.linkTokenRepository == nil {
+		d.linkTokenRepository = linktokenrepo.NewLinkTokenRepository(d.DBPool(ctx))
+	}
+
+	return d.linkTokenRepository
+}
+
+func (d *di) AuthService(ctx context.Context) tgrpc.AuthService {
+	if d.service == nil {
+		cfg := config.C()
+
+		d.service = service.NewAuthService(
+			d.UserRepository(ctx),
+			d.SessionRepository(ctx),
+			d.LinkTokenRepository(ctx),
+			cfg.Session.TTL(),
+			cfg.Session.RefreshTTL(),
+			cfg.Session.LinkTokenTTL(),
+			cfg.Server.DBReadTimeout(),
+			cfg.Server.DBWriteTimeout(),
+		)
+	}
+
+	return d.service
+}
+
+func (d *di) IAMHandler(ctx context.Context) iampbv1.IAMServiceServer {
+	if d.handler == nil {
+		d.handler = tgrpc.NewIAMHandler(d.AuthService(ctx))
+	}
+
+	return d.handler
+}
+
+func (d *di) Server(ctx context.Context) *grpcserver.Server {
+	if d.server == nil {
+		srv, err := grpcserver.New("gRPC server").
+			WithValidation().
+			WithHealth(d.Health(ctx)).
+			WithReflection().
+			WithTLS(config.C().Server.TLS()).
+			WithShutdownTimeout(config.C().Server.ShutdownTimeout()).
+			Build()
+		if err != nil {
+			panic(fmt.Sprintf("failed to build grpc server: %v\n", err))
+		}
+		iampbv1.RegisterIAMServiceServer(srv, d.IAMHandler(ctx))
+
+		d.server = srv
+	}
+
+	return d.server
+}
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		d.health = health.New()
+		d.health.Register("postgres", health.Ping(d.DBPool(ctx)))
+	}
+
+	return d.health
+}
@@ -0,0 +1,34 @@
+package envconfig
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type sessionEnv struct {
+	TTL        time.Duration `env:"SESSION_TTL" envDefault:"15m"`
+	RefreshTTL time.Duration `env:"SESSION_REFRESH_TTL" envDefault:"720h"`
+	LinkTTL    time.Duration `env:"SESSION_LINK_TOKEN_TTL" envDefault:"10m"`
+}
+
+type session struct {
+	raw sessionEnv
+}
+
+func NewSessionConfig() (*session, error) {
+	var raw sessionEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &session{raw: raw}, nil
+}
+
+// TTL is how long a session token is valid.
+func (cfg *session) TTL() time.Duration { return cfg.raw.TTL }
+
+// RefreshTTL is how long a refresh token is valid.
+func (cfg *session) RefreshTTL() time.Duration { return cfg.raw.RefreshTTL }
+
+// LinkTokenTTL is how long a link token can be redeemed.
+func (cfg *session) LinkTokenTTL() time.Duration { return cfg.raw.LinkTTL }
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"crypto/tls"
+	"time"
+)
+
+type Server interface {
+	Host() string
+	Port() int
+	Address() string
+	TLS() *tls.Config
+	ShutdownTimeout() time.Duration
+	DBReadTimeout() time.Duration
+	DBWriteTimeout() time.Duration
+}
+
+type Logger interface {
+	Level() string
+	AsJSON() bool
+}
+
+type Database interface {
+	MigrationDirectory() string
+	DSN() string
+}
+
+type Session interface {
+	TTL() time.Duration
+	RefreshTTL() time.Duration
+	LinkTokenTTL() time.Duration
+}
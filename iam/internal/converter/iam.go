@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+func RegisterRequestToParams(req *iampbv1.RegisterRequest) model.RegisterParams {
+	return model.RegisterParams{
+		Login:    req.GetLogin(),
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+}
+
+func LoginRequestToParams(req *iampbv1.LoginRequest) model.LoginParams {
+	return model.LoginParams{
+		Login:    req.GetLogin(),
+		Password: req.GetPassword(),
+	}
+}
+
+func UserFromModel(u *model.User) *iampbv1.User {
+	return &iampbv1.User{
+		Uuid:      u.ID.String(),
+		Login:     u.Login,
+		Email:     u.Email,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		Role:      RoleFromModel(u.Role),
+	}
+}
+
+func RoleFromModel(r model.Role) iampbv1.Role {
+	switch r {
+	case model.RoleCustomer:
+		return iampbv1.Role_ROLE_CUSTOMER
+	case model.RoleSupport:
+		return iampbv1.Role_ROLE_SUPPORT
+	case model.RoleAdmin:
+		return iampbv1.Role_ROLE_ADMIN
+	default:
+		return iampbv1.Role_ROLE_UNSPECIFIED
+	}
+}
+
+func SessionFromModel(s *model.Session) *iampbv1.Session {
+	return &iampbv1.Session{
+		Uuid:             s.ID.String(),
+		UserUuid:         s.UserID.String(),
+		ExpiresAt:        timestamppb.New(s.ExpiresAt),
+		RefreshExpiresAt: timestamppb.New(s.RefreshExpiresAt),
+		CreatedAt:        timestamppb.New(s.CreatedAt),
+	}
+}
+
+func IssuedLinkTokenFromModel(t *model.IssuedLinkToken) *iampbv1.IssueLinkTokenResponse {
+	return &iampbv1.IssueLinkTokenResponse{
+		LinkToken: t.Token,
+		ExpiresAt: timestamppb.New(t.ExpiresAt),
+	}
+}
+
+func SessionTokensFromModel(t *model.SessionTokens) *iampbv1.SessionTokens {
+	return &iampbv1.SessionTokens{
+		SessionToken: t.SessionToken,
+		RefreshToken: t.RefreshToken,
+		Session:      SessionFromModel(&t.Session),
+	}
+}
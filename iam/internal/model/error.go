@@ -0,0 +1,16 @@
+package model
+
+import "errors"
+
+var (
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrUserExists      = errors.New("user already exists")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrLinkTokenNotFound means a link token is unknown, expired or redeemed.
+	ErrLinkTokenNotFound = errors.New("link token not found")
+	// ErrInvalidCredentials means the login is unknown or the password is wrong.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInvalidToken means a token is unknown, expired or revoked.
+	ErrInvalidToken = errors.New("invalid token")
+)
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkToken lets another client, like the Telegram bot, start a session of
+// the user who issued it. It expires soon and is redeemed once; only its
+// SHA-256 hash is stored.
+type LinkToken struct {
+	TokenHash  []byte
+	UserID     uuid.UUID
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	RedeemedAt *time.Time
+}
+
+// IssuedLinkToken is a link token as it is handed to the user, once.
+type IssuedLinkToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
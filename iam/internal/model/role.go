@@ -0,0 +1,20 @@
+package model
+
+// Role decides what a user may do in other services.
+type Role string
+
+const (
+	RoleCustomer Role = "customer"
+	RoleSupport  Role = "support"
+	RoleAdmin    Role = "admin"
+)
+
+// Valid reports whether r is one of the known roles.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleCustomer, RoleSupport, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a login of a user. Only SHA-256 hashes of its tokens are stored.
+type Session struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	TokenHash   []byte
+	RefreshHash []byte
+	// PreviousRefreshHash is the hash of the refresh token replaced by the
+	// last refresh; presenting that token again revokes the session.
+	PreviousRefreshHash []byte
+	ExpiresAt           time.Time
+	RefreshExpiresAt    time.Time
+	CreatedAt           time.Time
+	RevokedAt           *time.Time
+}
+
+// SessionTokens are the tokens of a session; they are returned once and
+// cannot be recovered from the stored hashes.
+type SessionTokens struct {
+	SessionToken string
+	RefreshToken string
+	Session      Session
+}
+
+// Identity is the user and the session of a valid session token.
+type Identity struct {
+	User    User
+	Session Session
+}
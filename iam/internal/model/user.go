@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type User struct {
+	ID    uuid.UUID
+	Login string
+	Email string
+	// bcrypt hash of the password.
+	PasswordHash []byte
+	Role         Role
+	CreatedAt    time.Time
+}
+
+type RegisterParams struct {
+	Login    string
+	Email    string
+	Password string
+}
+
+type LoginParams struct {
+	// Login or email of the user.
+	Login    string
+	Password string
+}
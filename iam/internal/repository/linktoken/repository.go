@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewLinkTokenRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create stores the link token.
+func (r *repository) Create(ctx context.Context, t *model.LinkToken) error {
+	q := r.sb.
+		Insert("link_tokens").
+		Columns("token_hash", "user_id", "expires_at", "created_at").
+		Values(t.TokenHash, t.UserID, t.ExpiresAt, t.CreatedAt)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// Redeem marks the link token with the hash redeemed at the time and
+// returns its user. A token that is unknown, expired at the time or
+// already redeemed gives model.ErrLinkTokenNotFound, so of two concurrent
+// redeems only one succeeds.
+func (r *repository) Redeem(ctx context.Context, hash []byte, at time.Time) (uuid.UUID, error) {
+	q := r.sb.
+		Update("link_tokens").
+		Set("redeemed_at", at).
+		Where(sq.Eq{"token_hash": hash, "redeemed_at": nil}).
+		Where(sq.Gt{"expires_at": at}).
+		Suffix("RETURNING user_id")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var userID uuid.UUID
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, model.ErrLinkTokenNotFound
+		}
+		return uuid.Nil, err
+	}
+
+	return userID, nil
+}
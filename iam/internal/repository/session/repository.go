@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+)
+
+var sessionColumns = []string{
+	"id", "user_id", "token_hash", "refresh_hash", "previous_refresh_hash",
+	"expires_at", "refresh_expires_at", "created_at", "revoked_at",
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewSessionRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create stores the session and sets its ID.
+func (r *repository) Create(ctx context.Context, s *model.Session) error {
+	q := r.sb.
+		Insert("sessions").
+		Columns("user_id", "token_hash", "refresh_hash", "expires_at", "refresh_expires_at", "created_at").
+		Values(s.UserID, s.TokenHash, s.RefreshHash, s.ExpiresAt, s.RefreshExpiresAt, s.CreatedAt).
+		Suffix("RETURNING id")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.pool.QueryRow(ctx, sqlStr, args...).Scan(&s.ID)
+}
+
+func (r *repository) SessionByTokenHash(ctx context.Context, hash []byte) (*model.Session, error) {
+	return r.sessionWhere(ctx, sq.Eq{"token_hash": hash})
+}
+
+// SessionByRefreshHash returns the session whose current or previous
+// refresh token has the hash.
+func (r *repository) SessionByRefreshHash(ctx context.Context, hash []byte) (*model.Session, error) {
+	return r.sessionWhere(ctx, sq.Or{sq.Eq{"refresh_hash": hash}, sq.Eq{"previous_refresh_hash": hash}})
+}
+
+// Rotate replaces the tokens and expiry times of an active session with
+// those of s, if its refresh token still has the hash oldRefreshHash. The
+// replaced refresh hash becomes the previous one.
+func (r *repository) Rotate(ctx context.Context, s *model.Session, oldRefreshHash []byte) error {
+	q := r.sb.
+		Update("sessions").
+		Set("token_hash", s.TokenHash).
+		Set("previous_refresh_hash", sq.Expr("refresh_hash")).
+		Set("refresh_hash", s.RefreshHash).
+		Set("expires_at", s.ExpiresAt).
+		Set("refresh_expires_at", s.RefreshExpiresAt).
+		Where(sq.Eq{"id": s.ID, "refresh_hash": oldRefreshHash, "revoked_at": nil})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return model.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// Revoke ends the session at the time; an ended session is left as it is.
+func (r *repository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	q := r.sb.
+		Update("sessions").
+		Set("revoked_at", at).
+		Where(sq.Eq{"id": id, "revoked_at": nil})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+func (r *repository) sessionWhere(ctx context.Context, pred sq.Sqlizer) (*model.Session, error) {
+	q := r.sb.
+		Select(sessionColumns...).
+		From("sessions").
+		Where(pred)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var s model.Session
+	err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(
+		&s.ID,
+		&s.UserID,
+		&s.TokenHash,
+		&s.RefreshHash,
+		&s.PreviousRefreshHash,
+		&s.ExpiresAt,
+		&s.RefreshExpiresAt,
+		&s.CreatedAt,
+		&s.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+)
+
+// uniqueViolation is the Postgres error code of a duplicate key.
+const uniqueViolation = "23505"
+
+var userColumns = []string{"id", "login", "email", "password_hash", "role", "created_at"}
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewUserRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create stores the user and sets its ID and CreatedAt.
+func (r *repository) Create(ctx context.Context, u *model.User) error {
+	q := r.sb.
+		Insert("users").
+		Columns("login", "email", "password_hash", "role").
+		Values(u.Login, u.Email, u.PasswordHash, u.Role).
+		Suffix("RETURNING id, created_at")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&u.ID, &u.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.ErrUserExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) UserByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	return r.userWhere(ctx, sq.Eq{"id": id})
+}
+
+// UserByLogin returns the user whose login or email is login.
+func (r *repository) UserByLogin(ctx context.Context, login string) (*model.User, error) {
+	return r.userWhere(ctx, sq.Or{sq.Eq{"login": login}, sq.Eq{"email": login}})
+}
+
+// SetRole changes the role of the user.
+func (r *repository) SetRole(ctx context.Context, id uuid.UUID, role model.Role) error {
+	q := r.sb.
+		Update("users").
+		Set("role", role).
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *repository) userWhere(ctx context.Context, pred sq.Sqlizer) (*model.User, error) {
+	q := r.sb.
+		Select(userColumns...).
+		From("users").
+		Where(pred)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var u model.User
+	err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(&u.ID, &u.Login, &u.Email, &u.PasswordHash, &u.Role, &u.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &u, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// IssueLinkToken returns a new link token of the user of an active session
+// token. The token is valid for the link token TTL and can be redeemed once.
+func (s *service) IssueLinkToken(ctx context.Context, sessionToken string) (*model.IssuedLinkToken, error) {
+	const op = "iam.service.IssueLinkToken"
+
+	if sessionToken == "" {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	sess, err := s.sessionByToken(readCtx, sessionToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	now := time.Now()
+	if sess.RevokedAt != nil || !now.Before(sess.ExpiresAt) {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	token := rand.Text()
+	lt := &model.LinkToken{
+		TokenHash: hashToken(token),
+		UserID:    sess.UserID,
+		ExpiresAt: now.Add(s.linkTokenTTL),
+		CreatedAt: now,
+	}
+
+	writeCtx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.linkTokens.Create(writeCtx, lt); err != nil {
+		logger.Error(ctx, "repository create link token",
+			logger.String("user_id", sess.UserID.String()),
+			logger.ErrorF(err),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &model.IssuedLinkToken{Token: token, ExpiresAt: lt.ExpiresAt}, nil
+}
+
+// RedeemLinkToken marks a link token redeemed and creates a session of its
+// user. A token that is unknown, expired or redeemed is ErrInvalidToken.
+func (s *service) RedeemLinkToken(ctx context.Context, linkToken string) (*model.SessionTokens, error) {
+	const op = "iam.service.RedeemLinkToken"
+
+	if linkToken == "" {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	now := time.Now()
+	userID, err := s.linkTokens.Redeem(ctx, hashToken(linkToken), now)
+	switch {
+	case errors.Is(err, model.ErrLinkTokenNotFound):
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	case err != nil:
+		logger.Error(ctx, "repository redeem link token", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tokens := s.newTokens(model.Session{UserID: userID, CreatedAt: now}, now)
+	if err := s.sessions.Create(ctx, &tokens.Session); err != nil {
+		logger.Error(ctx, "repository create session",
+			logger.String("user_id", userID.String()),
+			logger.ErrorF(err),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+)
+
+type UserRepository interface {
+	Create(ctx context.Context, u *model.User) error
+	UserByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	UserByLogin(ctx context.Context, login string) (*model.User, error)
+	SetRole(ctx context.Context, id uuid.UUID, role model.Role) error
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, s *model.Session) error
+	SessionByTokenHash(ctx context.Context, hash []byte) (*model.Session, error)
+	SessionByRefreshHash(ctx context.Context, hash []byte) (*model.Session, error)
+	Rotate(ctx context.Context, s *model.Session, oldRefreshHash []byte) error
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type LinkTokenRepository interface {
+	Create(ctx context.Context, t *model.LinkToken) error
+	Redeem(ctx context.Context, hash []byte, at time.Time) (uuid.UUID, error)
+}
+
+type service struct {
+	users      UserRepository
+	sessions   SessionRepository
+	linkTokens LinkTokenRepository
+
+	sessionTTL   time.Duration
+	refreshTTL   time.Duration
+	linkTokenTTL time.Duration
+
+	readDBTimeout  time.Duration
+	writeDBTimeout time.Duration
+}
+
+func NewAuthService(
+	users UserRepository,
+	sessions SessionRepository,
+	linkTokens LinkTokenRepository,
+	sessionTTL, refreshTTL, linkTokenTTL time.Duration,
+	readDBTimeout, writeDBTimeout time.Duration,
+) *service {
+	return &service{
+		users:          users,
+		sessions:       sessions,
+		linkTokens:     linkTokens,
+		sessionTTL:     sessionTTL,
+		refreshTTL:     refreshTTL,
+		linkTokenTTL:   linkTokenTTL,
+		readDBTimeout:  readDBTimeout,
+		writeDBTimeout: writeDBTimeout,
+	}
+}
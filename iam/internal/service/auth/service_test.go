@@ -0,0 +1,632 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	"github.com/you-humble/rocket-maintenance/iam/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	sessionTTL   = 15 * time.Minute
+	refreshTTL   = 24 * time.Hour
+	linkTokenTTL = 10 * time.Minute
+)
+
+type deps struct {
+	users      *mocks.MockUserRepository
+	sessions   *mocks.MockSessionRepository
+	linkTokens *mocks.MockLinkTokenRepository
+}
+
+func newDeps(t *testing.T) deps {
+	return deps{
+		users:      mocks.NewMockUserRepository(t),
+		sessions:   mocks.NewMockSessionRepository(t),
+		linkTokens: mocks.NewMockLinkTokenRepository(t),
+	}
+}
+
+func newSvc(d deps) *service {
+	return NewAuthService(d.users, d.sessions, d.linkTokens,
+		sessionTTL, refreshTTL, linkTokenTTL,
+		5*time.Second, 5*time.Second,
+	)
+}
+
+func TestServiceRegister(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		params model.RegisterParams
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.User, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: login and email are lowered and the password is hashed",
+			params: model.RegisterParams{Login: " Yuri.G ", Email: "Yuri@Vostok.space", Password: "poyekhali"},
+			setup: func(d deps) {
+				d.users.
+					On("Create", mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+						return u.Login == "yuri.g" && u.Email == "yuri@vostok.space" &&
+							bcrypt.CompareHashAndPassword(u.PasswordHash, []byte("poyekhali")) == nil
+					})).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, "yuri.g", res.Login)
+				assert.Equal(t, model.RoleCustomer, res.Role)
+			},
+		},
+		{
+			name:   "validation error: every problem is reported",
+			params: model.RegisterParams{Login: "yu", Email: "not an email", Password: "short"},
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "login must be 3 to 32 letters, digits, dots, dashes and underscores; "+
+					"email must be a valid address; password must be 8 to 72 bytes")
+				assert.Nil(t, res)
+				d.users.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "already exists: login or email is taken",
+			params: model.RegisterParams{Login: "yuri", Email: "yuri@vostok.space", Password: "poyekhali"},
+			setup: func(d deps) {
+				d.users.
+					On("Create", mock.Anything, mock.Anything).
+					Return(model.ErrUserExists).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrUserExists)
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			res, err := newSvc(d).Register(context.Background(), tt.params)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceSetRole(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	user := &model.User{ID: uuid.New(), Login: "valentina", Role: model.RoleCustomer}
+
+	type testCase struct {
+		name   string
+		role   model.Role
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.User, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name: "success: the role is stored",
+			role: model.RoleSupport,
+			setup: func(d deps) {
+				d.users.
+					On("UserByLogin", mock.Anything, "valentina").
+					Return(&model.User{ID: user.ID, Login: user.Login, Role: user.Role}, nil).
+					Once()
+				d.users.
+					On("SetRole", mock.Anything, user.ID, model.RoleSupport).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, model.RoleSupport, res.Role)
+			},
+		},
+		{
+			name: "validation error: unknown role",
+			role: "root",
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.Nil(t, res)
+				d.users.AssertNotCalled(t, "SetRole", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "not found: unknown login",
+			role: model.RoleAdmin,
+			setup: func(d deps) {
+				d.users.
+					On("UserByLogin", mock.Anything, "valentina").
+					Return(nil, model.ErrUserNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.User, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrUserNotFound)
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			res, err := newSvc(d).SetRole(context.Background(), " Valentina ", tt.role)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceLogin(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("poyekhali"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := &model.User{ID: uuid.New(), Login: "yuri", Email: "yuri@vostok.space", PasswordHash: hash}
+
+	type testCase struct {
+		name   string
+		params model.LoginParams
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.SessionTokens, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: a session is created with hashed tokens",
+			params: model.LoginParams{Login: "Yuri", Password: "poyekhali"},
+			setup: func(d deps) {
+				d.users.
+					On("UserByLogin", mock.Anything, "yuri").
+					Return(user, nil).
+					Once()
+				d.sessions.
+					On("Create", mock.Anything, mock.AnythingOfType("*model.Session")).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.SessionTokens, err error, d deps) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				assert.NotEqual(t, res.SessionToken, res.RefreshToken)
+				assert.Equal(t, hashToken(res.SessionToken), res.Session.TokenHash)
+				assert.Equal(t, hashToken(res.RefreshToken), res.Session.RefreshHash)
+				assert.Equal(t, user.ID, res.Session.UserID)
+				assert.Equal(t, sessionTTL, res.Session.ExpiresAt.Sub(res.Session.CreatedAt))
+				assert.Equal(t, refreshTTL, res.Session.RefreshExpiresAt.Sub(res.Session.CreatedAt))
+			},
+		},
+		{
+			name:   "invalid credentials: wrong password",
+			params: model.LoginParams{Login: "yuri", Password: "wrong password"},
+			setup: func(d deps) {
+				d.users.
+					On("UserByLogin", mock.Anything, "yuri").
+					Return(user, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.SessionTokens, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidCredentials)
+				d.sessions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "invalid credentials: unknown login",
+			params: model.LoginParams{Login: "gherman", Password: "poyekhali"},
+			setup: func(d deps) {
+				d.users.
+					On("UserByLogin", mock.Anything, "gherman").
+					Return((*model.User)(nil), model.ErrUserNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.SessionTokens, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidCredentials)
+				assert.NotErrorIs(t, err, model.ErrUserNotFound)
+			},
+		},
+		{
+			name:   "validation error: empty password",
+			params: model.LoginParams{Login: "yuri"},
+			assert: func(t *testing.T, res *model.SessionTokens, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				d.users.AssertNotCalled(t, "UserByLogin", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			res, err := newSvc(d).Login(context.Background(), tt.params)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceValidateSession(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	token := gofakeit.UUID()
+	user := &model.User{ID: uuid.New(), Login: "yuri"}
+	active := func() *model.Session {
+		return &model.Session{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			TokenHash: hashToken(token),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+	}
+	revokedAt := time.Now().Add(-time.Second)
+
+	type testCase struct {
+		name    string
+		session func() *model.Session
+		wantErr error
+	}
+
+	tests := []testCase{
+		{name: "success: active session", session: active},
+		{
+			name: "invalid token: expired session",
+			session: func() *model.Session {
+				s := active()
+				s.ExpiresAt = time.Now().Add(-time.Second)
+				return s
+			},
+			wantErr: model.ErrInvalidToken,
+		},
+		{
+			name: "invalid token: revoked session",
+			session: func() *model.Session {
+				s := active()
+				s.RevokedAt = &revokedAt
+				return s
+			},
+			wantErr: model.ErrInvalidToken,
+		},
+		{name: "invalid token: unknown token", wantErr: model.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.session == nil {
+				d.sessions.
+					On("SessionByTokenHash", mock.Anything, hashToken(token)).
+					Return((*model.Session)(nil), model.ErrSessionNotFound).
+					Once()
+			} else {
+				d.sessions.
+					On("SessionByTokenHash", mock.Anything, hashToken(token)).
+					Return(tt.session(), nil).
+					Once()
+			}
+			if tt.wantErr == nil {
+				d.users.
+					On("UserByID", mock.Anything, user.ID).
+					Return(user, nil).
+					Once()
+			}
+
+			res, err := newSvc(d).ValidateSession(context.Background(), token)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, res)
+				d.users.AssertNotCalled(t, "UserByID", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, *user, res.User)
+		})
+	}
+}
+
+func TestServiceRefreshSession(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	refresh := gofakeit.UUID()
+	replaced := gofakeit.UUID()
+	session := func() *model.Session {
+		return &model.Session{
+			ID:                  uuid.New(),
+			UserID:              uuid.New(),
+			RefreshHash:         hashToken(refresh),
+			PreviousRefreshHash: hashToken(replaced),
+			RefreshExpiresAt:    time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("success: tokens are rotated", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		s := session()
+		d.sessions.
+			On("SessionByRefreshHash", mock.Anything, hashToken(refresh)).
+			Return(s, nil).
+			Once()
+		d.sessions.
+			On("Rotate", mock.Anything, mock.MatchedBy(func(n *model.Session) bool {
+				return n.ID == s.ID && string(n.PreviousRefreshHash) == string(hashToken(refresh))
+			}), hashToken(refresh)).
+			Return(nil).
+			Once()
+
+		res, err := newSvc(d).RefreshSession(context.Background(), refresh)
+		require.NoError(t, err)
+		assert.NotEqual(t, refresh, res.RefreshToken)
+		assert.Equal(t, hashToken(res.RefreshToken), res.Session.RefreshHash)
+		assert.Equal(t, hashToken(res.SessionToken), res.Session.TokenHash)
+	})
+
+	t.Run("invalid token: a replaced refresh token revokes the session", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		s := session()
+		d.sessions.
+			On("SessionByRefreshHash", mock.Anything, hashToken(replaced)).
+			Return(s, nil).
+			Once()
+		d.sessions.
+			On("Revoke", mock.Anything, s.ID, mock.AnythingOfType("time.Time")).
+			Return(nil).
+			Once()
+
+		res, err := newSvc(d).RefreshSession(context.Background(), replaced)
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+		assert.Nil(t, res)
+		d.sessions.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid token: expired refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		s := session()
+		s.RefreshExpiresAt = time.Now().Add(-time.Second)
+		d.sessions.
+			On("SessionByRefreshHash", mock.Anything, hashToken(refresh)).
+			Return(s, nil).
+			Once()
+
+		_, err := newSvc(d).RefreshSession(context.Background(), refresh)
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+		d.sessions.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid token: a concurrent refresh won", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sessions.
+			On("SessionByRefreshHash", mock.Anything, hashToken(refresh)).
+			Return(session(), nil).
+			Once()
+		d.sessions.
+			On("Rotate", mock.Anything, mock.Anything, hashToken(refresh)).
+			Return(model.ErrSessionNotFound).
+			Once()
+
+		_, err := newSvc(d).RefreshSession(context.Background(), refresh)
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+	})
+}
+
+func TestServiceRevokeSession(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	token := gofakeit.UUID()
+
+	t.Run("success: the session is revoked", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		id := uuid.New()
+		d.sessions.
+			On("SessionByTokenHash", mock.Anything, hashToken(token)).
+			Return(&model.Session{ID: id}, nil).
+			Once()
+		d.sessions.
+			On("Revoke", mock.Anything, id, mock.AnythingOfType("time.Time")).
+			Return(nil).
+			Once()
+
+		require.NoError(t, newSvc(d).RevokeSession(context.Background(), token))
+	})
+
+	t.Run("success: a revoked session stays as it is", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		revokedAt := time.Now().Add(-time.Hour)
+		d.sessions.
+			On("SessionByTokenHash", mock.Anything, hashToken(token)).
+			Return(&model.Session{ID: uuid.New(), RevokedAt: &revokedAt}, nil).
+			Once()
+
+		require.NoError(t, newSvc(d).RevokeSession(context.Background(), token))
+		d.sessions.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error: lookup fails", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sessions.
+			On("SessionByTokenHash", mock.Anything, hashToken(token)).
+			Return((*model.Session)(nil), errors.New("db is down")).
+			Once()
+
+		err := newSvc(d).RevokeSession(context.Background(), token)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, model.ErrInvalidToken)
+	})
+}
+
+func TestServiceIssueLinkToken(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	token := gofakeit.UUID()
+	userID := uuid.New()
+
+	t.Run("success: a link token of the session user is stored by its hash", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sessions.
+			On("SessionByTokenHash", mock.Anything, hashToken(token)).
+			Return(&model.Session{ID: uuid.New(), UserID: userID, ExpiresAt: time.Now().Add(time.Minute)}, nil).
+			Once()
+
+		var stored *model.LinkToken
+		d.linkTokens.
+			On("Create", mock.Anything, mock.AnythingOfType("*model.LinkToken")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*model.LinkToken) }).
+			Return(nil).
+			Once()
+
+		res, err := newSvc(d).IssueLinkToken(context.Background(), token)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Token)
+		assert.Equal(t, hashToken(res.Token), stored.TokenHash)
+		assert.Equal(t, userID, stored.UserID)
+		assert.Equal(t, stored.ExpiresAt, res.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(linkTokenTTL), res.ExpiresAt, time.Minute)
+	})
+
+	t.Run("invalid token: the session expired", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sessions.
+			On("SessionByTokenHash", mock.Anything, hashToken(token)).
+			Return(&model.Session{ID: uuid.New(), UserID: userID, ExpiresAt: time.Now().Add(-time.Minute)}, nil).
+			Once()
+
+		_, err := newSvc(d).IssueLinkToken(context.Background(), token)
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+		d.linkTokens.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid token: no session token", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		_, err := newSvc(d).IssueLinkToken(context.Background(), "")
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+	})
+}
+
+func TestServiceRedeemLinkToken(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	linkToken := gofakeit.LetterN(26)
+	userID := uuid.New()
+
+	t.Run("success: a session of the user is created", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.linkTokens.
+			On("Redeem", mock.Anything, hashToken(linkToken), mock.AnythingOfType("time.Time")).
+			Return(userID, nil).
+			Once()
+		d.sessions.
+			On("Create", mock.Anything, mock.MatchedBy(func(s *model.Session) bool {
+				return s.UserID == userID
+			})).
+			Return(nil).
+			Once()
+
+		tokens, err := newSvc(d).RedeemLinkToken(context.Background(), linkToken)
+		require.NoError(t, err)
+		assert.Equal(t, userID, tokens.Session.UserID)
+		assert.Equal(t, hashToken(tokens.SessionToken), tokens.Session.TokenHash)
+		assert.Equal(t, hashToken(tokens.RefreshToken), tokens.Session.RefreshHash)
+	})
+
+	t.Run("invalid token: the token is unknown, expired or redeemed", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.linkTokens.
+			On("Redeem", mock.Anything, hashToken(linkToken), mock.AnythingOfType("time.Time")).
+			Return(uuid.Nil, model.ErrLinkTokenNotFound).
+			Once()
+
+		_, err := newSvc(d).RedeemLinkToken(context.Background(), linkToken)
+		require.ErrorIs(t, err, model.ErrInvalidToken)
+		d.sessions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error: the session cannot be created", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.linkTokens.
+			On("Redeem", mock.Anything, hashToken(linkToken), mock.AnythingOfType("time.Time")).
+			Return(userID, nil).
+			Once()
+		d.sessions.
+			On("Create", mock.Anything, mock.Anything).
+			Return(errors.New("db is down")).
+			Once()
+
+		_, err := newSvc(d).RedeemLinkToken(context.Background(), linkToken)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, model.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// dummyHash is compared with the password of an unknown login, so a login
+// takes as long whether or not the user exists.
+var dummyHash = sync.OnceValue(func() []byte {
+	h, _ := bcrypt.GenerateFromPassword([]byte("not a password of anyone"), bcrypt.DefaultCost)
+	return h
+})
+
+// Login checks the password of the user with the login or email and
+// creates a session.
+func (s *service) Login(ctx context.Context, params model.LoginParams) (*model.SessionTokens, error) {
+	const op = "iam.service.Login"
+
+	params.Login = strings.ToLower(strings.TrimSpace(params.Login))
+	log := logger.With(
+		logger.String("login", params.Login),
+	)
+
+	if params.Login == "" || params.Password == "" {
+		log.Error(ctx, "validation", logger.String("problems", "login and password must be non-empty"))
+		return nil, fmt.Errorf("%s: %w", op,
+			errors.Join(model.ErrInvalidArgument, errors.New("login and password must be non-empty")))
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	u, err := s.users.UserByLogin(readCtx, params.Login)
+	switch {
+	case errors.Is(err, model.ErrUserNotFound):
+		_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(params.Password))
+		log.Info(ctx, "login: unknown user")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidCredentials)
+	case err != nil:
+		log.Error(ctx, "repository user by login", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(u.PasswordHash, []byte(params.Password)); err != nil {
+		log.Info(ctx, "login: wrong password", logger.String("user_id", u.ID.String()))
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidCredentials)
+	}
+
+	now := time.Now()
+	tokens := s.newTokens(model.Session{UserID: u.ID, CreatedAt: now}, now)
+
+	writeCtx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.sessions.Create(writeCtx, &tokens.Session); err != nil {
+		log.Error(ctx, "repository create session", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// ValidateSession returns the user and the session of an active session token.
+func (s *service) ValidateSession(ctx context.Context, token string) (*model.Identity, error) {
+	const op = "iam.service.ValidateSession"
+
+	if token == "" {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	sess, err := s.sessionByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if sess.RevokedAt != nil || !time.Now().Before(sess.ExpiresAt) {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	u, err := s.users.UserByID(ctx, sess.UserID)
+	if err != nil {
+		logger.Error(ctx, "repository user by id",
+			logger.String("user_id", sess.UserID.String()),
+			logger.ErrorF(err),
+		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &model.Identity{User: *u, Session: *sess}, nil
+}
+
+// RefreshSession exchanges a refresh token for new tokens of its session.
+// A refresh token that was already exchanged revokes the session, since
+// either the client or someone who stole the token is replaying it.
+func (s *service) RefreshSession(ctx context.Context, refreshToken string) (*model.SessionTokens, error) {
+	const op = "iam.service.RefreshSession"
+
+	if refreshToken == "" {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+	hash := hashToken(refreshToken)
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	sess, err := s.sessions.SessionByRefreshHash(ctx, hash)
+	switch {
+	case errors.Is(err, model.ErrSessionNotFound):
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	case err != nil:
+		logger.Error(ctx, "repository session by refresh hash", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log := logger.With(
+		logger.String("session_id", sess.ID.String()),
+		logger.String("user_id", sess.UserID.String()),
+	)
+
+	now := time.Now()
+	if sess.RevokedAt != nil || !now.Before(sess.RefreshExpiresAt) {
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+	if !bytes.Equal(sess.RefreshHash, hash) {
+		log.Warn(ctx, "refresh token reused, revoking the session")
+		if err := s.sessions.Revoke(ctx, sess.ID, now); err != nil {
+			log.Error(ctx, "repository revoke session", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	tokens := s.newTokens(*sess, now)
+	tokens.Session.PreviousRefreshHash = hash
+	if err := s.sessions.Rotate(ctx, &tokens.Session, hash); err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			// A concurrent refresh or revocation won.
+			return nil, fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+		}
+		log.Error(ctx, "repository rotate session", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeSession ends the session of a session token, even an expired one.
+func (s *service) RevokeSession(ctx context.Context, token string) error {
+	const op = "iam.service.RevokeSession"
+
+	if token == "" {
+		return fmt.Errorf("%s: %w", op, model.ErrInvalidToken)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	sess, err := s.sessionByToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sess.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.sessions.Revoke(ctx, sess.ID, time.Now()); err != nil {
+		logger.Error(ctx, "repository revoke session",
+			logger.String("session_id", sess.ID.String()),
+			logger.ErrorF(err),
+		)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// sessionByToken returns the session of a session token; an unknown token
+// is ErrInvalidToken.
+func (s *service) sessionByToken(ctx context.Context, token string) (*model.Session, error) {
+	sess, err := s.sessions.SessionByTokenHash(ctx, hashToken(token))
+	switch {
+	case errors.Is(err, model.ErrSessionNotFound):
+		return nil, model.ErrInvalidToken
+	case err != nil:
+		logger.Error(ctx, "repository session by token hash", logger.ErrorF(err))
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+// newTokens returns new tokens for the session that are valid from now.
+func (s *service) newTokens(sess model.Session, now time.Time) *model.SessionTokens {
+	sessionToken, refreshToken := rand.Text(), rand.Text()
+
+	sess.TokenHash = hashToken(sessionToken)
+	sess.RefreshHash = hashToken(refreshToken)
+	sess.ExpiresAt = now.Add(s.sessionTTL)
+	sess.RefreshExpiresAt = now.Add(s.refreshTTL)
+
+	return &model.SessionTokens{
+		SessionToken: sessionToken,
+		RefreshToken: refreshToken,
+		Session:      sess,
+	}
+}
+
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
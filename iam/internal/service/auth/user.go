@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	minPasswordLen = 8
+	// bcrypt reads at most 72 bytes of a password.
+	maxPasswordLen = 72
+)
+
+var loginPattern = regexp.MustCompile(`^[a-z0-9._-]{3,32}$`)
+
+// Register creates a user. Login and email are stored in lower case.
+func (s *service) Register(ctx context.Context, params model.RegisterParams) (*model.User, error) {
+	const op = "iam.service.Register"
+
+	params.Login = strings.ToLower(strings.TrimSpace(params.Login))
+	params.Email = strings.ToLower(strings.TrimSpace(params.Email))
+	log := logger.With(
+		logger.String("login", params.Login),
+	)
+
+	if err := validateRegister(params); err != nil {
+		log.Error(ctx, "validation", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error(ctx, "hash password", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: hash password: %w", op, err)
+	}
+
+	u := &model.User{
+		Login:        params.Login,
+		Email:        params.Email,
+		PasswordHash: hash,
+		Role:         model.RoleCustomer,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.users.Create(ctx, u); err != nil {
+		log.Error(ctx, "repository create user", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return u, nil
+}
+
+func validateRegister(params model.RegisterParams) error {
+	var problems []string
+	if !loginPattern.MatchString(params.Login) {
+		problems = append(problems, "login must be 3 to 32 letters, digits, dots, dashes and underscores")
+	}
+	if addr, err := mail.ParseAddress(params.Email); err != nil || addr.Address != params.Email {
+		problems = append(problems, "email must be a valid address")
+	}
+	if n := len(params.Password); n < minPasswordLen || n > maxPasswordLen {
+		problems = append(problems, fmt.Sprintf("password must be %d to %d bytes", minPasswordLen, maxPasswordLen))
+	}
+	if len(problems) > 0 {
+		return errors.Join(model.ErrInvalidArgument, errors.New(strings.Join(problems, "; ")))
+	}
+
+	return nil
+}
+
+// SetRole changes the role of the user whose login or email is login.
+func (s *service) SetRole(ctx context.Context, login string, role model.Role) (*model.User, error) {
+	const op = "iam.service.SetRole"
+
+	login = strings.ToLower(strings.TrimSpace(login))
+	log := logger.With(
+		logger.String("login", login),
+		logger.String("role", string(role)),
+	)
+
+	if !role.Valid() {
+		return nil, fmt.Errorf("%s: %w: unknown role %q", op, model.ErrInvalidArgument, role)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	u, err := s.users.UserByLogin(ctx, login)
+	if err != nil {
+		log.Error(ctx, "repository user by login", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.users.SetRole(ctx, u.ID, role); err != nil {
+		log.Error(ctx, "repository set role", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	u.Role = role
+
+	log.Info(ctx, "role changed")
+
+	return u, nil
+}
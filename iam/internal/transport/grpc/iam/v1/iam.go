@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/iam/internal/converter"
+	"github.com/you-humble/rocket-maintenance/iam/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+type AuthService interface {
+	Register(ctx context.Context, params model.RegisterParams) (*model.User, error)
+	Login(ctx context.Context, params model.LoginParams) (*model.SessionTokens, error)
+	ValidateSession(ctx context.Context, token string) (*model.Identity, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*model.SessionTokens, error)
+	RevokeSession(ctx context.Context, token string) error
+	IssueLinkToken(ctx context.Context, sessionToken string) (*model.IssuedLinkToken, error)
+	RedeemLinkToken(ctx context.Context, linkToken string) (*model.SessionTokens, error)
+}
+
+type handler struct {
+	iampbv1.UnimplementedIAMServiceServer
+	svc AuthService
+}
+
+func NewIAMHandler(service AuthService) *handler {
+	return &handler{svc: service}
+}
+
+func (h *handler) Register(
+	ctx context.Context,
+	req *iampbv1.RegisterRequest,
+) (*iampbv1.RegisterResponse, error) {
+	u, err := h.svc.Register(ctx, converter.RegisterRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.RegisterResponse{User: converter.UserFromModel(u)}, nil
+}
+
+func (h *handler) Login(
+	ctx context.Context,
+	req *iampbv1.LoginRequest,
+) (*iampbv1.LoginResponse, error) {
+	tokens, err := h.svc.Login(ctx, converter.LoginRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.LoginResponse{Tokens: converter.SessionTokensFromModel(tokens)}, nil
+}
+
+func (h *handler) ValidateSession(
+	ctx context.Context,
+	req *iampbv1.ValidateSessionRequest,
+) (*iampbv1.ValidateSessionResponse, error) {
+	id, err := h.svc.ValidateSession(ctx, req.GetSessionToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.ValidateSessionResponse{
+		User:    converter.UserFromModel(&id.User),
+		Session: converter.SessionFromModel(&id.Session),
+	}, nil
+}
+
+func (h *handler) RefreshSession(
+	ctx context.Context,
+	req *iampbv1.RefreshSessionRequest,
+) (*iampbv1.RefreshSessionResponse, error) {
+	tokens, err := h.svc.RefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.RefreshSessionResponse{Tokens: converter.SessionTokensFromModel(tokens)}, nil
+}
+
+func (h *handler) RevokeSession(
+	ctx context.Context,
+	req *iampbv1.RevokeSessionRequest,
+) (*iampbv1.RevokeSessionResponse, error) {
+	if err := h.svc.RevokeSession(ctx, req.GetSessionToken()); err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.RevokeSessionResponse{}, nil
+}
+
+func (h *handler) IssueLinkToken(
+	ctx context.Context,
+	req *iampbv1.IssueLinkTokenRequest,
+) (*iampbv1.IssueLinkTokenResponse, error) {
+	lt, err := h.svc.IssueLinkToken(ctx, req.GetSessionToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return converter.IssuedLinkTokenFromModel(lt), nil
+}
+
+func (h *handler) RedeemLinkToken(
+	ctx context.Context,
+	req *iampbv1.RedeemLinkTokenRequest,
+) (*iampbv1.RedeemLinkTokenResponse, error) {
+	tokens, err := h.svc.RedeemLinkToken(ctx, req.GetLinkToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &iampbv1.RedeemLinkTokenResponse{Tokens: converter.SessionTokensFromModel(tokens)}, nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "canceled")
+	case errors.Is(err, model.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrUserExists):
+		return status.Error(codes.AlreadyExists, "login or email is taken")
+	case errors.Is(err, model.ErrInvalidCredentials):
+		return status.Error(codes.Unauthenticated, "invalid login or password")
+	case errors.Is(err, model.ErrInvalidToken):
+		return status.Error(codes.Unauthenticated, "invalid token")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
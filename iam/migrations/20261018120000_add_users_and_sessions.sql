@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS users (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    login text NOT NULL,
+    email text NOT NULL,
+    password_hash bytea NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT users_login_unique UNIQUE (login),
+    CONSTRAINT users_email_unique UNIQUE (email)
+);
+
+CREATE TABLE IF NOT EXISTS sessions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_hash bytea NOT NULL,
+    refresh_hash bytea NOT NULL,
+    previous_refresh_hash bytea NULL,
+    expires_at timestamptz NOT NULL,
+    refresh_expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    revoked_at timestamptz NULL,
+
+    CONSTRAINT sessions_token_hash_unique UNIQUE (token_hash),
+    CONSTRAINT sessions_refresh_hash_unique UNIQUE (refresh_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_previous_refresh_hash ON sessions (previous_refresh_hash)
+    WHERE previous_refresh_hash IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sessions;
+DROP TABLE IF EXISTS users;
+-- +goose StatementEnd
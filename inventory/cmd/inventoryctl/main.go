@@ -0,0 +1,256 @@
+// Command inventoryctl imports parts into the inventory catalog and exports
+// them as JSON Lines or CSV. It connects to the inventory Mongo with the
+// same environment as the service.
+//
+//	inventoryctl [-env FILE] [-timeout D] import [-format F] [-dry-run] FILE|-
+//	inventoryctl [-env FILE] [-timeout D] export [-format F] [-include-archived] [-o FILE]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/catalog"
+	envconfig "github.com/you-humble/rocket-maintenance/inventory/internal/config/env"
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	repository "github.com/you-humble/rocket-maintenance/inventory/internal/repository/part"
+	warehouserepo "github.com/you-humble/rocket-maintenance/inventory/internal/repository/warehouse"
+	service "github.com/you-humble/rocket-maintenance/inventory/internal/service/part"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const usage = `usage: inventoryctl [-env FILE] [-timeout D] <command> [flags]
+
+commands:
+  import [-format jsonl|csv] [-dry-run] FILE|-   upsert parts by uuid
+  export [-format jsonl|csv] [-include-archived] [-o FILE]
+`
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	fs := flag.NewFlagSet("inventoryctl", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	envFile := fs.String("env", "", "load environment variables from `FILE`")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of a single database call")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	if *envFile != "" {
+		if err := godotenv.Load(*envFile); err != nil {
+			fmt.Fprintf(os.Stderr, "load %s: %v\n", *envFile, err)
+			return 1
+		}
+	}
+
+	// The service logs to stdout, which an export may be written to.
+	logger.SetNopLogger()
+
+	ctx, quit := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer quit()
+
+	switch cmd, cmdArgs := fs.Arg(0), fs.Args()[1:]; cmd {
+	case "import":
+		return runImport(ctx, *timeout, cmdArgs)
+	case "export":
+		return runExport(ctx, *timeout, cmdArgs)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", cmd)
+		fs.Usage()
+		return 2
+	}
+}
+
+func runImport(ctx context.Context, timeout time.Duration, args []string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := fs.String("format", "", "file format, jsonl or csv; by default the file extension decides")
+	dryRun := fs.Bool("dry-run", false, "validate the file without writing")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "import: want exactly one FILE or - for stdin")
+		return 2
+	}
+
+	path := fs.Arg(0)
+	f, err := fileFormat(*format, path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 2
+	}
+
+	in := io.Reader(os.Stdin)
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "import: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		in = file
+	}
+
+	rows, rowErrs, err := catalog.Read(f, in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	if len(rowErrs) > 0 {
+		printReport(&model.ImportReport{Rows: len(rows) + len(rowErrs), Errors: rowErrs}, false)
+		fmt.Fprintln(os.Stderr, "import: the file has malformed rows, nothing was written")
+		return 1
+	}
+
+	svc, disconnect, err := connect(ctx, timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+	defer disconnect()
+
+	report, err := svc.ImportParts(ctx, rows, *dryRun)
+	if report != nil {
+		printReport(report, *dryRun)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func runExport(ctx context.Context, timeout time.Duration, args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := fs.String("format", "", "file format, jsonl or csv; by default the -o extension decides")
+	includeArchived := fs.Bool("include-archived", true, "export archived parts as well")
+	output := fs.String("o", "-", "write to `FILE` instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 0 {
+		fmt.Fprintln(os.Stderr, "export: unexpected arguments")
+		return 2
+	}
+
+	f, err := fileFormat(*format, *output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 2
+	}
+
+	svc, disconnect, err := connect(ctx, timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	defer disconnect()
+
+	out := io.Writer(os.Stdout)
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "export: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		out = file
+	}
+
+	w := catalog.NewWriter(f, out)
+	n := 0
+	err = svc.ExportParts(ctx, model.PartsFilter{IncludeArchived: *includeArchived}, func(p *model.Part) error {
+		n++
+		return w.Write(p)
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "exported %d parts\n", n)
+
+	return 0
+}
+
+func fileFormat(flagValue, path string) (catalog.Format, error) {
+	if flagValue != "" {
+		return catalog.ParseFormat(flagValue)
+	}
+	return catalog.FormatFromPath(path), nil
+}
+
+type catalogService interface {
+	ImportParts(ctx context.Context, rows []model.ImportRow, dryRun bool) (*model.ImportReport, error)
+	ExportParts(ctx context.Context, filter model.PartsFilter, fn func(*model.Part) error) error
+}
+
+func connect(ctx context.Context, timeout time.Duration) (catalogService, func(), error) {
+	cfg, err := envconfig.NewMongoConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("mongo config: %w", err)
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.DSN()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to mongo: %w", err)
+	}
+	disconnect := func() {
+		dctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_ = client.Disconnect(dctx)
+	}
+
+	pctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := client.Ping(pctx, readpref.Primary()); err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("ping mongo: %w", err)
+	}
+
+	db := client.Database(cfg.DatabaseName())
+	repo := repository.NewPartRepository(
+		db.Collection(cfg.PartsCollection()),
+		db.Collection(cfg.PricesCollection()),
+		db.Collection(cfg.ReservationsCollection()),
+	)
+	warehouses := warehouserepo.NewWarehouseRepository(db.Collection(cfg.WarehousesCollection()))
+
+	return service.NewInventoryService(repo, warehouses, timeout, timeout), disconnect, nil
+}
+
+func printReport(r *model.ImportReport, dryRun bool) {
+	for _, e := range r.Errors {
+		fmt.Fprintf(os.Stderr, "line %d: %v\n", e.Line, e.Err)
+	}
+
+	switch {
+	case len(r.Errors) > 0:
+		fmt.Fprintf(os.Stderr, "%d rows, %d invalid\n", r.Rows, len(r.Errors))
+	case dryRun:
+		fmt.Fprintf(os.Stdout, "%d rows are valid, nothing was written (dry run)\n", r.Rows)
+	default:
+		fmt.Fprintf(os.Stdout, "%d rows: %d created, %d updated\n", r.Rows, r.Created, r.Updated)
+	}
+}
@@ -4,19 +4,20 @@ import (
 	"context"
 	"errors"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/you-humble/rocket-maintenance/inventory/internal/config"
-	repository "github.com/you-humble/rocket-maintenance/inventory/internal/repository/part"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	grpcserver "github.com/you-humble/rocket-maintenance/platform/grpc/server"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
 type app struct {
 	di       *di
 	listener net.Listener
-	server   *grpc.Server
+	server   *grpcserver.Server
 }
 
 func New(ctx context.Context) (*app, error) {
@@ -39,7 +40,6 @@ func (a *app) init(ctx context.Context) error {
 		a.initDI,
 		a.initListener,
 		a.initServer,
-		a.initPartsData,
 	}
 
 	for _, initFn := range inits {
@@ -71,15 +71,6 @@ func (a *app) initDI(_ context.Context) error {
 	return nil
 }
 
-func (a *app) initPartsData(ctx context.Context) error {
-	err := repository.PartsBootstrap(ctx, a.di.PartsRepository(ctx))
-	if err != nil {
-		logger.Error(ctx, "failed to bootstrap", logger.ErrorF(err))
-		return err
-	}
-	return nil
-}
-
 func (a *app) initListener(ctx context.Context) error {
 	lis, err := net.Listen("tcp", config.C().Server.Address())
 	if err != nil {
@@ -109,6 +100,15 @@ func (a *app) run(ctx context.Context) error {
 
 	errCh := make(chan error)
 
+	relay := a.di.PartChangeRelay(ctx)
+	go func() {
+		logger.Info(ctx,
+			"🚀 part changes relay running",
+			logger.String("topic", config.C().Kafka.InventoryPartsTopic()),
+		)
+		relay.Run(ctx)
+	}()
+
 	go func() {
 		defer close(errCh)
 
@@ -138,8 +138,24 @@ func (a *app) run(ctx context.Context) error {
 }
 
 //nolint:contextcheck
-func gracefulShutdown(ctx context.Context, s *grpc.Server) {
+func gracefulShutdown(ctx context.Context, s *grpcserver.Server) {
 	logger.Info(ctx, "🛑 Shutting down gRPC server...")
-	s.GracefulStop()
+
+	// The server stops first: its calls in flight still use the resources
+	// that the closer releases.
+	if err := s.Shutdown(context.WithoutCancel(ctx)); err != nil {
+		logger.Error(ctx, "❌ Error during server shutdown", logger.ErrorF(err))
+	}
+
+	closeCtx, cancel := context.WithTimeout(
+		context.Background(), // do not inherit cancellation from ctx
+		10*time.Second,
+	)
+	defer cancel()
+
+	if err := closer.CloseAll(closeCtx); err != nil {
+		logger.Error(ctx, "❌ Error during server shutdown", logger.ErrorF(err))
+		return
+	}
 	logger.Info(ctx, "✅ Server stopped")
 }
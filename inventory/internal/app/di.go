@@ -3,38 +3,65 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/IBM/sarama"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 
 	"github.com/you-humble/rocket-maintenance/inventory/internal/config"
+	"github.com/you-humble/rocket-maintenance/inventory/internal/converter"
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
 	repository "github.com/you-humble/rocket-maintenance/inventory/internal/repository/part"
+	warehouserepo "github.com/you-humble/rocket-maintenance/inventory/internal/repository/warehouse"
 	service "github.com/you-humble/rocket-maintenance/inventory/internal/service/part"
+	partproducer "github.com/you-humble/rocket-maintenance/inventory/internal/service/producer/part"
 	"github.com/you-humble/rocket-maintenance/inventory/internal/transport/grpc/interceptors"
 	tgrpc "github.com/you-humble/rocket-maintenance/inventory/internal/transport/grpc/inventory/v1"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
-	"github.com/you-humble/rocket-maintenance/platform/grpc/health"
+	grpcserver "github.com/you-humble/rocket-maintenance/platform/grpc/server"
+	"github.com/you-humble/rocket-maintenance/platform/health"
+	"github.com/you-humble/rocket-maintenance/platform/kafka"
+	"github.com/you-humble/rocket-maintenance/platform/kafka/producer"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
 	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
 )
 
 type PartRepository interface {
 	service.PartRepository
-	repository.BatchCreator
+	partproducer.ChangeRepository
+}
+
+type WarehouseRepository interface {
+	service.WarehouseRepository
+	EnsureDefault(ctx context.Context, createdAt time.Time) error
+}
+
+type PartChangeRelay interface {
+	Run(ctx context.Context)
 }
 
 type di struct {
 	mongo      *mongo.Client
 	collection *mongo.Collection
+	prices     *mongo.Collection
+	warehouses *mongo.Collection
+	reserved   *mongo.Collection
+
+	repository          PartRepository
+	warehouseRepository WarehouseRepository
+	service             tgrpc.InventoryService
+	handler             inventorypbv1.InventoryServiceServer
 
-	repository PartRepository
-	service    tgrpc.InventoryService
-	handler    inventorypbv1.InventoryServiceServer
+	server *grpcserver.Server
+	health *health.Health
 
-	server *grpc.Server
+	syncProducer   sarama.SyncProducer
+	partsProducer  kafka.Producer
+	kafkaConverter partproducer.Converter
+	relay          PartChangeRelay
 }
 
 func NewDI() *di { return &di{} }
@@ -73,14 +100,70 @@ func (d *di) PartsCollection(ctx context.Context) *mongo.Collection {
 		if err := ensurePartIndexes(ctx, d.collection); err != nil {
 			panic(fmt.Sprintf("failed to ensure indexes: %v\n", err))
 		}
+		if err := backfillPartVersions(ctx, d.collection); err != nil {
+			panic(fmt.Sprintf("failed to backfill part versions: %v\n", err))
+		}
+		if err := backfillStockLevels(ctx, d.collection); err != nil {
+			panic(fmt.Sprintf("failed to backfill stock levels: %v\n", err))
+		}
 	}
 
 	return d.collection
 }
 
+func (d *di) PricesCollection(ctx context.Context) *mongo.Collection {
+	if d.prices == nil {
+		d.prices = d.MongoDB(ctx).
+			Database(config.C().Mongo.DatabaseName()).
+			Collection(config.C().Mongo.PricesCollection())
+
+		if err := ensurePriceIndexes(ctx, d.prices); err != nil {
+			panic(fmt.Sprintf("failed to ensure price indexes: %v\n", err))
+		}
+	}
+
+	return d.prices
+}
+
+func (d *di) WarehousesCollection(ctx context.Context) *mongo.Collection {
+	if d.warehouses == nil {
+		d.warehouses = d.MongoDB(ctx).
+			Database(config.C().Mongo.DatabaseName()).
+			Collection(config.C().Mongo.WarehousesCollection())
+	}
+
+	return d.warehouses
+}
+
+func (d *di) ReservationsCollection(ctx context.Context) *mongo.Collection {
+	if d.reserved == nil {
+		d.reserved = d.MongoDB(ctx).
+			Database(config.C().Mongo.DatabaseName()).
+			Collection(config.C().Mongo.ReservationsCollection())
+	}
+
+	return d.reserved
+}
+
+func (d *di) WarehouseRepository(ctx context.Context) WarehouseRepository {
+	if d.warehouseRepository == nil {
+		d.warehouseRepository = warehouserepo.NewWarehouseRepository(d.WarehousesCollection(ctx))
+
+		if err := d.warehouseRepository.EnsureDefault(ctx, time.Now()); err != nil {
+			panic(fmt.Sprintf("failed to ensure the default warehouse: %v\n", err))
+		}
+	}
+
+	return d.warehouseRepository
+}
+
 func (d *di) PartsRepository(ctx context.Context) PartRepository {
 	if d.repository == nil {
-		d.repository = repository.NewPartRepository(d.PartsCollection(ctx))
+		d.repository = repository.NewPartRepository(
+			d.PartsCollection(ctx),
+			d.PricesCollection(ctx),
+			d.ReservationsCollection(ctx),
+		)
 	}
 
 	return d.repository
@@ -90,7 +173,9 @@ func (d *di) InventoryService(ctx context.Context) tgrpc.InventoryService {
 	if d.service == nil {
 		d.service = service.NewInventoryService(
 			d.PartsRepository(ctx),
+			d.WarehouseRepository(ctx),
 			config.C().Server.BDEReadTimeout(),
+			config.C().Server.BDEWriteTimeout(),
 		)
 	}
 
@@ -105,19 +190,94 @@ func (d *di) InventoryHandler(ctx context.Context) inventorypbv1.InventoryServic
 	return d.handler
 }
 
-func (d *di) Server(ctx context.Context) *grpc.Server {
+func (d *di) Server(ctx context.Context) *grpcserver.Server {
 	if d.server == nil {
-		d.server = grpc.NewServer(
-			grpc.UnaryInterceptor(interceptors.UnaryLogging()),
+		srv, err := grpcserver.New("gRPC server").
+			WithValidation().
+			WithHealth(d.Health(ctx)).
+			WithReflection().
+			WithTLS(config.C().Server.TLS()).
+			WithStreamInterceptors(interceptors.StreamShutdown(ctx)).
+			Build()
+		if err != nil {
+			panic(fmt.Sprintf("failed to build grpc server: %v\n", err))
+		}
+		inventorypbv1.RegisterInventoryServiceServer(srv, d.InventoryHandler(ctx))
+
+		d.server = srv
+	}
+
+	return d.server
+}
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		cfg := config.C()
+
+		d.health = health.New()
+		d.health.Register("mongo", health.Mongo(d.MongoDB(ctx)))
+		d.health.Register("kafka", health.Kafka(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.InventoryPartsProducerConfig(),
+		))
+	}
+
+	return d.health
+}
+
+func (d *di) SyncProducer(ctx context.Context) sarama.SyncProducer {
+	if d.syncProducer == nil {
+		cfg := config.C()
+
+		p, err := sarama.NewSyncProducer(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.InventoryPartsProducerConfig(),
 		)
-		inventorypbv1.RegisterInventoryServiceServer(d.server, d.InventoryHandler(ctx))
+		if err != nil {
+			panic(fmt.Sprintf("failed to create kafka sync producer: %v\n", err))
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
 
-		reflection.Register(d.server)
+		d.syncProducer = p
+	}
 
-		health.RegisterService(d.server)
+	return d.syncProducer
+}
+
+func (d *di) PartsProducer(ctx context.Context) kafka.Producer {
+	if d.partsProducer == nil {
+		d.partsProducer = producer.NewProducer(
+			d.SyncProducer(ctx),
+			config.C().Kafka.InventoryPartsTopic(),
+			logger.L(),
+		)
 	}
 
-	return d.server
+	return d.partsProducer
+}
+
+func (d *di) KafkaConverter(_ context.Context) partproducer.Converter {
+	if d.kafkaConverter == nil {
+		d.kafkaConverter = converter.NewKafkaConverter()
+	}
+
+	return d.kafkaConverter
+}
+
+func (d *di) PartChangeRelay(ctx context.Context) PartChangeRelay {
+	if d.relay == nil {
+		d.relay = partproducer.NewPartChangeRelay(
+			d.PartsRepository(ctx),
+			d.PartsProducer(ctx),
+			d.KafkaConverter(ctx),
+			config.C().Kafka.PartChangesRelayInterval(),
+			config.C().Kafka.PartChangesRelayBatchSize(),
+		)
+	}
+
+	return d.relay
 }
 
 func ensurePartIndexes(ctx context.Context, coll *mongo.Collection) error {
@@ -126,7 +286,77 @@ func ensurePartIndexes(ctx context.Context, coll *mongo.Collection) error {
 		{Keys: bson.D{{Key: "category", Value: 1}}},
 		{Keys: bson.D{{Key: "manufacturer.country_norm", Value: 1}}},
 		{Keys: bson.D{{Key: "tags", Value: 1}}},
+		// Keyset pagination orders by the sort field and then by _id.
+		{Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "price_cents", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "stock_quantity", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "dimensions.length", Value: 1}}},
+		{Keys: bson.D{{Key: "dimensions.width", Value: 1}}},
+		{Keys: bson.D{{Key: "dimensions.height", Value: 1}}},
+		{Keys: bson.D{{Key: "dimensions.weight", Value: 1}}},
+		{Keys: bson.D{{Key: "metadata.$**", Value: 1}}},
+		// The change relay looks up parts with unpublished changes.
+		{
+			Keys: bson.D{{Key: "outbox.id", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"outbox.id": bson.M{"$exists": true}}),
+		},
+		// A collection has at most one text index; it backs PartsFilter.Query.
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "description", Value: "text"},
+				{Key: "tags", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("parts_text").
+				SetWeights(bson.D{
+					{Key: "name", Value: 10},
+					{Key: "tags", Value: 5},
+					{Key: "description", Value: 1},
+				}),
+		},
 	}, options.CreateIndexes())
 
 	return err
 }
+
+// ensurePriceIndexes also creates the collection, which a transaction that
+// writes to it needs on older servers.
+func ensurePriceIndexes(ctx context.Context, coll *mongo.Collection) error {
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "part_uuid", Value: 1},
+			{Key: "changed_at", Value: 1},
+			{Key: "version", Value: 1},
+		},
+	})
+
+	return err
+}
+
+// backfillPartVersions gives version 1 to the parts stored before versions
+// were introduced, so they can be updated with expected_version 1.
+func backfillPartVersions(ctx context.Context, coll *mongo.Collection) error {
+	_, err := coll.UpdateMany(ctx,
+		bson.M{"version": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"version": 1}},
+	)
+
+	return err
+}
+
+// backfillStockLevels moves the stock of the parts stored before there
+// were warehouses to the default warehouse.
+func backfillStockLevels(ctx context.Context, coll *mongo.Collection) error {
+	_, err := coll.UpdateMany(ctx,
+		bson.M{"stock_levels": bson.M{"$exists": false}, "stock_quantity": bson.M{"$gt": 0}},
+		bson.A{bson.M{"$set": bson.M{"stock_levels": bson.A{bson.M{
+			"warehouse_id": model.DefaultWarehouseID,
+			"quantity":     "$stock_quantity",
+		}}}}},
+	)
+
+	return err
+}
@@ -0,0 +1,278 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+// csvColumns are the columns of a CSV file. Tags are separated by "|",
+// metadata is a JSON object, and compatibility and stock_levels are JSON
+// arrays of rules and of stock levels.
+var csvColumns = []string{
+	"uuid", "name", "description", "price_cents", "stock_quantity", "category",
+	"length", "width", "height", "weight",
+	"manufacturer_name", "manufacturer_country", "manufacturer_website",
+	"tags", "metadata", "compatibility", "stock_levels", "created_at", "updated_at", "archived_at",
+}
+
+var csvRequired = []string{"name", "price_cents", "stock_quantity", "category"}
+
+const tagSeparator = "|"
+
+// ReadCSV reads records from a CSV file with a header line. Columns may be
+// in any order and all but name, price_cents, stock_quantity and category
+// may be left out.
+func ReadCSV(r io.Reader) ([]model.ImportRow, []model.RowError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if !slices.Contains(csvColumns, h) {
+			return nil, nil, fmt.Errorf("unknown csv column %q", h)
+		}
+		index[h] = i
+	}
+	for _, h := range csvRequired {
+		if _, ok := index[h]; !ok {
+			return nil, nil, fmt.Errorf("csv column %q is missing", h)
+		}
+	}
+
+	var (
+		rows    []model.ImportRow
+		rowErrs []model.RowError
+	)
+	for {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if !errors.As(err, &perr) {
+				return nil, nil, fmt.Errorf("read csv: %w", err)
+			}
+			rowErrs = append(rowErrs, model.RowError{Line: perr.StartLine, Err: perr.Err})
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+		if len(fields) != len(header) {
+			rowErrs = append(rowErrs, model.RowError{
+				Line: line,
+				Err:  fmt.Errorf("want %d fields, got %d", len(header), len(fields)),
+			})
+			continue
+		}
+
+		rec, err := csvRecord(func(col string) string {
+			if i, ok := index[col]; ok {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		})
+		if err != nil {
+			rowErrs = append(rowErrs, model.RowError{Line: line, Err: err})
+			continue
+		}
+		rows = append(rows, model.ImportRow{Line: line, Part: rec.ToModel()})
+	}
+
+	return rows, rowErrs, nil
+}
+
+func csvRecord(get func(col string) string) (Record, error) {
+	var problems []string
+	parseInt := func(col string) int64 {
+		v, err := strconv.ParseInt(get(col), 10, 64)
+		if err != nil {
+			problems = append(problems, col+" must be an integer")
+		}
+		return v
+	}
+	parseFloat := func(col string) float64 {
+		if get(col) == "" {
+			return 0
+		}
+		v, err := strconv.ParseFloat(get(col), 64)
+		if err != nil {
+			problems = append(problems, col+" must be a number")
+		}
+		return v
+	}
+	parseTime := func(col string) *time.Time {
+		if get(col) == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, get(col))
+		if err != nil {
+			problems = append(problems, col+" must be an RFC 3339 time")
+			return nil
+		}
+		return &t
+	}
+
+	rec := Record{
+		UUID:          get("uuid"),
+		Name:          get("name"),
+		Description:   get("description"),
+		PriceCents:    parseInt("price_cents"),
+		StockQuantity: parseInt("stock_quantity"),
+		Category:      get("category"),
+		CreatedAt:     parseTime("created_at"),
+		ArchivedAt:    parseTime("archived_at"),
+	}
+	if get("length")+get("width")+get("height")+get("weight") != "" {
+		rec.Dimensions = &Dimensions{
+			Length: parseFloat("length"),
+			Width:  parseFloat("width"),
+			Height: parseFloat("height"),
+			Weight: parseFloat("weight"),
+		}
+	}
+	if get("manufacturer_name")+get("manufacturer_country")+get("manufacturer_website") != "" {
+		rec.Manufacturer = &Manufacturer{
+			Name:    get("manufacturer_name"),
+			Country: get("manufacturer_country"),
+			Website: get("manufacturer_website"),
+		}
+	}
+	if tags := get("tags"); tags != "" {
+		rec.Tags = strings.Split(tags, tagSeparator)
+	}
+	if md := get("metadata"); md != "" {
+		dec := json.NewDecoder(strings.NewReader(md))
+		dec.UseNumber()
+		if err := dec.Decode(&rec.Metadata); err != nil {
+			problems = append(problems, "metadata must be a JSON object")
+		}
+	}
+	if rules := get("compatibility"); rules != "" {
+		dec := json.NewDecoder(strings.NewReader(rules))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec.Compatibility); err != nil {
+			problems = append(problems, "compatibility must be a JSON array of rules")
+		}
+	}
+	if levels := get("stock_levels"); levels != "" {
+		dec := json.NewDecoder(strings.NewReader(levels))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec.StockLevels); err != nil {
+			problems = append(problems, "stock_levels must be a JSON array of stock levels")
+		}
+	}
+
+	if len(problems) > 0 {
+		return Record{}, errors.New(strings.Join(problems, "; "))
+	}
+	return rec, nil
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func NewCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (w *csvWriter) Write(p *model.Part) error {
+	if !w.wroteHeader {
+		if err := w.w.Write(csvColumns); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+
+	rec := RecordFromModel(p)
+	fields := map[string]string{
+		"uuid":           rec.UUID,
+		"name":           rec.Name,
+		"description":    rec.Description,
+		"price_cents":    strconv.FormatInt(rec.PriceCents, 10),
+		"stock_quantity": strconv.FormatInt(rec.StockQuantity, 10),
+		"category":       rec.Category,
+		"tags":           strings.Join(rec.Tags, tagSeparator),
+		"created_at":     formatTime(rec.CreatedAt),
+		"updated_at":     formatTime(rec.UpdatedAt),
+		"archived_at":    formatTime(rec.ArchivedAt),
+	}
+	if d := rec.Dimensions; d != nil {
+		fields["length"] = formatFloat(d.Length)
+		fields["width"] = formatFloat(d.Width)
+		fields["height"] = formatFloat(d.Height)
+		fields["weight"] = formatFloat(d.Weight)
+	}
+	if m := rec.Manufacturer; m != nil {
+		fields["manufacturer_name"] = m.Name
+		fields["manufacturer_country"] = m.Country
+		fields["manufacturer_website"] = m.Website
+	}
+	if len(rec.Metadata) > 0 {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		if err := enc.Encode(rec.Metadata); err != nil {
+			return fmt.Errorf("encode metadata of part %s: %w", rec.UUID, err)
+		}
+		fields["metadata"] = strings.TrimSpace(buf.String())
+	}
+	if len(rec.Compatibility) > 0 {
+		b, err := json.Marshal(rec.Compatibility)
+		if err != nil {
+			return fmt.Errorf("encode compatibility of part %s: %w", rec.UUID, err)
+		}
+		fields["compatibility"] = string(b)
+	}
+	if len(rec.StockLevels) > 0 {
+		b, err := json.Marshal(rec.StockLevels)
+		if err != nil {
+			return fmt.Errorf("encode stock levels of part %s: %w", rec.UUID, err)
+		}
+		fields["stock_levels"] = string(b)
+	}
+
+	row := make([]string, 0, len(csvColumns))
+	for _, col := range csvColumns {
+		row = append(row, fields[col])
+	}
+	return w.w.Write(row)
+}
+
+// Flush writes buffered rows; an export of no parts still gets a header.
+func (w *csvWriter) Flush() error {
+	if !w.wroteHeader {
+		if err := w.w.Write(csvColumns); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
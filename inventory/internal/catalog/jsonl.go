@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+const maxLineSize = 1 << 20
+
+// ReadJSONL reads one record per line; blank lines are skipped. Lines that
+// are not valid records are reported by line number.
+func ReadJSONL(r io.Reader) ([]model.ImportRow, []model.RowError, error) {
+	var (
+		rows    []model.ImportRow
+		rowErrs []model.RowError
+	)
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for line := 1; sc.Scan(); line++ {
+		raw := bytes.TrimSpace(sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		dec.DisallowUnknownFields()
+
+		var rec Record
+		if err := dec.Decode(&rec); err != nil {
+			rowErrs = append(rowErrs, model.RowError{Line: line, Err: fmt.Errorf("malformed record: %w", err)})
+			continue
+		}
+		rows = append(rows, model.ImportRow{Line: line, Part: rec.ToModel()})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, fmt.Errorf("read jsonl: %w", err)
+	}
+
+	return rows, rowErrs, nil
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func NewJSONLWriter(w io.Writer) *jsonlWriter {
+	return &jsonlWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *jsonlWriter) Write(p *model.Part) error {
+	return w.enc.Encode(RecordFromModel(p))
+}
+
+func (w *jsonlWriter) Flush() error { return nil }
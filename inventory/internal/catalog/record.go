@@ -0,0 +1,256 @@
+// Package catalog reads and writes parts as JSON Lines and CSV files.
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+type Format string
+
+const (
+	FormatJSONL Format = "jsonl"
+	FormatCSV   Format = "csv"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJSONL, FormatCSV:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, want jsonl or csv", s)
+	}
+}
+
+// FormatFromPath returns the format of a file by its extension; JSON Lines
+// is the default.
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+	return FormatJSONL
+}
+
+// Record is a part as it is stored in a file. Import ignores updated_at.
+type Record struct {
+	UUID          string         `json:"uuid,omitempty"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description,omitempty"`
+	PriceCents    int64          `json:"price_cents"`
+	StockQuantity int64          `json:"stock_quantity"`
+	Category      string         `json:"category"`
+	Dimensions    *Dimensions    `json:"dimensions,omitempty"`
+	Manufacturer  *Manufacturer  `json:"manufacturer,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+	CreatedAt     *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time     `json:"updated_at,omitempty"`
+	ArchivedAt    *time.Time     `json:"archived_at,omitempty"`
+	Compatibility []Rule         `json:"compatibility,omitempty"`
+	StockLevels   []StockLevel   `json:"stock_levels,omitempty"`
+}
+
+// StockLevel is the stock of a part in one warehouse. When a record has
+// stock levels, stock_quantity is zero or their sum.
+type StockLevel struct {
+	WarehouseID string `json:"warehouse_id"`
+	Quantity    int64  `json:"quantity"`
+}
+
+// Rule is a compatibility rule; kind is requires, conflicts_with or
+// compatible_with and the target is either part_uuid or category.
+type Rule struct {
+	Kind     string `json:"kind"`
+	PartUUID string `json:"part_uuid,omitempty"`
+	Category string `json:"category,omitempty"`
+}
+
+type Dimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Weight float64 `json:"weight"`
+}
+
+type Manufacturer struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	Website string `json:"website,omitempty"`
+}
+
+var categoryNames = map[model.Category]string{
+	model.CategoryEngine:   "ENGINE",
+	model.CategoryFuel:     "FUEL",
+	model.CategoryPorthole: "PORTHOLE",
+	model.CategoryWing:     "WING",
+}
+
+func RecordFromModel(p *model.Part) Record {
+	r := Record{
+		UUID:          p.ID,
+		Name:          p.Name,
+		Description:   p.Description,
+		PriceCents:    p.PriceCents,
+		StockQuantity: p.StockQuantity,
+		Category:      categoryNames[p.Category],
+		Tags:          p.Tags,
+		Metadata:      metadataFromModel(p.Metadata),
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+		ArchivedAt:    p.ArchivedAt,
+	}
+	for _, l := range p.StockLevels {
+		r.StockLevels = append(r.StockLevels, StockLevel{WarehouseID: l.WarehouseID, Quantity: l.Quantity})
+	}
+	for _, rule := range p.Compatibility {
+		r.Compatibility = append(r.Compatibility, Rule{
+			Kind:     string(rule.Kind),
+			PartUUID: rule.Target.PartID,
+			Category: categoryNames[rule.Target.Category],
+		})
+	}
+	if p.Dimensions != nil {
+		r.Dimensions = &Dimensions{
+			Length: p.Dimensions.Length,
+			Width:  p.Dimensions.Width,
+			Height: p.Dimensions.Height,
+			Weight: p.Dimensions.Weight,
+		}
+	}
+	if p.Manufacturer != nil {
+		r.Manufacturer = &Manufacturer{
+			Name:    p.Manufacturer.Name,
+			Country: p.Manufacturer.Country,
+			Website: p.Manufacturer.Website,
+		}
+	}
+	return r
+}
+
+// ToModel converts the record to a part. An unknown category becomes
+// CategoryUnknown, which the import validation rejects.
+func (r Record) ToModel() *model.Part {
+	p := &model.Part{
+		ID:            r.UUID,
+		Name:          r.Name,
+		Description:   r.Description,
+		PriceCents:    r.PriceCents,
+		StockQuantity: r.StockQuantity,
+		Category:      categoryFromName(r.Category),
+		Tags:          r.Tags,
+		Metadata:      metadataToModel(r.Metadata),
+		CreatedAt:     r.CreatedAt,
+		ArchivedAt:    r.ArchivedAt,
+	}
+	for _, l := range r.StockLevels {
+		p.StockLevels = append(p.StockLevels, model.StockLevel{WarehouseID: l.WarehouseID, Quantity: l.Quantity})
+	}
+	for _, rule := range r.Compatibility {
+		target := model.RuleTarget{PartID: rule.PartUUID}
+		if rule.Category != "" {
+			target.Category = categoryFromName(rule.Category)
+		}
+		p.Compatibility = append(p.Compatibility, model.CompatibilityRule{
+			Kind:   model.RuleKind(rule.Kind),
+			Target: target,
+		})
+	}
+	if r.Dimensions != nil {
+		p.Dimensions = &model.Dimensions{
+			Length: r.Dimensions.Length,
+			Width:  r.Dimensions.Width,
+			Height: r.Dimensions.Height,
+			Weight: r.Dimensions.Weight,
+		}
+	}
+	if r.Manufacturer != nil {
+		p.Manufacturer = &model.Manufacturer{
+			Name:    r.Manufacturer.Name,
+			Country: r.Manufacturer.Country,
+			Website: r.Manufacturer.Website,
+		}
+	}
+	return p
+}
+
+func categoryFromName(s string) model.Category {
+	for c, name := range categoryNames {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			return c
+		}
+	}
+	return model.CategoryUnknown
+}
+
+// metadataToModel turns JSON numbers written with a fraction or an exponent
+// into float64 and other numbers into int64, so a float64 value exported
+// as "850.0" keeps its type. Other values are kept for the validation to check.
+func metadataToModel(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+
+	out := make(map[string]any, len(src))
+	for k, v := range src {
+		out[k] = v
+		n, ok := v.(json.Number)
+		if !ok {
+			continue
+		}
+		if strings.ContainsAny(n.String(), ".eE") {
+			if f, err := n.Float64(); err == nil {
+				out[k] = f
+			}
+		} else if i, err := n.Int64(); err == nil {
+			out[k] = i
+		}
+	}
+	return out
+}
+
+func metadataFromModel(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+
+	out := make(map[string]any, len(src))
+	for k, v := range src {
+		out[k] = v
+		if f, ok := v.(float64); ok {
+			s := strconv.FormatFloat(f, 'g', -1, 64)
+			if !strings.ContainsAny(s, ".eE") {
+				s += ".0"
+			}
+			out[k] = json.Number(s)
+		}
+	}
+	return out
+}
+
+// Writer writes parts to a file; Flush must be called after the last part.
+type Writer interface {
+	Write(p *model.Part) error
+	Flush() error
+}
+
+// Read reads the rows of a file in the format.
+func Read(f Format, r io.Reader) ([]model.ImportRow, []model.RowError, error) {
+	if f == FormatCSV {
+		return ReadCSV(r)
+	}
+	return ReadJSONL(r)
+}
+
+func NewWriter(f Format, w io.Writer) Writer {
+	if f == FormatCSV {
+		return NewCSVWriter(w)
+	}
+	return NewJSONLWriter(w)
+}
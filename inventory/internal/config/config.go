@@ -16,6 +16,7 @@ type config struct {
 	Server Server
 	Logger Logger
 	Mongo  Database
+	Kafka  Kafka
 }
 
 func Load(path ...string) error {
@@ -42,10 +43,16 @@ func Load(path ...string) error {
 		return fmt.Errorf("%s Mongo: %w", op, err)
 	}
 
+	kafkaCfg, err := envconfig.NewKafkaConfig()
+	if err != nil {
+		return fmt.Errorf("%s Kafka: %w", op, err)
+	}
+
 	cfg = &config{
 		Server: serverCfg,
 		Logger: loggerCfg,
 		Mongo:  mongoCfg,
+		Kafka:  kafkaCfg,
 	}
 
 	return nil
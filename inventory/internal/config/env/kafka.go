@@ -0,0 +1,81 @@
+package envconfig
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/kafka/security"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
+)
+
+type kafkaEnv struct {
+	Brokers                  []string      `env:"KAFKA_BROKERS,required"`
+	InventoryPartsTopicName  string        `env:"INVENTORY_PARTS_TOPIC_NAME,required"`
+	PartChangesRelayInterval time.Duration `env:"PART_CHANGES_RELAY_INTERVAL" envDefault:"1s"`
+	PartChangesRelayBatch    int           `env:"PART_CHANGES_RELAY_BATCH_SIZE" envDefault:"100"`
+
+	TLSEnabled bool   `env:"KAFKA_TLS_ENABLED" envDefault:"false"`
+	TLSCA      string `env:"KAFKA_TLS_CA_FILE"`
+	// With a client certificate the brokers can authenticate the service by
+	// it instead of SASL.
+	TLSCert           string        `env:"KAFKA_TLS_CERT_FILE"`
+	TLSKey            string        `env:"KAFKA_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"KAFKA_TLS_RELOAD_INTERVAL" envDefault:"1m"`
+
+	// SCRAM-SHA-256 or SCRAM-SHA-512; empty turns SASL off.
+	SASLMechanism string `env:"KAFKA_SASL_MECHANISM"`
+	SASLUsername  string `env:"KAFKA_SASL_USERNAME"`
+	SASLPassword  string `env:"KAFKA_SASL_PASSWORD"`
+}
+
+type kafka struct {
+	raw      kafkaEnv
+	security *security.Config
+}
+
+func NewKafkaConfig() (*kafka, error) {
+	var raw kafkaEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	var tlsCfg *tls.Config
+	if raw.TLSEnabled {
+		var err error
+		tlsCfg, err = tlsconfig.Client(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, tlsconfig.WithReloadInterval(raw.TLSReloadInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sec, err := security.New(tlsCfg, security.SASL{
+		Mechanism: raw.SASLMechanism,
+		Username:  raw.SASLUsername,
+		Password:  raw.SASLPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka{raw: raw, security: sec}, nil
+}
+
+func (cfg *kafka) Brokers() []string                       { return cfg.raw.Brokers }
+func (cfg *kafka) InventoryPartsTopic() string             { return cfg.raw.InventoryPartsTopicName }
+func (cfg *kafka) PartChangesRelayInterval() time.Duration { return cfg.raw.PartChangesRelayInterval }
+func (cfg *kafka) PartChangesRelayBatchSize() int          { return cfg.raw.PartChangesRelayBatch }
+
+func (cfg *kafka) InventoryPartsProducerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return cfg.security.Apply(config)
+}
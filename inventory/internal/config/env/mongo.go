@@ -7,13 +7,16 @@ import (
 )
 
 type mongoEnv struct {
-	Host            string `env:"MONGO_HOST,required"`
-	Port            int    `env:"MONGO_PORT,required"`
-	User            string `env:"MONGO_INITDB_ROOT_USERNAME,required"`
-	Password        string `env:"MONGO_INITDB_ROOT_PASSWORD,required"`
-	DBName          string `env:"MONGO_DATABASE,required"`
-	AuthDB          string `env:"MONGO_AUTH_DB,required"`
-	PartsCollection string `env:"MONGO_PARTS_COLLECTION,required"`
+	Host                   string `env:"MONGO_HOST,required"`
+	Port                   int    `env:"MONGO_PORT,required"`
+	User                   string `env:"MONGO_INITDB_ROOT_USERNAME,required"`
+	Password               string `env:"MONGO_INITDB_ROOT_PASSWORD,required"`
+	DBName                 string `env:"MONGO_DATABASE,required"`
+	AuthDB                 string `env:"MONGO_AUTH_DB,required"`
+	PartsCollection        string `env:"MONGO_PARTS_COLLECTION,required"`
+	PricesCollection       string `env:"MONGO_PRICES_COLLECTION,required"`
+	WarehousesCollection   string `env:"MONGO_WAREHOUSES_COLLECTION,required"`
+	ReservationsCollection string `env:"MONGO_RESERVATIONS_COLLECTION,required"`
 }
 
 type mongo struct {
@@ -36,9 +39,24 @@ func (cfg *mongo) PartsCollection() string {
 	return cfg.raw.PartsCollection
 }
 
+func (cfg *mongo) PricesCollection() string {
+	return cfg.raw.PricesCollection
+}
+
+func (cfg *mongo) WarehousesCollection() string {
+	return cfg.raw.WarehousesCollection
+}
+
+func (cfg *mongo) ReservationsCollection() string {
+	return cfg.raw.ReservationsCollection
+}
+
+// DSN connects directly to the configured host: Mongo runs as a single-node
+// replica set for change streams, and its member address may not resolve
+// outside the docker network.
 func (cfg *mongo) DSN() string {
 	return fmt.Sprintf(
-		"mongodb://%s:%s@%s:%d/%s?authSource=%s",
+		"mongodb://%s:%s@%s:%d/%s?authSource=%s&directConnection=true",
 		cfg.raw.User,
 		cfg.raw.Password,
 		cfg.raw.Host,
@@ -1,21 +1,35 @@
 package envconfig
 
 import (
+	"crypto/tls"
 	"fmt"
 	"time"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
 )
 
 type grpcServerEnv struct {
 	Host string `env:"GRPC_HOST,required"`
 	Port int    `env:"GRPC_PORT,required"`
 
-	DBReadTimeout time.Duration `env:"DB_READ_TIMEOUT,required"`
+	DBReadTimeout  time.Duration `env:"DB_READ_TIMEOUT,required"`
+	DBWriteTimeout time.Duration `env:"DB_WRITE_TIMEOUT,required"`
+
+	TLSEnabled bool   `env:"GRPC_TLS_ENABLED" envDefault:"false"`
+	TLSCert    string `env:"GRPC_TLS_CERT_FILE"`
+	TLSKey     string `env:"GRPC_TLS_KEY_FILE"`
+	// TLSCA signs the certificates of the clients; with TLSClientAuth a
+	// client must present one.
+	TLSCA             string        `env:"GRPC_TLS_CA_FILE"`
+	TLSClientAuth     bool          `env:"GRPC_TLS_CLIENT_AUTH" envDefault:"false"`
+	TLSReloadInterval time.Duration `env:"GRPC_TLS_RELOAD_INTERVAL" envDefault:"1m"`
 }
 
 type grpcServer struct {
 	raw grpcServerEnv
+	tls *tls.Config
 }
 
 func NewGRPCerverConfig() (*grpcServer, error) {
@@ -23,7 +37,26 @@ func NewGRPCerverConfig() (*grpcServer, error) {
 	if err := env.Parse(&raw); err != nil {
 		return nil, err
 	}
-	return &grpcServer{raw: raw}, nil
+
+	cfg := &grpcServer{raw: raw}
+	if raw.TLSEnabled {
+		opts := []tlsconfig.Option{tlsconfig.WithReloadInterval(raw.TLSReloadInterval)}
+		if raw.TLSClientAuth {
+			opts = append(opts, tlsconfig.WithClientAuth())
+		}
+
+		var err error
+		cfg.tls, err = tlsconfig.Server(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
 }
 
 func (cfg *grpcServer) Host() string { return cfg.raw.Host }
@@ -35,3 +68,10 @@ func (cfg *grpcServer) Address() string {
 func (cfg *grpcServer) BDEReadTimeout() time.Duration {
 	return cfg.raw.DBReadTimeout
 }
+
+func (cfg *grpcServer) BDEWriteTimeout() time.Duration {
+	return cfg.raw.DBWriteTimeout
+}
+
+// TLS is the config of the server, nil when it serves in plaintext.
+func (cfg *grpcServer) TLS() *tls.Config { return cfg.tls }
@@ -1,12 +1,19 @@
 package config
 
-import "time"
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type Server interface {
 	Host() string
 	Port() int
 	Address() string
 	BDEReadTimeout() time.Duration
+	BDEWriteTimeout() time.Duration
+	TLS() *tls.Config
 }
 
 type Logger interface {
@@ -17,5 +24,16 @@ type Logger interface {
 type Database interface {
 	DatabaseName() string
 	PartsCollection() string
+	PricesCollection() string
+	WarehousesCollection() string
+	ReservationsCollection() string
 	DSN() string
 }
+
+type Kafka interface {
+	Brokers() []string
+	InventoryPartsTopic() string
+	PartChangesRelayInterval() time.Duration
+	PartChangesRelayBatchSize() int
+	InventoryPartsProducerConfig() *sarama.Config
+}
@@ -0,0 +1,95 @@
+package converter
+
+import (
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
+)
+
+func ValidateConfigurationResponseFromModel(violations []model.ConfigurationViolation) *inventorypbv1.ValidateConfigurationResponse {
+	out := make([]*inventorypbv1.ConfigurationViolation, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, &inventorypbv1.ConfigurationViolation{
+			PartUuid: v.PartID,
+			Kind:     ruleKindFromModel(v.Kind),
+			Target:   ruleTargetFromModel(v.Target),
+			Message:  v.Message,
+		})
+	}
+
+	return &inventorypbv1.ValidateConfigurationResponse{
+		Valid:      len(out) == 0,
+		Violations: out,
+	}
+}
+
+func compatibilityFromModel(rules []model.CompatibilityRule) []*inventorypbv1.CompatibilityRule {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	out := make([]*inventorypbv1.CompatibilityRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &inventorypbv1.CompatibilityRule{
+			Kind:   ruleKindFromModel(r.Kind),
+			Target: ruleTargetFromModel(r.Target),
+		})
+	}
+	return out
+}
+
+func compatibilityToModel(rules []*inventorypbv1.CompatibilityRule) []model.CompatibilityRule {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	out := make([]model.CompatibilityRule, 0, len(rules))
+	for _, r := range rules {
+		rule := model.CompatibilityRule{Kind: ruleKindToModel(r.GetKind())}
+		switch t := r.GetTarget().GetTarget().(type) {
+		case *inventorypbv1.CompatibilityTarget_PartUuid:
+			rule.Target.PartID = t.PartUuid
+		case *inventorypbv1.CompatibilityTarget_Category:
+			rule.Target.Category = categoryToModel(t.Category)
+		default:
+		}
+		out = append(out, rule)
+	}
+	return out
+}
+
+func ruleTargetFromModel(t model.RuleTarget) *inventorypbv1.CompatibilityTarget {
+	if t.PartID != "" {
+		return &inventorypbv1.CompatibilityTarget{
+			Target: &inventorypbv1.CompatibilityTarget_PartUuid{PartUuid: t.PartID},
+		}
+	}
+	return &inventorypbv1.CompatibilityTarget{
+		Target: &inventorypbv1.CompatibilityTarget_Category{Category: categoriyFromModel(t.Category)},
+	}
+}
+
+func ruleKindToModel(k inventorypbv1.CompatibilityRuleKind) model.RuleKind {
+	switch k {
+	case inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_REQUIRES:
+		return model.RuleRequires
+	case inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_CONFLICTS_WITH:
+		return model.RuleConflictsWith
+	case inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH:
+		return model.RuleCompatibleWith
+	default:
+		return ""
+	}
+}
+
+func ruleKindFromModel(k model.RuleKind) inventorypbv1.CompatibilityRuleKind {
+	switch k {
+	case model.RuleRequires:
+		return inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_REQUIRES
+	case model.RuleConflictsWith:
+		return inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_CONFLICTS_WITH
+	case model.RuleCompatibleWith:
+		return inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH
+	default:
+		return inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
+)
+
+type kafkaConverter struct{}
+
+func NewKafkaConverter() *kafkaConverter { return &kafkaConverter{} }
+
+func (c *kafkaConverter) PartChangeToPayload(m model.PartChange) ([]byte, error) {
+	pb := &inventorypbv1.PartChangedRecord{
+		EventUuid:  m.ID,
+		PartUuid:   m.PartID,
+		Type:       partChangeTypeFromModel(m.Type),
+		OccurredAt: timestamppb.New(m.OccurredAt),
+	}
+	if m.Before != nil {
+		pb.Before = PartFromModel(m.Before)
+	}
+	if m.After != nil {
+		pb.After = PartFromModel(m.After)
+	}
+
+	payload, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
+
+func partChangeTypeFromModel(t model.PartChangeType) inventorypbv1.PartChangeType {
+	switch t {
+	case model.PartChangeCreated:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_CREATED
+	case model.PartChangeUpdated:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_UPDATED
+	case model.PartChangeStockChanged:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_STOCK_CHANGED
+	case model.PartChangeArchived:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_ARCHIVED
+	case model.PartChangeRestored:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_RESTORED
+	default:
+		return inventorypbv1.PartChangeType_PART_CHANGE_TYPE_UNSPECIFIED
+	}
+}
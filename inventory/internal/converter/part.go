@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
@@ -19,12 +20,123 @@ func PartFromModel(p *model.Part) *inventorypbv1.Part {
 		Manufacturer:  manufacturerFromModel(p.Manufacturer),
 		Tags:          append([]string(nil), p.Tags...),
 		Metadata:      metadataFromModel(p.Metadata),
+		Compatibility: compatibilityFromModel(p.Compatibility),
+		Version:       p.Version,
+		StockLevels:   stockLevelsFromModel(p.StockLevels),
 		CreatedAt:     timestamppb.New(*p.CreatedAt),
 		UpdatedAt:     timestamppb.New(*p.UpdatedAt),
 	}
+	if p.ArchivedAt != nil {
+		out.ArchivedAt = timestamppb.New(*p.ArchivedAt)
+	}
+	return out
+}
+
+func ListPartsRequestToParams(req *inventorypbv1.ListPartsRequest) model.ListPartsParams {
+	return model.ListPartsParams{
+		Filter:       PartsFilterToModel(req.GetFilter()),
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		SortBy:       partSortToModel(req.GetSortBy()),
+		SortDesc:     req.GetSortDesc(),
+		IncludeTotal: req.GetIncludeTotal(),
+	}
+}
+
+func ListPartsResponseFromModel(res *model.ListPartsResponse) *inventorypbv1.ListPartsResponse {
+	out := make([]*inventorypbv1.Part, 0, len(res.Parts))
+	for i := range res.Parts {
+		out = append(out, PartFromModel(res.Parts[i]))
+	}
+
+	return &inventorypbv1.ListPartsResponse{
+		Parts:         out,
+		NextPageToken: res.NextPageToken,
+		TotalCount:    res.TotalCount,
+	}
+}
+
+func WatchPartsRequestToParams(req *inventorypbv1.WatchPartsRequest) model.WatchPartsParams {
+	return model.WatchPartsParams{
+		Filter:      PartsFilterToModel(req.GetFilter()),
+		ResumeToken: req.GetResumeToken(),
+	}
+}
+
+func WatchPartsResponseFromModel(ev model.PartsWatchEvent) *inventorypbv1.WatchPartsResponse {
+	out := &inventorypbv1.WatchPartsResponse{
+		Type:        watchEventTypeFromModel(ev.Type),
+		PartUuid:    ev.PartID,
+		ResumeToken: ev.ResumeToken,
+	}
+	if ev.Part != nil {
+		out.Part = PartFromModel(ev.Part)
+	}
 	return out
 }
 
+func watchEventTypeFromModel(t model.WatchEventType) inventorypbv1.WatchEventType {
+	switch t {
+	case model.WatchEventSnapshot:
+		return inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT
+	case model.WatchEventCurrent:
+		return inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_CURRENT
+	case model.WatchEventUpserted:
+		return inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_UPSERTED
+	case model.WatchEventRemoved:
+		return inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_REMOVED
+	default:
+		return inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func partSortToModel(s inventorypbv1.PartSortField) model.PartSort {
+	switch s {
+	case inventorypbv1.PartSortField_PART_SORT_FIELD_PRICE:
+		return model.PartSortPrice
+	case inventorypbv1.PartSortField_PART_SORT_FIELD_NAME:
+		return model.PartSortName
+	case inventorypbv1.PartSortField_PART_SORT_FIELD_STOCK_QUANTITY:
+		return model.PartSortStockQuantity
+	default:
+		return model.PartSortCreatedAt
+	}
+}
+
+func PartInfoToModel(p *inventorypbv1.PartInfo) model.PartInfo {
+	if p == nil {
+		return model.PartInfo{}
+	}
+	return model.PartInfo{
+		Name:          p.GetName(),
+		Description:   p.GetDescription(),
+		PriceCents:    p.GetPriceCents(),
+		StockQuantity: p.GetStockQuantity(),
+		Category:      categoryToModel(p.GetCategory()),
+		Dimensions:    dimensionsToModel(p.GetDimensions()),
+		Manufacturer:  manufacturerToModel(p.GetManufacturer()),
+		Tags:          append([]string(nil), p.GetTags()...),
+		Metadata:      metadataToModel(p.GetMetadata()),
+		Compatibility: compatibilityToModel(p.GetCompatibility()),
+		StockLevels:   stockLevelsToModel(p.GetStockLevels()),
+	}
+}
+
+func UpdatePartRequestToParams(req *inventorypbv1.UpdatePartRequest) model.UpdatePartParams {
+	paths := req.GetUpdateMask().GetPaths()
+	mask := make([]model.PartField, len(paths))
+	for i := range paths {
+		mask[i] = model.PartField(paths[i])
+	}
+
+	return model.UpdatePartParams{
+		ID:              req.GetUuid(),
+		Info:            PartInfoToModel(req.GetPart()),
+		Mask:            mask,
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+}
+
 func PartsFilterToModel(f *inventorypbv1.PartsFilter) model.PartsFilter {
 	if f == nil {
 		return model.PartsFilter{}
@@ -35,35 +147,108 @@ func PartsFilterToModel(f *inventorypbv1.PartsFilter) model.PartsFilter {
 		Categories:            append([]model.Category(nil), categoriesToModel(f.GetCategories())...),
 		ManufacturerCountries: append([]string(nil), f.GetManufacturerCountries()...),
 		Tags:                  append([]string(nil), f.GetTags()...),
+		IncludeArchived:       f.GetIncludeArchived(),
+		Query:                 f.GetQuery(),
+		PriceCents:            int64RangeToModel(f.GetPriceCents()),
+		StockQuantity:         int64RangeToModel(f.GetStockQuantity()),
+		Length:                floatRangeToModel(f.GetDimensions().GetLength()),
+		Width:                 floatRangeToModel(f.GetDimensions().GetWidth()),
+		Height:                floatRangeToModel(f.GetDimensions().GetHeight()),
+		Weight:                floatRangeToModel(f.GetDimensions().GetWeight()),
+		Metadata:              metadataPredicatesToModel(f.GetMetadata()),
 	}
 }
 
-func categoriesToModel(categoties []inventorypbv1.Category) []model.Category {
-	res := make([]model.Category, len(categoties))
+func int64RangeToModel(r *inventorypbv1.Int64Range) model.Int64Range {
+	if r == nil {
+		return model.Int64Range{}
+	}
+	var out model.Int64Range
+	if r.Min != nil {
+		out.Min = lo.ToPtr(r.GetMin())
+	}
+	if r.Max != nil {
+		out.Max = lo.ToPtr(r.GetMax())
+	}
+	return out
+}
 
-	var val model.Category
-	for i := range categoties {
-		switch categoties[i] {
-		case inventorypbv1.Category_CATEGORY_UNKNOWN:
-			val = model.CategoryUnknown
-		case inventorypbv1.Category_CATEGORY_ENGINE:
-			val = model.CategoryEngine
-		case inventorypbv1.Category_CATEGORY_FUEL:
-			val = model.CategoryFuel
-		case inventorypbv1.Category_CATEGORY_PORTHOLE:
-			val = model.CategoryPorthole
-		case inventorypbv1.Category_CATEGORY_WING:
-			val = model.CategoryWing
-		default:
-			val = model.CategoryUnknown
+func floatRangeToModel(r *inventorypbv1.DoubleRange) model.FloatRange {
+	if r == nil {
+		return model.FloatRange{}
+	}
+	var out model.FloatRange
+	if r.Min != nil {
+		out.Min = lo.ToPtr(r.GetMin())
+	}
+	if r.Max != nil {
+		out.Max = lo.ToPtr(r.GetMax())
+	}
+	return out
+}
+
+func metadataPredicatesToModel(preds []*inventorypbv1.MetadataPredicate) []model.MetadataPredicate {
+	if len(preds) == 0 {
+		return nil
+	}
+
+	out := make([]model.MetadataPredicate, len(preds))
+	for i, p := range preds {
+		out[i] = model.MetadataPredicate{
+			Key:      p.GetKey(),
+			Operator: metadataOperatorToModel(p.GetOperator()),
+			Value:    valueToModel(p.GetValue()),
 		}
+	}
+	return out
+}
 
-		res[i] = val
+// metadataOperatorToModel returns "" for an unspecified operator.
+func metadataOperatorToModel(op inventorypbv1.MetadataOperator) model.MetadataOperator {
+	switch op {
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_EQ:
+		return model.MetadataOpEq
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_NE:
+		return model.MetadataOpNe
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_GT:
+		return model.MetadataOpGt
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_GTE:
+		return model.MetadataOpGte
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_LT:
+		return model.MetadataOpLt
+	case inventorypbv1.MetadataOperator_METADATA_OPERATOR_LTE:
+		return model.MetadataOpLte
+	default:
+		return ""
+	}
+}
+
+func categoriesToModel(categoties []inventorypbv1.Category) []model.Category {
+	res := make([]model.Category, len(categoties))
+	for i := range categoties {
+		res[i] = categoryToModel(categoties[i])
 	}
 
 	return res
 }
 
+func categoryToModel(c inventorypbv1.Category) model.Category {
+	switch c {
+	case inventorypbv1.Category_CATEGORY_UNKNOWN:
+		return model.CategoryUnknown
+	case inventorypbv1.Category_CATEGORY_ENGINE:
+		return model.CategoryEngine
+	case inventorypbv1.Category_CATEGORY_FUEL:
+		return model.CategoryFuel
+	case inventorypbv1.Category_CATEGORY_PORTHOLE:
+		return model.CategoryPorthole
+	case inventorypbv1.Category_CATEGORY_WING:
+		return model.CategoryWing
+	default:
+		return model.CategoryUnknown
+	}
+}
+
 func categoriyFromModel(c model.Category) inventorypbv1.Category {
 	switch c {
 	case model.CategoryUnknown:
@@ -104,6 +289,59 @@ func manufacturerFromModel(m *model.Manufacturer) *inventorypbv1.Manufacturer {
 	}
 }
 
+func dimensionsToModel(d *inventorypbv1.Dimensions) *model.Dimensions {
+	if d == nil {
+		return nil
+	}
+	return &model.Dimensions{
+		Length: d.GetLength(),
+		Width:  d.GetWidth(),
+		Height: d.GetHeight(),
+		Weight: d.GetWeight(),
+	}
+}
+
+func manufacturerToModel(m *inventorypbv1.Manufacturer) *model.Manufacturer {
+	if m == nil {
+		return nil
+	}
+	return &model.Manufacturer{
+		Name:    m.GetName(),
+		Country: m.GetCountry(),
+		Website: m.GetWebsite(),
+	}
+}
+
+// metadataToModel keeps a key whose value has no field set as nil,
+// so validation can reject it instead of silently dropping it.
+func metadataToModel(src map[string]*inventorypbv1.Value) map[string]any {
+	if src == nil {
+		return nil
+	}
+
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = valueToModel(v)
+	}
+	return dst
+}
+
+// valueToModel returns nil for a value without any field set.
+func valueToModel(v *inventorypbv1.Value) any {
+	switch vv := v.GetValue().(type) {
+	case *inventorypbv1.Value_StringValue:
+		return vv.StringValue
+	case *inventorypbv1.Value_Int64Value:
+		return vv.Int64Value
+	case *inventorypbv1.Value_DoubleValue:
+		return vv.DoubleValue
+	case *inventorypbv1.Value_BoolValue:
+		return vv.BoolValue
+	default:
+		return nil
+	}
+}
+
 func metadataFromModel(src map[string]any) map[string]*inventorypbv1.Value {
 	if src == nil {
 		return nil
@@ -119,6 +357,8 @@ func metadataFromModel(src map[string]any) map[string]*inventorypbv1.Value {
 			dst[k] = &inventorypbv1.Value{Value: &inventorypbv1.Value_StringValue{StringValue: vv}}
 		case int64:
 			dst[k] = &inventorypbv1.Value{Value: &inventorypbv1.Value_Int64Value{Int64Value: vv}}
+		case int32:
+			dst[k] = &inventorypbv1.Value{Value: &inventorypbv1.Value_Int64Value{Int64Value: int64(vv)}}
 		case float64:
 			dst[k] = &inventorypbv1.Value{Value: &inventorypbv1.Value_DoubleValue{DoubleValue: vv}}
 		case bool:
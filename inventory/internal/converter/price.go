@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
+)
+
+func GetPriceHistoryRequestToParams(req *inventorypbv1.GetPriceHistoryRequest) model.PriceHistoryParams {
+	return model.PriceHistoryParams{
+		PartID: req.GetPartUuid(),
+		From:   timestampToModel(req.GetRange().GetFrom()),
+		To:     timestampToModel(req.GetRange().GetTo()),
+	}
+}
+
+func GetPriceHistoryResponseFromModel(changes []model.PriceChange) *inventorypbv1.GetPriceHistoryResponse {
+	out := make([]*inventorypbv1.PriceChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &inventorypbv1.PriceChange{
+			PriceCents: c.PriceCents,
+			Version:    c.Version,
+			ChangedAt:  timestamppb.New(c.ChangedAt),
+		})
+	}
+
+	return &inventorypbv1.GetPriceHistoryResponse{Changes: out}
+}
+
+func timestampToModel(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
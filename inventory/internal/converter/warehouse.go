@@ -0,0 +1,108 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
+)
+
+func WarehouseFromModel(w *model.Warehouse) *inventorypbv1.Warehouse {
+	return &inventorypbv1.Warehouse{
+		Id:        w.ID,
+		Name:      w.Name,
+		Spaceport: w.Spaceport,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+	}
+}
+
+func WarehouseToModel(w *inventorypbv1.Warehouse) model.Warehouse {
+	return model.Warehouse{
+		ID:        w.GetId(),
+		Name:      w.GetName(),
+		Spaceport: w.GetSpaceport(),
+	}
+}
+
+func ListWarehousesResponseFromModel(ws []model.Warehouse) *inventorypbv1.ListWarehousesResponse {
+	out := make([]*inventorypbv1.Warehouse, 0, len(ws))
+	for i := range ws {
+		out = append(out, WarehouseFromModel(&ws[i]))
+	}
+
+	return &inventorypbv1.ListWarehousesResponse{Warehouses: out}
+}
+
+func TransferStockRequestToParams(req *inventorypbv1.TransferStockRequest) model.TransferStockParams {
+	return model.TransferStockParams{
+		PartID:          req.GetPartUuid(),
+		FromWarehouseID: req.GetFromWarehouseId(),
+		ToWarehouseID:   req.GetToWarehouseId(),
+		Quantity:        req.GetQuantity(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+}
+
+func stockLevelsFromModel(levels []model.StockLevel) []*inventorypbv1.StockLevel {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	out := make([]*inventorypbv1.StockLevel, 0, len(levels))
+	for _, l := range levels {
+		out = append(out, &inventorypbv1.StockLevel{WarehouseId: l.WarehouseID, Quantity: l.Quantity})
+	}
+	return out
+}
+
+func stockLevelsToModel(levels []*inventorypbv1.StockLevel) []model.StockLevel {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	out := make([]model.StockLevel, 0, len(levels))
+	for _, l := range levels {
+		out = append(out, model.StockLevel{WarehouseID: l.GetWarehouseId(), Quantity: l.GetQuantity()})
+	}
+	return out
+}
+
+func ReservedItemsToModel(items []*inventorypbv1.ReservedItem) []model.ReservedItem {
+	out := make([]model.ReservedItem, 0, len(items))
+	for _, it := range items {
+		out = append(out, model.ReservedItem{
+			PartID:          it.GetPartUuid(),
+			Quantity:        it.GetQuantity(),
+			WarehouseID:     it.GetWarehouseId(),
+			ExpectedVersion: it.GetExpectedVersion(),
+		})
+	}
+	return out
+}
+
+func StockReservationFromModel(r *model.StockReservation) *inventorypbv1.StockReservation {
+	items := make([]*inventorypbv1.ReservedItem, 0, len(r.Items))
+	for _, it := range r.Items {
+		taken := make([]*inventorypbv1.StockLevel, 0, len(it.Taken))
+		for _, l := range it.Taken {
+			taken = append(taken, &inventorypbv1.StockLevel{WarehouseId: l.WarehouseID, Quantity: l.Quantity})
+		}
+		items = append(items, &inventorypbv1.ReservedItem{
+			PartUuid:        it.PartID,
+			Quantity:        it.Quantity,
+			WarehouseId:     it.WarehouseID,
+			Taken:           taken,
+			ExpectedVersion: it.ExpectedVersion,
+		})
+	}
+
+	out := &inventorypbv1.StockReservation{
+		Id:        r.ID,
+		Items:     items,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	if r.ReleasedAt != nil {
+		out.ReleasedAt = timestamppb.New(*r.ReleasedAt)
+	}
+	return out
+}
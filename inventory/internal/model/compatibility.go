@@ -0,0 +1,46 @@
+package model
+
+// RuleKind is the kind of a compatibility rule.
+type RuleKind string
+
+const (
+	// RuleRequires: a configuration with the part must also have the target.
+	RuleRequires RuleKind = "requires"
+	// RuleConflictsWith: a configuration with the part must not have the target.
+	RuleConflictsWith RuleKind = "conflicts_with"
+	// RuleCompatibleWith: the part only works with its compatible_with
+	// targets, so other parts of the categories of these targets are not
+	// allowed next to it.
+	RuleCompatibleWith RuleKind = "compatible_with"
+)
+
+// RuleTarget is a part or every part of a category; exactly one is set.
+type RuleTarget struct {
+	PartID   string
+	Category Category
+}
+
+// CompatibilityRule relates a part to other parts or categories.
+type CompatibilityRule struct {
+	Kind   RuleKind
+	Target RuleTarget
+}
+
+// ConfigurationViolation is a compatibility rule broken by a configuration.
+type ConfigurationViolation struct {
+	// Part whose rule is broken.
+	PartID string
+	Kind   RuleKind
+	// For RuleRequires, the missing target of the rule; otherwise the
+	// part of the configuration that breaks the rule.
+	Target  RuleTarget
+	Message string
+}
+
+// Matches reports whether p is the target part or belongs to the target category.
+func (t RuleTarget) Matches(p *Part) bool {
+	if t.PartID != "" {
+		return p.ID == t.PartID
+	}
+	return p.Category == t.Category
+}
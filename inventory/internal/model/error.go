@@ -1,8 +1,35 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrPartNotFound    = errors.New("part not found")
 	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrResumeTokenExpired means the change history after a resume token is gone.
+	ErrResumeTokenExpired = errors.New("resume token expired")
+	ErrVersionMismatch    = errors.New("version mismatch")
+	ErrWarehouseExists    = errors.New("warehouse already exists")
+	// ErrInsufficientStock means a warehouse has less stock than asked for.
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrPartArchived means an archived part was asked for stock.
+	ErrPartArchived = errors.New("part archived")
+	// ErrReservationReleased means a reservation was asked for after it was released.
+	ErrReservationReleased = errors.New("reservation released")
 )
+
+// VersionMismatchError means the part was changed since the expected
+// version; it matches ErrVersionMismatch.
+type VersionMismatchError struct {
+	Current int64
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("%s: current version is %d", ErrVersionMismatch, e.Current)
+}
+
+func (e *VersionMismatchError) Unwrap() error {
+	return ErrVersionMismatch
+}
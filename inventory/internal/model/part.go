@@ -21,7 +21,7 @@ type Part struct {
 	Description string
 	// Unit price of the part in cents.
 	PriceCents int64
-	// Quantity of this part currently available in stock.
+	// Quantity of this part currently available in stock, the sum of StockLevels.
 	StockQuantity int64
 	// Category of the part.
 	Category Category
@@ -38,6 +38,72 @@ type Part struct {
 	CreatedAt *time.Time
 	// Timestamp when the part was last updated.
 	UpdatedAt *time.Time
+	// Timestamp when the part was archived; nil for active parts.
+	ArchivedAt *time.Time
+	// Rules a configuration with this part must follow.
+	Compatibility []CompatibilityRule
+	// Version of the part; 1 for a new part, incremented by every change.
+	Version int64
+	// Stock of the part in each warehouse that has any.
+	StockLevels []StockLevel
+}
+
+// PartInfo holds the writable fields of a part.
+type PartInfo struct {
+	Name          string
+	Description   string
+	PriceCents    int64
+	StockQuantity int64
+	Category      Category
+	Dimensions    *Dimensions
+	Manufacturer  *Manufacturer
+	Tags          []string
+	// Values are string, int64, float64 or bool; nil marks a value
+	// that was sent without its type and is rejected by validation.
+	Metadata      map[string]any
+	Compatibility []CompatibilityRule
+	StockLevels   []StockLevel
+}
+
+// PartField names a PartInfo field in an update mask.
+type PartField string
+
+const (
+	PartFieldName          PartField = "name"
+	PartFieldDescription   PartField = "description"
+	PartFieldPriceCents    PartField = "price_cents"
+	PartFieldStockQuantity PartField = "stock_quantity"
+	PartFieldCategory      PartField = "category"
+	PartFieldDimensions    PartField = "dimensions"
+	PartFieldManufacturer  PartField = "manufacturer"
+	PartFieldTags          PartField = "tags"
+	PartFieldMetadata      PartField = "metadata"
+	PartFieldCompatibility PartField = "compatibility"
+	PartFieldStockLevels   PartField = "stock_levels"
+)
+
+// PartFields lists every PartInfo field; it is the mask of a full update.
+var PartFields = []PartField{
+	PartFieldName,
+	PartFieldDescription,
+	PartFieldPriceCents,
+	PartFieldStockQuantity,
+	PartFieldCategory,
+	PartFieldDimensions,
+	PartFieldManufacturer,
+	PartFieldTags,
+	PartFieldMetadata,
+	PartFieldCompatibility,
+	PartFieldStockLevels,
+}
+
+type UpdatePartParams struct {
+	ID   string
+	Info PartInfo
+	// Fields of Info to write; empty means all of them.
+	Mask []PartField
+	// The part is only updated if it still has this version.
+	ExpectedVersion int64
 }
 
 type Dimensions struct {
@@ -66,6 +132,18 @@ type PartsFilter struct {
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	// Archived parts are skipped unless set.
+	IncludeArchived bool
+	// Full-text query over name, description and tags.
+	Query         string
+	PriceCents    Int64Range
+	StockQuantity Int64Range
+	Length        FloatRange
+	Width         FloatRange
+	Height        FloatRange
+	Weight        FloatRange
+	// All predicates must hold.
+	Metadata []MetadataPredicate
 }
 
 func (f PartsFilter) Empty() bool {
@@ -73,9 +151,94 @@ func (f PartsFilter) Empty() bool {
 		len(f.Names) == 0 &&
 		len(f.Categories) == 0 &&
 		len(f.ManufacturerCountries) == 0 &&
-		len(f.Tags) == 0
+		len(f.Tags) == 0 &&
+		f.Query == "" &&
+		f.PriceCents.Empty() &&
+		f.StockQuantity.Empty() &&
+		f.Length.Empty() &&
+		f.Width.Empty() &&
+		f.Height.Empty() &&
+		f.Weight.Empty() &&
+		len(f.Metadata) == 0
+}
+
+// Int64Range is an inclusive range; a nil bound is open.
+type Int64Range struct {
+	Min *int64
+	Max *int64
+}
+
+func (r Int64Range) Empty() bool { return r.Min == nil && r.Max == nil }
+
+// FloatRange is an inclusive range; a nil bound is open.
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+func (r FloatRange) Empty() bool { return r.Min == nil && r.Max == nil }
+
+type MetadataOperator string
+
+const (
+	MetadataOpEq  MetadataOperator = "eq"
+	MetadataOpNe  MetadataOperator = "ne"
+	MetadataOpGt  MetadataOperator = "gt"
+	MetadataOpGte MetadataOperator = "gte"
+	MetadataOpLt  MetadataOperator = "lt"
+	MetadataOpLte MetadataOperator = "lte"
+)
+
+// MetadataPredicate compares the metadata value under Key with Value,
+// which is a string, int64, float64 or bool.
+type MetadataPredicate struct {
+	Key      string
+	Operator MetadataOperator
+	Value    any
+}
+
+// PartSort names the field parts are ordered by; ties are broken by ID.
+type PartSort string
+
+const (
+	PartSortCreatedAt     PartSort = "created_at"
+	PartSortPrice         PartSort = "price"
+	PartSortName          PartSort = "name"
+	PartSortStockQuantity PartSort = "stock_quantity"
+)
+
+type ListPartsParams struct {
+	Filter PartsFilter
+	// Zero means the default page size.
+	PageSize  int
+	PageToken string
+	// Empty means PartSortCreatedAt.
+	SortBy       PartSort
+	SortDesc     bool
+	IncludeTotal bool
 }
 
 type ListPartsResponse struct {
 	Parts []*Part
+	// Empty on the last page.
+	NextPageToken string
+	// Set only if the total was requested.
+	TotalCount *int64
+}
+
+// PartsCursor is the position right after the last part of a page:
+// the value of its sort field and its ID.
+type PartsCursor struct {
+	Value any
+	ID    string
+}
+
+// PartsQuery selects one page of parts.
+type PartsQuery struct {
+	Filter   PartsFilter
+	SortBy   PartSort
+	SortDesc bool
+	// Parts strictly after the cursor in sort order; nil for the first page.
+	After *PartsCursor
+	Limit int
 }
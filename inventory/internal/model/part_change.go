@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type PartChangeType string
+
+const (
+	PartChangeCreated      PartChangeType = "created"
+	PartChangeUpdated      PartChangeType = "updated"
+	PartChangeStockChanged PartChangeType = "stock_changed"
+	PartChangeArchived     PartChangeType = "archived"
+	PartChangeRestored     PartChangeType = "restored"
+)
+
+// PartChange is a committed change of a part waiting to be published.
+type PartChange struct {
+	ID         string
+	PartID     string
+	Type       PartChangeType
+	Before     *Part // nil for created parts
+	After      *Part
+	OccurredAt time.Time
+}
@@ -0,0 +1,27 @@
+package model
+
+// BatchResult counts the parts written by a batch upsert.
+type BatchResult struct {
+	Created int
+	Updated int
+}
+
+// ImportRow is a part read from line Line of an import file. A part
+// without an ID is created with a new one.
+type ImportRow struct {
+	Line int
+	Part *Part
+}
+
+// RowError is a problem with one line of an import file.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+type ImportReport struct {
+	Rows    int
+	Created int
+	Updated int
+	Errors  []RowError
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// PriceChange is one price of a part and the time it was set.
+type PriceChange struct {
+	PartID     string
+	PriceCents int64
+	// Version of the part that the change made.
+	Version   int64
+	ChangedAt time.Time
+}
+
+// PriceHistoryParams selects the price changes of a part made in [From, To).
+// A nil bound is open.
+type PriceHistoryParams struct {
+	PartID string
+	From   *time.Time
+	To     *time.Time
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// StockReservation is stock taken out of the warehouses for an order until
+// it is released.
+type StockReservation struct {
+	// ID chosen by the caller, e.g. the ID of the order.
+	ID        string
+	Items     []ReservedItem
+	CreatedAt time.Time
+	// Nil while the stock is reserved.
+	ReleasedAt *time.Time
+}
+
+// ReservedItem is the stock of one part in a reservation.
+type ReservedItem struct {
+	PartID   string
+	Quantity int64
+	// WarehouseID is the warehouse to take the stock from; any warehouse of
+	// the part when empty.
+	WarehouseID string
+	// ExpectedVersion is the version of the part the reservation is based
+	// on; the stock is only taken while the part still has it.
+	ExpectedVersion int64
+	// Taken is how much of Quantity came from each warehouse, so that a
+	// release puts it back where it was.
+	Taken []StockLevel
+}
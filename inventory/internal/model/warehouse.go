@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// DefaultWarehouseID is the warehouse that always exists. It holds the stock
+// written with StockQuantity only, including the stock parts had before
+// there were warehouses.
+const DefaultWarehouseID = "default"
+
+// Warehouse is a place, usually at a spaceport, where parts are stocked.
+type Warehouse struct {
+	// Short identifier, e.g. "baikonur".
+	ID   string
+	Name string
+	// Spaceport the warehouse is at.
+	Spaceport string
+	CreatedAt time.Time
+}
+
+// StockLevel is the stock of a part in one warehouse.
+type StockLevel struct {
+	WarehouseID string
+	Quantity    int64
+}
+
+type TransferStockParams struct {
+	PartID          string
+	FromWarehouseID string
+	ToWarehouseID   string
+	Quantity        int64
+	// The part is only changed if it still has this version.
+	ExpectedVersion int64
+}
@@ -0,0 +1,41 @@
+package model
+
+import "context"
+
+type WatchEventType string
+
+const (
+	WatchEventSnapshot WatchEventType = "snapshot"
+	WatchEventCurrent  WatchEventType = "current"
+	WatchEventUpserted WatchEventType = "upserted"
+	WatchEventRemoved  WatchEventType = "removed"
+)
+
+type WatchPartsParams struct {
+	Filter      PartsFilter
+	ResumeToken string
+}
+
+// PartsWatchEvent is one message of a parts watch.
+type PartsWatchEvent struct {
+	Type        WatchEventType
+	PartID      string
+	Part        *Part // nil for current and for deleted parts
+	ResumeToken string
+}
+
+// PartStreamChange is a change of a part read from the change stream.
+type PartStreamChange struct {
+	PartID  string
+	Deleted bool
+	Part    *Part // state after the change; nil if deleted
+}
+
+// PartsStream is an open stream of part changes.
+type PartsStream interface {
+	// Next blocks until the next change or until ctx is done.
+	Next(ctx context.Context) (PartStreamChange, error)
+	// ResumeToken returns the token of the last read change.
+	ResumeToken() []byte
+	Close(ctx context.Context) error
+}
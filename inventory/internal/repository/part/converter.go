@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
+	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/v2/bson"
 
 	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
@@ -24,6 +27,22 @@ func EntityToModel(e *PartEntity) *model.Part {
 		Metadata:      e.Metadata,
 		CreatedAt:     e.CreatedAt,
 		UpdatedAt:     e.UpdatedAt,
+		ArchivedAt:    e.ArchivedAt,
+		Version:       e.Version,
+	}
+
+	for _, r := range e.Compatibility {
+		out.Compatibility = append(out.Compatibility, model.CompatibilityRule{
+			Kind:   r.Kind,
+			Target: model.RuleTarget{PartID: r.PartID, Category: r.Category},
+		})
+	}
+
+	for _, l := range e.StockLevels {
+		out.StockLevels = append(out.StockLevels, model.StockLevel{
+			WarehouseID: l.WarehouseID,
+			Quantity:    l.Quantity,
+		})
 	}
 
 	if e.Dimensions != nil {
@@ -62,6 +81,23 @@ func EntityFromModel(p *model.Part) *PartEntity {
 		Metadata:      p.Metadata,
 		CreatedAt:     p.CreatedAt,
 		UpdatedAt:     p.UpdatedAt,
+		ArchivedAt:    p.ArchivedAt,
+		Version:       p.Version,
+	}
+
+	for _, r := range p.Compatibility {
+		out.Compatibility = append(out.Compatibility, CompatibilityRuleEntity{
+			Kind:     r.Kind,
+			PartID:   r.Target.PartID,
+			Category: r.Target.Category,
+		})
+	}
+
+	for _, l := range p.StockLevels {
+		out.StockLevels = append(out.StockLevels, StockLevelEntity{
+			WarehouseID: l.WarehouseID,
+			Quantity:    l.Quantity,
+		})
 	}
 
 	if p.Dimensions != nil {
@@ -107,10 +143,511 @@ func BuildMongoFilter(f model.PartsFilter) bson.M {
 		}
 		q["manufacturer.country_norm"] = bson.M{"$in": norm}
 	}
+	if !f.IncludeArchived {
+		// Matches documents without the field as well.
+		q["archived_at"] = nil
+	}
+	if f.Query != "" {
+		q["$text"] = bson.M{"$search": f.Query}
+	}
+	addRange(q, "price_cents", f.PriceCents.Min, f.PriceCents.Max)
+	addRange(q, "stock_quantity", f.StockQuantity.Min, f.StockQuantity.Max)
+	addRange(q, "dimensions.length", f.Length.Min, f.Length.Max)
+	addRange(q, "dimensions.width", f.Width.Min, f.Width.Max)
+	addRange(q, "dimensions.height", f.Height.Min, f.Height.Max)
+	addRange(q, "dimensions.weight", f.Weight.Min, f.Weight.Max)
+	if len(f.Metadata) > 0 {
+		// Several predicates may share a key, so each is a separate condition.
+		preds := make(bson.A, 0, len(f.Metadata))
+		for _, p := range f.Metadata {
+			preds = append(preds, bson.M{
+				"metadata." + p.Key: bson.M{"$" + string(p.Operator): p.Value},
+			})
+		}
+		q["$and"] = preds
+	}
 
 	return q
 }
 
+func addRange[T int64 | float64](q bson.M, field string, minV, maxV *T) {
+	cond := bson.M{}
+	if minV != nil {
+		cond["$gte"] = *minV
+	}
+	if maxV != nil {
+		cond["$lte"] = *maxV
+	}
+	if len(cond) > 0 {
+		q[field] = cond
+	}
+}
+
+// sortFields maps sort keys to document fields.
+var sortFields = map[model.PartSort]string{
+	model.PartSortCreatedAt:     "created_at",
+	model.PartSortPrice:         "price_cents",
+	model.PartSortName:          "name",
+	model.PartSortStockQuantity: "stock_quantity",
+}
+
+// BuildMongoPage returns the filter and sort of a page of parts. Parts are
+// ordered by the sort field and then by _id, and the cursor continues
+// from the last part of the previous page (keyset pagination).
+func BuildMongoPage(q model.PartsQuery) (bson.M, bson.D, error) {
+	field, ok := sortFields[q.SortBy]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown sort field %q", q.SortBy)
+	}
+
+	dir, cmp := 1, "$gt"
+	if q.SortDesc {
+		dir, cmp = -1, "$lt"
+	}
+	sort := bson.D{{Key: field, Value: dir}, {Key: "_id", Value: dir}}
+
+	filter := BuildMongoFilter(q.Filter)
+	if q.After == nil {
+		return filter, sort, nil
+	}
+
+	after := bson.M{"$or": bson.A{
+		bson.M{field: bson.M{cmp: q.After.Value}},
+		bson.M{field: q.After.Value, "_id": bson.M{cmp: q.After.ID}},
+	}}
+	if len(filter) == 0 {
+		return after, sort, nil
+	}
+
+	return bson.M{"$and": bson.A{filter, after}}, sort, nil
+}
+
+// BuildMongoUpdate returns the $set pipeline stage writing the masked fields of info.
+func BuildMongoUpdate(info model.PartInfo, mask []model.PartField, updatedAt time.Time) (bson.M, error) {
+	ent := EntityFromModel(&model.Part{
+		Name:          info.Name,
+		Description:   info.Description,
+		PriceCents:    info.PriceCents,
+		StockQuantity: info.StockQuantity,
+		Category:      info.Category,
+		Dimensions:    info.Dimensions,
+		Manufacturer:  info.Manufacturer,
+		Tags:          info.Tags,
+		Metadata:      info.Metadata,
+		Compatibility: info.Compatibility,
+		StockLevels:   info.StockLevels,
+	})
+
+	set := bson.M{"updated_at": updatedAt}
+	for _, f := range mask {
+		switch f {
+		case model.PartFieldName:
+			set["name"] = ent.Name
+		case model.PartFieldDescription:
+			set["description"] = ent.Description
+		case model.PartFieldPriceCents:
+			set["price_cents"] = ent.PriceCents
+		case model.PartFieldStockQuantity:
+			set["stock_quantity"] = ent.StockQuantity
+		case model.PartFieldCategory:
+			set["category"] = ent.Category
+		case model.PartFieldDimensions:
+			set["dimensions"] = ent.Dimensions
+		case model.PartFieldManufacturer:
+			set["manufacturer"] = ent.Manufacturer
+		case model.PartFieldTags:
+			set["tags"] = ent.Tags
+		case model.PartFieldMetadata:
+			set["metadata"] = ent.Metadata
+		case model.PartFieldCompatibility:
+			set["compatibility"] = ent.Compatibility
+		case model.PartFieldStockLevels:
+			set["stock_levels"] = ent.StockLevels
+		default:
+			return nil, fmt.Errorf("unknown part field %q", f)
+		}
+	}
+
+	return setStage(set), nil
+}
+
+// BuildStockTransfer returns the pipeline stages that move quantity of the
+// part from one warehouse to another. Levels that drop to zero are removed.
+func BuildStockTransfer(from, to string, quantity int64, updatedAt time.Time) []bson.M {
+	levels := bson.M{"$ifNull": bson.A{"$stock_levels", bson.A{}}}
+	target := bson.A{bson.M{"warehouse_id": bson.M{"$literal": to}, "quantity": 0}}
+
+	return []bson.M{
+		{"$set": bson.M{"stock_levels": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{bson.M{"$literal": to}, bson.M{"$map": bson.M{
+				"input": levels, "as": "l", "in": "$$l.warehouse_id",
+			}}}},
+			levels,
+			bson.M{"$concatArrays": bson.A{levels, target}},
+		}}}},
+		{"$set": bson.M{"stock_levels": bson.M{"$map": bson.M{
+			"input": "$stock_levels",
+			"as":    "l",
+			"in": bson.M{
+				"warehouse_id": "$$l.warehouse_id",
+				"quantity": bson.M{"$switch": bson.M{
+					"branches": bson.A{
+						bson.M{
+							"case": bson.M{"$eq": bson.A{"$$l.warehouse_id", bson.M{"$literal": from}}},
+							"then": bson.M{"$subtract": bson.A{"$$l.quantity", quantity}},
+						},
+						bson.M{
+							"case": bson.M{"$eq": bson.A{"$$l.warehouse_id", bson.M{"$literal": to}}},
+							"then": bson.M{"$add": bson.A{"$$l.quantity", quantity}},
+						},
+					},
+					"default": "$$l.quantity",
+				}},
+			},
+		}}}},
+		{"$set": bson.M{"stock_levels": bson.M{"$filter": bson.M{
+			"input": "$stock_levels",
+			"as":    "l",
+			"cond":  bson.M{"$gt": bson.A{"$$l.quantity", 0}},
+		}}}},
+		setStage(bson.M{"updated_at": updatedAt}),
+	}
+}
+
+// BuildStockTake returns the pipeline stages that take quantity of the part
+// out of its warehouses in the order of its stock levels. Levels that drop
+// to zero are removed. The part must have at least quantity in stock.
+func BuildStockTake(quantity int64, updatedAt time.Time) []bson.M {
+	levels := bson.M{"$ifNull": bson.A{"$stock_levels", bson.A{}}}
+
+	return []bson.M{
+		{"$set": bson.M{"stock_levels": bson.M{"$let": bson.M{
+			"vars": bson.M{"take": bson.M{"$reduce": bson.M{
+				"input":        levels,
+				"initialValue": bson.M{"left": quantity, "levels": bson.A{}},
+				"in": bson.M{
+					"left": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{"$$value.left", "$$this.quantity"}}}},
+					"levels": bson.M{"$concatArrays": bson.A{"$$value.levels", bson.A{bson.M{
+						"warehouse_id": "$$this.warehouse_id",
+						"quantity": bson.M{"$subtract": bson.A{
+							"$$this.quantity",
+							bson.M{"$min": bson.A{"$$value.left", "$$this.quantity"}},
+						}},
+					}}}},
+				},
+			}}},
+			"in": "$$take.levels",
+		}}}},
+		positiveLevelsStage,
+		{"$set": bson.M{"stock_quantity": bson.M{"$subtract": bson.A{"$stock_quantity", quantity}}}},
+		setStage(bson.M{"updated_at": updatedAt}),
+	}
+}
+
+// BuildStockTakeFrom returns the pipeline stages that take quantity of the
+// part out of one warehouse. A level that drops to zero is removed. The
+// warehouse must hold at least quantity of the part.
+func BuildStockTakeFrom(warehouseID string, quantity int64, updatedAt time.Time) []bson.M {
+	return []bson.M{
+		{"$set": bson.M{"stock_levels": bson.M{"$map": bson.M{
+			"input": "$stock_levels",
+			"as":    "l",
+			"in": bson.M{
+				"warehouse_id": "$$l.warehouse_id",
+				"quantity": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$$l.warehouse_id", bson.M{"$literal": warehouseID}}},
+					bson.M{"$subtract": bson.A{"$$l.quantity", quantity}},
+					"$$l.quantity",
+				}},
+			},
+		}}}},
+		positiveLevelsStage,
+		{"$set": bson.M{"stock_quantity": bson.M{"$subtract": bson.A{"$stock_quantity", quantity}}}},
+		setStage(bson.M{"updated_at": updatedAt}),
+	}
+}
+
+// BuildStockReturn returns the pipeline stages that put the stock levels
+// back into the warehouses of the part.
+func BuildStockReturn(returned []model.StockLevel, updatedAt time.Time) []bson.M {
+	levels := bson.M{"$ifNull": bson.A{"$stock_levels", bson.A{}}}
+
+	var total int64
+	targets := make(bson.A, 0, len(returned))
+	branches := make(bson.A, 0, len(returned))
+	for _, l := range returned {
+		total += l.Quantity
+		targets = append(targets, bson.M{"warehouse_id": l.WarehouseID, "quantity": int64(0)})
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{"$$l.warehouse_id", bson.M{"$literal": l.WarehouseID}}},
+			"then": bson.M{"$add": bson.A{"$$l.quantity", l.Quantity}},
+		})
+	}
+	if len(branches) == 0 {
+		return []bson.M{setStage(bson.M{"updated_at": updatedAt})}
+	}
+
+	return []bson.M{
+		{"$set": bson.M{"stock_levels": bson.M{"$concatArrays": bson.A{levels, bson.M{"$filter": bson.M{
+			"input": bson.M{"$literal": targets},
+			"as":    "t",
+			"cond": bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$t.warehouse_id", bson.M{"$map": bson.M{
+				"input": levels, "as": "l", "in": "$$l.warehouse_id",
+			}}}}}},
+		}}}}}},
+		{"$set": bson.M{"stock_levels": bson.M{"$map": bson.M{
+			"input": "$stock_levels",
+			"as":    "l",
+			"in": bson.M{
+				"warehouse_id": "$$l.warehouse_id",
+				"quantity":     bson.M{"$switch": bson.M{"branches": branches, "default": "$$l.quantity"}},
+			},
+		}}}},
+		positiveLevelsStage,
+		{"$set": bson.M{"stock_quantity": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$stock_quantity", 0}}, total}}}},
+		setStage(bson.M{"updated_at": updatedAt}),
+	}
+}
+
+// positiveLevelsStage removes the stock levels without stock.
+var positiveLevelsStage = bson.M{"$set": bson.M{"stock_levels": bson.M{"$filter": bson.M{
+	"input": "$stock_levels",
+	"as":    "l",
+	"cond":  bson.M{"$gt": bson.A{"$$l.quantity", 0}},
+}}}}
+
+// takenLevels returns how much stock each warehouse lost from before to after.
+func takenLevels(before, after []StockLevelEntity) []model.StockLevel {
+	left := make(map[string]int64, len(after))
+	for _, l := range after {
+		left[l.WarehouseID] = l.Quantity
+	}
+
+	var taken []model.StockLevel
+	for _, l := range before {
+		if q := l.Quantity - left[l.WarehouseID]; q > 0 {
+			taken = append(taken, model.StockLevel{WarehouseID: l.WarehouseID, Quantity: q})
+		}
+	}
+
+	return taken
+}
+
+func ReservationEntityFromModel(r *model.StockReservation) *ReservationEntity {
+	items := make([]ReservedItemEntity, 0, len(r.Items))
+	for _, it := range r.Items {
+		taken := make([]StockLevelEntity, 0, len(it.Taken))
+		for _, l := range it.Taken {
+			taken = append(taken, StockLevelEntity{WarehouseID: l.WarehouseID, Quantity: l.Quantity})
+		}
+		items = append(items, ReservedItemEntity{
+			PartID:          it.PartID,
+			Quantity:        it.Quantity,
+			WarehouseID:     it.WarehouseID,
+			ExpectedVersion: it.ExpectedVersion,
+			Taken:           taken,
+		})
+	}
+
+	return &ReservationEntity{
+		ID:         r.ID,
+		Items:      items,
+		CreatedAt:  r.CreatedAt,
+		ReleasedAt: r.ReleasedAt,
+	}
+}
+
+func ReservationEntityToModel(e *ReservationEntity) *model.StockReservation {
+	items := make([]model.ReservedItem, 0, len(e.Items))
+	for _, it := range e.Items {
+		taken := make([]model.StockLevel, 0, len(it.Taken))
+		for _, l := range it.Taken {
+			taken = append(taken, model.StockLevel{WarehouseID: l.WarehouseID, Quantity: l.Quantity})
+		}
+		items = append(items, model.ReservedItem{
+			PartID:          it.PartID,
+			Quantity:        it.Quantity,
+			WarehouseID:     it.WarehouseID,
+			ExpectedVersion: it.ExpectedVersion,
+			Taken:           taken,
+		})
+	}
+
+	return &model.StockReservation{
+		ID:         e.ID,
+		Items:      items,
+		CreatedAt:  e.CreatedAt,
+		ReleasedAt: e.ReleasedAt,
+	}
+}
+
+// setStage returns a $set pipeline stage writing the values as they are.
+// Without $literal, a string such as "$price_cents" would be read as a field path.
+func setStage(fields bson.M) bson.M {
+	set := make(bson.M, len(fields))
+	for k, v := range fields {
+		set[k] = bson.M{"$literal": v}
+	}
+
+	return bson.M{"$set": set}
+}
+
+// BuildOutboxUpdate returns an update pipeline that runs the stages,
+// increments the version of the part and appends the change to the outbox
+// of the part, with the part before and after the stages. The change is
+// thus committed with the part itself.
+func BuildOutboxUpdate(
+	changeID string,
+	changeType model.PartChangeType,
+	occurredAt time.Time,
+	stages ...bson.M,
+) bson.A {
+	return outboxPipeline(changeID, bson.M{"$literal": changeType}, partSnapshot, occurredAt, stages)
+}
+
+// BuildOutboxUpsert is BuildOutboxUpdate for an upsert: the change is
+// "created" if the part did not exist before the stages and "updated" otherwise.
+func BuildOutboxUpsert(changeID string, occurredAt time.Time, stages ...bson.M) bson.A {
+	// An upserted document starts with the _id only; every part has created_at.
+	existed := bson.M{"$ne": bson.A{bson.M{"$type": "$created_at"}, "missing"}}
+
+	changeType := bson.M{"$cond": bson.A{
+		existed,
+		bson.M{"$literal": model.PartChangeUpdated},
+		bson.M{"$literal": model.PartChangeCreated},
+	}}
+	before := bson.M{"$cond": bson.A{existed, partSnapshot, nil}}
+
+	return outboxPipeline(changeID, changeType, before, occurredAt, stages)
+}
+
+// BuildMongoUpsert returns the pipeline stages that make the stored part
+// equal to p. The creation time of an existing part is kept unless p has one.
+func BuildMongoUpsert(p *model.Part, updatedAt time.Time) ([]bson.M, error) {
+	set, err := BuildMongoUpdate(model.PartInfo{
+		Name:          p.Name,
+		Description:   p.Description,
+		PriceCents:    p.PriceCents,
+		StockQuantity: p.StockQuantity,
+		Category:      p.Category,
+		Dimensions:    p.Dimensions,
+		Manufacturer:  p.Manufacturer,
+		Tags:          p.Tags,
+		Metadata:      p.Metadata,
+		Compatibility: p.Compatibility,
+		StockLevels:   p.StockLevels,
+	}, model.PartFields, updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := bson.M{"$set": bson.M{"created_at": bson.M{"$ifNull": bson.A{
+		"$created_at", bson.M{"$literal": updatedAt},
+	}}}}
+	if p.CreatedAt != nil {
+		createdAt = setStage(bson.M{"created_at": *p.CreatedAt})
+	}
+
+	archivedAt := bson.M{"$unset": "archived_at"}
+	if p.ArchivedAt != nil {
+		archivedAt = setStage(bson.M{"archived_at": *p.ArchivedAt})
+	}
+
+	return []bson.M{set, createdAt, archivedAt}, nil
+}
+
+// partSnapshot is the part document without its outbox.
+var partSnapshot = bson.M{"$unsetField": bson.M{"field": "outbox", "input": "$$ROOT"}}
+
+func outboxPipeline(changeID string, changeType, before any, occurredAt time.Time, stages []bson.M) bson.A {
+	pipeline := make(bson.A, 0, len(stages)+3)
+	pipeline = append(pipeline, bson.M{"$set": bson.M{"outbox": bson.M{"$concatArrays": bson.A{
+		bson.M{"$ifNull": bson.A{"$outbox", bson.A{}}},
+		bson.A{bson.M{
+			"id":          bson.M{"$literal": changeID},
+			"type":        changeType,
+			"occurred_at": occurredAt,
+			"before":      before,
+		}},
+	}}}})
+	for _, st := range stages {
+		pipeline = append(pipeline, st)
+	}
+	pipeline = append(pipeline, bson.M{"$set": bson.M{"version": bson.M{"$add": bson.A{
+		bson.M{"$ifNull": bson.A{"$version", 0}}, 1,
+	}}}})
+
+	return append(pipeline, bson.M{"$set": bson.M{"outbox": bson.M{"$map": bson.M{
+		"input": "$outbox",
+		"as":    "change",
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$$change.id", bson.M{"$literal": changeID}}},
+			bson.M{"$mergeObjects": bson.A{"$$change", bson.M{"after": partSnapshot}}},
+			"$$change",
+		}},
+	}}}})
+}
+
+func newPartDocument(p *model.Part, changeID string) *partDocument {
+	ent := EntityFromModel(p)
+
+	return &partDocument{
+		PartEntity: *ent,
+		Outbox: []PartChangeEntity{{
+			ID:         changeID,
+			Type:       model.PartChangeCreated,
+			After:      ent,
+			OccurredAt: lo.FromPtr(p.CreatedAt),
+		}},
+	}
+}
+
+// documentChanges returns the changes in the outbox of the document.
+func documentChanges(doc *partDocument) []model.PartChange {
+	out := make([]model.PartChange, 0, len(doc.Outbox))
+	for _, e := range doc.Outbox {
+		out = append(out, ChangeEntityToModel(doc.ID, e))
+	}
+
+	return out
+}
+
+func ChangeEntityToModel(partID string, e PartChangeEntity) model.PartChange {
+	return model.PartChange{
+		ID:         e.ID,
+		PartID:     partID,
+		Type:       e.Type,
+		Before:     EntityToModel(e.Before),
+		After:      EntityToModel(e.After),
+		OccurredAt: e.OccurredAt,
+	}
+}
+
 func normalizeCountry(s string) string {
 	return strings.ToLower(strings.TrimSpace(s))
 }
+
+// PriceChangeEntityFromChange returns the price history entry of the change,
+// or nil if the change keeps the price.
+func PriceChangeEntityFromChange(c model.PartChange) *PriceChangeEntity {
+	if c.After == nil || (c.Before != nil && c.Before.PriceCents == c.After.PriceCents) {
+		return nil
+	}
+
+	return &PriceChangeEntity{
+		ID:         c.ID,
+		PartID:     c.PartID,
+		PriceCents: c.After.PriceCents,
+		Version:    c.After.Version,
+		ChangedAt:  c.OccurredAt,
+	}
+}
+
+func PriceChangeEntityToModel(e PriceChangeEntity) model.PriceChange {
+	return model.PriceChange{
+		PartID:     e.PartID,
+		PriceCents: e.PriceCents,
+		Version:    e.Version,
+		ChangedAt:  e.ChangedAt,
+	}
+}
@@ -7,18 +7,33 @@ import (
 )
 
 type PartEntity struct {
-	ID            string              `bson:"_id"`
-	Name          string              `bson:"name"`
-	Description   string              `bson:"description,omitempty"`
-	PriceCents    int64               `bson:"price_cents"`
-	StockQuantity int64               `bson:"stock_quantity"`
-	Category      model.Category      `bson:"category"`
-	Dimensions    *DimensionsEntity   `bson:"dimensions,omitempty"`
-	Manufacturer  *ManufacturerEntity `bson:"manufacturer,omitempty"`
-	Tags          []string            `bson:"tags,omitempty"`
-	Metadata      map[string]any      `bson:"metadata,omitempty"`
-	CreatedAt     *time.Time          `bson:"created_at,omitempty"`
-	UpdatedAt     *time.Time          `bson:"updated_at,omitempty"`
+	ID            string                    `bson:"_id"`
+	Name          string                    `bson:"name"`
+	Description   string                    `bson:"description,omitempty"`
+	PriceCents    int64                     `bson:"price_cents"`
+	StockQuantity int64                     `bson:"stock_quantity"`
+	Category      model.Category            `bson:"category"`
+	Dimensions    *DimensionsEntity         `bson:"dimensions,omitempty"`
+	Manufacturer  *ManufacturerEntity       `bson:"manufacturer,omitempty"`
+	Tags          []string                  `bson:"tags,omitempty"`
+	Metadata      map[string]any            `bson:"metadata,omitempty"`
+	CreatedAt     *time.Time                `bson:"created_at,omitempty"`
+	UpdatedAt     *time.Time                `bson:"updated_at,omitempty"`
+	ArchivedAt    *time.Time                `bson:"archived_at,omitempty"`
+	Compatibility []CompatibilityRuleEntity `bson:"compatibility,omitempty"`
+	Version       int64                     `bson:"version"`
+	StockLevels   []StockLevelEntity        `bson:"stock_levels,omitempty"`
+}
+
+type StockLevelEntity struct {
+	WarehouseID string `bson:"warehouse_id"`
+	Quantity    int64  `bson:"quantity"`
+}
+
+type CompatibilityRuleEntity struct {
+	Kind     model.RuleKind `bson:"kind"`
+	PartID   string         `bson:"part_uuid,omitempty"`
+	Category model.Category `bson:"category,omitempty"`
 }
 
 type ManufacturerEntity struct {
@@ -34,3 +49,49 @@ type DimensionsEntity struct {
 	Height float64 `bson:"height"`
 	Weight float64 `bson:"weight"`
 }
+
+// PartChangeEntity is an unpublished change stored in the outbox array of
+// the part document, so it is written atomically with the change itself.
+type PartChangeEntity struct {
+	ID         string               `bson:"id"`
+	Type       model.PartChangeType `bson:"type"`
+	Before     *PartEntity          `bson:"before"`
+	After      *PartEntity          `bson:"after"`
+	OccurredAt time.Time            `bson:"occurred_at"`
+}
+
+// PriceChangeEntity is a document of the price history. Its ID is the ID of
+// the part change that set the price.
+type PriceChangeEntity struct {
+	ID         string    `bson:"_id"`
+	PartID     string    `bson:"part_uuid"`
+	PriceCents int64     `bson:"price_cents"`
+	Version    int64     `bson:"version"`
+	ChangedAt  time.Time `bson:"changed_at"`
+}
+
+type partDocument struct {
+	PartEntity `bson:",inline"`
+	Outbox     []PartChangeEntity `bson:"outbox"`
+}
+
+type outboxDocument struct {
+	ID     string             `bson:"_id"`
+	Outbox []PartChangeEntity `bson:"outbox"`
+}
+
+// ReservationEntity is a document of the reservations collection.
+type ReservationEntity struct {
+	ID         string               `bson:"_id"`
+	Items      []ReservedItemEntity `bson:"items"`
+	CreatedAt  time.Time            `bson:"created_at"`
+	ReleasedAt *time.Time           `bson:"released_at,omitempty"`
+}
+
+type ReservedItemEntity struct {
+	PartID          string             `bson:"part_uuid"`
+	Quantity        int64              `bson:"quantity"`
+	WarehouseID     string             `bson:"warehouse_id,omitempty"`
+	ExpectedVersion int64              `bson:"expected_version,omitempty"`
+	Taken           []StockLevelEntity `bson:"taken"`
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+// priceHistorySort orders the price changes of a part by time; changes made
+// at the same time are ordered by the version they made.
+var priceHistorySort = bson.D{{Key: "changed_at", Value: 1}, {Key: "version", Value: 1}}
+
+// PriceHistory returns the price changes of a part made in the range of
+// params, oldest first. With params.From set, the last change made before
+// it comes first, as it sets the price in effect at From.
+func (r *repository) PriceHistory(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error) {
+	const op = "repository.PriceHistory"
+
+	var out []model.PriceChange
+	if params.From != nil {
+		var ent PriceChangeEntity
+		err := r.prices.FindOne(ctx,
+			bson.M{"part_uuid": params.PartID, "changed_at": bson.M{"$lt": *params.From}},
+			options.FindOne().SetSort(bson.D{{Key: "changed_at", Value: -1}, {Key: "version", Value: -1}}),
+		).Decode(&ent)
+		switch {
+		case err == nil:
+			out = append(out, PriceChangeEntityToModel(ent))
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	changedAt := bson.M{}
+	if params.From != nil {
+		changedAt["$gte"] = *params.From
+	}
+	if params.To != nil {
+		changedAt["$lt"] = *params.To
+	}
+	filter := bson.M{"part_uuid": params.PartID}
+	if len(changedAt) > 0 {
+		filter["changed_at"] = changedAt
+	}
+
+	cur, err := r.prices.Find(ctx, filter, options.Find().SetSort(priceHistorySort))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var ents []PriceChangeEntity
+	if err := cur.All(ctx, &ents); err != nil {
+		return nil, fmt.Errorf("%s cursor: %w", op, err)
+	}
+	for _, e := range ents {
+		out = append(out, PriceChangeEntityToModel(e))
+	}
+
+	return out, nil
+}
+
+// recordPrices adds the changes that set a new price to the price history.
+func (r *repository) recordPrices(ctx context.Context, changes []model.PartChange) error {
+	var docs []any
+	for _, c := range changes {
+		if ent := PriceChangeEntityFromChange(c); ent != nil {
+			docs = append(docs, ent)
+		}
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	if _, err := r.prices.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("record prices: %w", err)
+	}
+
+	return nil
+}
+
+// changesByIDs returns the changes with changeIDs from the outboxes of the parts with ids.
+func (r *repository) changesByIDs(ctx context.Context, ids, changeIDs []string) ([]model.PartChange, error) {
+	cur, err := r.coll.Find(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		options.Find().SetProjection(bson.M{
+			"outbox": bson.M{"$elemMatch": bson.M{"id": bson.M{"$in": changeIDs}}},
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find changes: %w", err)
+	}
+
+	var docs []outboxDocument
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("find changes cursor: %w", err)
+	}
+
+	out := make([]model.PartChange, 0, len(docs))
+	for _, d := range docs {
+		for _, e := range d.Outbox {
+			out = append(out, ChangeEntityToModel(d.ID, e))
+		}
+	}
+
+	return out, nil
+}
+
+// inTransaction runs fn in a transaction. fn is run again on transient
+// errors, so it must only write through the context it gets.
+func (r *repository) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	sess, err := r.coll.Database().Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	defer sess.EndSession(ctx)
+
+	_, err = sess.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+
+	return err
+}
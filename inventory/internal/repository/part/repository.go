@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
-	"github.com/samber/lo"
+	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -15,19 +16,34 @@ import (
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
+// partProjection leaves out the outbox, which only the change relay reads.
+var partProjection = bson.M{"outbox": 0}
+
+// lastChangeProjection keeps only the newest change of the outbox, which is
+// the one made by the update that returns the document.
+var lastChangeProjection = bson.M{"outbox": bson.M{"$slice": -1}}
+
 type repository struct {
-	coll *mongo.Collection
+	coll         *mongo.Collection
+	prices       *mongo.Collection
+	reservations *mongo.Collection
 }
 
-func NewPartRepository(collection *mongo.Collection) *repository {
-	return &repository{coll: collection}
+// NewPartRepository returns a repository of the parts collection. Every
+// price change of a part is recorded in the prices collection and every
+// stock reservation in the reservations collection in the same transaction
+// as the part, so Mongo must run as a replica set.
+func NewPartRepository(parts, prices, reservations *mongo.Collection) *repository {
+	return &repository{coll: parts, prices: prices, reservations: reservations}
 }
 
 func (s *repository) PartByID(ctx context.Context, id string) (*model.Part, error) {
 	const op = "repository.PartByID"
 
 	var ent PartEntity
-	err := s.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&ent)
+	err := s.coll.FindOne(ctx, bson.M{"_id": id},
+		options.FindOne().SetProjection(partProjection),
+	).Decode(&ent)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, model.ErrPartNotFound
@@ -38,15 +54,23 @@ func (s *repository) PartByID(ctx context.Context, id string) (*model.Part, erro
 	return EntityToModel(&ent), nil
 }
 
-func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+// List returns one page of parts selected by the query.
+func (r *repository) List(ctx context.Context, q model.PartsQuery) ([]*model.Part, error) {
 	const op = "repository.List"
 
-	cur, err := r.coll.Find(ctx, BuildMongoFilter(filter))
+	filter, sort, err := BuildMongoPage(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cur, err := r.coll.Find(ctx, filter,
+		options.Find().SetSort(sort).SetLimit(int64(q.Limit)).SetProjection(partProjection),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
-		if cerr := cur.Close(ctx); err != nil {
+		if cerr := cur.Close(ctx); cerr != nil {
 			logger.Error(ctx, "cursor close",
 				logger.ErrorF(fmt.Errorf("%s failed to close cursor: %w", op, cerr)),
 			)
@@ -54,7 +78,7 @@ func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*mod
 		}
 	}()
 
-	out := make([]*model.Part, 0)
+	out := make([]*model.Part, 0, q.Limit)
 	for cur.Next(ctx) {
 		var ent PartEntity
 		if err := cur.Decode(&ent); err != nil {
@@ -69,31 +93,325 @@ func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*mod
 	return out, nil
 }
 
-func (r *repository) CreateBatch(ctx context.Context, parts []*model.Part) error {
+// Count returns the number of parts matching the filter.
+func (r *repository) Count(ctx context.Context, filter model.PartsFilter) (int64, error) {
+	const op = "repository.Count"
+
+	n, err := r.coll.CountDocuments(ctx, BuildMongoFilter(filter))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+// Matches reports whether the part matches the filter.
+func (r *repository) Matches(ctx context.Context, id string, filter model.PartsFilter) (bool, error) {
+	const op = "repository.Matches"
+
+	n, err := r.coll.CountDocuments(ctx,
+		bson.M{"$and": bson.A{bson.M{"_id": id}, BuildMongoFilter(filter)}},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n > 0, nil
+}
+
+// CreateBatch upserts the parts by ID in one bulk write; each written part
+// gets a created or updated change in its outbox.
+func (r *repository) CreateBatch(ctx context.Context, parts []*model.Part) (model.BatchResult, error) {
 	const op = "repository.CreateBatch"
 
-	docs := make([]any, 0, len(parts))
+	now := time.Now()
+	writes := make([]mongo.WriteModel, 0, len(parts))
+	ids := make([]string, 0, len(parts))
+	changeIDs := make([]string, 0, len(parts))
 	for _, p := range parts {
 		if p == nil {
 			continue
 		}
 		if p.ID == "" {
-			return fmt.Errorf("%s: part ID is empty", op)
+			return model.BatchResult{}, fmt.Errorf("%s: part ID is empty", op)
 		}
-		if p.CreatedAt == nil || p.CreatedAt.IsZero() {
-			p.CreatedAt = lo.ToPtr(time.Now())
+
+		stages, err := BuildMongoUpsert(p, now)
+		if err != nil {
+			return model.BatchResult{}, fmt.Errorf("%s: %w", op, err)
 		}
 
-		docs = append(docs, EntityFromModel(p))
+		changeID := uuid.NewString()
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": p.ID}).
+			SetUpdate(BuildOutboxUpsert(changeID, now, stages...)).
+			SetUpsert(true),
+		)
+		ids = append(ids, p.ID)
+		changeIDs = append(changeIDs, changeID)
 	}
-	if len(docs) == 0 {
+	if len(writes) == 0 {
+		return model.BatchResult{}, nil
+	}
+
+	var out model.BatchResult
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		res, err := r.coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+		out = model.BatchResult{
+			Created: int(res.UpsertedCount),
+			Updated: int(res.MatchedCount),
+		}
+
+		changes, err := r.changesByIDs(ctx, ids, changeIDs)
+		if err != nil {
+			return err
+		}
+
+		return r.recordPrices(ctx, changes)
+	})
+	if err != nil {
+		return model.BatchResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+func (r *repository) Create(ctx context.Context, part *model.Part) error {
+	const op = "repository.Create"
+
+	doc := newPartDocument(part, uuid.NewString())
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		if _, err := r.coll.InsertOne(ctx, doc); err != nil {
+			return err
+		}
+
+		return r.recordPrices(ctx, documentChanges(doc))
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Update writes the masked fields of info if the part still has the
+// expected version and returns the updated part. A part with another
+// version is left as it is and VersionMismatchError is returned.
+func (r *repository) Update(
+	ctx context.Context,
+	id string,
+	expectedVersion int64,
+	info model.PartInfo,
+	mask []model.PartField,
+	updatedAt time.Time,
+) (*model.Part, error) {
+	const op = "repository.Update"
+
+	set, err := BuildMongoUpdate(info, mask, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changeType := model.PartChangeUpdated
+	if len(mask) > 0 && !slices.ContainsFunc(mask, func(f model.PartField) bool {
+		return f != model.PartFieldStockQuantity && f != model.PartFieldStockLevels
+	}) {
+		changeType = model.PartChangeStockChanged
+	}
+
+	var p *model.Part
+	err = r.inTransaction(ctx, func(ctx context.Context) error {
+		doc, err := r.findOneAndUpdate(ctx, op, bson.M{"_id": id, "version": expectedVersion},
+			BuildOutboxUpdate(uuid.NewString(), changeType, updatedAt, set),
+		)
+		if err != nil {
+			return err
+		}
+		p = EntityToModel(&doc.PartEntity)
+
+		err = r.recordPrices(ctx, documentChanges(doc))
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
 		return nil
+	})
+	if err == nil {
+		return p, nil
+	}
+	if !errors.Is(err, model.ErrPartNotFound) {
+		return nil, err
+	}
+
+	// Either the part does not exist or its version is not the expected one.
+	return nil, r.versionMismatch(ctx, op, id)
+}
+
+// TransferStock moves stock of the part between warehouses if the part
+// still has the expected version and the source warehouse has enough stock.
+func (r *repository) TransferStock(
+	ctx context.Context,
+	params model.TransferStockParams,
+	updatedAt time.Time,
+) (*model.Part, error) {
+	const op = "repository.TransferStock"
+
+	filter := bson.M{
+		"_id":     params.PartID,
+		"version": params.ExpectedVersion,
+		"stock_levels": bson.M{"$elemMatch": bson.M{
+			"warehouse_id": params.FromWarehouseID,
+			"quantity":     bson.M{"$gte": params.Quantity},
+		}},
 	}
+	update := BuildOutboxUpdate(uuid.NewString(), model.PartChangeStockChanged, updatedAt,
+		BuildStockTransfer(params.FromWarehouseID, params.ToWarehouseID, params.Quantity, updatedAt)...,
+	)
 
-	_, err := r.coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	doc, err := r.findOneAndUpdate(ctx, op, filter, update)
+	if err == nil {
+		return EntityToModel(&doc.PartEntity), nil
+	}
+	if !errors.Is(err, model.ErrPartNotFound) {
+		return nil, err
+	}
+
+	err = r.versionMismatch(ctx, op, params.PartID)
+	if !errors.Is(err, model.ErrVersionMismatch) {
+		return nil, err
+	}
+	var mismatch *model.VersionMismatchError
+	if errors.As(err, &mismatch) && mismatch.Current == params.ExpectedVersion {
+		return nil, fmt.Errorf("%s: %w in warehouse %s", op, model.ErrInsufficientStock, params.FromWarehouseID)
+	}
+
+	return nil, err
+}
+
+// versionMismatch explains why an update of the part with an expected
+// version matched nothing: the part does not exist, or its version differs.
+// The error has the current version, which is the expected one if another
+// condition of the update failed.
+func (r *repository) versionMismatch(ctx context.Context, op, id string) error {
+	var cur struct {
+		Version int64 `bson:"version"`
+	}
+	err := r.coll.FindOne(ctx, bson.M{"_id": id},
+		options.FindOne().SetProjection(bson.M{"version": 1}),
+	).Decode(&cur)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.ErrPartNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &model.VersionMismatchError{Current: cur.Version}
+}
+
+// SetArchivedAt archives the part at archivedAt, or restores it if archivedAt is nil.
+func (r *repository) SetArchivedAt(
+	ctx context.Context,
+	id string,
+	archivedAt *time.Time,
+	updatedAt time.Time,
+) (*model.Part, error) {
+	const op = "repository.SetArchivedAt"
+
+	update := BuildOutboxUpdate(uuid.NewString(), model.PartChangeArchived, updatedAt,
+		setStage(bson.M{"updated_at": updatedAt, "archived_at": archivedAt}),
+	)
+	if archivedAt == nil {
+		update = BuildOutboxUpdate(uuid.NewString(), model.PartChangeRestored, updatedAt,
+			setStage(bson.M{"updated_at": updatedAt}),
+			bson.M{"$unset": "archived_at"},
+		)
+	}
+
+	doc, err := r.findOneAndUpdate(ctx, op, bson.M{"_id": id}, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return EntityToModel(&doc.PartEntity), nil
+}
+
+// PendingChanges returns the unpublished changes of at most limit parts.
+// Changes of one part are returned in the order they were made.
+func (r *repository) PendingChanges(ctx context.Context, limit int) ([]model.PartChange, error) {
+	const op = "repository.PendingChanges"
+
+	cur, err := r.coll.Find(ctx,
+		bson.M{"outbox.id": bson.M{"$exists": true}},
+		options.Find().
+			SetProjection(bson.M{"outbox": 1}).
+			SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if cerr := cur.Close(ctx); cerr != nil {
+			logger.Error(ctx, "cursor close",
+				logger.ErrorF(fmt.Errorf("%s failed to close cursor: %w", op, cerr)),
+			)
+		}
+	}()
+
+	var out []model.PartChange
+	for cur.Next(ctx) {
+		var doc outboxDocument
+		if err := cur.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%s decode: %w", op, err)
+		}
+		for _, e := range doc.Outbox {
+			out = append(out, ChangeEntityToModel(doc.ID, e))
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, fmt.Errorf("%s cursor: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// AckChange removes a published change from the outbox of the part.
+func (r *repository) AckChange(ctx context.Context, partID, changeID string) error {
+	const op = "repository.AckChange"
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": partID},
+		bson.M{"$pull": bson.M{"outbox": bson.M{"id": changeID}}},
+	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
+
+// findOneAndUpdate returns the updated part with the change made by update
+// as the only entry of its outbox.
+func (r *repository) findOneAndUpdate(ctx context.Context, op string, filter bson.M, update bson.A) (*partDocument, error) {
+	var doc partDocument
+	err := r.coll.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(lastChangeProjection),
+	).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &doc, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+// ReserveStock takes the stock of the items out of their parts and records
+// the reservation, all in one transaction. A reservation that is already
+// recorded is returned as it is and takes nothing.
+func (r *repository) ReserveStock(
+	ctx context.Context,
+	id string,
+	items []model.ReservedItem,
+	reservedAt time.Time,
+) (*model.StockReservation, error) {
+	const op = "repository.ReserveStock"
+
+	var out *model.StockReservation
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		existing, err := r.reservation(ctx, id)
+		switch {
+		case err == nil && existing.ReleasedAt != nil:
+			return model.ErrReservationReleased
+		case err == nil:
+			out = existing
+			return nil
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return err
+		}
+
+		res := &model.StockReservation{ID: id, CreatedAt: reservedAt}
+		for _, it := range items {
+			taken, err := r.takeStock(ctx, op, it, reservedAt)
+			if err != nil {
+				return err
+			}
+			it.Taken = taken
+			res.Items = append(res.Items, it)
+		}
+
+		if _, err := r.reservations.InsertOne(ctx, ReservationEntityFromModel(res)); err != nil {
+			return err
+		}
+		out = res
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// ReleaseStock puts the stock of the reservation back into the warehouses it
+// was taken from and marks the reservation released. An unknown reservation
+// is recorded as released, so that a late ReserveStock takes nothing.
+func (r *repository) ReleaseStock(ctx context.Context, id string, releasedAt time.Time) error {
+	const op = "repository.ReleaseStock"
+
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		res, err := r.reservation(ctx, id)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			_, err = r.reservations.InsertOne(ctx, ReservationEntity{
+				ID:         id,
+				Items:      []ReservedItemEntity{},
+				CreatedAt:  releasedAt,
+				ReleasedAt: &releasedAt,
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if res.ReleasedAt != nil {
+			return nil
+		}
+
+		for _, it := range res.Items {
+			_, err := r.findOneAndUpdate(ctx, op, bson.M{"_id": it.PartID},
+				BuildOutboxUpdate(uuid.NewString(), model.PartChangeStockChanged, releasedAt,
+					BuildStockReturn(it.Taken, releasedAt)...,
+				),
+			)
+			if err != nil {
+				return fmt.Errorf("part %s: %w", it.PartID, err)
+			}
+		}
+
+		_, err = r.reservations.UpdateOne(ctx, bson.M{"_id": id},
+			bson.M{"$set": bson.M{"released_at": releasedAt}},
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// takeStock takes the quantity of the item out of its part, from its
+// warehouse if it names one, and returns how much it took from each
+// warehouse. The part must still have the expected version of the item.
+func (r *repository) takeStock(
+	ctx context.Context,
+	op string,
+	it model.ReservedItem,
+	takenAt time.Time,
+) ([]model.StockLevel, error) {
+	filter := bson.M{
+		"_id":            it.PartID,
+		"version":        it.ExpectedVersion,
+		"archived_at":    nil,
+		"stock_quantity": bson.M{"$gte": it.Quantity},
+	}
+	take := BuildStockTake(it.Quantity, takenAt)
+	if it.WarehouseID != "" {
+		filter["stock_levels"] = bson.M{"$elemMatch": bson.M{
+			"warehouse_id": it.WarehouseID,
+			"quantity":     bson.M{"$gte": it.Quantity},
+		}}
+		take = BuildStockTakeFrom(it.WarehouseID, it.Quantity, takenAt)
+	}
+	update := BuildOutboxUpdate(uuid.NewString(), model.PartChangeStockChanged, takenAt, take...)
+
+	doc, err := r.findOneAndUpdate(ctx, op, filter, update)
+	if err == nil {
+		var before []StockLevelEntity
+		if len(doc.Outbox) > 0 && doc.Outbox[0].Before != nil {
+			before = doc.Outbox[0].Before.StockLevels
+		}
+		return takenLevels(before, doc.StockLevels), nil
+	}
+	if !errors.Is(err, model.ErrPartNotFound) {
+		return nil, err
+	}
+
+	// Either the part does not exist, changed, is archived or lacks the stock.
+	var cur PartEntity
+	err = r.coll.FindOne(ctx, bson.M{"_id": it.PartID},
+		options.FindOne().SetProjection(partProjection),
+	).Decode(&cur)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, it.PartID)
+	case err != nil:
+		return nil, err
+	case cur.Version != it.ExpectedVersion:
+		return nil, fmt.Errorf("part %s: %w", it.PartID, &model.VersionMismatchError{Current: cur.Version})
+	case cur.ArchivedAt != nil:
+		return nil, fmt.Errorf("%w: %s", model.ErrPartArchived, it.PartID)
+	case it.WarehouseID != "":
+		var left int64
+		for _, l := range cur.StockLevels {
+			if l.WarehouseID == it.WarehouseID {
+				left = l.Quantity
+			}
+		}
+		return nil, fmt.Errorf("%w of part %s in warehouse %s: %d left, %d asked",
+			model.ErrInsufficientStock, it.PartID, it.WarehouseID, left, it.Quantity)
+	default:
+		return nil, fmt.Errorf("%w of part %s: %d left, %d asked",
+			model.ErrInsufficientStock, it.PartID, cur.StockQuantity, it.Quantity)
+	}
+}
+
+func (r *repository) reservation(ctx context.Context, id string) (*model.StockReservation, error) {
+	var ent ReservationEntity
+	if err := r.reservations.FindOne(ctx, bson.M{"_id": id}).Decode(&ent); err != nil {
+		return nil, err
+	}
+
+	return ReservationEntityToModel(&ent), nil
+}
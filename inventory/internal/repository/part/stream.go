@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+// Server error codes of a change stream that cannot resume from its token.
+const (
+	codeChangeStreamFatal       = 280
+	codeChangeStreamHistoryLost = 286
+)
+
+type changeEventEntity struct {
+	OperationType string `bson:"operationType"`
+	DocumentKey   struct {
+		ID string `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument *PartEntity `bson:"fullDocument"`
+}
+
+// partsStream reads part changes from a Mongo change stream. Change streams
+// need a replica set; a single-node one is enough.
+type partsStream struct {
+	cs *mongo.ChangeStream
+}
+
+// WatchParts opens a change stream of the parts collection. With a nil
+// resumeAfter the stream starts now, otherwise right after the token.
+func (r *repository) WatchParts(ctx context.Context, resumeAfter []byte) (model.PartsStream, error) {
+	const op = "repository.WatchParts"
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeAfter != nil {
+		if err := bson.Raw(resumeAfter).Validate(); err != nil {
+			return nil, fmt.Errorf("%w: malformed resume token", model.ErrInvalidArgument)
+		}
+		opts.SetResumeAfter(bson.Raw(resumeAfter))
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"operationType": bson.M{"$in": bson.A{"insert", "replace", "delete"}}},
+			// Every part update sets updated_at; updates of the change relay
+			// only touch the outbox and are skipped.
+			bson.M{
+				"operationType": "update",
+				"updateDescription.updatedFields.updated_at": bson.M{"$exists": true},
+			},
+		}}}},
+		{{Key: "$project", Value: bson.M{"fullDocument.outbox": 0}}},
+	}
+
+	cs, err := r.coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, streamError(err))
+	}
+
+	return &partsStream{cs: cs}, nil
+}
+
+func (s *partsStream) Next(ctx context.Context) (model.PartStreamChange, error) {
+	const op = "repository.partsStream.Next"
+
+	if !s.cs.Next(ctx) {
+		err := s.cs.Err()
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err == nil {
+			err = errors.New("change stream closed")
+		}
+		return model.PartStreamChange{}, fmt.Errorf("%s: %w", op, streamError(err))
+	}
+
+	var ev changeEventEntity
+	if err := s.cs.Decode(&ev); err != nil {
+		return model.PartStreamChange{}, fmt.Errorf("%s decode: %w", op, err)
+	}
+
+	return model.PartStreamChange{
+		PartID:  ev.DocumentKey.ID,
+		Deleted: ev.OperationType == "delete",
+		Part:    EntityToModel(ev.FullDocument),
+	}, nil
+}
+
+func (s *partsStream) ResumeToken() []byte {
+	return s.cs.ResumeToken()
+}
+
+func (s *partsStream) Close(ctx context.Context) error {
+	return s.cs.Close(ctx)
+}
+
+func streamError(err error) error {
+	var se mongo.ServerError
+	if errors.As(err, &se) &&
+		(se.HasErrorCode(codeChangeStreamHistoryLost) || se.HasErrorCode(codeChangeStreamFatal)) {
+		return fmt.Errorf("%w: %w", model.ErrResumeTokenExpired, err)
+	}
+	return err
+}
@@ -0,0 +1,21 @@
+package repository
+
+import "github.com/you-humble/rocket-maintenance/inventory/internal/model"
+
+func EntityToModel(e WarehouseEntity) model.Warehouse {
+	return model.Warehouse{
+		ID:        e.ID,
+		Name:      e.Name,
+		Spaceport: e.Spaceport,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func EntityFromModel(w *model.Warehouse) WarehouseEntity {
+	return WarehouseEntity{
+		ID:        w.ID,
+		Name:      w.Name,
+		Spaceport: w.Spaceport,
+		CreatedAt: w.CreatedAt,
+	}
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "time"
+
+type WarehouseEntity struct {
+	ID        string    `bson:"_id"`
+	Name      string    `bson:"name"`
+	Spaceport string    `bson:"spaceport,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
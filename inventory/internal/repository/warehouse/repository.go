@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+type repository struct {
+	coll *mongo.Collection
+}
+
+func NewWarehouseRepository(collection *mongo.Collection) *repository {
+	return &repository{coll: collection}
+}
+
+func (r *repository) Create(ctx context.Context, w *model.Warehouse) error {
+	const op = "repository.warehouse.Create"
+
+	if _, err := r.coll.InsertOne(ctx, EntityFromModel(w)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrWarehouseExists
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EnsureDefault creates the default warehouse unless it exists.
+func (r *repository) EnsureDefault(ctx context.Context, createdAt time.Time) error {
+	const op = "repository.warehouse.EnsureDefault"
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": model.DefaultWarehouseID},
+		bson.M{"$setOnInsert": bson.M{"name": "Default warehouse", "created_at": createdAt}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// List returns the warehouses with the IDs ordered by ID, or all of them if ids is nil.
+func (r *repository) List(ctx context.Context, ids []string) ([]model.Warehouse, error) {
+	const op = "repository.warehouse.List"
+
+	filter := bson.M{}
+	if ids != nil {
+		filter["_id"] = bson.M{"$in": ids}
+	}
+
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var ents []WarehouseEntity
+	if err := cur.All(ctx, &ents); err != nil {
+		return nil, fmt.Errorf("%s cursor: %w", op, err)
+	}
+
+	out := make([]model.Warehouse, 0, len(ents))
+	for _, e := range ents {
+		out = append(out, EntityToModel(e))
+	}
+
+	return out, nil
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
@@ -38,28 +39,219 @@ func (_m *MockPartRepository) EXPECT() *MockPartRepository_Expecter {
 	return &MockPartRepository_Expecter{mock: &_m.Mock}
 }
 
-// List provides a mock function for the type MockPartRepository
-func (_mock *MockPartRepository) List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+// Count provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) Count(ctx context.Context, filter model.PartsFilter) (int64, error) {
 	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Count")
 	}
 
-	var r0 []*model.Part
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsFilter) ([]*model.Part, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsFilter) (int64, error)); ok {
 		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsFilter) []*model.Part); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsFilter) int64); ok {
 		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PartsFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockPartRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+func (_e *MockPartRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockPartRepository_Count_Call {
+	return &MockPartRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockPartRepository_Count_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *MockPartRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.PartsFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.PartsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_Count_Call) Return(n int64, err error) *MockPartRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPartRepository_Count_Call) RunAndReturn(run func(ctx context.Context, filter model.PartsFilter) (int64, error)) *MockPartRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) Create(ctx context.Context, part *model.Part) error {
+	ret := _mock.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Part) error); ok {
+		r0 = returnFunc(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPartRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPartRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *MockPartRepository_Expecter) Create(ctx interface{}, part interface{}) *MockPartRepository_Create_Call {
+	return &MockPartRepository_Create_Call{Call: _e.mock.On("Create", ctx, part)}
+}
+
+func (_c *MockPartRepository_Create_Call) Run(run func(ctx context.Context, part *model.Part)) *MockPartRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Part
+		if args[1] != nil {
+			arg1 = args[1].(*model.Part)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_Create_Call) Return(err error) *MockPartRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPartRepository_Create_Call) RunAndReturn(run func(ctx context.Context, part *model.Part) error) *MockPartRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) CreateBatch(ctx context.Context, parts []*model.Part) (model.BatchResult, error) {
+	ret := _mock.Called(ctx, parts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 model.BatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Part) (model.BatchResult, error)); ok {
+		return returnFunc(ctx, parts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Part) model.BatchResult); ok {
+		r0 = returnFunc(ctx, parts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.BatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*model.Part) error); ok {
+		r1 = returnFunc(ctx, parts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockPartRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parts []*model.Part
+func (_e *MockPartRepository_Expecter) CreateBatch(ctx interface{}, parts interface{}) *MockPartRepository_CreateBatch_Call {
+	return &MockPartRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, parts)}
+}
+
+func (_c *MockPartRepository_CreateBatch_Call) Run(run func(ctx context.Context, parts []*model.Part)) *MockPartRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.Part
+		if args[1] != nil {
+			arg1 = args[1].([]*model.Part)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_CreateBatch_Call) Return(batchResult model.BatchResult, err error) *MockPartRepository_CreateBatch_Call {
+	_c.Call.Return(batchResult, err)
+	return _c
+}
+
+func (_c *MockPartRepository_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, parts []*model.Part) (model.BatchResult, error)) *MockPartRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) List(ctx context.Context, query model.PartsQuery) ([]*model.Part, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*model.Part
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsQuery) ([]*model.Part, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PartsQuery) []*model.Part); ok {
+		r0 = returnFunc(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Part)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PartsFilter) error); ok {
-		r1 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PartsQuery) error); ok {
+		r1 = returnFunc(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,20 +265,20 @@ type MockPartRepository_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter model.PartsFilter
-func (_e *MockPartRepository_Expecter) List(ctx interface{}, filter interface{}) *MockPartRepository_List_Call {
-	return &MockPartRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
+//   - query model.PartsQuery
+func (_e *MockPartRepository_Expecter) List(ctx interface{}, query interface{}) *MockPartRepository_List_Call {
+	return &MockPartRepository_List_Call{Call: _e.mock.On("List", ctx, query)}
 }
 
-func (_c *MockPartRepository_List_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *MockPartRepository_List_Call {
+func (_c *MockPartRepository_List_Call) Run(run func(ctx context.Context, query model.PartsQuery)) *MockPartRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 model.PartsFilter
+		var arg1 model.PartsQuery
 		if args[1] != nil {
-			arg1 = args[1].(model.PartsFilter)
+			arg1 = args[1].(model.PartsQuery)
 		}
 		run(
 			arg0,
@@ -101,7 +293,79 @@ func (_c *MockPartRepository_List_Call) Return(parts []*model.Part, err error) *
 	return _c
 }
 
-func (_c *MockPartRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)) *MockPartRepository_List_Call {
+func (_c *MockPartRepository_List_Call) RunAndReturn(run func(ctx context.Context, query model.PartsQuery) ([]*model.Part, error)) *MockPartRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Matches provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) Matches(ctx context.Context, id string, filter model.PartsFilter) (bool, error) {
+	ret := _mock.Called(ctx, id, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Matches")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PartsFilter) (bool, error)); ok {
+		return returnFunc(ctx, id, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.PartsFilter) bool); ok {
+		r0 = returnFunc(ctx, id, filter)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.PartsFilter) error); ok {
+		r1 = returnFunc(ctx, id, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_Matches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Matches'
+type MockPartRepository_Matches_Call struct {
+	*mock.Call
+}
+
+// Matches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - filter model.PartsFilter
+func (_e *MockPartRepository_Expecter) Matches(ctx interface{}, id interface{}, filter interface{}) *MockPartRepository_Matches_Call {
+	return &MockPartRepository_Matches_Call{Call: _e.mock.On("Matches", ctx, id, filter)}
+}
+
+func (_c *MockPartRepository_Matches_Call) Run(run func(ctx context.Context, id string, filter model.PartsFilter)) *MockPartRepository_Matches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.PartsFilter
+		if args[2] != nil {
+			arg2 = args[2].(model.PartsFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_Matches_Call) Return(b bool, err error) *MockPartRepository_Matches_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPartRepository_Matches_Call) RunAndReturn(run func(ctx context.Context, id string, filter model.PartsFilter) (bool, error)) *MockPartRepository_Matches_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -173,3 +437,528 @@ func (_c *MockPartRepository_PartByID_Call) RunAndReturn(run func(ctx context.Co
 	_c.Call.Return(run)
 	return _c
 }
+
+// PriceHistory provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) PriceHistory(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceHistory")
+	}
+
+	var r0 []model.PriceChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PriceHistoryParams) ([]model.PriceChange, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PriceHistoryParams) []model.PriceChange); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PriceChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.PriceHistoryParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_PriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceHistory'
+type MockPartRepository_PriceHistory_Call struct {
+	*mock.Call
+}
+
+// PriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params model.PriceHistoryParams
+func (_e *MockPartRepository_Expecter) PriceHistory(ctx interface{}, params interface{}) *MockPartRepository_PriceHistory_Call {
+	return &MockPartRepository_PriceHistory_Call{Call: _e.mock.On("PriceHistory", ctx, params)}
+}
+
+func (_c *MockPartRepository_PriceHistory_Call) Run(run func(ctx context.Context, params model.PriceHistoryParams)) *MockPartRepository_PriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.PriceHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(model.PriceHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_PriceHistory_Call) Return(priceChanges []model.PriceChange, err error) *MockPartRepository_PriceHistory_Call {
+	_c.Call.Return(priceChanges, err)
+	return _c
+}
+
+func (_c *MockPartRepository_PriceHistory_Call) RunAndReturn(run func(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error)) *MockPartRepository_PriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStock provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) ReleaseStock(ctx context.Context, id string, releasedAt time.Time) error {
+	ret := _mock.Called(ctx, id, releasedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, releasedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPartRepository_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type MockPartRepository_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - releasedAt time.Time
+func (_e *MockPartRepository_Expecter) ReleaseStock(ctx interface{}, id interface{}, releasedAt interface{}) *MockPartRepository_ReleaseStock_Call {
+	return &MockPartRepository_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, id, releasedAt)}
+}
+
+func (_c *MockPartRepository_ReleaseStock_Call) Run(run func(ctx context.Context, id string, releasedAt time.Time)) *MockPartRepository_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_ReleaseStock_Call) Return(err error) *MockPartRepository_ReleaseStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPartRepository_ReleaseStock_Call) RunAndReturn(run func(ctx context.Context, id string, releasedAt time.Time) error) *MockPartRepository_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) ReserveStock(ctx context.Context, id string, items []model.ReservedItem, reservedAt time.Time) (*model.StockReservation, error) {
+	ret := _mock.Called(ctx, id, items, reservedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 *model.StockReservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []model.ReservedItem, time.Time) (*model.StockReservation, error)); ok {
+		return returnFunc(ctx, id, items, reservedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []model.ReservedItem, time.Time) *model.StockReservation); ok {
+		r0 = returnFunc(ctx, id, items, reservedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockReservation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []model.ReservedItem, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, items, reservedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockPartRepository_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - items []model.ReservedItem
+//   - reservedAt time.Time
+func (_e *MockPartRepository_Expecter) ReserveStock(ctx interface{}, id interface{}, items interface{}, reservedAt interface{}) *MockPartRepository_ReserveStock_Call {
+	return &MockPartRepository_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, id, items, reservedAt)}
+}
+
+func (_c *MockPartRepository_ReserveStock_Call) Run(run func(ctx context.Context, id string, items []model.ReservedItem, reservedAt time.Time)) *MockPartRepository_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []model.ReservedItem
+		if args[2] != nil {
+			arg2 = args[2].([]model.ReservedItem)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_ReserveStock_Call) Return(stockReservation *model.StockReservation, err error) *MockPartRepository_ReserveStock_Call {
+	_c.Call.Return(stockReservation, err)
+	return _c
+}
+
+func (_c *MockPartRepository_ReserveStock_Call) RunAndReturn(run func(ctx context.Context, id string, items []model.ReservedItem, reservedAt time.Time) (*model.StockReservation, error)) *MockPartRepository_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetArchivedAt provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) SetArchivedAt(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) (*model.Part, error) {
+	ret := _mock.Called(ctx, id, archivedAt, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArchivedAt")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) (*model.Part, error)); ok {
+		return returnFunc(ctx, id, archivedAt, updatedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *time.Time, time.Time) *model.Part); ok {
+		r0 = returnFunc(ctx, id, archivedAt, updatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, archivedAt, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_SetArchivedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArchivedAt'
+type MockPartRepository_SetArchivedAt_Call struct {
+	*mock.Call
+}
+
+// SetArchivedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - archivedAt *time.Time
+//   - updatedAt time.Time
+func (_e *MockPartRepository_Expecter) SetArchivedAt(ctx interface{}, id interface{}, archivedAt interface{}, updatedAt interface{}) *MockPartRepository_SetArchivedAt_Call {
+	return &MockPartRepository_SetArchivedAt_Call{Call: _e.mock.On("SetArchivedAt", ctx, id, archivedAt, updatedAt)}
+}
+
+func (_c *MockPartRepository_SetArchivedAt_Call) Run(run func(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time)) *MockPartRepository_SetArchivedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_SetArchivedAt_Call) Return(part *model.Part, err error) *MockPartRepository_SetArchivedAt_Call {
+	_c.Call.Return(part, err)
+	return _c
+}
+
+func (_c *MockPartRepository_SetArchivedAt_Call) RunAndReturn(run func(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) (*model.Part, error)) *MockPartRepository_SetArchivedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferStock provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) TransferStock(ctx context.Context, params model.TransferStockParams, updatedAt time.Time) (*model.Part, error) {
+	ret := _mock.Called(ctx, params, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferStock")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransferStockParams, time.Time) (*model.Part, error)); ok {
+		return returnFunc(ctx, params, updatedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.TransferStockParams, time.Time) *model.Part); ok {
+		r0 = returnFunc(ctx, params, updatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.TransferStockParams, time.Time) error); ok {
+		r1 = returnFunc(ctx, params, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_TransferStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferStock'
+type MockPartRepository_TransferStock_Call struct {
+	*mock.Call
+}
+
+// TransferStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params model.TransferStockParams
+//   - updatedAt time.Time
+func (_e *MockPartRepository_Expecter) TransferStock(ctx interface{}, params interface{}, updatedAt interface{}) *MockPartRepository_TransferStock_Call {
+	return &MockPartRepository_TransferStock_Call{Call: _e.mock.On("TransferStock", ctx, params, updatedAt)}
+}
+
+func (_c *MockPartRepository_TransferStock_Call) Run(run func(ctx context.Context, params model.TransferStockParams, updatedAt time.Time)) *MockPartRepository_TransferStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.TransferStockParams
+		if args[1] != nil {
+			arg1 = args[1].(model.TransferStockParams)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_TransferStock_Call) Return(part *model.Part, err error) *MockPartRepository_TransferStock_Call {
+	_c.Call.Return(part, err)
+	return _c
+}
+
+func (_c *MockPartRepository_TransferStock_Call) RunAndReturn(run func(ctx context.Context, params model.TransferStockParams, updatedAt time.Time) (*model.Part, error)) *MockPartRepository_TransferStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) Update(ctx context.Context, id string, expectedVersion int64, info model.PartInfo, mask []model.PartField, updatedAt time.Time) (*model.Part, error) {
+	ret := _mock.Called(ctx, id, expectedVersion, info, mask, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, model.PartInfo, []model.PartField, time.Time) (*model.Part, error)); ok {
+		return returnFunc(ctx, id, expectedVersion, info, mask, updatedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, model.PartInfo, []model.PartField, time.Time) *model.Part); ok {
+		r0 = returnFunc(ctx, id, expectedVersion, info, mask, updatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, model.PartInfo, []model.PartField, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, expectedVersion, info, mask, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPartRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - expectedVersion int64
+//   - info model.PartInfo
+//   - mask []model.PartField
+//   - updatedAt time.Time
+func (_e *MockPartRepository_Expecter) Update(ctx interface{}, id interface{}, expectedVersion interface{}, info interface{}, mask interface{}, updatedAt interface{}) *MockPartRepository_Update_Call {
+	return &MockPartRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, expectedVersion, info, mask, updatedAt)}
+}
+
+func (_c *MockPartRepository_Update_Call) Run(run func(ctx context.Context, id string, expectedVersion int64, info model.PartInfo, mask []model.PartField, updatedAt time.Time)) *MockPartRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 model.PartInfo
+		if args[3] != nil {
+			arg3 = args[3].(model.PartInfo)
+		}
+		var arg4 []model.PartField
+		if args[4] != nil {
+			arg4 = args[4].([]model.PartField)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_Update_Call) Return(part *model.Part, err error) *MockPartRepository_Update_Call {
+	_c.Call.Return(part, err)
+	return _c
+}
+
+func (_c *MockPartRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id string, expectedVersion int64, info model.PartInfo, mask []model.PartField, updatedAt time.Time) (*model.Part, error)) *MockPartRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function for the type MockPartRepository
+func (_mock *MockPartRepository) WatchParts(ctx context.Context, resumeAfter []byte) (model.PartsStream, error) {
+	ret := _mock.Called(ctx, resumeAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 model.PartsStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) (model.PartsStream, error)); ok {
+		return returnFunc(ctx, resumeAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) model.PartsStream); ok {
+		r0 = returnFunc(ctx, resumeAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PartsStream)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = returnFunc(ctx, resumeAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPartRepository_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type MockPartRepository_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resumeAfter []byte
+func (_e *MockPartRepository_Expecter) WatchParts(ctx interface{}, resumeAfter interface{}) *MockPartRepository_WatchParts_Call {
+	return &MockPartRepository_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, resumeAfter)}
+}
+
+func (_c *MockPartRepository_WatchParts_Call) Run(run func(ctx context.Context, resumeAfter []byte)) *MockPartRepository_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPartRepository_WatchParts_Call) Return(partsStream model.PartsStream, err error) *MockPartRepository_WatchParts_Call {
+	_c.Call.Return(partsStream, err)
+	return _c
+}
+
+func (_c *MockPartRepository_WatchParts_Call) RunAndReturn(run func(ctx context.Context, resumeAfter []byte) (model.PartsStream, error)) *MockPartRepository_WatchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
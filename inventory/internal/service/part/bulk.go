@@ -0,0 +1,193 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const importBatchSize = 500
+
+// ImportParts upserts the parts of the rows by ID in batches. Rows are
+// validated first and nothing is written if any row is invalid; the report
+// then lists the problems by line. With dryRun the rows are only validated.
+func (s *service) ImportParts(ctx context.Context, rows []model.ImportRow, dryRun bool) (*model.ImportReport, error) {
+	const op = "inventory.service.ImportParts"
+	log := logger.With(
+		logger.Int("rows", len(rows)),
+		logger.Bool("dry_run", dryRun),
+	)
+
+	report := &model.ImportReport{Rows: len(rows)}
+	parts := make([]*model.Part, 0, len(rows))
+	seen := make(map[string]int, len(rows))
+	for _, row := range rows {
+		if err := validateImportRow(row, seen); err != nil {
+			report.Errors = append(report.Errors, model.RowError{Line: row.Line, Err: err})
+			continue
+		}
+		parts = append(parts, row.Part)
+	}
+	if err := s.checkImportWarehouses(ctx, rows, report); err != nil {
+		log.Error(ctx, "check warehouses", logger.ErrorF(err))
+		return report, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(report.Errors) > 0 {
+		log.Error(ctx, "validation: import rows", logger.Int("invalid", len(report.Errors)))
+		return report, fmt.Errorf("%w: %d of %d rows are invalid",
+			model.ErrInvalidArgument, len(report.Errors), len(rows))
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	for batch := range slices.Chunk(parts, importBatchSize) {
+		wctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+		res, err := s.repo.CreateBatch(wctx, batch)
+		cancel()
+		if err != nil {
+			log.Error(ctx, "repository create batch", logger.ErrorF(err))
+			return report, fmt.Errorf("%s: %w", op, err)
+		}
+		report.Created += res.Created
+		report.Updated += res.Updated
+	}
+
+	return report, nil
+}
+
+// ExportParts calls fn for every part matching the filter, oldest first.
+func (s *service) ExportParts(ctx context.Context, filter model.PartsFilter, fn func(*model.Part) error) error {
+	const op = "inventory.service.ExportParts"
+
+	if err := validateFilter(filter); err != nil {
+		logger.Error(ctx, "validation: filter", logger.ErrorF(err))
+		return err
+	}
+
+	if err := s.eachPart(ctx, filter, fn); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// eachPart calls fn for every part matching the filter, reading them in pages.
+func (s *service) eachPart(ctx context.Context, filter model.PartsFilter, fn func(*model.Part) error) error {
+	q := model.PartsQuery{
+		Filter: filter,
+		SortBy: model.PartSortCreatedAt,
+		Limit:  maxPageSize,
+	}
+
+	for {
+		rctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+		parts, err := s.repo.List(rctx, q)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("list parts: %w", err)
+		}
+
+		for _, p := range parts {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+		if len(parts) < q.Limit {
+			return nil
+		}
+
+		last := parts[len(parts)-1]
+		q.After = &model.PartsCursor{Value: sortValue(q.SortBy, last), ID: last.ID}
+	}
+}
+
+// validateImportRow checks the part of the row and gives it an ID if it
+// has none. seen maps the IDs of the previous rows to their lines.
+func validateImportRow(row model.ImportRow, seen map[string]int) error {
+	p := row.Part
+	if p == nil {
+		return fmt.Errorf("%w: empty row", model.ErrInvalidArgument)
+	}
+
+	p.ID = strings.TrimSpace(p.ID)
+	if p.ID == "" {
+		p.ID = uuid.NewString()
+	} else if _, err := uuid.Parse(p.ID); err != nil {
+		return fmt.Errorf("%w: uuid %q is malformed", model.ErrInvalidArgument, p.ID)
+	}
+	if line, ok := seen[p.ID]; ok {
+		return fmt.Errorf("%w: uuid %s is already on line %d", model.ErrInvalidArgument, p.ID, line)
+	}
+	seen[p.ID] = row.Line
+
+	info := model.PartInfo{
+		Name:          p.Name,
+		Description:   p.Description,
+		PriceCents:    p.PriceCents,
+		StockQuantity: p.StockQuantity,
+		Category:      p.Category,
+		Dimensions:    p.Dimensions,
+		Manufacturer:  p.Manufacturer,
+		Tags:          p.Tags,
+		Metadata:      p.Metadata,
+		Compatibility: p.Compatibility,
+		StockLevels:   p.StockLevels,
+	}
+	if err := validatePartInfo(info, model.PartFields); err != nil {
+		return err
+	}
+	if _, err := normalizeStock(&info, model.PartFields); err != nil {
+		return err
+	}
+	p.Name = strings.TrimSpace(p.Name)
+	p.StockQuantity = info.StockQuantity
+	p.StockLevels = info.StockLevels
+
+	return nil
+}
+
+// checkImportWarehouses reports the valid rows whose stock is in a
+// warehouse that does not exist.
+func (s *service) checkImportWarehouses(ctx context.Context, rows []model.ImportRow, report *model.ImportReport) error {
+	var levels []model.StockLevel
+	for _, row := range rows {
+		if row.Part != nil {
+			levels = append(levels, row.Part.StockLevels...)
+		}
+	}
+
+	missing, err := s.missingWarehouses(ctx, levels)
+	if err != nil || len(missing) == 0 {
+		return err
+	}
+
+	invalid := make(map[int]struct{}, len(report.Errors))
+	for _, e := range report.Errors {
+		invalid[e.Line] = struct{}{}
+	}
+	for _, row := range rows {
+		if _, ok := invalid[row.Line]; ok || row.Part == nil {
+			continue
+		}
+		for _, l := range row.Part.StockLevels {
+			if slices.Contains(missing, l.WarehouseID) {
+				report.Errors = append(report.Errors, model.RowError{
+					Line: row.Line,
+					Err:  fmt.Errorf("%w: unknown warehouse %s", model.ErrInvalidArgument, l.WarehouseID),
+				})
+				break
+			}
+		}
+	}
+	slices.SortStableFunc(report.Errors, func(a, b model.RowError) int { return cmp.Compare(a.Line, b.Line) })
+
+	return nil
+}
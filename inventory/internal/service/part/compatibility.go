@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// ValidateConfiguration checks the parts against the compatibility rules
+// of each of them and returns the broken rules in the order of partIDs.
+// A repeated ID counts once; archived parts are checked as well.
+func (s *service) ValidateConfiguration(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error) {
+	const op = "inventory.service.ValidateConfiguration"
+	log := logger.With(
+		logger.Int("ids_count", len(partIDs)),
+	)
+
+	ids := make([]string, 0, len(partIDs))
+	for _, id := range partIDs {
+		id = strings.TrimSpace(id)
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	switch {
+	case len(ids) == 0:
+		log.Error(ctx, "validation: no part ids")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("part_uuids must be non-empty"))
+	case len(ids) > maxPageSize:
+		log.Error(ctx, "validation: too many part ids")
+		return nil, fmt.Errorf("%w: at most %d part_uuids are allowed", model.ErrInvalidArgument, maxPageSize)
+	}
+
+	parts, err := s.partsByIDs(ctx, ids)
+	if err != nil {
+		log.Error(ctx, "repository list parts", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(parts) != len(ids) {
+		missing := slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+			_, ok := parts[id]
+			return ok
+		})
+		log.Error(ctx, "parts not found", logger.String("missing", strings.Join(missing, ",")))
+		return nil, fmt.Errorf("%s: %w: %s", op, model.ErrPartNotFound, strings.Join(missing, ", "))
+	}
+
+	// compatible_with targets outside the configuration are only needed
+	// for their categories.
+	var outside []string
+	for _, p := range parts {
+		for _, r := range p.Compatibility {
+			id := r.Target.PartID
+			if r.Kind == model.RuleCompatibleWith && id != "" && parts[id] == nil && !slices.Contains(outside, id) {
+				outside = append(outside, id)
+			}
+		}
+	}
+	targets := parts
+	if len(outside) > 0 {
+		found, err := s.partsByIDs(ctx, outside)
+		if err != nil {
+			log.Error(ctx, "repository list rule targets", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for id, p := range parts {
+			found[id] = p
+		}
+		targets = found
+	}
+
+	config := make([]*model.Part, 0, len(ids))
+	for _, id := range ids {
+		config = append(config, parts[id])
+	}
+
+	var violations []model.ConfigurationViolation
+	for _, p := range config {
+		violations = append(violations, checkRules(p, config, targets)...)
+	}
+
+	return violations, nil
+}
+
+func (s *service) partsByIDs(ctx context.Context, ids []string) (map[string]*model.Part, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	parts, err := s.repo.List(ctx, model.PartsQuery{
+		Filter: model.PartsFilter{IDs: ids, IncludeArchived: true},
+		SortBy: model.PartSortCreatedAt,
+		Limit:  len(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*model.Part, len(parts))
+	for _, p := range parts {
+		out[p.ID] = p
+	}
+	return out, nil
+}
+
+// checkRules returns the rules of p broken by the configuration. targets
+// holds the configuration parts and the parts its rules name.
+func checkRules(p *model.Part, config []*model.Part, targets map[string]*model.Part) []model.ConfigurationViolation {
+	others := slices.DeleteFunc(slices.Clone(config), func(o *model.Part) bool { return o.ID == p.ID })
+
+	var violations []model.ConfigurationViolation
+	// compatible_with targets by the category they cover.
+	compatible := make(map[model.Category][]model.RuleTarget)
+	for _, r := range p.Compatibility {
+		switch r.Kind {
+		case model.RuleRequires:
+			if !slices.ContainsFunc(others, r.Target.Matches) {
+				violations = append(violations, model.ConfigurationViolation{
+					PartID:  p.ID,
+					Kind:    r.Kind,
+					Target:  r.Target,
+					Message: fmt.Sprintf("%s requires %s", p.Name, describeTarget(r.Target, targets)),
+				})
+			}
+		case model.RuleConflictsWith:
+			for _, o := range others {
+				if r.Target.Matches(o) {
+					violations = append(violations, model.ConfigurationViolation{
+						PartID:  p.ID,
+						Kind:    r.Kind,
+						Target:  model.RuleTarget{PartID: o.ID},
+						Message: fmt.Sprintf("%s conflicts with %s", p.Name, o.Name),
+					})
+				}
+			}
+		case model.RuleCompatibleWith:
+			c := r.Target.Category
+			if r.Target.PartID != "" {
+				t, ok := targets[r.Target.PartID]
+				if !ok {
+					continue
+				}
+				c = t.Category
+			}
+			compatible[c] = append(compatible[c], r.Target)
+		}
+	}
+
+	for _, o := range others {
+		allowed, ok := compatible[o.Category]
+		if !ok || slices.ContainsFunc(allowed, func(t model.RuleTarget) bool { return t.Matches(o) }) {
+			continue
+		}
+		violations = append(violations, model.ConfigurationViolation{
+			PartID:  p.ID,
+			Kind:    model.RuleCompatibleWith,
+			Target:  model.RuleTarget{PartID: o.ID},
+			Message: fmt.Sprintf("%s is not compatible with %s", p.Name, o.Name),
+		})
+	}
+
+	return violations
+}
+
+func describeTarget(t model.RuleTarget, parts map[string]*model.Part) string {
+	if t.PartID == "" {
+		return "a part of category " + categoryName(t.Category)
+	}
+	if p, ok := parts[t.PartID]; ok {
+		return p.Name
+	}
+	return "part " + t.PartID
+}
+
+func categoryName(c model.Category) string {
+	switch c {
+	case model.CategoryEngine:
+		return "ENGINE"
+	case model.CategoryFuel:
+		return "FUEL"
+	case model.CategoryPorthole:
+		return "PORTHOLE"
+	case model.CategoryWing:
+		return "WING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// validateCompatibility checks that every rule has a known kind and exactly
+// one valid target.
+func validateCompatibility(rules []model.CompatibilityRule) []string {
+	var problems []string
+	for i, r := range rules {
+		switch r.Kind {
+		case model.RuleRequires, model.RuleConflictsWith, model.RuleCompatibleWith:
+		default:
+			problems = append(problems, fmt.Sprintf("compatibility[%d] must have a known kind", i))
+		}
+
+		switch {
+		case r.Target.PartID != "" && r.Target.Category != model.CategoryUnknown:
+			problems = append(problems, fmt.Sprintf("compatibility[%d] must have one target, a part or a category", i))
+		case r.Target.PartID != "":
+			if _, err := uuid.Parse(r.Target.PartID); err != nil {
+				problems = append(problems, fmt.Sprintf("compatibility[%d] target uuid is malformed", i))
+			}
+		case !knownCategory(r.Target.Category):
+			problems = append(problems, fmt.Sprintf("compatibility[%d] must have a target", i))
+		}
+	}
+	return problems
+}
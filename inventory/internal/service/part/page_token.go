@@ -0,0 +1,110 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+)
+
+var errInvalidPageToken = errors.Join(model.ErrInvalidArgument, errors.New("invalid page_token"))
+
+// pageToken is the opaque ListParts page token. It records the sort and
+// filter it was issued for, so it cannot be replayed against a different
+// listing, and the position of the last part returned.
+type pageToken struct {
+	SortBy   model.PartSort  `json:"s"`
+	SortDesc bool            `json:"d,omitempty"`
+	Filter   string          `json:"f"`
+	Value    json.RawMessage `json:"v"`
+	ID       string          `json:"id"`
+}
+
+func encodePageToken(params model.ListPartsParams, last *model.Part) (string, error) {
+	value, err := json.Marshal(sortValue(params.SortBy, last))
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := json.Marshal(pageToken{
+		SortBy:   params.SortBy,
+		SortDesc: params.SortDesc,
+		Filter:   filterFingerprint(params.Filter),
+		Value:    value,
+		ID:       last.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodePageToken returns the cursor stored in the token, or nil for an
+// empty token.
+func decodePageToken(params model.ListPartsParams) (*model.PartsCursor, error) {
+	if params.PageToken == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(params.PageToken)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.ID == "" {
+		return nil, errInvalidPageToken
+	}
+	if t.SortBy != params.SortBy || t.SortDesc != params.SortDesc || t.Filter != filterFingerprint(params.Filter) {
+		return nil, errors.Join(model.ErrInvalidArgument,
+			errors.New("page_token was issued for a different filter or sort"))
+	}
+
+	var value any
+	switch params.SortBy {
+	case model.PartSortPrice, model.PartSortStockQuantity:
+		var v int64
+		err = json.Unmarshal(t.Value, &v)
+		value = v
+	case model.PartSortName:
+		var v string
+		err = json.Unmarshal(t.Value, &v)
+		value = v
+	default:
+		var v time.Time
+		err = json.Unmarshal(t.Value, &v)
+		value = v
+	}
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	return &model.PartsCursor{Value: value, ID: t.ID}, nil
+}
+
+func sortValue(sort model.PartSort, p *model.Part) any {
+	switch sort {
+	case model.PartSortPrice:
+		return p.PriceCents
+	case model.PartSortStockQuantity:
+		return p.StockQuantity
+	case model.PartSortName:
+		return p.Name
+	default:
+		if p.CreatedAt == nil {
+			return time.Time{}
+		}
+		return p.CreatedAt.UTC()
+	}
+}
+
+func filterFingerprint(f model.PartsFilter) string {
+	raw, _ := json.Marshal(f) //nolint:errchkjson // plain struct of strings and ints
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8])
+}
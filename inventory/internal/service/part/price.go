@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// PriceHistory returns the price changes of a part in the range of params,
+// oldest first. With params.From set, the first change is the price in
+// effect at From. Prices set before the history was kept are not in it.
+func (s *service) PriceHistory(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error) {
+	const op = "inventory.service.PriceHistory"
+	log := logger.With(
+		logger.String("part_id", params.PartID),
+	)
+
+	params.PartID = strings.TrimSpace(params.PartID)
+	if params.PartID == "" {
+		log.Error(ctx, "validation: empty part id")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("part_uuid must be non-empty"))
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		log.Error(ctx, "validation: empty range")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("range.from must be before range.to"))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	// Both an unknown part and a part with no recorded prices have no history.
+	if _, err := s.repo.PartByID(ctx, params.PartID); err != nil {
+		log.Error(ctx, "repository part by id", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changes, err := s.repo.PriceHistory(ctx, params)
+	if err != nil {
+		log.Error(ctx, "repository price history", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changes, nil
+}
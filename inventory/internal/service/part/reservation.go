@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// ReserveStock takes the stock of the items out of the warehouses until the
+// reservation is released; either every item is reserved or none is.
+// Reserving again with the same ID returns the reservation and takes nothing.
+func (s *service) ReserveStock(
+	ctx context.Context,
+	id string,
+	items []model.ReservedItem,
+) (*model.StockReservation, error) {
+	const op = "inventory.service.ReserveStock"
+	log := logger.With(
+		logger.String("reservation_id", id),
+		logger.Int("items_count", len(items)),
+	)
+
+	id = strings.TrimSpace(id)
+
+	var problems []string
+	if id == "" {
+		problems = append(problems, "reservation_id must be non-empty")
+	}
+	if len(items) == 0 {
+		problems = append(problems, "items must be non-empty")
+	}
+	seen := make(map[string]struct{}, len(items))
+	for i := range items {
+		items[i].PartID = strings.TrimSpace(items[i].PartID)
+		items[i].WarehouseID = strings.TrimSpace(items[i].WarehouseID)
+		it := items[i]
+		if it.PartID == "" {
+			problems = append(problems, "items part_uuid must be non-empty")
+			continue
+		}
+		if it.Quantity <= 0 {
+			problems = append(problems, fmt.Sprintf("items quantity of %s must be positive", it.PartID))
+		}
+		if it.ExpectedVersion <= 0 {
+			problems = append(problems, fmt.Sprintf("items expected_version of %s must be positive", it.PartID))
+		}
+		if _, ok := seen[it.PartID]; ok {
+			problems = append(problems, fmt.Sprintf("items list part %s more than once", it.PartID))
+		}
+		seen[it.PartID] = struct{}{}
+	}
+	if len(problems) > 0 {
+		log.Error(ctx, "validation", logger.String("problems", strings.Join(problems, "; ")))
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidArgument, strings.Join(problems, "; "))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	res, err := s.repo.ReserveStock(ctx, id, items, time.Now())
+	if err != nil {
+		log.Error(ctx, "repository reserve stock", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// ReleaseStock puts the stock of the reservation back. Releasing twice
+// changes nothing.
+func (s *service) ReleaseStock(ctx context.Context, id string) error {
+	const op = "inventory.service.ReleaseStock"
+	log := logger.With(
+		logger.String("reservation_id", id),
+	)
+
+	id = strings.TrimSpace(id)
+	if id == "" {
+		log.Error(ctx, "validation: empty reservation id")
+		return fmt.Errorf("%w: reservation_id must be non-empty", model.ErrInvalidArgument)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.repo.ReleaseStock(ctx, id, time.Now()); err != nil {
+		log.Error(ctx, "repository release stock", logger.ErrorF(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -4,28 +4,73 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
 type PartRepository interface {
 	PartByID(ctx context.Context, id string) (*model.Part, error)
-	List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	List(ctx context.Context, query model.PartsQuery) ([]*model.Part, error)
+	Count(ctx context.Context, filter model.PartsFilter) (int64, error)
+	Create(ctx context.Context, part *model.Part) error
+	CreateBatch(ctx context.Context, parts []*model.Part) (model.BatchResult, error)
+	Update(
+		ctx context.Context,
+		id string,
+		expectedVersion int64,
+		info model.PartInfo,
+		mask []model.PartField,
+		updatedAt time.Time,
+	) (*model.Part, error)
+	SetArchivedAt(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) (*model.Part, error)
+	WatchParts(ctx context.Context, resumeAfter []byte) (model.PartsStream, error)
+	Matches(ctx context.Context, id string, filter model.PartsFilter) (bool, error)
+	PriceHistory(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error)
+	TransferStock(ctx context.Context, params model.TransferStockParams, updatedAt time.Time) (*model.Part, error)
+	ReserveStock(
+		ctx context.Context,
+		id string,
+		items []model.ReservedItem,
+		reservedAt time.Time,
+	) (*model.StockReservation, error)
+	ReleaseStock(ctx context.Context, id string, releasedAt time.Time) error
+}
+
+type WarehouseRepository interface {
+	Create(ctx context.Context, w *model.Warehouse) error
+	List(ctx context.Context, ids []string) ([]model.Warehouse, error)
 }
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
 type service struct {
-	repo          PartRepository
-	readDBTimeout time.Duration
+	repo           PartRepository
+	warehouses     WarehouseRepository
+	readDBTimeout  time.Duration
+	writeDBTimeout time.Duration
 }
 
 func NewInventoryService(
 	repo PartRepository,
+	warehouses WarehouseRepository,
 	readDBTimeout time.Duration,
+	writeDBTimeout time.Duration,
 ) *service {
-	return &service{repo: repo, readDBTimeout: readDBTimeout}
+	return &service{
+		repo:           repo,
+		warehouses:     warehouses,
+		readDBTimeout:  readDBTimeout,
+		writeDBTimeout: writeDBTimeout,
+	}
 }
 
 func (s *service) Part(ctx context.Context, partID string) (*model.Part, error) {
@@ -51,20 +96,357 @@ func (s *service) Part(ctx context.Context, partID string) (*model.Part, error)
 	return p, nil
 }
 
-func (s *service) ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+// ListParts returns one page of parts; a page has at most PageSize parts.
+func (s *service) ListParts(ctx context.Context, params model.ListPartsParams) (*model.ListPartsResponse, error) {
 	const op = "inventory.service.ListParts"
 	log := logger.With(
-		logger.Int("ids_count", len(filter.IDs)),
+		logger.Int("ids_count", len(params.Filter.IDs)),
+		logger.Int("page_size", params.PageSize),
+		logger.String("sort_by", string(params.SortBy)),
 	)
 
+	switch {
+	case params.PageSize < 0:
+		log.Error(ctx, "validation: negative page size")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("page_size must be non-negative"))
+	case params.PageSize == 0:
+		params.PageSize = defaultPageSize
+	case params.PageSize > maxPageSize:
+		params.PageSize = maxPageSize
+	}
+	if params.SortBy == "" {
+		params.SortBy = model.PartSortCreatedAt
+	}
+	if err := validateFilter(params.Filter); err != nil {
+		log.Error(ctx, "validation: filter", logger.ErrorF(err))
+		return nil, err
+	}
+
+	after, err := decodePageToken(params)
+	if err != nil {
+		log.Error(ctx, "validation: page token", logger.ErrorF(err))
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
 	defer cancel()
 
-	out, err := s.repo.List(ctx, filter)
+	// One extra part tells whether there is a next page.
+	parts, err := s.repo.List(ctx, model.PartsQuery{
+		Filter:   params.Filter,
+		SortBy:   params.SortBy,
+		SortDesc: params.SortDesc,
+		After:    after,
+		Limit:    params.PageSize + 1,
+	})
 	if err != nil {
 		log.Error(ctx, "repository list parts", logger.ErrorF(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	out := &model.ListPartsResponse{Parts: parts}
+	if len(parts) > params.PageSize {
+		out.Parts = parts[:params.PageSize]
+		out.NextPageToken, err = encodePageToken(params, out.Parts[len(out.Parts)-1])
+		if err != nil {
+			log.Error(ctx, "encode page token", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if params.IncludeTotal {
+		total, err := s.repo.Count(ctx, params.Filter)
+		if err != nil {
+			log.Error(ctx, "repository count parts", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		out.TotalCount = &total
+	}
+
 	return out, nil
 }
+
+func (s *service) CreatePart(ctx context.Context, info model.PartInfo) (*model.Part, error) {
+	const op = "inventory.service.CreatePart"
+	log := logger.With(
+		logger.String("name", info.Name),
+	)
+
+	if err := validatePartInfo(info, model.PartFields); err != nil {
+		log.Error(ctx, "validation", logger.ErrorF(err))
+		return nil, err
+	}
+	if _, err := normalizeStock(&info, model.PartFields); err != nil {
+		log.Error(ctx, "validation: stock", logger.ErrorF(err))
+		return nil, err
+	}
+	if err := s.checkWarehouses(ctx, info.StockLevels); err != nil {
+		log.Error(ctx, "validation: warehouses", logger.ErrorF(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	p := &model.Part{
+		ID:            uuid.NewString(),
+		Name:          strings.TrimSpace(info.Name),
+		Description:   info.Description,
+		PriceCents:    info.PriceCents,
+		StockQuantity: info.StockQuantity,
+		Category:      info.Category,
+		Dimensions:    info.Dimensions,
+		Manufacturer:  info.Manufacturer,
+		Tags:          info.Tags,
+		Metadata:      info.Metadata,
+		Compatibility: info.Compatibility,
+		StockLevels:   info.StockLevels,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+		Version:       1,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.repo.Create(ctx, p); err != nil {
+		log.Error(ctx, "repository create part", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+func (s *service) UpdatePart(ctx context.Context, params model.UpdatePartParams) (*model.Part, error) {
+	const op = "inventory.service.UpdatePart"
+	log := logger.With(
+		logger.String("part_id", params.ID),
+		logger.Int("mask_len", len(params.Mask)),
+	)
+
+	params.ID = strings.TrimSpace(params.ID)
+	if params.ID == "" {
+		log.Error(ctx, "validation: empty part id")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("uuid must be non-empty"))
+	}
+
+	if params.ExpectedVersion <= 0 {
+		log.Error(ctx, "validation: no expected version")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("expected_version must be positive"))
+	}
+
+	mask := params.Mask
+	if len(mask) == 0 {
+		mask = model.PartFields
+	}
+	if err := validatePartInfo(params.Info, mask); err != nil {
+		log.Error(ctx, "validation", logger.ErrorF(err))
+		return nil, err
+	}
+	mask, err := normalizeStock(&params.Info, mask)
+	if err != nil {
+		log.Error(ctx, "validation: stock", logger.ErrorF(err))
+		return nil, err
+	}
+	if err := s.checkWarehouses(ctx, params.Info.StockLevels); err != nil {
+		log.Error(ctx, "validation: warehouses", logger.ErrorF(err))
+		return nil, err
+	}
+	params.Info.Name = strings.TrimSpace(params.Info.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	p, err := s.repo.Update(ctx, params.ID, params.ExpectedVersion, params.Info, mask, time.Now())
+	if err != nil {
+		log.Error(ctx, "repository update part", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+// ArchivePart hides the part from ListParts. Archiving an archived part
+// returns it unchanged.
+func (s *service) ArchivePart(ctx context.Context, partID string) (*model.Part, error) {
+	const op = "inventory.service.ArchivePart"
+
+	p, err := s.setArchived(ctx, partID, true)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+// RestorePart returns an archived part to the catalog. Restoring an
+// active part returns it unchanged.
+func (s *service) RestorePart(ctx context.Context, partID string) (*model.Part, error) {
+	const op = "inventory.service.RestorePart"
+
+	p, err := s.setArchived(ctx, partID, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+func (s *service) setArchived(ctx context.Context, partID string, archived bool) (*model.Part, error) {
+	log := logger.With(
+		logger.String("part_id", partID),
+	)
+
+	partID = strings.TrimSpace(partID)
+	if partID == "" {
+		log.Error(ctx, "validation: empty part id")
+		return nil, errors.Join(model.ErrInvalidArgument, errors.New("uuid must be non-empty"))
+	}
+
+	rctx, rcancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer rcancel()
+
+	p, err := s.repo.PartByID(rctx, partID)
+	if err != nil {
+		log.Error(ctx, "repository part by id", logger.ErrorF(err))
+		return nil, err
+	}
+	if (p.ArchivedAt != nil) == archived {
+		return p, nil
+	}
+
+	now := time.Now()
+	var archivedAt *time.Time
+	if archived {
+		archivedAt = &now
+	}
+
+	wctx, wcancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer wcancel()
+
+	p, err = s.repo.SetArchivedAt(wctx, partID, archivedAt, now)
+	if err != nil {
+		log.Error(ctx, "repository set archived at", logger.ErrorF(err))
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// validatePartInfo checks the fields of info listed in mask.
+func validatePartInfo(info model.PartInfo, mask []model.PartField) error {
+	var problems []string
+	for _, f := range mask {
+		switch f {
+		case model.PartFieldName:
+			if strings.TrimSpace(info.Name) == "" {
+				problems = append(problems, "name must be non-empty")
+			}
+		case model.PartFieldPriceCents:
+			if info.PriceCents < 0 {
+				problems = append(problems, "price_cents must be non-negative")
+			}
+		case model.PartFieldStockQuantity:
+			if info.StockQuantity < 0 {
+				problems = append(problems, "stock_quantity must be non-negative")
+			}
+		case model.PartFieldCategory:
+			if !knownCategory(info.Category) {
+				problems = append(problems, "category must be a known category")
+			}
+		case model.PartFieldMetadata:
+			for k, v := range info.Metadata {
+				if !validMetadataKey(k) {
+					problems = append(problems, fmt.Sprintf("metadata key %q is invalid", k))
+					continue
+				}
+
+				switch v.(type) {
+				case string, int64, float64, bool:
+				default:
+					problems = append(problems, fmt.Sprintf("metadata %q must have a typed value", k))
+				}
+			}
+		case model.PartFieldCompatibility:
+			problems = append(problems, validateCompatibility(info.Compatibility)...)
+		case model.PartFieldStockLevels:
+			problems = append(problems, validateStockLevels(info.StockLevels)...)
+		case model.PartFieldDescription, model.PartFieldDimensions,
+			model.PartFieldManufacturer, model.PartFieldTags:
+		default:
+			problems = append(problems, fmt.Sprintf("unknown field %q in update mask", f))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	slices.Sort(problems)
+	return fmt.Errorf("%w: %s", model.ErrInvalidArgument, strings.Join(problems, "; "))
+}
+
+// validateFilter rejects inverted ranges and malformed metadata predicates.
+func validateFilter(f model.PartsFilter) error {
+	var problems []string
+	for _, r := range []struct {
+		name     string
+		inverted bool
+	}{
+		{"price_cents", inverted(f.PriceCents.Min, f.PriceCents.Max)},
+		{"stock_quantity", inverted(f.StockQuantity.Min, f.StockQuantity.Max)},
+		{"dimensions.length", inverted(f.Length.Min, f.Length.Max)},
+		{"dimensions.width", inverted(f.Width.Min, f.Width.Max)},
+		{"dimensions.height", inverted(f.Height.Min, f.Height.Max)},
+		{"dimensions.weight", inverted(f.Weight.Min, f.Weight.Max)},
+	} {
+		if r.inverted {
+			problems = append(problems, r.name+" range min must not exceed max")
+		}
+	}
+
+	for _, p := range f.Metadata {
+		if !validMetadataKey(p.Key) {
+			problems = append(problems, fmt.Sprintf("metadata key %q is invalid", p.Key))
+			continue
+		}
+
+		switch p.Operator {
+		case model.MetadataOpEq, model.MetadataOpNe:
+			switch p.Value.(type) {
+			case string, int64, float64, bool:
+			default:
+				problems = append(problems, fmt.Sprintf("metadata %q must have a typed value", p.Key))
+			}
+		case model.MetadataOpGt, model.MetadataOpGte, model.MetadataOpLt, model.MetadataOpLte:
+			switch p.Value.(type) {
+			case string, int64, float64:
+			default:
+				problems = append(problems, fmt.Sprintf("metadata %q must be compared with a number or string", p.Key))
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("metadata %q has no operator", p.Key))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", model.ErrInvalidArgument, strings.Join(problems, "; "))
+}
+
+// validMetadataKey rejects the keys Mongo would read as a nested path or an
+// operator.
+func validMetadataKey(k string) bool {
+	return k != "" && !strings.Contains(k, ".") && !strings.HasPrefix(k, "$")
+}
+
+func inverted[T int64 | float64](minV, maxV *T) bool {
+	return minV != nil && maxV != nil && *minV > *maxV
+}
+
+func knownCategory(c model.Category) bool {
+	switch c {
+	case model.CategoryEngine, model.CategoryFuel, model.CategoryPorthole, model.CategoryWing:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,10 +22,11 @@ func TestServicePart(t *testing.T) {
 
 	type deps struct {
 		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
 	}
 
 	newSvc := func(d deps) *service {
-		return NewInventoryService(d.repository, 5*time.Second)
+		return NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
 	}
 
 	type testCase struct {
@@ -104,6 +107,7 @@ func TestServicePart(t *testing.T) {
 
 			d := deps{
 				repository: mocks.NewMockPartRepository(t),
+				warehouses: mocks.NewMockWarehouseRepository(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
@@ -122,10 +126,11 @@ func TestServiceListParts(t *testing.T) {
 
 	type deps struct {
 		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
 	}
 
 	newSvc := func(d deps) *service {
-		return NewInventoryService(d.repository, 5*time.Second)
+		return NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
 	}
 
 	// Stable test data set.
@@ -152,11 +157,16 @@ func TestServiceListParts(t *testing.T) {
 
 	all := []*model.Part{p1, p2, p3, p4}
 
+	// query is the repository query of the first page for the filter.
+	query := func(f model.PartsFilter) model.PartsQuery {
+		return model.PartsQuery{Filter: f, SortBy: model.PartSortCreatedAt, Limit: defaultPageSize + 1}
+	}
+
 	type testCase struct {
 		name   string
 		filter model.PartsFilter
 		setup  func(d deps)
-		assert func(t *testing.T, res []*model.Part, err error, d deps)
+		assert func(t *testing.T, res *model.ListPartsResponse, err error, d deps)
 	}
 
 	tests := []testCase{
@@ -165,11 +175,11 @@ func TestServiceListParts(t *testing.T) {
 			filter: model.PartsFilter{},
 			setup: func(d deps) {
 				d.repository.
-					On("List", mock.Anything, model.PartsFilter{}).
+					On("List", mock.Anything, query(model.PartsFilter{})).
 					Return(([]*model.Part)(nil), errors.New("db read failed")).
 					Once()
 			},
-			assert: func(t *testing.T, res []*model.Part, err error, d deps) {
+			assert: func(t *testing.T, res *model.ListPartsResponse, err error, d deps) {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, "db read failed")
 				assert.Nil(t, res)
@@ -181,13 +191,15 @@ func TestServiceListParts(t *testing.T) {
 			filter: model.PartsFilter{},
 			setup: func(d deps) {
 				d.repository.
-					On("List", mock.Anything, model.PartsFilter{}).
+					On("List", mock.Anything, query(model.PartsFilter{})).
 					Return(all, nil).
 					Once()
 			},
-			assert: func(t *testing.T, res []*model.Part, err error, d deps) {
+			assert: func(t *testing.T, res *model.ListPartsResponse, err error, d deps) {
 				require.NoError(t, err)
-				assert.Equal(t, all, res)
+				assert.Equal(t, all, res.Parts)
+				assert.Empty(t, res.NextPageToken)
+				assert.Nil(t, res.TotalCount)
 				d.repository.AssertExpectations(t)
 			},
 		},
@@ -198,16 +210,482 @@ func TestServiceListParts(t *testing.T) {
 			},
 			setup: func(d deps) {
 				d.repository.
-					On("List", mock.Anything, model.PartsFilter{IDs: []string{"id-2", "id-3"}}).
+					On("List", mock.Anything, query(model.PartsFilter{IDs: []string{"id-2", "id-3"}})).
 					Return([]*model.Part{p2, p3}, nil).
 					Once()
 			},
-			assert: func(t *testing.T, res []*model.Part, err error, d deps) {
+			assert: func(t *testing.T, res *model.ListPartsResponse, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, []*model.Part{p2, p3}, res.Parts)
+				d.repository.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockPartRepository(t),
+				warehouses: mocks.NewMockWarehouseRepository(t),
+			}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := newSvc(d)
+
+			res, err := svc.ListParts(context.Background(), model.ListPartsParams{Filter: tt.filter})
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceCreatePart(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
+	}
+
+	newSvc := func(d deps) *service {
+		return NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
+	}
+
+	validInfo := func() model.PartInfo {
+		return model.PartInfo{
+			Name:          gofakeit.ProductName(),
+			PriceCents:    int64(gofakeit.Price(10, 999)),
+			StockQuantity: 5,
+			Category:      model.CategoryWing,
+			Metadata: map[string]any{
+				"span_m":   12.5,
+				"military": true,
+			},
+		}
+	}
+
+	type testCase struct {
+		name   string
+		info   func() model.PartInfo
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.Part, err error, d deps)
+	}
+
+	invalid := func(msg string) func(t *testing.T, res *model.Part, err error, d deps) {
+		return func(t *testing.T, res *model.Part, err error, d deps) {
+			require.Error(t, err)
+			assert.ErrorIs(t, err, model.ErrInvalidArgument)
+			assert.ErrorContains(t, err, msg)
+			assert.Nil(t, res)
+
+			d.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		}
+	}
+
+	tests := []testCase{
+		{
+			name: "validation error: blank name",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.Name = "  "
+				return info
+			},
+			assert: invalid("name must be non-empty"),
+		},
+		{
+			name: "validation error: negative price and stock",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.PriceCents = -1
+				info.StockQuantity = -1
+				return info
+			},
+			assert: invalid("price_cents must be non-negative; stock_quantity must be non-negative"),
+		},
+		{
+			name: "validation error: unknown category",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.Category = model.CategoryUnknown
+				return info
+			},
+			assert: invalid("category must be a known category"),
+		},
+		{
+			name: "validation error: untyped metadata value",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.Metadata["color"] = nil
+				return info
+			},
+			assert: invalid(`metadata "color" must have a typed value`),
+		},
+		{
+			name: "validation error: metadata keys with a path or an operator",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.Metadata["thrust.max"] = int64(800)
+				info.Metadata["$where"] = "1"
+				return info
+			},
+			assert: invalid(`metadata key "$where" is invalid; metadata key "thrust.max" is invalid`),
+		},
+		{
+			name: "validation error: malformed compatibility rules",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.Compatibility = []model.CompatibilityRule{
+					{Kind: model.RuleRequires, Target: model.RuleTarget{Category: model.CategoryEngine}},
+					{Kind: "fits", Target: model.RuleTarget{Category: model.CategoryFuel}},
+					{Kind: model.RuleConflictsWith},
+					{Kind: model.RuleCompatibleWith, Target: model.RuleTarget{PartID: "engine-1"}},
+				}
+				return info
+			},
+			assert: invalid("compatibility[1] must have a known kind; compatibility[2] must have a target; " +
+				"compatibility[3] target uuid is malformed"),
+		},
+		{
+			name: "success: part gets id and timestamps",
+			info: validInfo,
+			setup: func(d deps) {
+				d.repository.
+					On("Create", mock.Anything, mock.AnythingOfType("*model.Part")).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				assert.NotEmpty(t, res.ID)
+				require.NotNil(t, res.CreatedAt)
+				assert.Equal(t, res.CreatedAt, res.UpdatedAt)
+				assert.Nil(t, res.ArchivedAt)
+
+				d.repository.AssertExpectations(t)
+			},
+		},
+		{
+			name: "success: stock quantity goes to the default warehouse",
+			info: validInfo,
+			setup: func(d deps) {
+				d.repository.
+					On("Create", mock.Anything, mock.AnythingOfType("*model.Part")).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, []model.StockLevel{{WarehouseID: model.DefaultWarehouseID, Quantity: 5}}, res.StockLevels)
+				d.warehouses.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "success: stock quantity is the sum of the stock levels",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.StockQuantity = 0
+				info.StockLevels = []model.StockLevel{
+					{WarehouseID: "baikonur-1", Quantity: 2},
+					{WarehouseID: model.DefaultWarehouseID, Quantity: 0},
+					{WarehouseID: "kourou", Quantity: 4},
+				}
+				return info
+			},
+			setup: func(d deps) {
+				d.warehouses.
+					On("List", mock.Anything, []string{"baikonur-1", "kourou"}).
+					Return([]model.Warehouse{{ID: "baikonur-1"}, {ID: "kourou"}}, nil).
+					Once()
+				d.repository.
+					On("Create", mock.Anything, mock.AnythingOfType("*model.Part")).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, int64(6), res.StockQuantity)
+				assert.Equal(t, []model.StockLevel{
+					{WarehouseID: "baikonur-1", Quantity: 2},
+					{WarehouseID: "kourou", Quantity: 4},
+				}, res.StockLevels)
+			},
+		},
+		{
+			name: "validation error: stock quantity differs from the stock levels",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.StockLevels = []model.StockLevel{{WarehouseID: "kourou", Quantity: 4}}
+				return info
+			},
+			assert: invalid("stock_quantity must be zero or the sum of stock_levels"),
+		},
+		{
+			name: "validation error: unknown warehouse",
+			info: func() model.PartInfo {
+				info := validInfo()
+				info.StockQuantity = 0
+				info.StockLevels = []model.StockLevel{{WarehouseID: "kourou", Quantity: 4}}
+				return info
+			},
+			setup: func(d deps) {
+				d.warehouses.
+					On("List", mock.Anything, []string{"kourou"}).
+					Return([]model.Warehouse{}, nil).
+					Once()
+			},
+			assert: invalid("unknown warehouses: kourou"),
+		},
+		{
+			name: "repository error: Create fails",
+			info: validInfo,
+			setup: func(d deps) {
+				d.repository.
+					On("Create", mock.Anything, mock.AnythingOfType("*model.Part")).
+					Return(errors.New("db write failed")).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "db write failed")
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockPartRepository(t),
+				warehouses: mocks.NewMockWarehouseRepository(t),
+			}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := newSvc(d)
+
+			res, err := svc.CreatePart(context.Background(), tt.info())
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceUpdatePart(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
+	}
+
+	newSvc := func(d deps) *service {
+		return NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
+	}
+
+	partID := gofakeit.UUID()
+
+	type testCase struct {
+		name   string
+		params model.UpdatePartParams
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.Part, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name: "validation error: unknown mask path",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Mask:            []model.PartField{"uuid"},
+				ExpectedVersion: 1,
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, `unknown field "uuid"`)
+				d.repository.AssertNotCalled(t, "Update",
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "validation error: metadata key with an operator",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{Metadata: map[string]any{"$set": "x"}},
+				Mask:            []model.PartField{model.PartFieldMetadata},
+				ExpectedVersion: 1,
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, `metadata key "$set" is invalid`)
+				d.repository.AssertNotCalled(t, "Update",
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "validation error: empty mask validates every field",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{PriceCents: 100},
+				ExpectedVersion: 1,
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "name must be non-empty")
+			},
+		},
+		{
+			name: "success: only masked fields are validated and written",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{PriceCents: 100},
+				Mask:            []model.PartField{model.PartFieldPriceCents},
+				ExpectedVersion: 4,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("Update",
+						mock.Anything,
+						partID,
+						int64(4),
+						model.PartInfo{PriceCents: 100},
+						[]model.PartField{model.PartFieldPriceCents},
+						mock.AnythingOfType("time.Time"),
+					).
+					Return(&model.Part{ID: partID, PriceCents: 100, Version: 5}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
 				require.NoError(t, err)
-				assert.Equal(t, []*model.Part{p2, p3}, res)
+				assert.Equal(t, int64(100), res.PriceCents)
+				assert.Equal(t, int64(5), res.Version)
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name: "success: stock quantity also replaces the stock levels",
+			params: model.UpdatePartParams{
+				ID: partID,
+				Info: model.PartInfo{
+					StockQuantity: 3,
+					StockLevels:   []model.StockLevel{{WarehouseID: "kourou", Quantity: 7}},
+				},
+				Mask:            []model.PartField{model.PartFieldStockQuantity},
+				ExpectedVersion: 1,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("Update",
+						mock.Anything,
+						partID,
+						int64(1),
+						model.PartInfo{
+							StockQuantity: 3,
+							StockLevels:   []model.StockLevel{{WarehouseID: model.DefaultWarehouseID, Quantity: 3}},
+						},
+						[]model.PartField{model.PartFieldStockQuantity, model.PartFieldStockLevels},
+						mock.AnythingOfType("time.Time"),
+					).
+					Return(&model.Part{ID: partID, StockQuantity: 3, Version: 2}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				d.warehouses.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "success: empty stock levels clear the stock",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{StockQuantity: 3},
+				Mask:            []model.PartField{model.PartFieldStockLevels},
+				ExpectedVersion: 1,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("Update",
+						mock.Anything,
+						partID,
+						int64(1),
+						model.PartInfo{},
+						[]model.PartField{model.PartFieldStockLevels, model.PartFieldStockQuantity},
+						mock.AnythingOfType("time.Time"),
+					).
+					Return(&model.Part{ID: partID, Version: 2}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "not found: repository reports missing part",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{StockQuantity: 3},
+				Mask:            []model.PartField{model.PartFieldStockQuantity},
+				ExpectedVersion: 1,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("Update", mock.Anything, partID, int64(1), mock.Anything, mock.Anything, mock.Anything).
+					Return((*model.Part)(nil), model.ErrPartNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartNotFound)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "version mismatch: the part was changed since the expected version",
+			params: model.UpdatePartParams{
+				ID:              partID,
+				Info:            model.PartInfo{StockQuantity: 3},
+				Mask:            []model.PartField{model.PartFieldStockQuantity},
+				ExpectedVersion: 2,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("Update", mock.Anything, partID, int64(2), mock.Anything, mock.Anything, mock.Anything).
+					Return((*model.Part)(nil), &model.VersionMismatchError{Current: 3}).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrVersionMismatch)
+
+				var mismatch *model.VersionMismatchError
+				require.ErrorAs(t, err, &mismatch)
+				assert.Equal(t, int64(3), mismatch.Current)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "validation error: no expected version",
+			params: model.UpdatePartParams{
+				ID:   partID,
+				Info: model.PartInfo{StockQuantity: 3},
+				Mask: []model.PartField{model.PartFieldStockQuantity},
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "expected_version must be positive")
+				d.repository.AssertNotCalled(t, "Update",
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -216,6 +694,7 @@ func TestServiceListParts(t *testing.T) {
 
 			d := deps{
 				repository: mocks.NewMockPartRepository(t),
+				warehouses: mocks.NewMockWarehouseRepository(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
@@ -223,8 +702,1152 @@ func TestServiceListParts(t *testing.T) {
 
 			svc := newSvc(d)
 
-			res, err := svc.ListParts(context.Background(), tt.filter)
+			res, err := svc.UpdatePart(context.Background(), tt.params)
 			tt.assert(t, res, err, d)
 		})
 	}
 }
+
+func TestServiceArchivePart(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
+	}
+
+	newSvc := func(d deps) *service {
+		return NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
+	}
+
+	partID := gofakeit.UUID()
+	archivedAt := time.Now().Add(-time.Hour)
+
+	type testCase struct {
+		name   string
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.Part, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name: "success: active part is archived",
+			setup: func(d deps) {
+				d.repository.
+					On("PartByID", mock.Anything, partID).
+					Return(&model.Part{ID: partID}, nil).
+					Once()
+				d.repository.
+					On("SetArchivedAt", mock.Anything, partID, mock.AnythingOfType("*time.Time"), mock.AnythingOfType("time.Time")).
+					Return(&model.Part{ID: partID, ArchivedAt: &archivedAt}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				assert.NotNil(t, res.ArchivedAt)
+				d.repository.AssertExpectations(t)
+			},
+		},
+		{
+			name: "no-op: archived part is returned unchanged",
+			setup: func(d deps) {
+				d.repository.
+					On("PartByID", mock.Anything, partID).
+					Return(&model.Part{ID: partID, ArchivedAt: &archivedAt}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, &archivedAt, res.ArchivedAt)
+				d.repository.AssertNotCalled(t, "SetArchivedAt", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "not found: missing part",
+			setup: func(d deps) {
+				d.repository.
+					On("PartByID", mock.Anything, partID).
+					Return((*model.Part)(nil), model.ErrPartNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartNotFound)
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockPartRepository(t),
+				warehouses: mocks.NewMockWarehouseRepository(t),
+			}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := newSvc(d)
+
+			res, err := svc.ArchivePart(context.Background(), partID)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceRestorePart(t *testing.T) {
+	t.Parallel()
+
+	partID := gofakeit.UUID()
+	archivedAt := time.Now().Add(-time.Hour)
+
+	repo := mocks.NewMockPartRepository(t)
+	repo.
+		On("PartByID", mock.Anything, partID).
+		Return(&model.Part{ID: partID, ArchivedAt: &archivedAt}, nil).
+		Once()
+	repo.
+		On("SetArchivedAt", mock.Anything, partID, (*time.Time)(nil), mock.AnythingOfType("time.Time")).
+		Return(&model.Part{ID: partID}, nil).
+		Once()
+
+	svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+	res, err := svc.RestorePart(context.Background(), partID)
+	require.NoError(t, err)
+	assert.Nil(t, res.ArchivedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestServiceListPartsPagination(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	parts := make([]*model.Part, 5)
+	for i := range parts {
+		parts[i] = &model.Part{
+			ID:         gofakeit.UUID(),
+			Name:       gofakeit.ProductName(),
+			PriceCents: int64(100 * (i + 1)),
+			CreatedAt:  &created,
+		}
+	}
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+
+	repo := mocks.NewMockPartRepository(t)
+	repo.
+		On("List", mock.Anything, model.PartsQuery{
+			Filter: filter, SortBy: model.PartSortPrice, SortDesc: true, Limit: 3,
+		}).
+		Return(parts[:3], nil).
+		Once()
+	repo.
+		On("List", mock.Anything, model.PartsQuery{
+			Filter: filter, SortBy: model.PartSortPrice, SortDesc: true, Limit: 3,
+			After: &model.PartsCursor{Value: int64(200), ID: parts[1].ID},
+		}).
+		Return(parts[2:4], nil).
+		Once()
+	repo.
+		On("Count", mock.Anything, filter).
+		Return(int64(4), nil).
+		Once()
+
+	svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+	ctx := context.Background()
+
+	params := model.ListPartsParams{
+		Filter:       filter,
+		PageSize:     2,
+		SortBy:       model.PartSortPrice,
+		SortDesc:     true,
+		IncludeTotal: true,
+	}
+	first, err := svc.ListParts(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, parts[:2], first.Parts)
+	require.NotEmpty(t, first.NextPageToken)
+	require.NotNil(t, first.TotalCount)
+	assert.Equal(t, int64(4), *first.TotalCount)
+
+	params.PageToken = first.NextPageToken
+	params.IncludeTotal = false
+	second, err := svc.ListParts(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, parts[2:4], second.Parts)
+	assert.Empty(t, second.NextPageToken)
+	assert.Nil(t, second.TotalCount)
+
+	t.Run("token of another sort is rejected", func(t *testing.T) {
+		other := params
+		other.SortDesc = false
+		_, err := svc.ListParts(ctx, other)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("token of another filter is rejected", func(t *testing.T) {
+		other := params
+		other.Filter = model.PartsFilter{}
+		_, err := svc.ListParts(ctx, other)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("garbage token is rejected", func(t *testing.T) {
+		other := params
+		other.PageToken = "not-a-token"
+		_, err := svc.ListParts(ctx, other)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("negative page size is rejected", func(t *testing.T) {
+		_, err := svc.ListParts(ctx, model.ListPartsParams{PageSize: -1})
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestServiceListPartsValidatesFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		filter model.PartsFilter
+		msg    string
+	}{
+		{
+			name: "inverted price range",
+			filter: model.PartsFilter{
+				PriceCents: model.Int64Range{Min: lo.ToPtr(int64(200)), Max: lo.ToPtr(int64(100))},
+			},
+			msg: "price_cents range min must not exceed max",
+		},
+		{
+			name: "inverted weight range",
+			filter: model.PartsFilter{
+				Weight: model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)},
+			},
+			msg: "dimensions.weight range min must not exceed max",
+		},
+		{
+			name: "metadata key with operator injection",
+			filter: model.PartsFilter{
+				Metadata: []model.MetadataPredicate{{Key: "$where", Operator: model.MetadataOpEq, Value: "x"}},
+			},
+			msg: `metadata key "$where" is invalid`,
+		},
+		{
+			name: "metadata key with nested path",
+			filter: model.PartsFilter{
+				Metadata: []model.MetadataPredicate{{Key: "a.b", Operator: model.MetadataOpEq, Value: "x"}},
+			},
+			msg: `metadata key "a.b" is invalid`,
+		},
+		{
+			name: "ordering operator with bool",
+			filter: model.PartsFilter{
+				Metadata: []model.MetadataPredicate{{Key: "military_grade", Operator: model.MetadataOpGt, Value: true}},
+			},
+			msg: `metadata "military_grade" must be compared with a number or string`,
+		},
+		{
+			name: "missing operator",
+			filter: model.PartsFilter{
+				Metadata: []model.MetadataPredicate{{Key: "max_thrust_kn", Value: int64(800)}},
+			},
+			msg: `metadata "max_thrust_kn" has no operator`,
+		},
+		{
+			name: "untyped value",
+			filter: model.PartsFilter{
+				Metadata: []model.MetadataPredicate{{Key: "fuel_type", Operator: model.MetadataOpEq}},
+			},
+			msg: `metadata "fuel_type" must have a typed value`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockPartRepository(t)
+			svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+			res, err := svc.ListParts(context.Background(), model.ListPartsParams{Filter: tt.filter})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, model.ErrInvalidArgument)
+			assert.ErrorContains(t, err, tt.msg)
+			assert.Nil(t, res)
+			repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("search and range filter reaches repository", func(t *testing.T) {
+		t.Parallel()
+
+		filter := model.PartsFilter{
+			Query:      "plasma engine",
+			PriceCents: model.Int64Range{Max: lo.ToPtr(int64(20_000_000))},
+			Metadata: []model.MetadataPredicate{
+				{Key: "military_grade", Operator: model.MetadataOpEq, Value: true},
+				{Key: "max_thrust_kn", Operator: model.MetadataOpGte, Value: int64(800)},
+			},
+		}
+
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("List", mock.Anything, model.PartsQuery{
+				Filter: filter, SortBy: model.PartSortCreatedAt, Limit: defaultPageSize + 1,
+			}).
+			Return([]*model.Part{}, nil).
+			Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		_, err := svc.ListParts(context.Background(), model.ListPartsParams{Filter: filter})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+// fakeStream replays changes and then blocks until ctx is done.
+type fakeStream struct {
+	changes []model.PartStreamChange
+	read    int
+	closed  bool
+}
+
+func (s *fakeStream) Next(ctx context.Context) (model.PartStreamChange, error) {
+	if s.read < len(s.changes) {
+		s.read++
+		return s.changes[s.read-1], nil
+	}
+	<-ctx.Done()
+	return model.PartStreamChange{}, ctx.Err()
+}
+
+func (s *fakeStream) ResumeToken() []byte { return []byte{byte(s.read)} }
+
+func (s *fakeStream) Close(context.Context) error {
+	s.closed = true
+	return nil
+}
+
+func TestServiceWatchParts(t *testing.T) {
+	t.Parallel()
+
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryWing}}
+	p1 := &model.Part{ID: gofakeit.UUID(), Category: model.CategoryWing}
+	p2 := &model.Part{ID: gofakeit.UUID(), Category: model.CategoryWing}
+	p3 := &model.Part{ID: gofakeit.UUID(), Category: model.CategoryFuel}
+
+	// watch runs WatchParts until want events are sent.
+	watch := func(svc *service, params model.WatchPartsParams, want int) ([]model.PartsWatchEvent, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var got []model.PartsWatchEvent
+		err := svc.WatchParts(ctx, params, func(ev model.PartsWatchEvent) error {
+			got = append(got, ev)
+			if len(got) == want {
+				cancel()
+			}
+			return nil
+		})
+		return got, err
+	}
+
+	t.Run("success: snapshot, then changes of visible and matching parts", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &fakeStream{changes: []model.PartStreamChange{
+			{PartID: p3.ID, Part: p3},
+			{PartID: p1.ID, Part: p1},
+			{PartID: p2.ID, Part: p2},
+		}}
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("WatchParts", mock.Anything, []byte(nil)).
+			Return(stream, nil).
+			Once()
+		repo.
+			On("List", mock.Anything, model.PartsQuery{
+				Filter: filter, SortBy: model.PartSortCreatedAt, Limit: maxPageSize,
+			}).
+			Return([]*model.Part{p1, p2}, nil).
+			Once()
+		repo.On("Matches", mock.Anything, p3.ID, filter).Return(false, nil).Once()
+		repo.On("Matches", mock.Anything, p1.ID, filter).Return(true, nil).Once()
+		repo.On("Matches", mock.Anything, p2.ID, filter).Return(false, nil).Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		got, err := watch(svc, model.WatchPartsParams{Filter: filter}, 5)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, got, 5)
+
+		types := lo.Map(got, func(ev model.PartsWatchEvent, _ int) model.WatchEventType { return ev.Type })
+		assert.Equal(t, []model.WatchEventType{
+			model.WatchEventSnapshot,
+			model.WatchEventSnapshot,
+			model.WatchEventCurrent,
+			model.WatchEventUpserted,
+			model.WatchEventRemoved,
+		}, types)
+		assert.Empty(t, got[0].ResumeToken)
+		assert.NotEmpty(t, got[2].ResumeToken)
+		assert.Equal(t, p1.ID, got[3].PartID)
+		assert.Equal(t, p2.ID, got[4].PartID)
+		assert.True(t, stream.closed)
+	})
+
+	t.Run("resume: no snapshot and unknown parts are removed", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &fakeStream{changes: []model.PartStreamChange{
+			{PartID: p3.ID, Part: p3},
+		}}
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("WatchParts", mock.Anything, []byte{1}).
+			Return(stream, nil).
+			Once()
+		repo.On("Matches", mock.Anything, p3.ID, filter).Return(false, nil).Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		got, err := watch(svc, model.WatchPartsParams{Filter: filter, ResumeToken: "AQ"}, 1)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, got, 1)
+		assert.Equal(t, model.WatchEventRemoved, got[0].Type)
+		repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid argument: malformed resume token", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPartRepository(t)
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		_, err := watch(svc, model.WatchPartsParams{ResumeToken: "not base64!"}, 1)
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+		repo.AssertNotCalled(t, "WatchParts", mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceImportParts(t *testing.T) {
+	t.Parallel()
+
+	validPart := func() *model.Part {
+		return &model.Part{
+			ID:            gofakeit.UUID(),
+			Name:          gofakeit.ProductName(),
+			PriceCents:    int64(gofakeit.Price(10, 999)),
+			StockQuantity: 3,
+			Category:      model.CategoryFuel,
+		}
+	}
+
+	t.Run("success: parts are upserted in batches", func(t *testing.T) {
+		t.Parallel()
+
+		rows := make([]model.ImportRow, importBatchSize+1)
+		for i := range rows {
+			rows[i] = model.ImportRow{Line: i + 1, Part: validPart()}
+		}
+		rows[0].Part.ID = ""
+
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("CreateBatch", mock.Anything, mock.MatchedBy(func(ps []*model.Part) bool {
+				return len(ps) == importBatchSize && ps[0].ID != ""
+			})).
+			Return(model.BatchResult{Created: 400, Updated: 100}, nil).
+			Once()
+		repo.
+			On("CreateBatch", mock.Anything, mock.MatchedBy(func(ps []*model.Part) bool { return len(ps) == 1 })).
+			Return(model.BatchResult{Updated: 1}, nil).
+			Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		report, err := svc.ImportParts(context.Background(), rows, false)
+		require.NoError(t, err)
+		assert.Equal(t, &model.ImportReport{Rows: len(rows), Created: 400, Updated: 101}, report)
+	})
+
+	t.Run("dry run: rows are validated but not written", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPartRepository(t)
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		report, err := svc.ImportParts(context.Background(), []model.ImportRow{{Line: 1, Part: validPart()}}, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Rows)
+		assert.Empty(t, report.Errors)
+		repo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation error: invalid rows are reported by line and nothing is written", func(t *testing.T) {
+		t.Parallel()
+
+		dup := validPart()
+		blank := validPart()
+		blank.Name = " "
+		malformed := validPart()
+		malformed.ID = "not-a-uuid"
+		rows := []model.ImportRow{
+			{Line: 1, Part: dup},
+			{Line: 2, Part: validPart()},
+			{Line: 4, Part: blank},
+			{Line: 5, Part: malformed},
+			{Line: 7, Part: &model.Part{ID: dup.ID, Name: "Copy", Category: model.CategoryWing}},
+		}
+
+		repo := mocks.NewMockPartRepository(t)
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		report, err := svc.ImportParts(context.Background(), rows, false)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "3 of 5 rows are invalid")
+		require.Len(t, report.Errors, 3)
+
+		lines := lo.Map(report.Errors, func(e model.RowError, _ int) int { return e.Line })
+		assert.Equal(t, []int{4, 5, 7}, lines)
+		assert.ErrorContains(t, report.Errors[0].Err, "name must be non-empty")
+		assert.ErrorContains(t, report.Errors[1].Err, "malformed")
+		assert.ErrorContains(t, report.Errors[2].Err, "already on line 1")
+		repo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation error: a metadata key with a path", func(t *testing.T) {
+		t.Parallel()
+
+		part := validPart()
+		part.Metadata = map[string]any{"dimensions.length": 1.5}
+
+		repo := mocks.NewMockPartRepository(t)
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		report, err := svc.ImportParts(context.Background(), []model.ImportRow{{Line: 3, Part: part}}, false)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+		require.Len(t, report.Errors, 1)
+		assert.Equal(t, 3, report.Errors[0].Line)
+		assert.ErrorContains(t, report.Errors[0].Err, `metadata key "dimensions.length" is invalid`)
+		repo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error: the write fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("CreateBatch", mock.Anything, mock.Anything).
+			Return(model.BatchResult{}, errors.New("db write failed")).
+			Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+		_, err := svc.ImportParts(context.Background(), []model.ImportRow{{Line: 1, Part: validPart()}}, false)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "db write failed")
+	})
+}
+
+func TestServiceExportParts(t *testing.T) {
+	t.Parallel()
+
+	parts := make([]*model.Part, maxPageSize+1)
+	for i := range parts {
+		parts[i] = &model.Part{ID: gofakeit.UUID(), CreatedAt: lo.ToPtr(time.Unix(int64(i), 0))}
+	}
+
+	repo := mocks.NewMockPartRepository(t)
+	repo.
+		On("List", mock.Anything, mock.MatchedBy(func(q model.PartsQuery) bool { return q.After == nil })).
+		Return(parts[:maxPageSize], nil).
+		Once()
+	repo.
+		On("List", mock.Anything, mock.MatchedBy(func(q model.PartsQuery) bool {
+			return q.After != nil && q.After.ID == parts[maxPageSize-1].ID
+		})).
+		Return(parts[maxPageSize:], nil).
+		Once()
+
+	svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+	var got []string
+	err := svc.ExportParts(context.Background(), model.PartsFilter{IncludeArchived: true}, func(p *model.Part) error {
+		got = append(got, p.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lo.Map(parts, func(p *model.Part, _ int) string { return p.ID }), got)
+}
+
+func TestServiceValidateConfiguration(t *testing.T) {
+	t.Parallel()
+
+	engineMk1 := &model.Part{ID: gofakeit.UUID(), Name: "Engine Mk1", Category: model.CategoryEngine}
+	engineMk2 := &model.Part{ID: gofakeit.UUID(), Name: "Engine Mk2", Category: model.CategoryEngine}
+	wing := &model.Part{ID: gofakeit.UUID(), Name: "Wing", Category: model.CategoryWing}
+	fuel := &model.Part{
+		ID:       gofakeit.UUID(),
+		Name:     "Fuel Cell",
+		Category: model.CategoryFuel,
+		Compatibility: []model.CompatibilityRule{
+			{Kind: model.RuleRequires, Target: model.RuleTarget{Category: model.CategoryEngine}},
+			{Kind: model.RuleCompatibleWith, Target: model.RuleTarget{PartID: engineMk1.ID}},
+			{Kind: model.RuleConflictsWith, Target: model.RuleTarget{PartID: wing.ID}},
+		},
+	}
+
+	byIDs := func(ids ...string) any {
+		return mock.MatchedBy(func(q model.PartsQuery) bool {
+			return q.Filter.IncludeArchived && assert.ObjectsAreEqual(ids, q.Filter.IDs)
+		})
+	}
+
+	type testCase struct {
+		name    string
+		partIDs []string
+		setup   func(repo *mocks.MockPartRepository)
+		assert  func(t *testing.T, res []model.ConfigurationViolation, err error)
+	}
+
+	tests := []testCase{
+		{
+			name:    "valid: fuel cell with its engine",
+			partIDs: []string{fuel.ID, engineMk1.ID, fuel.ID},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("List", mock.Anything, byIDs(fuel.ID, engineMk1.ID)).
+					Return([]*model.Part{engineMk1, fuel}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.ConfigurationViolation, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, res)
+			},
+		},
+		{
+			name:    "violation: fuel cell without an engine",
+			partIDs: []string{fuel.ID},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("List", mock.Anything, byIDs(fuel.ID)).
+					Return([]*model.Part{fuel}, nil).
+					Once()
+				repo.
+					On("List", mock.Anything, byIDs(engineMk1.ID)).
+					Return([]*model.Part{engineMk1}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.ConfigurationViolation, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []model.ConfigurationViolation{{
+					PartID:  fuel.ID,
+					Kind:    model.RuleRequires,
+					Target:  model.RuleTarget{Category: model.CategoryEngine},
+					Message: "Fuel Cell requires a part of category ENGINE",
+				}}, res)
+			},
+		},
+		{
+			name:    "violation: incompatible engine and conflicting wing",
+			partIDs: []string{engineMk2.ID, fuel.ID, wing.ID},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("List", mock.Anything, byIDs(engineMk2.ID, fuel.ID, wing.ID)).
+					Return([]*model.Part{engineMk2, fuel, wing}, nil).
+					Once()
+				repo.
+					On("List", mock.Anything, byIDs(engineMk1.ID)).
+					Return([]*model.Part{engineMk1}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.ConfigurationViolation, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []model.ConfigurationViolation{
+					{
+						PartID:  fuel.ID,
+						Kind:    model.RuleConflictsWith,
+						Target:  model.RuleTarget{PartID: wing.ID},
+						Message: "Fuel Cell conflicts with Wing",
+					},
+					{
+						PartID:  fuel.ID,
+						Kind:    model.RuleCompatibleWith,
+						Target:  model.RuleTarget{PartID: engineMk2.ID},
+						Message: "Fuel Cell is not compatible with Engine Mk2",
+					},
+				}, res)
+			},
+		},
+		{
+			name:    "not found: unknown part",
+			partIDs: []string{fuel.ID, engineMk1.ID},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("List", mock.Anything, byIDs(fuel.ID, engineMk1.ID)).
+					Return([]*model.Part{fuel}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.ConfigurationViolation, err error) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartNotFound)
+				assert.ErrorContains(t, err, engineMk1.ID)
+			},
+		},
+		{
+			name:    "validation error: no parts",
+			partIDs: []string{" "},
+			assert: func(t *testing.T, res []model.ConfigurationViolation, err error) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockPartRepository(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+			res, err := svc.ValidateConfiguration(context.Background(), tt.partIDs)
+			tt.assert(t, res, err)
+		})
+	}
+}
+
+func TestServicePriceHistory(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
+	}
+
+	type testCase struct {
+		name   string
+		params model.PriceHistoryParams
+		setup  func(d deps)
+		assert func(t *testing.T, res []model.PriceChange, err error, d deps)
+	}
+
+	partID := gofakeit.UUID()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	history := []model.PriceChange{
+		{PartID: partID, PriceCents: 1000, Version: 1, ChangedAt: from.AddDate(0, 0, -3)},
+		{PartID: partID, PriceCents: 1200, Version: 4, ChangedAt: from.AddDate(0, 0, 2)},
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: trims uuid and returns the history",
+			params: model.PriceHistoryParams{PartID: " " + partID + " ", From: &from, To: &to},
+			setup: func(d deps) {
+				d.repository.
+					On("PartByID", mock.Anything, partID).
+					Return(&model.Part{ID: partID}, nil).
+					Once()
+				d.repository.
+					On("PriceHistory", mock.Anything, model.PriceHistoryParams{PartID: partID, From: &from, To: &to}).
+					Return(history, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.PriceChange, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, history, res)
+			},
+		},
+		{
+			name:   "not found: unknown part",
+			params: model.PriceHistoryParams{PartID: partID},
+			setup: func(d deps) {
+				d.repository.
+					On("PartByID", mock.Anything, partID).
+					Return((*model.Part)(nil), model.ErrPartNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.PriceChange, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartNotFound)
+				d.repository.AssertNotCalled(t, "PriceHistory", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "validation error: empty uuid",
+			params: model.PriceHistoryParams{PartID: "  "},
+			assert: func(t *testing.T, res []model.PriceChange, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				d.repository.AssertNotCalled(t, "PartByID", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "validation error: from is not before to",
+			params: model.PriceHistoryParams{PartID: partID, From: &to, To: &from},
+			assert: func(t *testing.T, res []model.PriceChange, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "range.from must be before range.to")
+				d.repository.AssertNotCalled(t, "PriceHistory", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{repository: mocks.NewMockPartRepository(t), warehouses: mocks.NewMockWarehouseRepository(t)}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
+
+			res, err := svc.PriceHistory(context.Background(), tt.params)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceTransferStock(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockPartRepository
+		warehouses *mocks.MockWarehouseRepository
+	}
+
+	type testCase struct {
+		name   string
+		params model.TransferStockParams
+		setup  func(d deps)
+		assert func(t *testing.T, res *model.Part, err error, d deps)
+	}
+
+	partID := gofakeit.UUID()
+	params := model.TransferStockParams{
+		PartID:          partID,
+		FromWarehouseID: model.DefaultWarehouseID,
+		ToWarehouseID:   "kourou",
+		Quantity:        2,
+		ExpectedVersion: 3,
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: stock is moved",
+			params: params,
+			setup: func(d deps) {
+				d.warehouses.
+					On("List", mock.Anything, []string{"kourou"}).
+					Return([]model.Warehouse{{ID: "kourou"}}, nil).
+					Once()
+				d.repository.
+					On("TransferStock", mock.Anything, params, mock.AnythingOfType("time.Time")).
+					Return(&model.Part{ID: partID, Version: 4}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.NoError(t, err)
+				assert.Equal(t, int64(4), res.Version)
+			},
+		},
+		{
+			name: "validation error: same warehouse and no quantity",
+			params: model.TransferStockParams{
+				PartID:          partID,
+				FromWarehouseID: "kourou",
+				ToWarehouseID:   "kourou",
+				ExpectedVersion: 3,
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "from_warehouse_id and to_warehouse_id must differ; quantity must be positive")
+				d.repository.AssertNotCalled(t, "TransferStock", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "validation error: unknown target warehouse",
+			params: params,
+			setup: func(d deps) {
+				d.warehouses.
+					On("List", mock.Anything, []string{"kourou"}).
+					Return([]model.Warehouse{}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "unknown warehouses: kourou")
+				d.repository.AssertNotCalled(t, "TransferStock", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "insufficient stock: repository rejects the transfer",
+			params: model.TransferStockParams{
+				PartID:          partID,
+				FromWarehouseID: "kourou",
+				ToWarehouseID:   model.DefaultWarehouseID,
+				Quantity:        5,
+				ExpectedVersion: 3,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("TransferStock", mock.Anything, mock.Anything, mock.Anything).
+					Return((*model.Part)(nil), model.ErrInsufficientStock).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.Part, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInsufficientStock)
+				d.warehouses.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{repository: mocks.NewMockPartRepository(t), warehouses: mocks.NewMockWarehouseRepository(t)}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := NewInventoryService(d.repository, d.warehouses, 5*time.Second, 5*time.Second)
+
+			res, err := svc.TransferStock(context.Background(), tt.params)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestServiceCreateWarehouse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success: fields are trimmed and created_at is set", func(t *testing.T) {
+		t.Parallel()
+
+		warehouses := mocks.NewMockWarehouseRepository(t)
+		warehouses.
+			On("Create", mock.Anything, mock.MatchedBy(func(w *model.Warehouse) bool {
+				return w.ID == "kourou" && w.Name == "Guiana Space Centre" && !w.CreatedAt.IsZero()
+			})).
+			Return(nil).
+			Once()
+
+		svc := NewInventoryService(mocks.NewMockPartRepository(t), warehouses, 5*time.Second, 5*time.Second)
+
+		res, err := svc.CreateWarehouse(context.Background(), model.Warehouse{ID: " kourou ", Name: " Guiana Space Centre "})
+		require.NoError(t, err)
+		assert.Equal(t, "kourou", res.ID)
+	})
+
+	t.Run("validation error: malformed id and blank name", func(t *testing.T) {
+		t.Parallel()
+
+		warehouses := mocks.NewMockWarehouseRepository(t)
+		svc := NewInventoryService(mocks.NewMockPartRepository(t), warehouses, 5*time.Second, 5*time.Second)
+
+		res, err := svc.CreateWarehouse(context.Background(), model.Warehouse{ID: "Kourou 1"})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, model.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "id must be 1 to 32 lowercase letters, digits and dashes; name must be non-empty")
+		assert.Nil(t, res)
+		warehouses.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("already exists: repository reports a duplicate", func(t *testing.T) {
+		t.Parallel()
+
+		warehouses := mocks.NewMockWarehouseRepository(t)
+		warehouses.
+			On("Create", mock.Anything, mock.Anything).
+			Return(model.ErrWarehouseExists).
+			Once()
+
+		svc := NewInventoryService(mocks.NewMockPartRepository(t), warehouses, 5*time.Second, 5*time.Second)
+
+		_, err := svc.CreateWarehouse(context.Background(), model.Warehouse{ID: "kourou", Name: "Kourou"})
+		assert.ErrorIs(t, err, model.ErrWarehouseExists)
+	})
+}
+
+func TestServiceReserveStock(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name  string
+		id    string
+		items []model.ReservedItem
+		setup func(repo *mocks.MockPartRepository)
+		check func(t *testing.T, res *model.StockReservation, err error, repo *mocks.MockPartRepository)
+	}
+
+	orderID := gofakeit.UUID()
+	partA, partB := gofakeit.UUID(), gofakeit.UUID()
+
+	tests := []testCase{
+		{
+			name: "success: items are reserved",
+			id:   " " + orderID + " ",
+			items: []model.ReservedItem{
+				{PartID: partA, Quantity: 1, ExpectedVersion: 1},
+				{PartID: " " + partB, Quantity: 2, ExpectedVersion: 3},
+			},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("ReserveStock", mock.Anything, orderID,
+						[]model.ReservedItem{
+							{PartID: partA, Quantity: 1, ExpectedVersion: 1},
+							{PartID: partB, Quantity: 2, ExpectedVersion: 3},
+						},
+						mock.AnythingOfType("time.Time"),
+					).
+					Return(&model.StockReservation{ID: orderID}, nil).
+					Once()
+			},
+			check: func(t *testing.T, res *model.StockReservation, err error, _ *mocks.MockPartRepository) {
+				require.NoError(t, err)
+				assert.Equal(t, orderID, res.ID)
+			},
+		},
+		{
+			name:  "success: the warehouse of an item is trimmed",
+			id:    orderID,
+			items: []model.ReservedItem{{PartID: partA, Quantity: 1, WarehouseID: " baikonur ", ExpectedVersion: 1}},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("ReserveStock", mock.Anything, orderID,
+						[]model.ReservedItem{{PartID: partA, Quantity: 1, WarehouseID: "baikonur", ExpectedVersion: 1}},
+						mock.AnythingOfType("time.Time"),
+					).
+					Return(&model.StockReservation{ID: orderID}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ *model.StockReservation, err error, _ *mocks.MockPartRepository) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "validation error: empty id, repeated part and no quantity",
+			id:    "  ",
+			items: []model.ReservedItem{{PartID: partA, Quantity: 1, ExpectedVersion: 1}, {PartID: partA}},
+			check: func(t *testing.T, res *model.StockReservation, err error, repo *mocks.MockPartRepository) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+				assert.ErrorContains(t, err, "reservation_id must be non-empty")
+				assert.ErrorContains(t, err, "items quantity of "+partA+" must be positive")
+				assert.ErrorContains(t, err, "items expected_version of "+partA+" must be positive")
+				assert.ErrorContains(t, err, "items list part "+partA+" more than once")
+				repo.AssertNotCalled(t, "ReserveStock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "validation error: no items",
+			id:   orderID,
+			check: func(t *testing.T, res *model.StockReservation, err error, repo *mocks.MockPartRepository) {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "items must be non-empty")
+				repo.AssertNotCalled(t, "ReserveStock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:  "insufficient stock: repository rejects the reservation",
+			id:    orderID,
+			items: []model.ReservedItem{{PartID: partA, Quantity: 5, ExpectedVersion: 1}},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("ReserveStock", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return((*model.StockReservation)(nil), model.ErrInsufficientStock).
+					Once()
+			},
+			check: func(t *testing.T, res *model.StockReservation, err error, _ *mocks.MockPartRepository) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrInsufficientStock)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name:  "version mismatch: a part changed since it was read",
+			id:    orderID,
+			items: []model.ReservedItem{{PartID: partA, Quantity: 1, ExpectedVersion: 1}},
+			setup: func(repo *mocks.MockPartRepository) {
+				repo.
+					On("ReserveStock", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return((*model.StockReservation)(nil), fmt.Errorf("part %s: %w", partA, &model.VersionMismatchError{Current: 2})).
+					Once()
+			},
+			check: func(t *testing.T, res *model.StockReservation, err error, _ *mocks.MockPartRepository) {
+				require.Error(t, err)
+				var mismatch *model.VersionMismatchError
+				require.ErrorAs(t, err, &mismatch)
+				assert.Equal(t, int64(2), mismatch.Current)
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockPartRepository(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+
+			res, err := svc.ReserveStock(context.Background(), tt.id, tt.items)
+			tt.check(t, res, err, repo)
+		})
+	}
+}
+
+func TestServiceReleaseStock(t *testing.T) {
+	t.Parallel()
+
+	orderID := gofakeit.UUID()
+
+	t.Run("success: release is passed on", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPartRepository(t)
+		repo.
+			On("ReleaseStock", mock.Anything, orderID, mock.AnythingOfType("time.Time")).
+			Return(nil).
+			Once()
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+		require.NoError(t, svc.ReleaseStock(context.Background(), orderID))
+	})
+
+	t.Run("validation error: empty id", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockPartRepository(t)
+
+		svc := NewInventoryService(repo, mocks.NewMockWarehouseRepository(t), 5*time.Second, 5*time.Second)
+		err := svc.ReleaseStock(context.Background(), " ")
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+		repo.AssertNotCalled(t, "ReleaseStock", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+var warehouseIDPattern = regexp.MustCompile(`^[a-z0-9-]{1,32}$`)
+
+// CreateWarehouse adds a warehouse with a unique ID.
+func (s *service) CreateWarehouse(ctx context.Context, w model.Warehouse) (*model.Warehouse, error) {
+	const op = "inventory.service.CreateWarehouse"
+	log := logger.With(
+		logger.String("warehouse_id", w.ID),
+	)
+
+	w.ID = strings.TrimSpace(w.ID)
+	w.Name = strings.TrimSpace(w.Name)
+	w.Spaceport = strings.TrimSpace(w.Spaceport)
+
+	var problems []string
+	if !warehouseIDPattern.MatchString(w.ID) {
+		problems = append(problems, "id must be 1 to 32 lowercase letters, digits and dashes")
+	}
+	if w.Name == "" {
+		problems = append(problems, "name must be non-empty")
+	}
+	if len(problems) > 0 {
+		log.Error(ctx, "validation", logger.String("problems", strings.Join(problems, "; ")))
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidArgument, strings.Join(problems, "; "))
+	}
+	w.CreatedAt = time.Now()
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	if err := s.warehouses.Create(ctx, &w); err != nil {
+		log.Error(ctx, "repository create warehouse", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &w, nil
+}
+
+// ListWarehouses returns every warehouse ordered by ID.
+func (s *service) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	const op = "inventory.service.ListWarehouses"
+
+	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	ws, err := s.warehouses.List(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "repository list warehouses", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ws, nil
+}
+
+// TransferStock moves stock of a part between warehouses; the total stock
+// of the part stays the same.
+func (s *service) TransferStock(ctx context.Context, params model.TransferStockParams) (*model.Part, error) {
+	const op = "inventory.service.TransferStock"
+	log := logger.With(
+		logger.String("part_id", params.PartID),
+		logger.String("from_warehouse_id", params.FromWarehouseID),
+		logger.String("to_warehouse_id", params.ToWarehouseID),
+		logger.Int("quantity", int(params.Quantity)),
+	)
+
+	params.PartID = strings.TrimSpace(params.PartID)
+	params.FromWarehouseID = strings.TrimSpace(params.FromWarehouseID)
+	params.ToWarehouseID = strings.TrimSpace(params.ToWarehouseID)
+
+	var problems []string
+	if params.PartID == "" {
+		problems = append(problems, "part_uuid must be non-empty")
+	}
+	if params.FromWarehouseID == "" || params.ToWarehouseID == "" {
+		problems = append(problems, "from_warehouse_id and to_warehouse_id must be non-empty")
+	} else if params.FromWarehouseID == params.ToWarehouseID {
+		problems = append(problems, "from_warehouse_id and to_warehouse_id must differ")
+	}
+	if params.Quantity <= 0 {
+		problems = append(problems, "quantity must be positive")
+	}
+	if params.ExpectedVersion <= 0 {
+		problems = append(problems, "expected_version must be positive")
+	}
+	if len(problems) > 0 {
+		log.Error(ctx, "validation", logger.String("problems", strings.Join(problems, "; ")))
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidArgument, strings.Join(problems, "; "))
+	}
+
+	// The source warehouse is checked by the stock the part has in it.
+	err := s.checkWarehouses(ctx, []model.StockLevel{{WarehouseID: params.ToWarehouseID}})
+	if err != nil {
+		log.Error(ctx, "validation: warehouses", logger.ErrorF(err))
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.writeDBTimeout)
+	defer cancel()
+
+	p, err := s.repo.TransferStock(ctx, params, time.Now())
+	if err != nil {
+		log.Error(ctx, "repository transfer stock", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+// validateStockLevels returns the problems of the stock levels of a part.
+func validateStockLevels(levels []model.StockLevel) []string {
+	var problems []string
+	seen := make(map[string]struct{}, len(levels))
+	for _, l := range levels {
+		if l.WarehouseID == "" {
+			problems = append(problems, "stock_levels warehouse_id must be non-empty")
+			continue
+		}
+		if l.Quantity < 0 {
+			problems = append(problems, fmt.Sprintf("stock_levels quantity in %s must be non-negative", l.WarehouseID))
+		}
+		if _, ok := seen[l.WarehouseID]; ok {
+			problems = append(problems, fmt.Sprintf("stock_levels lists warehouse %s more than once", l.WarehouseID))
+		}
+		seen[l.WarehouseID] = struct{}{}
+	}
+
+	return problems
+}
+
+// normalizeStock makes the stock fields of info agree when mask writes
+// either of them and returns the mask writing both. With stock levels,
+// StockQuantity becomes their sum and, if written, must be zero or that sum.
+// Otherwise the whole StockQuantity goes to the default warehouse. Empty
+// levels are dropped.
+func normalizeStock(info *model.PartInfo, mask []model.PartField) ([]model.PartField, error) {
+	withQuantity := slices.Contains(mask, model.PartFieldStockQuantity)
+	withLevels := slices.Contains(mask, model.PartFieldStockLevels)
+	if !withQuantity && !withLevels {
+		return mask, nil
+	}
+
+	var levels []model.StockLevel
+	switch {
+	case withLevels && len(info.StockLevels) > 0:
+		var sum int64
+		for _, l := range info.StockLevels {
+			sum += l.Quantity
+			if l.Quantity > 0 {
+				levels = append(levels, l)
+			}
+		}
+		if withQuantity && info.StockQuantity != 0 && info.StockQuantity != sum {
+			return nil, errors.Join(model.ErrInvalidArgument,
+				errors.New("stock_quantity must be zero or the sum of stock_levels"))
+		}
+		info.StockQuantity = sum
+	case withQuantity:
+		if info.StockQuantity > 0 {
+			levels = []model.StockLevel{{WarehouseID: model.DefaultWarehouseID, Quantity: info.StockQuantity}}
+		}
+	default:
+		info.StockQuantity = 0
+	}
+	info.StockLevels = levels
+
+	out := slices.Clone(mask)
+	for _, f := range []model.PartField{model.PartFieldStockQuantity, model.PartFieldStockLevels} {
+		if !slices.Contains(out, f) {
+			out = append(out, f)
+		}
+	}
+
+	return out, nil
+}
+
+// checkWarehouses returns an error if a warehouse of the levels does not
+// exist. The default warehouse always does.
+func (s *service) checkWarehouses(ctx context.Context, levels []model.StockLevel) error {
+	missing, err := s.missingWarehouses(ctx, levels)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: unknown warehouses: %s", model.ErrInvalidArgument, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// missingWarehouses returns the IDs of the warehouses of the levels that do not exist.
+func (s *service) missingWarehouses(ctx context.Context, levels []model.StockLevel) ([]string, error) {
+	var ids []string
+	for _, l := range levels {
+		if l.WarehouseID != model.DefaultWarehouseID && !slices.Contains(ids, l.WarehouseID) {
+			ids = append(ids, l.WarehouseID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+	defer cancel()
+
+	found, err := s.warehouses.List(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list warehouses: %w", err)
+	}
+
+	return slices.DeleteFunc(ids, func(id string) bool {
+		return slices.ContainsFunc(found, func(w model.Warehouse) bool { return w.ID == id })
+	}), nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// WatchParts sends the parts matching the filter and then their changes
+// until ctx is done or send fails.
+func (s *service) WatchParts(
+	ctx context.Context,
+	params model.WatchPartsParams,
+	send func(model.PartsWatchEvent) error,
+) error {
+	const op = "inventory.service.WatchParts"
+	log := logger.With(
+		logger.Bool("resume", params.ResumeToken != ""),
+	)
+
+	if err := validateFilter(params.Filter); err != nil {
+		log.Error(ctx, "validation: filter", logger.ErrorF(err))
+		return err
+	}
+
+	var resumeAfter []byte
+	if params.ResumeToken != "" {
+		tok, err := base64.RawURLEncoding.DecodeString(params.ResumeToken)
+		if err != nil {
+			log.Error(ctx, "validation: resume token", logger.ErrorF(err))
+			return errors.Join(model.ErrInvalidArgument, errors.New("malformed resume token"))
+		}
+		resumeAfter = tok
+	}
+
+	// The stream is opened before the snapshot is read, so changes made
+	// while the snapshot is sent are not missed.
+	stream, err := s.repo.WatchParts(ctx, resumeAfter)
+	if err != nil {
+		log.Error(ctx, "repository watch parts", logger.ErrorF(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if cerr := stream.Close(context.WithoutCancel(ctx)); cerr != nil {
+			log.Error(ctx, "close parts stream", logger.ErrorF(cerr))
+		}
+	}()
+
+	// visible holds the parts the client has; it is unknown after a resume.
+	var visible map[string]struct{}
+	if resumeAfter == nil {
+		visible = make(map[string]struct{})
+		if err := s.sendSnapshot(ctx, params.Filter, visible, send); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		err := send(model.PartsWatchEvent{
+			Type:        model.WatchEventCurrent,
+			ResumeToken: encodeResumeToken(stream.ResumeToken()),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for {
+		change, err := stream.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error(ctx, "parts stream next", logger.ErrorF(err))
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		ev, ok, err := s.watchEvent(ctx, params.Filter, change, visible)
+		if err != nil {
+			log.Error(ctx, "watch event", logger.ErrorF(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if !ok {
+			continue
+		}
+
+		ev.ResumeToken = encodeResumeToken(stream.ResumeToken())
+		if err := send(ev); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+}
+
+func (s *service) sendSnapshot(
+	ctx context.Context,
+	filter model.PartsFilter,
+	visible map[string]struct{},
+	send func(model.PartsWatchEvent) error,
+) error {
+	return s.eachPart(ctx, filter, func(p *model.Part) error {
+		visible[p.ID] = struct{}{}
+		return send(model.PartsWatchEvent{
+			Type:   model.WatchEventSnapshot,
+			PartID: p.ID,
+			Part:   p,
+		})
+	})
+}
+
+// watchEvent turns a change into an event for the client. Changes of parts
+// that neither match the filter nor are visible to the client are skipped.
+func (s *service) watchEvent(
+	ctx context.Context,
+	filter model.PartsFilter,
+	change model.PartStreamChange,
+	visible map[string]struct{},
+) (model.PartsWatchEvent, bool, error) {
+	ev := model.PartsWatchEvent{PartID: change.PartID, Part: change.Part}
+
+	matches := false
+	if !change.Deleted && change.Part != nil {
+		rctx, cancel := context.WithTimeout(ctx, s.readDBTimeout)
+		defer cancel()
+
+		var err error
+		matches, err = s.repo.Matches(rctx, change.PartID, filter)
+		if err != nil {
+			return model.PartsWatchEvent{}, false, err
+		}
+	}
+
+	if matches {
+		if visible != nil {
+			visible[change.PartID] = struct{}{}
+		}
+		ev.Type = model.WatchEventUpserted
+		return ev, true, nil
+	}
+
+	if visible != nil {
+		if _, ok := visible[change.PartID]; !ok {
+			return model.PartsWatchEvent{}, false, nil
+		}
+		delete(visible, change.PartID)
+	}
+	ev.Type = model.WatchEventRemoved
+	return ev, true, nil
+}
+
+func encodeResumeToken(tok []byte) string {
+	if len(tok) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(tok)
+}
@@ -0,0 +1,113 @@
+package partproducer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+type ChangeRepository interface {
+	PendingChanges(ctx context.Context, limit int) ([]model.PartChange, error)
+	AckChange(ctx context.Context, partID, changeID string) error
+}
+
+type Producer interface {
+	Send(ctx context.Context, key, value []byte) error
+}
+
+type Converter interface {
+	PartChangeToPayload(m model.PartChange) ([]byte, error)
+}
+
+// relay publishes the part changes stored in the outbox to Kafka. A change
+// is removed from the outbox only after Kafka accepts it, so delivery is
+// at least once.
+type relay struct {
+	repo      ChangeRepository
+	producer  Producer
+	conv      Converter
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPartChangeRelay(
+	repo ChangeRepository,
+	producer Producer,
+	conv Converter,
+	interval time.Duration,
+	batchSize int,
+) *relay {
+	return &relay{
+		repo:      repo,
+		producer:  producer,
+		conv:      conv,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run relays pending changes every interval until ctx is done.
+func (r *relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RelayPending(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "relay part changes", logger.ErrorF(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes one batch of pending changes. After a failure the
+// remaining changes of the same part are left for the next run, so the
+// changes of a part are published in order.
+func (r *relay) RelayPending(ctx context.Context) error {
+	const op = "partproducer.RelayPending"
+
+	changes, err := r.repo.PendingChanges(ctx, r.batchSize)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var errs []error
+	failed := make(map[string]struct{})
+	for _, c := range changes {
+		if _, ok := failed[c.PartID]; ok {
+			continue
+		}
+		if err := r.publish(ctx, c); err != nil {
+			failed[c.PartID] = struct{}{}
+			errs = append(errs, fmt.Errorf("%s: change %s: %w", op, c.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *relay) publish(ctx context.Context, c model.PartChange) error {
+	payload, err := r.conv.PartChangeToPayload(c)
+	if err != nil {
+		return fmt.Errorf("converter part_change_to_payload error: %w", err)
+	}
+
+	if err := r.producer.Send(ctx, []byte(c.PartID), payload); err != nil {
+		return fmt.Errorf("producer to inventory.parts topic error: %w", err)
+	}
+
+	// A change that is sent but not acknowledged is sent again by the next run.
+	if err := r.repo.AckChange(ctx, c.PartID, c.ID); err != nil {
+		return fmt.Errorf("ack change: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package partproducer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/inventory/internal/model"
+	"github.com/you-humble/rocket-maintenance/inventory/internal/service/producer/mocks"
+)
+
+func TestRelayPending(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockChangeRepository
+		producer   *mocks.MockProducer
+		converter  *mocks.MockConverter
+	}
+
+	newRelay := func(d deps) *relay {
+		return NewPartChangeRelay(d.repository, d.producer, d.converter, time.Second, 10)
+	}
+
+	partA, partB := gofakeit.UUID(), gofakeit.UUID()
+	a1 := model.PartChange{ID: gofakeit.UUID(), PartID: partA, Type: model.PartChangeUpdated}
+	a2 := model.PartChange{ID: gofakeit.UUID(), PartID: partA, Type: model.PartChangeStockChanged}
+	b1 := model.PartChange{ID: gofakeit.UUID(), PartID: partB, Type: model.PartChangeArchived}
+	errKafka := errors.New("kafka is down")
+
+	type testCase struct {
+		name   string
+		setup  func(d deps)
+		assert func(t *testing.T, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name: "success: changes are sent and acknowledged in order",
+			setup: func(d deps) {
+				d.repository.
+					On("PendingChanges", mock.Anything, 10).
+					Return([]model.PartChange{a1, a2}, nil).
+					Once()
+				for _, c := range []model.PartChange{a1, a2} {
+					d.converter.
+						On("PartChangeToPayload", c).
+						Return([]byte(c.ID), nil).
+						Once()
+					d.producer.
+						On("Send", mock.Anything, []byte(partA), []byte(c.ID)).
+						Return(nil).
+						Once()
+					d.repository.
+						On("AckChange", mock.Anything, partA, c.ID).
+						Return(nil).
+						Once()
+				}
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.NoError(t, err)
+				d.repository.AssertExpectations(t)
+				d.producer.AssertExpectations(t)
+			},
+		},
+		{
+			name: "send failure: later changes of the part wait, other parts go on",
+			setup: func(d deps) {
+				d.repository.
+					On("PendingChanges", mock.Anything, 10).
+					Return([]model.PartChange{a1, a2, b1}, nil).
+					Once()
+				d.converter.
+					On("PartChangeToPayload", a1).
+					Return([]byte(a1.ID), nil).
+					Once()
+				d.producer.
+					On("Send", mock.Anything, []byte(partA), []byte(a1.ID)).
+					Return(errKafka).
+					Once()
+				d.converter.
+					On("PartChangeToPayload", b1).
+					Return([]byte(b1.ID), nil).
+					Once()
+				d.producer.
+					On("Send", mock.Anything, []byte(partB), []byte(b1.ID)).
+					Return(nil).
+					Once()
+				d.repository.
+					On("AckChange", mock.Anything, partB, b1.ID).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, errKafka)
+				d.converter.AssertNotCalled(t, "PartChangeToPayload", a2)
+				d.repository.AssertNotCalled(t, "AckChange", mock.Anything, partA, mock.Anything)
+			},
+		},
+		{
+			name: "repository failure: nothing is sent",
+			setup: func(d deps) {
+				d.repository.
+					On("PendingChanges", mock.Anything, 10).
+					Return(([]model.PartChange)(nil), errors.New("mongo is down")).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.Error(t, err)
+				d.producer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockChangeRepository(t),
+				producer:   mocks.NewMockProducer(t),
+				converter:  mocks.NewMockConverter(t),
+			}
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			r := newRelay(d)
+
+			err := r.RelayPending(context.Background())
+			tt.assert(t, err, d)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamShutdown cancels open streams when ctx is done, so GracefulStop
+// does not wait for long-lived streams such as WatchParts.
+func StreamShutdown(ctx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		sctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(ctx, cancel)
+		defer stop()
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: sctx})
+		if err != nil && ctx.Err() != nil {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
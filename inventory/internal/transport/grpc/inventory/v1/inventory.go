@@ -3,7 +3,10 @@ package grpc
 import (
 	"context"
 	"errors"
+	"strconv"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -14,9 +17,24 @@ import (
 
 type InventoryService interface {
 	Part(ctx context.Context, partID string) (*model.Part, error)
-	ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	ListParts(ctx context.Context, params model.ListPartsParams) (*model.ListPartsResponse, error)
+	CreatePart(ctx context.Context, info model.PartInfo) (*model.Part, error)
+	UpdatePart(ctx context.Context, params model.UpdatePartParams) (*model.Part, error)
+	ArchivePart(ctx context.Context, partID string) (*model.Part, error)
+	RestorePart(ctx context.Context, partID string) (*model.Part, error)
+	WatchParts(ctx context.Context, params model.WatchPartsParams, send func(model.PartsWatchEvent) error) error
+	ValidateConfiguration(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error)
+	PriceHistory(ctx context.Context, params model.PriceHistoryParams) ([]model.PriceChange, error)
+	CreateWarehouse(ctx context.Context, w model.Warehouse) (*model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+	TransferStock(ctx context.Context, params model.TransferStockParams) (*model.Part, error)
+	ReserveStock(ctx context.Context, id string, items []model.ReservedItem) (*model.StockReservation, error)
+	ReleaseStock(ctx context.Context, id string) error
 }
 
+// versionMismatchReason is the ErrorInfo reason of an Aborted update.
+const versionMismatchReason = "VERSION_MISMATCH"
+
 type handler struct {
 	inventorypbv1.UnimplementedInventoryServiceServer
 	svc InventoryService
@@ -41,27 +59,187 @@ func (h *handler) ListParts(
 	ctx context.Context,
 	req *inventorypbv1.ListPartsRequest,
 ) (*inventorypbv1.ListPartsResponse, error) {
-	filter := converter.PartsFilterToModel(req.GetFilter())
+	res, err := h.svc.ListParts(ctx, converter.ListPartsRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return converter.ListPartsResponseFromModel(res), nil
+}
+
+func (h *handler) CreatePart(
+	ctx context.Context,
+	req *inventorypbv1.CreatePartRequest,
+) (*inventorypbv1.CreatePartResponse, error) {
+	p, err := h.svc.CreatePart(ctx, converter.PartInfoToModel(req.GetPart()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.CreatePartResponse{Part: converter.PartFromModel(p)}, nil
+}
+
+func (h *handler) UpdatePart(
+	ctx context.Context,
+	req *inventorypbv1.UpdatePartRequest,
+) (*inventorypbv1.UpdatePartResponse, error) {
+	p, err := h.svc.UpdatePart(ctx, converter.UpdatePartRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.UpdatePartResponse{Part: converter.PartFromModel(p)}, nil
+}
+
+func (h *handler) ArchivePart(
+	ctx context.Context,
+	req *inventorypbv1.ArchivePartRequest,
+) (*inventorypbv1.ArchivePartResponse, error) {
+	p, err := h.svc.ArchivePart(ctx, req.GetUuid())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.ArchivePartResponse{Part: converter.PartFromModel(p)}, nil
+}
+
+func (h *handler) RestorePart(
+	ctx context.Context,
+	req *inventorypbv1.RestorePartRequest,
+) (*inventorypbv1.RestorePartResponse, error) {
+	p, err := h.svc.RestorePart(ctx, req.GetUuid())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.RestorePartResponse{Part: converter.PartFromModel(p)}, nil
+}
+
+func (h *handler) WatchParts(
+	req *inventorypbv1.WatchPartsRequest,
+	stream grpc.ServerStreamingServer[inventorypbv1.WatchPartsResponse],
+) error {
+	err := h.svc.WatchParts(stream.Context(), converter.WatchPartsRequestToParams(req),
+		func(ev model.PartsWatchEvent) error {
+			return stream.Send(converter.WatchPartsResponseFromModel(ev))
+		},
+	)
+	if err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (h *handler) ValidateConfiguration(
+	ctx context.Context,
+	req *inventorypbv1.ValidateConfigurationRequest,
+) (*inventorypbv1.ValidateConfigurationResponse, error) {
+	violations, err := h.svc.ValidateConfiguration(ctx, req.GetPartUuids())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return converter.ValidateConfigurationResponseFromModel(violations), nil
+}
+
+func (h *handler) GetPriceHistory(
+	ctx context.Context,
+	req *inventorypbv1.GetPriceHistoryRequest,
+) (*inventorypbv1.GetPriceHistoryResponse, error) {
+	changes, err := h.svc.PriceHistory(ctx, converter.GetPriceHistoryRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return converter.GetPriceHistoryResponseFromModel(changes), nil
+}
+
+func (h *handler) CreateWarehouse(
+	ctx context.Context,
+	req *inventorypbv1.CreateWarehouseRequest,
+) (*inventorypbv1.CreateWarehouseResponse, error) {
+	w, err := h.svc.CreateWarehouse(ctx, converter.WarehouseToModel(req.GetWarehouse()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.CreateWarehouseResponse{Warehouse: converter.WarehouseFromModel(w)}, nil
+}
+
+func (h *handler) ListWarehouses(
+	ctx context.Context,
+	_ *inventorypbv1.ListWarehousesRequest,
+) (*inventorypbv1.ListWarehousesResponse, error) {
+	ws, err := h.svc.ListWarehouses(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return converter.ListWarehousesResponseFromModel(ws), nil
+}
+
+func (h *handler) TransferStock(
+	ctx context.Context,
+	req *inventorypbv1.TransferStockRequest,
+) (*inventorypbv1.TransferStockResponse, error) {
+	p, err := h.svc.TransferStock(ctx, converter.TransferStockRequestToParams(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &inventorypbv1.TransferStockResponse{Part: converter.PartFromModel(p)}, nil
+}
 
-	parts, err := h.svc.ListParts(ctx, filter)
+func (h *handler) ReserveStock(
+	ctx context.Context,
+	req *inventorypbv1.ReserveStockRequest,
+) (*inventorypbv1.ReserveStockResponse, error) {
+	res, err := h.svc.ReserveStock(ctx, req.GetReservationId(), converter.ReservedItemsToModel(req.GetItems()))
 	if err != nil {
 		return nil, mapError(err)
 	}
+	return &inventorypbv1.ReserveStockResponse{Reservation: converter.StockReservationFromModel(res)}, nil
+}
 
-	out := make([]*inventorypbv1.Part, 0, len(parts))
-	for i := range parts {
-		out = append(out, converter.PartFromModel(parts[i]))
+func (h *handler) ReleaseStock(
+	ctx context.Context,
+	req *inventorypbv1.ReleaseStockRequest,
+) (*inventorypbv1.ReleaseStockResponse, error) {
+	if err := h.svc.ReleaseStock(ctx, req.GetReservationId()); err != nil {
+		return nil, mapError(err)
 	}
-	return &inventorypbv1.ListPartsResponse{Parts: out}, nil
+	return &inventorypbv1.ReleaseStockResponse{}, nil
 }
 
 func mapError(err error) error {
 	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "canceled")
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		return status.Error(codes.FailedPrecondition, "resume token expired, watch again without it")
 	case errors.Is(err, model.ErrInvalidArgument):
-		return status.Error(codes.InvalidArgument, "invalid argument")
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, model.ErrPartNotFound):
 		return status.Error(codes.NotFound, "part not found")
+	case errors.Is(err, model.ErrVersionMismatch):
+		return versionMismatchStatus(err)
+	case errors.Is(err, model.ErrWarehouseExists):
+		return status.Error(codes.AlreadyExists, "warehouse already exists")
+	case errors.Is(err, model.ErrInsufficientStock),
+		errors.Is(err, model.ErrPartArchived),
+		errors.Is(err, model.ErrReservationReleased):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal error")
 	}
 }
+
+// versionMismatchStatus returns Aborted with the current version of the part
+// in an ErrorInfo detail, so clients can tell a conflict from other aborts.
+func versionMismatchStatus(err error) error {
+	var mismatch *model.VersionMismatchError
+	if !errors.As(err, &mismatch) {
+		return status.Error(codes.Aborted, model.ErrVersionMismatch.Error())
+	}
+
+	st := status.New(codes.Aborted, mismatch.Error())
+	withInfo, derr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   versionMismatchReason,
+		Domain:   "inventory.v1",
+		Metadata: map[string]string{"current_version": strconv.FormatInt(mismatch.Current, 10)},
+	})
+	if derr != nil {
+		return st.Err()
+	}
+	return withInfo.Err()
+}
@@ -4,31 +4,44 @@ package e2e
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/docker/go-connections/nat"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/samber/lo"
 	tc "github.com/testcontainers/testcontainers-go"
+	kafkaTc "github.com/testcontainers/testcontainers-go/modules/kafka"
+	dockernet "github.com/testcontainers/testcontainers-go/network"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	tcnetwork "github.com/you-humble/rocket-maintenance/platform/testcontainers/network"
 	"github.com/you-humble/rocket-maintenance/platform/testcontainers/path"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig/devca"
 	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
 )
 
@@ -48,10 +61,32 @@ const (
 	mongoPass = "inv123ghU_w"
 	mongoAuth = "admin"
 
-	mongoDB         = "inventory-db"
-	mongoCollection = "parts"
+	mongoDB                     = "inventory-db"
+	mongoCollection             = "parts"
+	mongoPricesCollection       = "part_prices"
+	mongoWarehousesCollection   = "warehouses"
+	mongoReservationsCollection = "stock_reservations"
 
 	grpcPort = "50051"
+
+	// The service serves over mutual TLS with certificates of a dev CA.
+	certsDir          = "/app/certs"
+	inventoryCertName = "inventory"
+	clientCertName    = "order"
+
+	mongoReplicaSetEntrypoint = `head -c 756 /dev/urandom | base64 > /tmp/mongo-keyfile
+chmod 400 /tmp/mongo-keyfile
+chown mongodb:mongodb /tmp/mongo-keyfile
+exec docker-entrypoint.sh mongod --replSet rs0 --bind_ip_all --keyFile /tmp/mongo-keyfile`
+	mongoReplicaSetInit = `try { rs.status() } catch (e) {
+  rs.initiate({ _id: 'rs0', members: [{ _id: 0, host: 'localhost:27017' }] })
+}
+quit(db.hello().isWritablePrimary ? 0 : 1)`
+
+	kafkaImage = "confluentinc/cp-kafka:7.6.1"
+	// Containers on the test network reach the broker by this alias.
+	kafkaAlias = "kafka-inventory"
+	topicParts = "inventory.parts"
 )
 
 var (
@@ -59,14 +94,19 @@ var (
 
 	net        *tcnetwork.Network
 	mongoC     tc.Container
+	kafkaC     tc.Container
 	inventoryC tc.Container
 
+	kafkaBrokers []string
+
 	mongoClient *mongo.Client
 	partsColl   *mongo.Collection
 
 	grpcConn *grpc.ClientConn
 	grpcAddr string
 
+	certsHostDir string
+
 	invClient    inventorypbv1.InventoryServiceClient
 	healthClient grpc_health_v1.HealthClient
 )
@@ -152,15 +192,27 @@ func NewFakeMongoPart(category inventorypbv1.Category, country string) mongoPart
 // 	App     *app.Container
 // }
 
-func mustDialGRPC(addr string) *grpc.ClientConn {
-	conn, err := grpc.NewClient(
-		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+func mustDialGRPC(addr string, creds credentials.TransportCredentials) *grpc.ClientConn {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
 	Expect(err).NotTo(HaveOccurred())
 	return conn
 }
 
+// clientTLS trusts the dev CA and, with a certificate name, presents that
+// certificate. The mapped address of the container is not in the
+// certificate of the service, so the service is verified by its name.
+func clientTLS(certName string) *tls.Config {
+	files := tlsconfig.Files{CAFile: filepath.Join(certsHostDir, devca.CAFile)}
+	if certName != "" {
+		files.CertFile = filepath.Join(certsHostDir, devca.CertFile(certName))
+		files.KeyFile = filepath.Join(certsHostDir, devca.KeyFile(certName))
+	}
+
+	cfg, err := tlsconfig.Client(files, tlsconfig.WithServerName(inventoryCertName))
+	Expect(err).NotTo(HaveOccurred())
+	return cfg
+}
+
 func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
@@ -187,7 +239,9 @@ var _ = BeforeSuite(func() {
 			"MONGO_INITDB_ROOT_USERNAME": mongoUser,
 			"MONGO_INITDB_ROOT_PASSWORD": mongoPass,
 		},
-		Networks: []string{net.Name()},
+		// WatchParts needs a replica set; with authentication it needs a key file.
+		Entrypoint: []string{"bash", "-c", mongoReplicaSetEntrypoint},
+		Networks:   []string{net.Name()},
 		NetworkAliases: map[string][]string{
 			net.Name(): {"mongo-inventory"},
 		},
@@ -199,6 +253,17 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
+	By("initiating mongo replica set")
+	Eventually(func(g Gomega) {
+		code, _, err := mongoC.Exec(ctx, []string{
+			"mongosh", "--quiet",
+			"-u", mongoUser, "-p", mongoPass, "--authenticationDatabase", mongoAuth,
+			"--eval", mongoReplicaSetInit,
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(code).To(BeZero())
+	}).WithTimeout(60 * time.Second).WithPolling(time.Second).Should(Succeed())
+
 	By("connecting to mongo via mapped port (from test process)")
 	mongoHost, err := mongoC.Host(ctx)
 	Expect(err).NotTo(HaveOccurred())
@@ -207,7 +272,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	mongoURI := fmt.Sprintf(
-		"mongodb://%s:%s@%s:%s/?authSource=%s",
+		"mongodb://%s:%s@%s:%s/?authSource=%s&directConnection=true",
 		mongoUser, mongoPass, mongoHost, mongoMapped.Port(), mongoAuth,
 	)
 
@@ -217,6 +282,35 @@ var _ = BeforeSuite(func() {
 
 	partsColl = mongoClient.Database(mongoDB).Collection(mongoCollection)
 
+	By("starting kafka container (cp-kafka)")
+	kc, err := kafkaTc.Run(ctx,
+		kafkaImage,
+		kafkaTc.WithClusterID("Mk3OEYBSD34fcwNTJENDM2Qk"),
+		dockernet.WithNetworkName([]string{kafkaAlias}, net.Name()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	kafkaC = kc
+
+	kafkaBrokers, err = kc.Brokers(ctx)
+	Expect(err).NotTo(HaveOccurred())
+
+	By("creating kafka topics")
+	Expect(createTopics(kafkaBrokers, topicParts)).To(Succeed())
+
+	By("generating dev CA and service certificates")
+	certsHostDir, err = os.MkdirTemp("", "inventory-e2e-certs-")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(devca.Generate(certsHostDir, []string{inventoryCertName, clientCertName})).To(Succeed())
+
+	var certFiles []tc.ContainerFile
+	for _, name := range []string{devca.CAFile, devca.CertFile(inventoryCertName), devca.KeyFile(inventoryCertName)} {
+		certFiles = append(certFiles, tc.ContainerFile{
+			HostFilePath:      filepath.Join(certsHostDir, name),
+			ContainerFilePath: certsDir + "/" + name,
+			FileMode:          0o644,
+		})
+	}
+
 	By("starting inventory container from Dockerfile via testcontainers build")
 	projectRoot := path.GetProjectRoot()
 
@@ -246,12 +340,26 @@ var _ = BeforeSuite(func() {
 			"MONGO_PORT":     "27017",
 			"MONGO_DATABASE": mongoDB,
 
-			"MONGO_PARTS_COLLECTION": mongoCollection,
+			"MONGO_PARTS_COLLECTION":        mongoCollection,
+			"MONGO_PRICES_COLLECTION":       mongoPricesCollection,
+			"MONGO_WAREHOUSES_COLLECTION":   mongoWarehousesCollection,
+			"MONGO_RESERVATIONS_COLLECTION": mongoReservationsCollection,
 
 			"MONGO_AUTH_DB":              mongoAuth,
 			"MONGO_INITDB_ROOT_USERNAME": mongoUser,
 			"MONGO_INITDB_ROOT_PASSWORD": mongoPass,
+
+			"KAFKA_BROKERS":               kafkaAlias + ":9092",
+			"INVENTORY_PARTS_TOPIC_NAME":  topicParts,
+			"PART_CHANGES_RELAY_INTERVAL": "200ms",
+
+			"GRPC_TLS_ENABLED":     "true",
+			"GRPC_TLS_CERT_FILE":   certsDir + "/" + devca.CertFile(inventoryCertName),
+			"GRPC_TLS_KEY_FILE":    certsDir + "/" + devca.KeyFile(inventoryCertName),
+			"GRPC_TLS_CA_FILE":     certsDir + "/" + devca.CAFile,
+			"GRPC_TLS_CLIENT_AUTH": "true",
 		},
+		Files:      certFiles,
 		Networks:   []string{net.Name()},
 		WaitingFor: wait.ForListeningPort(nat.Port(grpcPort + "/tcp")).WithStartupTimeout(90 * time.Second),
 	}
@@ -271,7 +379,7 @@ var _ = BeforeSuite(func() {
 
 	grpcAddr = fmt.Sprintf("%s:%s", invHost, invMapped.Port())
 
-	grpcConn = mustDialGRPC(grpcAddr)
+	grpcConn = mustDialGRPC(grpcAddr, credentials.NewTLS(clientTLS(clientCertName)))
 	invClient = inventorypbv1.NewInventoryServiceClient(grpcConn)
 	healthClient = grpc_health_v1.NewHealthClient(grpcConn)
 
@@ -293,12 +401,18 @@ var _ = AfterSuite(func() {
 	if inventoryC != nil {
 		_ = inventoryC.Terminate(ctx)
 	}
+	if kafkaC != nil {
+		_ = kafkaC.Terminate(ctx)
+	}
 	if mongoC != nil {
 		_ = mongoC.Terminate(ctx)
 	}
 	if net != nil {
 		_ = net.Remove(ctx)
 	}
+	if certsHostDir != "" {
+		_ = os.RemoveAll(certsHostDir)
+	}
 })
 
 var _ = Describe("InventoryService e2e", func() {
@@ -406,4 +520,653 @@ var _ = Describe("InventoryService e2e", func() {
 			Expect(resp.GetParts()).To(HaveLen(2))
 		})
 	})
+
+	Context("UpdatePart", func() {
+		It("bumps the version and rejects a stale expected_version", func() {
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 5,
+					Category:      inventorypbv1.Category_CATEGORY_FUEL,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.GetPart().GetVersion()).To(Equal(int64(1)))
+
+			updated, err := invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+				Uuid:            created.GetPart().GetUuid(),
+				Part:            &inventorypbv1.PartInfo{StockQuantity: 4},
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"stock_quantity"}},
+				ExpectedVersion: 1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.GetPart().GetVersion()).To(Equal(int64(2)))
+
+			By("updating with the stale version")
+			_, err = invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+				Uuid:            created.GetPart().GetUuid(),
+				Part:            &inventorypbv1.PartInfo{StockQuantity: 3},
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"stock_quantity"}},
+				ExpectedVersion: 1,
+			})
+			st := status.Convert(err)
+			Expect(st.Code()).To(Equal(codes.Aborted))
+
+			var info *errdetails.ErrorInfo
+			for _, d := range st.Details() {
+				if ei, ok := d.(*errdetails.ErrorInfo); ok {
+					info = ei
+				}
+			}
+			Expect(info).NotTo(BeNil())
+			Expect(info.GetReason()).To(Equal("VERSION_MISMATCH"))
+			Expect(info.GetMetadata()).To(HaveKeyWithValue("current_version", "2"))
+		})
+	})
+
+	Context("Warehouses", func() {
+		It("keeps per-warehouse stock and transfers it between warehouses", func() {
+			whID := "e2e-" + strings.ToLower(gofakeit.LetterN(8))
+			wh, err := invClient.CreateWarehouse(ctx, &inventorypbv1.CreateWarehouseRequest{
+				Warehouse: &inventorypbv1.Warehouse{Id: whID, Name: "Kourou", Spaceport: "Guiana Space Centre"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wh.GetWarehouse().GetCreatedAt()).NotTo(BeNil())
+
+			By("creating the same warehouse again")
+			_, err = invClient.CreateWarehouse(ctx, &inventorypbv1.CreateWarehouseRequest{
+				Warehouse: &inventorypbv1.Warehouse{Id: whID, Name: "Kourou"},
+			})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			list, err := invClient.ListWarehouses(ctx, &inventorypbv1.ListWarehousesRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			ids := lo.Map(list.GetWarehouses(), func(w *inventorypbv1.Warehouse, _ int) string { return w.GetId() })
+			Expect(ids).To(ContainElements("default", whID))
+
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 5,
+					Category:      inventorypbv1.Category_CATEGORY_WING,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.GetPart().GetStockLevels()).To(HaveLen(1))
+			Expect(created.GetPart().GetStockLevels()[0].GetWarehouseId()).To(Equal("default"))
+
+			moved, err := invClient.TransferStock(ctx, &inventorypbv1.TransferStockRequest{
+				PartUuid:        created.GetPart().GetUuid(),
+				FromWarehouseId: "default",
+				ToWarehouseId:   whID,
+				Quantity:        2,
+				ExpectedVersion: 1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.GetPart().GetStockQuantity()).To(Equal(int64(5)))
+			Expect(moved.GetPart().GetVersion()).To(Equal(int64(2)))
+
+			levels := map[string]int64{}
+			for _, l := range moved.GetPart().GetStockLevels() {
+				levels[l.GetWarehouseId()] = l.GetQuantity()
+			}
+			Expect(levels).To(Equal(map[string]int64{"default": 3, whID: 2}))
+
+			By("moving more than the warehouse holds")
+			_, err = invClient.TransferStock(ctx, &inventorypbv1.TransferStockRequest{
+				PartUuid:        created.GetPart().GetUuid(),
+				FromWarehouseId: whID,
+				ToWarehouseId:   "default",
+				Quantity:        3,
+				ExpectedVersion: 2,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("rejects stock in an unknown warehouse", func() {
+			_, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:        gofakeit.ProductName(),
+					PriceCents:  1000,
+					Category:    inventorypbv1.Category_CATEGORY_WING,
+					StockLevels: []*inventorypbv1.StockLevel{{WarehouseId: "nowhere", Quantity: 1}},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("Stock reservations", func() {
+		It("reserves stock once and puts it back where it was taken from", func() {
+			whID := "e2e-" + strings.ToLower(gofakeit.LetterN(8))
+			_, err := invClient.CreateWarehouse(ctx, &inventorypbv1.CreateWarehouseRequest{
+				Warehouse: &inventorypbv1.Warehouse{Id: whID, Name: "Plesetsk"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:       gofakeit.ProductName(),
+					PriceCents: 1000,
+					Category:   inventorypbv1.Category_CATEGORY_FUEL,
+					StockLevels: []*inventorypbv1.StockLevel{
+						{WarehouseId: "default", Quantity: 1},
+						{WarehouseId: whID, Quantity: 3},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			partID := created.GetPart().GetUuid()
+			version := created.GetPart().GetVersion()
+
+			stockOf := func() (int64, map[string]int64) {
+				got, err := invClient.GetPart(ctx, &inventorypbv1.GetPartRequest{Uuid: partID})
+				Expect(err).NotTo(HaveOccurred())
+				levels := map[string]int64{}
+				for _, l := range got.GetPart().GetStockLevels() {
+					levels[l.GetWarehouseId()] = l.GetQuantity()
+				}
+				return got.GetPart().GetStockQuantity(), levels
+			}
+
+			reservationID := gofakeit.UUID()
+			req := &inventorypbv1.ReserveStockRequest{
+				ReservationId: reservationID,
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: partID, Quantity: 2, ExpectedVersion: version},
+				},
+			}
+			res, err := invClient.ReserveStock(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.GetReservation().GetReleasedAt()).To(BeNil())
+
+			quantity, levels := stockOf()
+			Expect(quantity).To(Equal(int64(2)))
+			Expect(levels).To(Equal(map[string]int64{whID: 2}))
+
+			By("reserving again with the same id")
+			_, err = invClient.ReserveStock(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			quantity, _ = stockOf()
+			Expect(quantity).To(Equal(int64(2)))
+
+			By("reserving more than is left")
+			_, err = invClient.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+				ReservationId: gofakeit.UUID(),
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: partID, Quantity: 3, ExpectedVersion: version + 1},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			By("releasing twice")
+			for range 2 {
+				_, err = invClient.ReleaseStock(ctx, &inventorypbv1.ReleaseStockRequest{ReservationId: reservationID})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			quantity, levels = stockOf()
+			Expect(quantity).To(Equal(int64(4)))
+			Expect(levels).To(Equal(map[string]int64{"default": 1, whID: 3}))
+
+			By("reserving after the release")
+			_, err = invClient.ReserveStock(ctx, req)
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("reserves stock from the warehouse an item names", func() {
+			whID := "e2e-" + strings.ToLower(gofakeit.LetterN(8))
+			_, err := invClient.CreateWarehouse(ctx, &inventorypbv1.CreateWarehouseRequest{
+				Warehouse: &inventorypbv1.Warehouse{Id: whID, Name: "Vostochny"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:       gofakeit.ProductName(),
+					PriceCents: 1000,
+					Category:   inventorypbv1.Category_CATEGORY_FUEL,
+					StockLevels: []*inventorypbv1.StockLevel{
+						{WarehouseId: "default", Quantity: 2},
+						{WarehouseId: whID, Quantity: 2},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			partID := created.GetPart().GetUuid()
+			version := created.GetPart().GetVersion()
+
+			By("reserving more than the warehouse holds")
+			_, err = invClient.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+				ReservationId: gofakeit.UUID(),
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: partID, Quantity: 3, WarehouseId: whID, ExpectedVersion: version},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			res, err := invClient.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+				ReservationId: gofakeit.UUID(),
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: partID, Quantity: 2, WarehouseId: whID, ExpectedVersion: version},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.GetReservation().GetItems()).To(HaveLen(1))
+			item := res.GetReservation().GetItems()[0]
+			Expect(item.GetWarehouseId()).To(Equal(whID))
+			Expect(item.GetTaken()).To(HaveLen(1))
+			Expect(item.GetTaken()[0].GetWarehouseId()).To(Equal(whID))
+			Expect(item.GetTaken()[0].GetQuantity()).To(Equal(int64(2)))
+
+			got, err := invClient.GetPart(ctx, &inventorypbv1.GetPartRequest{Uuid: partID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.GetPart().GetStockQuantity()).To(Equal(int64(2)))
+			Expect(got.GetPart().GetStockLevels()).To(HaveLen(1))
+			Expect(got.GetPart().GetStockLevels()[0].GetWarehouseId()).To(Equal("default"))
+		})
+
+		It("takes nothing for a reservation released before it arrives", func() {
+			reservationID := gofakeit.UUID()
+			_, err := invClient.ReleaseStock(ctx, &inventorypbv1.ReleaseStockRequest{ReservationId: reservationID})
+			Expect(err).NotTo(HaveOccurred())
+
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 1,
+					Category:      inventorypbv1.Category_CATEGORY_FUEL,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = invClient.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+				ReservationId: reservationID,
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: created.GetPart().GetUuid(), Quantity: 1, ExpectedVersion: created.GetPart().GetVersion()},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("reserves nothing when a part changed since it was read", func() {
+			parts := make([]*inventorypbv1.Part, 2)
+			for i := range parts {
+				created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+					Part: &inventorypbv1.PartInfo{
+						Name:          gofakeit.ProductName(),
+						PriceCents:    1000,
+						StockQuantity: 5,
+						Category:      inventorypbv1.Category_CATEGORY_WING,
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				parts[i] = created.GetPart()
+			}
+
+			By("changing the second part")
+			_, err := invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+				Uuid:            parts[1].GetUuid(),
+				Part:            &inventorypbv1.PartInfo{PriceCents: 1200},
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"price_cents"}},
+				ExpectedVersion: parts[1].GetVersion(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = invClient.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+				ReservationId: gofakeit.UUID(),
+				Items: []*inventorypbv1.ReservedItem{
+					{PartUuid: parts[0].GetUuid(), Quantity: 2, ExpectedVersion: parts[0].GetVersion()},
+					{PartUuid: parts[1].GetUuid(), Quantity: 2, ExpectedVersion: parts[1].GetVersion()},
+				},
+			})
+			st := status.Convert(err)
+			Expect(st.Code()).To(Equal(codes.Aborted))
+
+			var info *errdetails.ErrorInfo
+			for _, d := range st.Details() {
+				if ei, ok := d.(*errdetails.ErrorInfo); ok {
+					info = ei
+				}
+			}
+			Expect(info).NotTo(BeNil())
+			Expect(info.GetReason()).To(Equal("VERSION_MISMATCH"))
+			Expect(info.GetMetadata()).To(HaveKeyWithValue("current_version", strconv.FormatInt(parts[1].GetVersion()+1, 10)))
+
+			By("checking the first part kept its stock")
+			got, err := invClient.GetPart(ctx, &inventorypbv1.GetPartRequest{Uuid: parts[0].GetUuid()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.GetPart().GetStockQuantity()).To(Equal(int64(5)))
+			Expect(got.GetPart().GetVersion()).To(Equal(parts[0].GetVersion()))
+		})
+	})
+
+	Context("GetPriceHistory", func() {
+		It("records the price of every change that sets one", func() {
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 5,
+					Category:      inventorypbv1.Category_CATEGORY_ENGINE,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			partID := created.GetPart().GetUuid()
+
+			for i, upd := range []struct {
+				info *inventorypbv1.PartInfo
+				path string
+			}{
+				{&inventorypbv1.PartInfo{PriceCents: 1500}, "price_cents"},
+				{&inventorypbv1.PartInfo{StockQuantity: 4}, "stock_quantity"},
+			} {
+				_, err = invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+					Uuid:            partID,
+					Part:            upd.info,
+					UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{upd.path}},
+					ExpectedVersion: int64(i + 1),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			res, err := invClient.GetPriceHistory(ctx, &inventorypbv1.GetPriceHistoryRequest{PartUuid: partID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.GetChanges()).To(HaveLen(2))
+			Expect(res.GetChanges()[0].GetPriceCents()).To(Equal(int64(1000)))
+			Expect(res.GetChanges()[0].GetVersion()).To(Equal(int64(1)))
+			Expect(res.GetChanges()[1].GetPriceCents()).To(Equal(int64(1500)))
+			Expect(res.GetChanges()[1].GetVersion()).To(Equal(int64(2)))
+
+			By("asking for the changes after the second one")
+			res, err = invClient.GetPriceHistory(ctx, &inventorypbv1.GetPriceHistoryRequest{
+				PartUuid: partID,
+				Range: &inventorypbv1.TimeRange{
+					From: timestamppb.New(res.GetChanges()[1].GetChangedAt().AsTime().Add(time.Millisecond)),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.GetChanges()).To(HaveLen(1))
+			Expect(res.GetChanges()[0].GetPriceCents()).To(Equal(int64(1500)))
+		})
+
+		It("returns NotFound for an unknown part", func() {
+			_, err := invClient.GetPriceHistory(ctx, &inventorypbv1.GetPriceHistoryRequest{PartUuid: gofakeit.UUID()})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Context("WatchParts", func() {
+		It("sends a snapshot, changes and resumes after a token", func() {
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 5,
+					Category:      inventorypbv1.Category_CATEGORY_WING,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			partID := created.GetPart().GetUuid()
+
+			req := &inventorypbv1.WatchPartsRequest{
+				Filter: &inventorypbv1.PartsFilter{
+					Categories: []inventorypbv1.Category{inventorypbv1.Category_CATEGORY_WING},
+				},
+			}
+
+			wctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			stream, err := invClient.WatchParts(wctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.GetType()).To(Equal(inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT))
+			Expect(msg.GetPartUuid()).To(Equal(partID))
+
+			msg, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.GetType()).To(Equal(inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_CURRENT))
+			Expect(msg.GetResumeToken()).NotTo(BeEmpty())
+
+			By("updating the part")
+			_, err = invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+				Uuid:            partID,
+				Part:            &inventorypbv1.PartInfo{PriceCents: 1500},
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"price_cents"}},
+				ExpectedVersion: created.GetPart().GetVersion(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.GetType()).To(Equal(inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_UPSERTED))
+			Expect(msg.GetPart().GetPriceCents()).To(Equal(int64(1500)))
+
+			By("archiving the part")
+			_, err = invClient.ArchivePart(ctx, &inventorypbv1.ArchivePartRequest{Uuid: partID})
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.GetType()).To(Equal(inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_REMOVED))
+			Expect(msg.GetPartUuid()).To(Equal(partID))
+			cancel()
+
+			By("restoring the part while no one watches")
+			_, err = invClient.RestorePart(ctx, &inventorypbv1.RestorePartRequest{Uuid: partID})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("resuming after the last token")
+			req.ResumeToken = msg.GetResumeToken()
+			wctx, cancel = context.WithTimeout(ctx, 30*time.Second)
+			defer cancel()
+			stream, err = invClient.WatchParts(wctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.GetType()).To(Equal(inventorypbv1.WatchEventType_WATCH_EVENT_TYPE_UPSERTED))
+			Expect(msg.GetPart().GetArchivedAt()).To(BeNil())
+		})
+
+		It("returns InvalidArgument for a malformed resume token", func() {
+			stream, err := invClient.WatchParts(ctx, &inventorypbv1.WatchPartsRequest{ResumeToken: "not a token"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("ValidateConfiguration", func() {
+		It("reports the broken compatibility rules of a ship build", func() {
+			engine, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:     "Engine " + gofakeit.UUID(),
+					Category: inventorypbv1.Category_CATEGORY_ENGINE,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			engineID := engine.GetPart().GetUuid()
+
+			fuel, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:     "Fuel " + gofakeit.UUID(),
+					Category: inventorypbv1.Category_CATEGORY_FUEL,
+					Compatibility: []*inventorypbv1.CompatibilityRule{{
+						Kind: inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_REQUIRES,
+						Target: &inventorypbv1.CompatibilityTarget{
+							Target: &inventorypbv1.CompatibilityTarget_Category{Category: inventorypbv1.Category_CATEGORY_ENGINE},
+						},
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			fuelID := fuel.GetPart().GetUuid()
+			Expect(fuel.GetPart().GetCompatibility()).To(HaveLen(1))
+
+			By("validating the fuel cell alone")
+			resp, err := invClient.ValidateConfiguration(ctx, &inventorypbv1.ValidateConfigurationRequest{
+				PartUuids: []string{fuelID},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetValid()).To(BeFalse())
+			Expect(resp.GetViolations()).To(HaveLen(1))
+			Expect(resp.GetViolations()[0].GetPartUuid()).To(Equal(fuelID))
+			Expect(resp.GetViolations()[0].GetKind()).To(Equal(inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_REQUIRES))
+
+			By("validating the fuel cell with an engine")
+			resp, err = invClient.ValidateConfiguration(ctx, &inventorypbv1.ValidateConfigurationRequest{
+				PartUuids: []string{fuelID, engineID},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetValid()).To(BeTrue())
+			Expect(resp.GetViolations()).To(BeEmpty())
+		})
+
+		It("returns NotFound for an unknown part", func() {
+			_, err := invClient.ValidateConfiguration(ctx, &inventorypbv1.ValidateConfigurationRequest{
+				PartUuids: []string{gofakeit.UUID()},
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Context("mutual TLS", func() {
+		check := func(creds credentials.TransportCredentials) error {
+			conn := mustDialGRPC(grpcAddr, creds)
+			defer conn.Close()
+
+			_, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+			return err
+		}
+
+		It("rejects a client without a certificate", func() {
+			err := check(credentials.NewTLS(clientTLS("")))
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		It("rejects a plaintext client", func() {
+			err := check(insecure.NewCredentials())
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+	})
+
+	Context("PartChanged events", func() {
+		It("publishes the part before and after an update", func() {
+			created, err := invClient.CreatePart(ctx, &inventorypbv1.CreatePartRequest{
+				Part: &inventorypbv1.PartInfo{
+					Name:          gofakeit.ProductName(),
+					PriceCents:    1000,
+					StockQuantity: 5,
+					Category:      inventorypbv1.Category_CATEGORY_WING,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			partID := created.GetPart().GetUuid()
+
+			_, err = invClient.UpdatePart(ctx, &inventorypbv1.UpdatePartRequest{
+				Uuid:            partID,
+				Part:            &inventorypbv1.PartInfo{StockQuantity: 2},
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"stock_quantity"}},
+				ExpectedVersion: created.GetPart().GetVersion(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var events []*inventorypbv1.PartChangedRecord
+			Eventually(func(g Gomega) {
+				events, err = readPartEvents(kafkaBrokers, partID)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(events).To(HaveLen(2))
+			}).WithTimeout(15 * time.Second).WithPolling(500 * time.Millisecond).Should(Succeed())
+
+			Expect(events[0].GetType()).To(Equal(inventorypbv1.PartChangeType_PART_CHANGE_TYPE_CREATED))
+			Expect(events[0].GetBefore()).To(BeNil())
+			Expect(events[0].GetAfter().GetStockQuantity()).To(Equal(int64(5)))
+
+			Expect(events[1].GetType()).To(Equal(inventorypbv1.PartChangeType_PART_CHANGE_TYPE_STOCK_CHANGED))
+			Expect(events[1].GetBefore().GetStockQuantity()).To(Equal(int64(5)))
+			Expect(events[1].GetAfter().GetStockQuantity()).To(Equal(int64(2)))
+
+			By("removing published changes from the outbox")
+			Eventually(func(g Gomega) {
+				n, err := partsColl.CountDocuments(ctx, bson.M{"_id": partID, "outbox.id": bson.M{"$exists": true}})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(n).To(BeZero())
+			}).WithTimeout(5 * time.Second).WithPolling(200 * time.Millisecond).Should(Succeed())
+		})
+	})
 })
+
+func createTopics(brokers []string, topics ...string) error {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V4_0_0_0
+	cfg.Admin.Timeout = 10 * time.Second
+
+	admin, err := sarama.NewClusterAdmin(brokers, cfg)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	for _, t := range topics {
+		err := admin.CreateTopic(t, &sarama.TopicDetail{
+			NumPartitions:     1,
+			ReplicationFactor: 1,
+		}, false)
+		if err != nil && !errors.Is(err, sarama.ErrTopicAlreadyExists) {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPartEvents returns the events of the part published so far.
+func readPartEvents(brokers []string, partID string) ([]*inventorypbv1.PartChangedRecord, error) {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V4_0_0_0
+
+	client, err := sarama.NewClient(brokers, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	newest, err := client.GetOffset(topicParts, 0, sarama.OffsetNewest)
+	if err != nil {
+		return nil, err
+	}
+	if newest == 0 {
+		return nil, nil
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+	defer consumer.Close()
+
+	pc, err := consumer.ConsumePartition(topicParts, 0, sarama.OffsetOldest)
+	if err != nil {
+		return nil, err
+	}
+	defer pc.Close()
+
+	var out []*inventorypbv1.PartChangedRecord
+	for msg := range pc.Messages() {
+		if string(msg.Key) == partID {
+			var rec inventorypbv1.PartChangedRecord
+			if err := proto.Unmarshal(msg.Value, &rec); err != nil {
+				return nil, err
+			}
+			out = append(out, &rec)
+		}
+		if msg.Offset >= newest-1 {
+			break
+		}
+	}
+	return out, nil
+}
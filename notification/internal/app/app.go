@@ -2,19 +2,22 @@ package app
 
 import (
 	"context"
-	"time"
+	"errors"
+	"net/http"
 
-	"github.com/go-telegram/bot"
-	"github.com/go-telegram/bot/models"
+	"github.com/go-chi/chi/v5/middleware"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/you-humble/rocket-maintenance/notification/internal/config"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
 )
 
 type app struct {
-	di *di
+	di     *di
+	server *http.Server
 }
 
 func New(ctx context.Context) (*app, error) {
@@ -35,6 +38,9 @@ func (a *app) init(ctx context.Context) error {
 		a.initLogger,
 		a.initCloser,
 		a.initDI,
+		a.initTemplates,
+		a.initTables,
+		a.initServer,
 		a.initTelegramBot,
 	}
 
@@ -67,42 +73,54 @@ func (a *app) initDI(_ context.Context) error {
 	return nil
 }
 
-func (a *app) initTelegramBot(ctx context.Context) error {
-	const startMsg = `
-	👋 **Привет! Я бот уведомлений AstraDock.**
-	
-	Я присылаю важные события по твоим заказам:
-	🚀 сборка корабля завершена  
-	💳 заказ успешно оплачен  
-	
-	Чтобы начать, просто оформи заказ в сервисе — а дальше я буду держать тебя в курсе.  
-	Если уведомления приходят не туда — проверь, что ты вошёл под нужным аккаунтом.
-	`
+// initTemplates loads notification templates up front,
+// so a broken one stops the service before it consumes anything.
+func (a *app) initTemplates(ctx context.Context) error {
+	a.di.Renderer(ctx)
+	return nil
+}
+
+func (a *app) initTables(ctx context.Context) error {
+	if err := a.di.Migrator(ctx).Up(); err != nil {
+		logger.Error(ctx, "failed to apply migrations", logger.ErrorF(err))
+		return err
+	}
+	return nil
+}
+
+func (a *app) initServer(ctx context.Context) error {
+	cfg := config.C()
+
+	webhookServer, err := notificationv1.NewServer(
+		a.di.WebhookHandler(ctx),
+		a.di.SecurityHandler(ctx),
+	)
+	if err != nil {
+		logger.Error(ctx, "failed to create a new server", logger.ErrorF(err))
+		return err
+	}
+
+	r := a.di.Router(ctx)
+	r.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+	)
+	r.Mount("/", webhookServer)
+
+	r.Get(health.LivezPath, a.di.Health(ctx).LivezHandler())
+	r.Get(health.ReadyzPath, a.di.Health(ctx).ReadyzHandler())
+
+	a.server = &http.Server{
+		Addr:              cfg.Server.Address(),
+		Handler:           r,
+		ReadHeaderTimeout: cfg.Server.ReadTimeout(),
+	}
+	return nil
+}
 
+func (a *app) initTelegramBot(ctx context.Context) error {
 	telegramBot := a.di.TelegramBot(ctx)
-	tgSvc := a.di.TelegramService(ctx)
-
-	telegramBot.RegisterHandler(
-		bot.HandlerTypeMessageText,
-		"/start",
-		bot.MatchTypeExact,
-		func(ctx context.Context, b *bot.Bot, update *models.Update) {
-			logger.Info(ctx, "New user",
-				logger.String("username", update.Message.From.Username),
-				logger.Int64("chat_id", update.Message.Chat.ID),
-			)
-
-			_, err := b.SendMessage(ctx, &bot.SendMessageParams{
-				ChatID:    update.Message.Chat.ID,
-				Text:      startMsg,
-				ParseMode: models.ParseModeMarkdownV1,
-			})
-			if err != nil {
-				logger.Error(ctx, "Failed to send activation message", logger.ErrorF(err))
-			}
-
-			tgSvc.AddChatID(ctx, update.Message.Chat.ID)
-		})
+	a.di.TelegramHandler(ctx).Register(telegramBot)
 
 	go func() {
 		logger.Info(ctx, "🤖 Telegram bot started...")
@@ -133,6 +151,53 @@ func (a *app) run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		logger.Info(egCtx, "🚀 order.cancelled consumer running")
+		if err := a.di.OrderCancelledConsumer(egCtx).RunOrderCancelledConsume(egCtx); err != nil {
+			return err
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		logger.Info(egCtx, "🚀 webhook dispatcher running")
+		return a.di.WebhookService(egCtx).RunDispatcher(egCtx)
+	})
+
+	eg.Go(func() error {
+		logger.Info(egCtx,
+			"🚀 webhook admin server listening",
+			logger.String("address", config.C().Server.Address()),
+		)
+		err := a.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		<-egCtx.Done()
+		a.di.Health(egCtx).Shutdown()
+
+		shutdownCtx, cancel := context.WithTimeout(
+			context.WithoutCancel(egCtx),
+			config.C().Server.ShutdownTimeout(),
+		)
+		defer cancel()
+
+		return a.server.Shutdown(shutdownCtx)
+	})
+
+	if dir := config.C().Templates.Dir(); dir != "" {
+		eg.Go(func() error {
+			logger.Info(egCtx, "🚀 notification templates watcher running", logger.String("dir", dir))
+			a.di.Renderer(egCtx).Watch(egCtx, config.C().Templates.ReloadInterval())
+			return nil
+		})
+	}
+
 	if err := eg.Wait(); err != nil {
 		return err
 	}
@@ -143,7 +208,7 @@ func (a *app) run(ctx context.Context) error {
 func gracefulShutdown() {
 	ctx, cancel := context.WithTimeout(
 		context.Background(), // do not inherit cancellation from ctx
-		10*time.Second,
+		config.C().Server.ShutdownTimeout(),
 	)
 	defer cancel()
 
@@ -3,27 +3,88 @@ package app
 import (
 	"context"
 	"fmt"
+	"net/mail"
+	"net/smtp"
+	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-telegram/bot"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
 
+	iamclient "github.com/you-humble/rocket-maintenance/notification/internal/client/grpc/iam/v1"
+	orderclient "github.com/you-humble/rocket-maintenance/notification/internal/client/http/order"
 	tgclient "github.com/you-humble/rocket-maintenance/notification/internal/client/http/telegram"
+	webhookclient "github.com/you-humble/rocket-maintenance/notification/internal/client/http/webhook"
+	smtpclient "github.com/you-humble/rocket-maintenance/notification/internal/client/smtp"
 	"github.com/you-humble/rocket-maintenance/notification/internal/config"
 	converter "github.com/you-humble/rocket-maintenance/notification/internal/converter/kafka"
+	msgconverter "github.com/you-humble/rocket-maintenance/notification/internal/converter/message"
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	deliveryrepo "github.com/you-humble/rocket-maintenance/notification/internal/repository/delivery"
+	preferencerepo "github.com/you-humble/rocket-maintenance/notification/internal/repository/preference"
+	sessionrepo "github.com/you-humble/rocket-maintenance/notification/internal/repository/session"
+	webhookrepo "github.com/you-humble/rocket-maintenance/notification/internal/repository/webhook"
 	oaconsumer "github.com/you-humble/rocket-maintenance/notification/internal/service/consumer/order_assembled"
+	occonsumer "github.com/you-humble/rocket-maintenance/notification/internal/service/consumer/order_cancelled"
 	opconsumer "github.com/you-humble/rocket-maintenance/notification/internal/service/consumer/order_paid"
-	service "github.com/you-humble/rocket-maintenance/notification/internal/service/telegram"
+	notificationsvc "github.com/you-humble/rocket-maintenance/notification/internal/service/notification"
+	ordersvc "github.com/you-humble/rocket-maintenance/notification/internal/service/order"
+	preferencesvc "github.com/you-humble/rocket-maintenance/notification/internal/service/preference"
+	webhooksvc "github.com/you-humble/rocket-maintenance/notification/internal/service/webhook"
+	thttp "github.com/you-humble/rocket-maintenance/notification/internal/transport/http/webhook/v1"
+	tghandler "github.com/you-humble/rocket-maintenance/notification/internal/transport/telegram"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	"github.com/you-humble/rocket-maintenance/platform/db/migrator"
+	grpcclient "github.com/you-humble/rocket-maintenance/platform/grpc/client"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	"github.com/you-humble/rocket-maintenance/platform/kafka"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/consumer"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/middleware"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
 )
 
-type TelegramService interface {
+type NotificationService interface {
 	oaconsumer.ShipAssembledNotifier
 	opconsumer.OrderPaidNotifier
-	AddChatID(ctx context.Context, chatID int64)
+}
+
+type WebhookService interface {
+	thttp.WebhookService
+	oaconsumer.ShipAssembledPublisher
+	opconsumer.OrderPaidPublisher
+	occonsumer.OrderCancelledPublisher
+	RunDispatcher(ctx context.Context) error
+}
+
+type PreferenceRepository interface {
+	notificationsvc.RecipientRepository
+	preferencesvc.PreferenceRepository
+	ordersvc.UserResolver
+}
+
+type SessionRepository interface {
+	ordersvc.SessionRepository
+	preferencesvc.SessionSaver
+}
+
+type IAMClient interface {
+	preferencesvc.LinkTokenRedeemer
+	ordersvc.SessionRefresher
+}
+
+type Renderer interface {
+	notificationsvc.Renderer
+	Watch(ctx context.Context, interval time.Duration)
+}
+
+type TelegramHandler interface {
+	Register(b *bot.Bot)
 }
 
 type OrderPaidConsumer interface {
@@ -34,9 +95,14 @@ type OrderAssembledConsumer interface {
 	RunOrderAssembledConsume(ctx context.Context) error
 }
 
+type OrderCancelledConsumer interface {
+	RunOrderCancelledConsume(ctx context.Context) error
+}
+
 type Converter interface {
 	opconsumer.PaidOrderConverter
 	oaconsumer.AssembledShipConverter
+	occonsumer.CancelledOrderConverter
 }
 
 type di struct {
@@ -50,9 +116,36 @@ type di struct {
 	orderAseembledKafkaConsumer kafka.Consumer
 	orderAseembledConsumer      OrderAssembledConsumer
 
+	orderCancelledConsumerGroup sarama.ConsumerGroup
+	orderCancelledKafkaConsumer kafka.Consumer
+	orderCancelledConsumer      OrderCancelledConsumer
+
+	dbPool   *pgxpool.Pool
+	migrator *migrator.Migrator
+
 	tgBot     *bot.Bot
-	tgClient  service.MessageSender
-	tgService TelegramService
+	tgHandler TelegramHandler
+	channels  map[model.Channel]notificationsvc.Channel
+	renderer  Renderer
+
+	iamConn   *grpc.ClientConn
+	iamClient IAMClient
+
+	preferenceRepo      PreferenceRepository
+	sessionRepo         SessionRepository
+	preferenceService   tghandler.PreferenceService
+	orderClient         ordersvc.OrderClient
+	orderService        tghandler.OrderService
+	deliveryLog         notificationsvc.DeliveryLog
+	notificationService NotificationService
+
+	webhookRepo    webhooksvc.WebhookRepository
+	webhookService WebhookService
+	webhookHandler notificationv1.Handler
+	security       notificationv1.SecurityHandler
+
+	health *health.Health
+	router *chi.Mux
 }
 
 func NewDI() *di { return &di{} }
@@ -108,7 +201,8 @@ func (d *di) OrderPaidConsumer(ctx context.Context) OrderPaidConsumer {
 		d.orderPaidConsumer = opconsumer.NewOrderPaidConsumer(
 			d.OrderPaidKafkaConsumer(ctx),
 			d.KafkaConverter(ctx),
-			d.TelegramService(ctx),
+			d.NotificationService(ctx),
+			d.WebhookService(ctx),
 		)
 	}
 
@@ -158,13 +252,103 @@ func (d *di) OrderAssembledConsumer(ctx context.Context) OrderAssembledConsumer
 		d.orderAseembledConsumer = oaconsumer.NewOrderAssembledConsumer(
 			d.OrderAssembledKafkaConsumer(ctx),
 			d.KafkaConverter(ctx),
-			d.TelegramService(ctx),
+			d.NotificationService(ctx),
+			d.WebhookService(ctx),
 		)
 	}
 
 	return d.orderAseembledConsumer
 }
 
+func (d *di) OrderCancelledConsumerGroup(ctx context.Context) sarama.ConsumerGroup {
+	if d.orderCancelledConsumerGroup == nil {
+		cfg := config.C()
+
+		consumerGroup, err := sarama.NewConsumerGroup(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.OrderCancelledConsumerGroupID(),
+			cfg.Kafka.OrderCancelledConsumerConfig(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order.cancelled consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order.cancelled consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.orderCancelledConsumerGroup = consumerGroup
+	}
+
+	return d.orderCancelledConsumerGroup
+}
+
+func (d *di) OrderCancelledKafkaConsumer(ctx context.Context) kafka.Consumer {
+	if d.orderCancelledKafkaConsumer == nil {
+		d.orderCancelledKafkaConsumer = consumer.NewConsumer(
+			d.OrderCancelledConsumerGroup(ctx),
+			[]string{
+				config.C().Kafka.OrderCancelledTopic(),
+			},
+			logger.L(),
+			middleware.Recovery(logger.L()),
+			middleware.Logging(logger.L()),
+		)
+	}
+
+	return d.orderCancelledKafkaConsumer
+}
+
+func (d *di) OrderCancelledConsumer(ctx context.Context) OrderCancelledConsumer {
+	if d.orderCancelledConsumer == nil {
+		d.orderCancelledConsumer = occonsumer.NewOrderCancelledConsumer(
+			d.OrderCancelledKafkaConsumer(ctx),
+			d.KafkaConverter(ctx),
+			d.WebhookService(ctx),
+		)
+	}
+
+	return d.orderCancelledConsumer
+}
+
+func (d *di) DBPool(ctx context.Context) *pgxpool.Pool {
+	if d.dbPool == nil {
+		pool, err := pgxpool.New(ctx, config.C().Postgres.DSN())
+		if err != nil {
+			panic(fmt.Sprintf("failed to create pg pool: %v\n", err))
+		}
+
+		closer.AddNamed("PGX Pool",
+			func(ctx context.Context) error {
+				pool.Close()
+				return nil
+			})
+
+		if err := pool.Ping(ctx); err != nil {
+			panic(fmt.Sprintf("failed to ping db: %v\n", err))
+		}
+
+		d.dbPool = pool
+	}
+
+	return d.dbPool
+}
+
+func (d *di) Migrator(ctx context.Context) *migrator.Migrator {
+	if d.migrator == nil {
+		d.migrator = migrator.NewMigrator(
+			stdlib.OpenDBFromPool(d.DBPool(ctx)),
+			config.C().Postgres.MigrationDirectory(),
+		)
+
+		closer.AddNamed("Migrator",
+			func(ctx context.Context) error {
+				return d.migrator.Close()
+			})
+	}
+
+	return d.migrator
+}
+
 func (d *di) TelegramBot(ctx context.Context) *bot.Bot {
 	if d.tgBot == nil {
 		b, err := bot.New(config.C().Telegram.BotToken())
@@ -182,20 +366,247 @@ func (d *di) TelegramBot(ctx context.Context) *bot.Bot {
 	return d.tgBot
 }
 
-func (d *di) TelegramClient(ctx context.Context) service.MessageSender {
-	if d.tgClient == nil {
-		d.tgClient = tgclient.NewClient(d.TelegramBot(ctx))
+func (d *di) TelegramHandler(ctx context.Context) TelegramHandler {
+	if d.tgHandler == nil {
+		d.tgHandler = tghandler.NewHandler(d.PreferenceService(ctx), d.OrderService(ctx))
+	}
+
+	return d.tgHandler
+}
+
+func (d *di) Channels(ctx context.Context) map[model.Channel]notificationsvc.Channel {
+	if d.channels == nil {
+		tgCfg := config.C().Telegram
+		emailCfg := config.C().Email
+
+		from, err := mail.ParseAddress(emailCfg.From())
+		if err != nil {
+			panic(fmt.Sprintf("failed to parse SMTP sender address: %s\n", err.Error()))
+		}
+
+		var auth smtp.Auth
+		if emailCfg.Username() != "" {
+			auth = smtp.PlainAuth("", emailCfg.Username(), emailCfg.Password(), emailCfg.Host())
+		}
+
+		d.channels = map[model.Channel]notificationsvc.Channel{
+			model.ChannelTelegram: {
+				Sender:  tgclient.NewClient(d.TelegramBot(ctx)),
+				Limiter: rate.NewLimiter(rate.Limit(tgCfg.SendRatePerSecond()), tgCfg.SendBurst()),
+			},
+			model.ChannelEmail: {
+				Sender:  smtpclient.NewClient(emailCfg.Address(), from, auth, emailCfg.Timeout()),
+				Limiter: rate.NewLimiter(rate.Limit(emailCfg.SendRatePerSecond()), emailCfg.SendBurst()),
+			},
+		}
+	}
+
+	return d.channels
+}
+
+func (d *di) Renderer(ctx context.Context) Renderer {
+	if d.renderer == nil {
+		cfg := config.C().Templates
+
+		r, err := msgconverter.NewRenderer(cfg.Dir(), model.Locale(cfg.DefaultLocale()))
+		if err != nil {
+			panic(fmt.Sprintf("failed to load notification templates: %s\n", err.Error()))
+		}
+
+		d.renderer = r
+	}
+
+	return d.renderer
+}
+
+func (d *di) IAMConn(ctx context.Context) *grpc.ClientConn {
+	if d.iamConn == nil {
+		cfg := config.C().IAM
+
+		// RedeemLinkToken and RefreshSession are not idempotent, so the
+		// calls are not retried.
+		conn, err := grpcclient.New(cfg.Address()).
+			WithTimeout(cfg.Timeout()).
+			WithTLS(cfg.TLS()).
+			Build()
+		if err != nil {
+			panic(fmt.Sprintf("failed to connect to IAM Service %s: %v", cfg.Address(), err))
+		}
+		closer.AddNamed("IAM Service", func(ctx context.Context) error {
+			return conn.Close()
+		})
+
+		d.iamConn = conn
+	}
+
+	return d.iamConn
+}
+
+func (d *di) IAMClient(ctx context.Context) IAMClient {
+	if d.iamClient == nil {
+		d.iamClient = iamclient.NewClient(iampbv1.NewIAMServiceClient(d.IAMConn(ctx)))
+	}
+
+	return d.iamClient
+}
+
+func (d *di) PreferenceRepository(ctx context.Context) PreferenceRepository {
+	if d.preferenceRepo == nil {
+		d.preferenceRepo = preferencerepo.NewPreferenceRepository(d.DBPool(ctx))
+	}
+
+	return d.preferenceRepo
+}
+
+func (d *di) SessionRepository(ctx context.Context) SessionRepository {
+	if d.sessionRepo == nil {
+		d.sessionRepo = sessionrepo.NewSessionRepository(d.DBPool(ctx))
+	}
+
+	return d.sessionRepo
+}
+
+func (d *di) PreferenceService(ctx context.Context) tghandler.PreferenceService {
+	if d.preferenceService == nil {
+		d.preferenceService = preferencesvc.NewPreferenceService(
+			d.PreferenceRepository(ctx),
+			d.SessionRepository(ctx),
+			d.IAMClient(ctx),
+		)
 	}
 
-	return d.tgClient
+	return d.preferenceService
 }
 
-func (d *di) TelegramService(ctx context.Context) TelegramService {
-	if d.tgService == nil {
-		d.tgService = service.NewTgService(
-			d.TelegramClient(ctx),
+func (d *di) OrderClient(ctx context.Context) ordersvc.OrderClient {
+	if d.orderClient == nil {
+		cfg := config.C().Order
+
+		c, err := orderclient.NewClient(cfg.URL(), cfg.Timeout())
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order client: %s\n", err.Error()))
+		}
+
+		d.orderClient = c
+	}
+
+	return d.orderClient
+}
+
+func (d *di) OrderService(ctx context.Context) tghandler.OrderService {
+	if d.orderService == nil {
+		d.orderService = ordersvc.NewOrderService(
+			d.OrderClient(ctx),
+			d.PreferenceRepository(ctx),
+			d.SessionRepository(ctx),
+			d.IAMClient(ctx),
+			config.C().Order.ListLimit(),
 		)
 	}
 
-	return d.tgService
+	return d.orderService
+}
+
+func (d *di) DeliveryLog(ctx context.Context) notificationsvc.DeliveryLog {
+	if d.deliveryLog == nil {
+		d.deliveryLog = deliveryrepo.NewDeliveryRepository(
+			d.DBPool(ctx),
+			config.C().Delivery.LogRetention(),
+		)
+	}
+
+	return d.deliveryLog
+}
+
+func (d *di) NotificationService(ctx context.Context) NotificationService {
+	if d.notificationService == nil {
+		cfg := config.C().Delivery
+
+		d.notificationService = notificationsvc.NewNotificationService(
+			d.Channels(ctx),
+			d.Renderer(ctx),
+			d.PreferenceRepository(ctx),
+			d.DeliveryLog(ctx),
+			model.DeliveryPolicy{
+				MaxAttempts: cfg.MaxAttempts(),
+				BaseDelay:   cfg.RetryBaseDelay(),
+				MaxDelay:    cfg.RetryMaxDelay(),
+				Concurrency: cfg.Workers(),
+			},
+		)
+	}
+
+	return d.notificationService
+}
+
+func (d *di) WebhookRepository(ctx context.Context) webhooksvc.WebhookRepository {
+	if d.webhookRepo == nil {
+		d.webhookRepo = webhookrepo.NewWebhookRepository(d.DBPool(ctx))
+	}
+
+	return d.webhookRepo
+}
+
+func (d *di) WebhookService(ctx context.Context) WebhookService {
+	if d.webhookService == nil {
+		cfg := config.C().Webhook
+
+		d.webhookService = webhooksvc.NewWebhookService(
+			d.WebhookRepository(ctx),
+			webhookclient.NewClient(cfg.Timeout()),
+			model.WebhookPolicy{
+				MaxAttempts:  cfg.MaxAttempts(),
+				BaseDelay:    cfg.RetryBaseDelay(),
+				MaxDelay:     cfg.RetryMaxDelay(),
+				DisableAfter: cfg.DisableAfter(),
+				PollInterval: cfg.PollInterval(),
+				BatchSize:    cfg.BatchSize(),
+				// Another dispatcher may retry a claimed delivery only
+				// once its request has surely timed out.
+				Lease: 2 * cfg.Timeout(),
+			},
+		)
+	}
+
+	return d.webhookService
+}
+
+func (d *di) WebhookHandler(ctx context.Context) notificationv1.Handler {
+	if d.webhookHandler == nil {
+		d.webhookHandler = thttp.NewWebhookHandler(d.WebhookService(ctx))
+	}
+
+	return d.webhookHandler
+}
+
+func (d *di) SecurityHandler(_ context.Context) notificationv1.SecurityHandler {
+	if d.security == nil {
+		d.security = thttp.NewSecurityHandler(config.C().Webhook.AdminToken())
+	}
+
+	return d.security
+}
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		cfg := config.C()
+
+		d.health = health.New()
+		d.health.Register("postgres", health.Ping(d.DBPool(ctx)))
+		d.health.Register("iam", health.GRPC(d.IAMConn(ctx), ""))
+		d.health.Register("kafka", health.Kafka(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.OrderPaidConsumerConfig(),
+		))
+	}
+
+	return d.health
+}
+
+func (d *di) Router(_ context.Context) *chi.Mux {
+	if d.router == nil {
+		d.router = chi.NewRouter()
+	}
+
+	return d.router
 }
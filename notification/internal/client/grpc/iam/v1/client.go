@@ -0,0 +1,64 @@
+package iamclient
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	converter "github.com/you-humble/rocket-maintenance/notification/internal/converter/iam"
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+type client struct {
+	grpc iampbv1.IAMServiceClient
+}
+
+func NewClient(grpc iampbv1.IAMServiceClient) *client {
+	return &client{grpc: grpc}
+}
+
+// RedeemLinkToken exchanges a link token issued to a signed-in user for a
+// session of that user. A token that IAM rejects gives
+// model.ErrInvalidLinkToken.
+func (c *client) RedeemLinkToken(ctx context.Context, token string) (model.UserSession, error) {
+	const op = "iamclient.RedeemLinkToken"
+
+	res, err := c.grpc.RedeemLinkToken(ctx, &iampbv1.RedeemLinkTokenRequest{LinkToken: token})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return model.UserSession{}, fmt.Errorf("%s: %w", op, model.ErrInvalidLinkToken)
+		}
+		return model.UserSession{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sess, err := converter.SessionTokensToModel(res.GetTokens())
+	if err != nil {
+		return model.UserSession{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sess, nil
+}
+
+// RefreshSession exchanges a refresh token for new tokens of its session.
+// A token that IAM rejects gives model.ErrUnauthorized.
+func (c *client) RefreshSession(ctx context.Context, refreshToken string) (model.UserSession, error) {
+	const op = "iamclient.RefreshSession"
+
+	res, err := c.grpc.RefreshSession(ctx, &iampbv1.RefreshSessionRequest{RefreshToken: refreshToken})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return model.UserSession{}, fmt.Errorf("%s: %w", op, model.ErrUnauthorized)
+		}
+		return model.UserSession{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sess, err := converter.SessionTokensToModel(res.GetTokens())
+	if err != nil {
+		return model.UserSession{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sess, nil
+}
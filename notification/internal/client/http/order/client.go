@@ -0,0 +1,151 @@
+package orderclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+)
+
+type client struct {
+	api *orderv1.Client
+}
+
+// NewClient returns a client of the order API at baseURL. Every call is made
+// with the session token of the user it is made for, so the order service
+// checks that the orders are theirs.
+func NewClient(baseURL string, timeout time.Duration) (*client, error) {
+	api, err := orderv1.NewClient(
+		baseURL,
+		securitySource{},
+		orderv1.WithClient(&http.Client{Timeout: timeout}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("order client: %w", err)
+	}
+
+	return &client{api: api}, nil
+}
+
+// Orders returns at most limit orders of the token's user, newest first.
+func (c *client) Orders(ctx context.Context, token string, limit int) ([]model.Order, error) {
+	const op = "orderclient.Orders"
+
+	res, err := c.api.ListOrders(withToken(ctx, token), orderv1.ListOrdersParams{
+		Limit: orderv1.NewOptInt32(int32(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch r := res.(type) {
+	case *orderv1.ListOrdersResponse:
+		orders := make([]model.Order, len(r.Orders))
+		for i := range r.Orders {
+			orders[i] = orderToModel(&r.Orders[i])
+		}
+		return orders, nil
+	case *orderv1.UnauthorizedError:
+		return nil, fmt.Errorf("%s: %w", op, model.ErrUnauthorized)
+	default:
+		return nil, fmt.Errorf("%s: %w", op, unexpected(res))
+	}
+}
+
+// Order returns an order of the token's user. Orders of other users are
+// reported as not found.
+func (c *client) Order(ctx context.Context, token string, orderID uuid.UUID) (model.Order, error) {
+	const op = "orderclient.Order"
+
+	res, err := c.api.GetOrderByUUID(withToken(ctx, token), orderv1.GetOrderByUUIDParams{OrderUUID: orderID})
+	if err != nil {
+		return model.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch r := res.(type) {
+	case *orderv1.Order:
+		return orderToModel(r), nil
+	case *orderv1.NotFoundError, *orderv1.ForbiddenError:
+		return model.Order{}, fmt.Errorf("%s: %w", op, model.ErrOrderNotFound)
+	case *orderv1.UnauthorizedError:
+		return model.Order{}, fmt.Errorf("%s: %w", op, model.ErrUnauthorized)
+	default:
+		return model.Order{}, fmt.Errorf("%s: %w", op, unexpected(res))
+	}
+}
+
+// Cancel cancels an order of the token's user that awaits payment.
+func (c *client) Cancel(ctx context.Context, token string, orderID uuid.UUID) error {
+	const op = "orderclient.Cancel"
+
+	res, err := c.api.CancelOrder(withToken(ctx, token), orderv1.CancelOrderParams{OrderUUID: orderID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch res.(type) {
+	case *orderv1.CancelOrderNoContent:
+		return nil
+	case *orderv1.NotFoundError, *orderv1.ForbiddenError:
+		return fmt.Errorf("%s: %w", op, model.ErrOrderNotFound)
+	case *orderv1.ConflictError:
+		return fmt.Errorf("%s: %w", op, model.ErrOrderNotCancellable)
+	case *orderv1.UnauthorizedError:
+		return fmt.Errorf("%s: %w", op, model.ErrUnauthorized)
+	default:
+		return fmt.Errorf("%s: %w", op, unexpected(res))
+	}
+}
+
+type tokenKey struct{}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// securitySource sends the session token of the call as a bearer token.
+type securitySource struct{}
+
+func (securitySource) BearerAuth(ctx context.Context, _ orderv1.OperationName) (orderv1.BearerAuth, error) {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	return orderv1.BearerAuth{Token: token}, nil
+}
+
+func (securitySource) SessionCookie(context.Context, orderv1.OperationName) (orderv1.SessionCookie, error) {
+	return orderv1.SessionCookie{}, ogenerrors.ErrSkipClientSecurity
+}
+
+func orderToModel(o *orderv1.Order) model.Order {
+	ord := model.Order{
+		ID:         o.OrderUUID,
+		UserID:     o.UserUUID,
+		Status:     model.OrderStatus(o.Status),
+		TotalPrice: o.TotalPrice,
+		CreatedAt:  o.CreatedAt.Or(time.Time{}),
+	}
+	if pm, ok := o.PaymentMethod.Get(); ok {
+		ord.PaymentMethod = string(pm)
+	}
+
+	return ord
+}
+
+// errorResponse is implemented by every error schema of the order API.
+type errorResponse interface {
+	GetCode() orderv1.OptInt32
+	GetMessage() orderv1.OptString
+}
+
+func unexpected(res any) error {
+	if e, ok := res.(errorResponse); ok {
+		return fmt.Errorf("order service responded %d: %s", e.GetCode().Or(0), e.GetMessage().Or(""))
+	}
+
+	return fmt.Errorf("unexpected order service response %T", res)
+}
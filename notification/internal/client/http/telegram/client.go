@@ -2,9 +2,15 @@ package tgclient
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-telegram/bot"
 	"github.com/go-telegram/bot/models"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
 )
 
 type client struct {
@@ -15,14 +21,38 @@ func NewClient(bot *bot.Bot) *client {
 	return &client{bot: bot}
 }
 
-func (c *client) SendMessage(ctx context.Context, chatID int64, text string) error {
+func (c *client) Send(ctx context.Context, address string, msg model.Message) error {
+	chatID, err := strconv.ParseInt(address, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: chat id %q: %w", model.ErrPermanentDelivery, address, err)
+	}
+
 	if _, err := c.bot.SendMessage(ctx, &bot.SendMessageParams{
 		ChatID:    chatID,
-		Text:      text,
+		Text:      msg.Markdown,
 		ParseMode: models.ParseModeMarkdownV1,
 	}); err != nil {
-		return err
+		return mapError(err)
 	}
 
 	return nil
 }
+
+// mapError translates Telegram API errors into delivery semantics:
+// 429 carries retry_after, 400/403/404 will never succeed for this chat.
+func mapError(err error) error {
+	var tooMany *bot.TooManyRequestsError
+	switch {
+	case errors.As(err, &tooMany):
+		return &model.RetryAfterError{
+			After: time.Duration(tooMany.RetryAfter) * time.Second,
+			Err:   err,
+		}
+	case errors.Is(err, bot.ErrorForbidden),
+		errors.Is(err, bot.ErrorBadRequest),
+		errors.Is(err, bot.ErrorNotFound):
+		return fmt.Errorf("%w: %w", model.ErrPermanentDelivery, err)
+	default:
+		return err
+	}
+}
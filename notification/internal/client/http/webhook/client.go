@@ -0,0 +1,84 @@
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+const (
+	headerID        = "X-Webhook-Id"
+	headerEvent     = "X-Webhook-Event"
+	headerTimestamp = "X-Webhook-Timestamp"
+	headerSignature = "X-Webhook-Signature"
+
+	userAgent = "rocket-maintenance-webhooks/1.0"
+)
+
+type client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *client {
+	return &client{
+		http: &http.Client{
+			Timeout: timeout,
+			// Subscribers must point at the final URL: following redirects
+			// would send signed payloads to endpoints nobody registered.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send POSTs the signed payload and returns the response status code.
+// Any non-2xx response is an error; the code is still returned so it can be
+// kept in the delivery history.
+func (c *client) Send(ctx context.Context, req model.WebhookRequest) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(headerID, req.DeliveryID.String())
+	httpReq.Header.Set(headerEvent, string(req.EventType))
+	httpReq.Header.Set(headerTimestamp, timestamp)
+	httpReq.Header.Set(headerSignature, "sha256="+sign(req.Secret, timestamp, req.Payload))
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded part of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// sign computes hex(HMAC-SHA256(secret, "<timestamp>.<body>")).
+// Binding the timestamp lets receivers reject replayed requests.
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
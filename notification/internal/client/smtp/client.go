@@ -0,0 +1,178 @@
+package smtpclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+type client struct {
+	addr    string
+	from    *mail.Address
+	auth    smtp.Auth
+	timeout time.Duration
+	// rootCAs verify the server certificate on STARTTLS; nil trusts the
+	// roots of the system.
+	rootCAs *x509.CertPool
+}
+
+// NewClient creates an email channel talking to the SMTP server at addr.
+// auth may be nil for servers that accept anonymous submission
+// (e.g. a local stand-in like Mailpit).
+func NewClient(addr string, from *mail.Address, auth smtp.Auth, timeout time.Duration) *client {
+	return &client{
+		addr:    addr,
+		from:    from,
+		auth:    auth,
+		timeout: timeout,
+	}
+}
+
+func (c *client) Send(ctx context.Context, address string, msg model.Message) error {
+	to, err := mail.ParseAddress(address)
+	if err != nil {
+		return fmt.Errorf("%w: %w", model.ErrPermanentDelivery, err)
+	}
+
+	body, err := buildMessage(c.from, to, msg, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", model.ErrPermanentDelivery, err)
+	}
+
+	if err := c.send(ctx, to.Address, body); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+func (c *client) send(ctx context.Context, rcpt string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	host, _, err := net.SplitHostPort(c.addr)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	sc, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer sc.Close()
+
+	if ok, _ := sc.Extension("STARTTLS"); ok {
+		if err := sc.StartTLS(&tls.Config{ServerName: host, RootCAs: c.rootCAs, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if c.auth != nil {
+		if err := sc.Auth(c.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := sc.Mail(c.from.Address); err != nil {
+		return err
+	}
+	if err := sc.Rcpt(rcpt); err != nil {
+		return err
+	}
+
+	w, err := sc.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return sc.Quit()
+}
+
+// mapError treats 5xx SMTP replies as permanent: the server rejected
+// the recipient or the message and retrying will not help.
+func mapError(err error) error {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) && tpErr.Code >= 500 {
+		return fmt.Errorf("%w: %w", model.ErrPermanentDelivery, err)
+	}
+
+	return err
+}
+
+// buildMessage renders a multipart/alternative message
+// with plain-text and HTML bodies.
+func buildMessage(from, to *mail.Address, msg model.Message, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=utf-8", content: msg.Text},
+		{contentType: "text/html; charset=utf-8", content: msg.HTML},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, h := range [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()})},
+	} {
+		buf.WriteString(h[0] + ": " + h[1] + "\r\n")
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,278 @@
+package smtpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig/devca"
+)
+
+// received is what the stand-in server got from a session.
+type received struct {
+	tlsBeforeMail bool
+	auth          string
+	from          string
+	rcpt          string
+	data          []byte
+}
+
+// smtpServer is an in-process SMTP stand-in that offers STARTTLS and
+// AUTH PLAIN, and answers RCPT with rcptReply.
+type smtpServer struct {
+	ln        net.Listener
+	tls       *tls.Config
+	rcptReply string
+
+	mu   sync.Mutex
+	got  received
+	done chan struct{}
+}
+
+func newSMTPServer(t *testing.T, rcptReply string) (*smtpServer, *x509.CertPool) {
+	t.Helper()
+
+	ca, err := devca.NewCA()
+	require.NoError(t, err)
+	certPEM, keyPEM, err := ca.Issue("smtp", "127.0.0.1")
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(ca.CertPEM()))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	s := &smtpServer{
+		ln:        ln,
+		tls:       &tls.Config{Certificates: []tls.Certificate{cert}},
+		rcptReply: rcptReply,
+		done:      make(chan struct{}),
+	}
+	go s.serve()
+
+	return s, roots
+}
+
+func (s *smtpServer) addr() string { return s.ln.Addr().String() }
+
+func (s *smtpServer) received(t *testing.T) received {
+	t.Helper()
+
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP session did not end")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.got
+}
+
+func (s *smtpServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	tp := textproto.NewConn(conn)
+	secure := false
+	reply := func(lines ...string) { _ = tp.PrintfLine("%s", strings.Join(lines, "\r\n")) }
+
+	reply("220 127.0.0.1 ESMTP stand-in")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if secure {
+				reply("250-127.0.0.1", "250 AUTH PLAIN")
+			} else {
+				reply("250-127.0.0.1", "250-STARTTLS", "250 AUTH PLAIN")
+			}
+		case "STARTTLS":
+			reply("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			secure = true
+		case "AUTH":
+			s.mu.Lock()
+			s.got.auth = arg
+			s.mu.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			s.got.tlsBeforeMail = secure
+			s.got.from = arg
+			s.mu.Unlock()
+			reply("250 ok")
+		case "RCPT":
+			s.mu.Lock()
+			s.got.rcpt = arg
+			s.mu.Unlock()
+			reply(s.rcptReply)
+		case "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.got.data = data
+			s.mu.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func newTestClient(t *testing.T, addr string, roots *x509.CertPool) *client {
+	t.Helper()
+
+	from, err := mail.ParseAddress("AstraDock <noreply@astradock.local>")
+	require.NoError(t, err)
+
+	c := NewClient(addr, from, smtp.PlainAuth("", "bot", "secret", "127.0.0.1"), 5*time.Second)
+	c.rootCAs = roots
+	return c
+}
+
+func testMessage() model.Message {
+	return model.Message{
+		Subject: "Заказ оплачен",
+		Text:    "Заказ оплачен картой.\nСпасибо!",
+		HTML:    "<p>Заказ оплачен <b>картой</b>.</p>",
+	}
+}
+
+func TestClientSend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success: the message is sent over STARTTLS as multipart/alternative", func(t *testing.T) {
+		t.Parallel()
+
+		srv, roots := newSMTPServer(t, "250 ok")
+		msg := testMessage()
+
+		err := newTestClient(t, srv.addr(), roots).Send(context.Background(), "user@example.com", msg)
+		require.NoError(t, err)
+
+		got := srv.received(t)
+		assert.True(t, got.tlsBeforeMail, "MAIL was sent before STARTTLS")
+		assert.True(t, strings.HasPrefix(got.auth, "PLAIN "), "auth %q", got.auth)
+		assert.Equal(t, "FROM:<noreply@astradock.local>", got.from)
+		assert.Equal(t, "TO:<user@example.com>", got.rcpt)
+
+		parsed, err := mail.ReadMessage(strings.NewReader(string(got.data)))
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, msg.Subject, subject)
+		assert.Equal(t, "user@example.com", mustAddress(t, parsed.Header.Get("To")))
+
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		require.Equal(t, "multipart/alternative", mediaType)
+
+		mr := multipart.NewReader(parsed.Body, params["boundary"])
+		for _, want := range []struct {
+			contentType string
+			body        string
+		}{
+			{contentType: "text/plain; charset=utf-8", body: msg.Text},
+			{contentType: "text/html; charset=utf-8", body: msg.HTML},
+		} {
+			part, err := mr.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, want.contentType, part.Header.Get("Content-Type"))
+
+			// NextPart decodes the quoted-printable body.
+			body, err := io.ReadAll(part)
+			require.NoError(t, err)
+			assert.Equal(t, want.body, string(body))
+		}
+		_, err = mr.NextPart()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("permanent: the server rejects the recipient", func(t *testing.T) {
+		t.Parallel()
+
+		srv, roots := newSMTPServer(t, "550 no such user")
+
+		err := newTestClient(t, srv.addr(), roots).Send(context.Background(), "user@example.com", testMessage())
+		require.ErrorIs(t, err, model.ErrPermanentDelivery)
+	})
+
+	t.Run("transient: the server defers the recipient", func(t *testing.T) {
+		t.Parallel()
+
+		srv, roots := newSMTPServer(t, "451 try again later")
+
+		err := newTestClient(t, srv.addr(), roots).Send(context.Background(), "user@example.com", testMessage())
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, model.ErrPermanentDelivery)
+	})
+
+	t.Run("error: a server certificate the client does not trust", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := newSMTPServer(t, "250 ok")
+
+		err := newTestClient(t, srv.addr(), x509.NewCertPool()).Send(context.Background(), "user@example.com", testMessage())
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, model.ErrPermanentDelivery)
+		assert.Empty(t, srv.received(t).from)
+	})
+
+	t.Run("permanent: an invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		err := newTestClient(t, "127.0.0.1:1", nil).Send(context.Background(), "not an address", testMessage())
+		require.ErrorIs(t, err, model.ErrPermanentDelivery)
+	})
+}
+
+func mustAddress(t *testing.T, header string) string {
+	t.Helper()
+
+	addr, err := mail.ParseAddress(header)
+	require.NoError(t, err)
+	return addr.Address
+}
@@ -13,9 +13,17 @@ import (
 var cfg *config
 
 type config struct {
-	Kafka    Kafka
-	Telegram Telegram
-	Logger   Logger
+	Server    Server
+	Postgres  Database
+	Kafka     Kafka
+	Telegram  Telegram
+	Order     Order
+	IAM       IAM
+	Email     Email
+	Templates Templates
+	Delivery  Delivery
+	Webhook   Webhook
+	Logger    Logger
 }
 
 func Load(path ...string) error {
@@ -27,6 +35,16 @@ func Load(path ...string) error {
 		}
 	}
 
+	serverCfg, err := envconfig.NewHTTPServerConfig()
+	if err != nil {
+		return fmt.Errorf("%s Server: %w", op, err)
+	}
+
+	postgresCfg, err := envconfig.NewPostgresConfig()
+	if err != nil {
+		return fmt.Errorf("%s Postgres: %w", op, err)
+	}
+
 	kafkaCfg, err := envconfig.NewKafkaConfig()
 	if err != nil {
 		return fmt.Errorf("%s Kafka: %w", op, err)
@@ -37,15 +55,53 @@ func Load(path ...string) error {
 		return fmt.Errorf("%s Telegram: %w", op, err)
 	}
 
+	orderCfg, err := envconfig.NewOrderConfig()
+	if err != nil {
+		return fmt.Errorf("%s Order: %w", op, err)
+	}
+
+	iamCfg, err := envconfig.NewIAMConfig()
+	if err != nil {
+		return fmt.Errorf("%s IAM: %w", op, err)
+	}
+
+	emailCfg, err := envconfig.NewEmailConfig()
+	if err != nil {
+		return fmt.Errorf("%s Email: %w", op, err)
+	}
+
+	templatesCfg, err := envconfig.NewTemplatesConfig()
+	if err != nil {
+		return fmt.Errorf("%s Templates: %w", op, err)
+	}
+
+	deliveryCfg, err := envconfig.NewDeliveryConfig()
+	if err != nil {
+		return fmt.Errorf("%s Delivery: %w", op, err)
+	}
+
+	webhookCfg, err := envconfig.NewWebhookConfig()
+	if err != nil {
+		return fmt.Errorf("%s Webhook: %w", op, err)
+	}
+
 	loggerCfg, err := envconfig.NewLoggerConfig()
 	if err != nil {
 		return fmt.Errorf("%s Logger: %w", op, err)
 	}
 
 	cfg = &config{
-		Kafka:    kafkaCfg,
-		Telegram: telegramCfg,
-		Logger:   loggerCfg,
+		Server:    serverCfg,
+		Postgres:  postgresCfg,
+		Kafka:     kafkaCfg,
+		Telegram:  telegramCfg,
+		Order:     orderCfg,
+		IAM:       iamCfg,
+		Email:     emailCfg,
+		Templates: templatesCfg,
+		Delivery:  deliveryCfg,
+		Webhook:   webhookCfg,
+		Logger:    loggerCfg,
 	}
 
 	return nil
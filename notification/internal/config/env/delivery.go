@@ -0,0 +1,33 @@
+package envconfig
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type deliveryEnv struct {
+	MaxAttempts    int           `env:"DELIVERY_MAX_ATTEMPTS" envDefault:"5"`
+	RetryBaseDelay time.Duration `env:"DELIVERY_RETRY_BASE_DELAY" envDefault:"1s"`
+	RetryMaxDelay  time.Duration `env:"DELIVERY_RETRY_MAX_DELAY" envDefault:"30s"`
+	Workers        int           `env:"DELIVERY_WORKERS" envDefault:"8"`
+	LogRetention   time.Duration `env:"DELIVERY_LOG_RETENTION" envDefault:"24h"`
+}
+
+type delivery struct {
+	raw deliveryEnv
+}
+
+func NewDeliveryConfig() (*delivery, error) {
+	var raw deliveryEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &delivery{raw: raw}, nil
+}
+
+func (cfg *delivery) MaxAttempts() int              { return cfg.raw.MaxAttempts }
+func (cfg *delivery) RetryBaseDelay() time.Duration { return cfg.raw.RetryBaseDelay }
+func (cfg *delivery) RetryMaxDelay() time.Duration  { return cfg.raw.RetryMaxDelay }
+func (cfg *delivery) Workers() int                  { return cfg.raw.Workers }
+func (cfg *delivery) LogRetention() time.Duration   { return cfg.raw.LogRetention }
@@ -0,0 +1,41 @@
+package envconfig
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type emailEnv struct {
+	Host              string        `env:"SMTP_HOST,required"`
+	Port              int           `env:"SMTP_PORT,required"`
+	Username          string        `env:"SMTP_USERNAME"`
+	Password          string        `env:"SMTP_PASSWORD"`
+	From              string        `env:"SMTP_FROM,required"`
+	Timeout           time.Duration `env:"SMTP_TIMEOUT" envDefault:"10s"`
+	SendRatePerSecond float64       `env:"SMTP_SEND_RATE_PER_SECOND" envDefault:"10"`
+	SendBurst         int           `env:"SMTP_SEND_BURST" envDefault:"1"`
+}
+
+type email struct {
+	raw emailEnv
+}
+
+func NewEmailConfig() (*email, error) {
+	var raw emailEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &email{raw: raw}, nil
+}
+
+func (cfg *email) Host() string               { return cfg.raw.Host }
+func (cfg *email) Address() string            { return net.JoinHostPort(cfg.raw.Host, strconv.Itoa(cfg.raw.Port)) }
+func (cfg *email) Username() string           { return cfg.raw.Username }
+func (cfg *email) Password() string           { return cfg.raw.Password }
+func (cfg *email) From() string               { return cfg.raw.From }
+func (cfg *email) Timeout() time.Duration     { return cfg.raw.Timeout }
+func (cfg *email) SendRatePerSecond() float64 { return cfg.raw.SendRatePerSecond }
+func (cfg *email) SendBurst() int             { return cfg.raw.SendBurst }
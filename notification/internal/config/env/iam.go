@@ -0,0 +1,60 @@
+package envconfig
+
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
+)
+
+type iamEnv struct {
+	GRPCHost string        `env:"IAM_GRPC_HOST,required"`
+	GRPCPort int           `env:"IAM_GRPC_PORT,required"`
+	Timeout  time.Duration `env:"IAM_GRPC_TIMEOUT" envDefault:"5s"`
+
+	// TLSCA signs the certificate of IAM; with TLSCert and TLSKey the client
+	// presents its own certificate for mutual TLS.
+	TLSEnabled        bool          `env:"IAM_GRPC_TLS_ENABLED" envDefault:"false"`
+	TLSCA             string        `env:"IAM_GRPC_TLS_CA_FILE"`
+	TLSCert           string        `env:"IAM_GRPC_TLS_CERT_FILE"`
+	TLSKey            string        `env:"IAM_GRPC_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"IAM_GRPC_TLS_RELOAD_INTERVAL" envDefault:"1m"`
+}
+
+type iam struct {
+	raw iamEnv
+	tls *tls.Config
+}
+
+func NewIAMConfig() (*iam, error) {
+	var raw iamEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	cfg := &iam{raw: raw}
+	if raw.TLSEnabled {
+		var err error
+		cfg.tls, err = tlsconfig.Client(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, tlsconfig.WithReloadInterval(raw.TLSReloadInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *iam) Address() string {
+	return fmt.Sprintf("%s:%d", cfg.raw.GRPCHost, cfg.raw.GRPCPort)
+}
+func (cfg *iam) Timeout() time.Duration { return cfg.raw.Timeout }
+
+// TLS is the config of the connection, nil when it is in plaintext.
+func (cfg *iam) TLS() *tls.Config { return cfg.tls }
@@ -0,0 +1,29 @@
+package envconfig
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderEnv struct {
+	URL       string        `env:"ORDER_HTTP_URL,required"`
+	Timeout   time.Duration `env:"ORDER_HTTP_TIMEOUT" envDefault:"5s"`
+	ListLimit int           `env:"ORDER_LIST_LIMIT" envDefault:"10"`
+}
+
+type order struct {
+	raw orderEnv
+}
+
+func NewOrderConfig() (*order, error) {
+	var raw orderEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &order{raw: raw}, nil
+}
+
+func (cfg *order) URL() string            { return cfg.raw.URL }
+func (cfg *order) Timeout() time.Duration { return cfg.raw.Timeout }
+func (cfg *order) ListLimit() int         { return cfg.raw.ListLimit }
@@ -1,9 +1,13 @@
 package envconfig
 
-import "github.com/caarlos0/env/v11"
+import (
+	"github.com/caarlos0/env/v11"
+)
 
 type telegramEnv struct {
-	BotToken string `env:"TELEGRAM_BOT_TOKEN,required"`
+	BotToken          string  `env:"TELEGRAM_BOT_TOKEN,required"`
+	SendRatePerSecond float64 `env:"TELEGRAM_SEND_RATE_PER_SECOND" envDefault:"25"`
+	SendBurst         int     `env:"TELEGRAM_SEND_BURST" envDefault:"1"`
 }
 
 type telegram struct {
@@ -18,4 +22,6 @@ func NewTelegramConfig() (*telegram, error) {
 	return &telegram{raw: raw}, nil
 }
 
-func (cfg *telegram) BotToken() string { return cfg.raw.BotToken }
+func (cfg *telegram) BotToken() string           { return cfg.raw.BotToken }
+func (cfg *telegram) SendRatePerSecond() float64 { return cfg.raw.SendRatePerSecond }
+func (cfg *telegram) SendBurst() int             { return cfg.raw.SendBurst }
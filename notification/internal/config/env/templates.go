@@ -0,0 +1,29 @@
+package envconfig
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type templatesEnv struct {
+	Dir            string        `env:"TEMPLATES_DIR"`
+	ReloadInterval time.Duration `env:"TEMPLATES_RELOAD_INTERVAL" envDefault:"5s"`
+	DefaultLocale  string        `env:"TEMPLATES_DEFAULT_LOCALE" envDefault:"ru"`
+}
+
+type templates struct {
+	raw templatesEnv
+}
+
+func NewTemplatesConfig() (*templates, error) {
+	var raw templatesEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &templates{raw: raw}, nil
+}
+
+func (cfg *templates) Dir() string                   { return cfg.raw.Dir }
+func (cfg *templates) ReloadInterval() time.Duration { return cfg.raw.ReloadInterval }
+func (cfg *templates) DefaultLocale() string         { return cfg.raw.DefaultLocale }
@@ -0,0 +1,39 @@
+package envconfig
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnv struct {
+	AdminToken     string        `env:"WEBHOOK_ADMIN_TOKEN,required,notEmpty"`
+	Timeout        time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	MaxAttempts    int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	RetryBaseDelay time.Duration `env:"WEBHOOK_RETRY_BASE_DELAY" envDefault:"10s"`
+	RetryMaxDelay  time.Duration `env:"WEBHOOK_RETRY_MAX_DELAY" envDefault:"1h"`
+	DisableAfter   int           `env:"WEBHOOK_DISABLE_AFTER" envDefault:"20"`
+	PollInterval   time.Duration `env:"WEBHOOK_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize      int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"32"`
+}
+
+type webhook struct {
+	raw webhookEnv
+}
+
+func NewWebhookConfig() (*webhook, error) {
+	var raw webhookEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &webhook{raw: raw}, nil
+}
+
+func (cfg *webhook) AdminToken() string            { return cfg.raw.AdminToken }
+func (cfg *webhook) Timeout() time.Duration        { return cfg.raw.Timeout }
+func (cfg *webhook) MaxAttempts() int              { return cfg.raw.MaxAttempts }
+func (cfg *webhook) RetryBaseDelay() time.Duration { return cfg.raw.RetryBaseDelay }
+func (cfg *webhook) RetryMaxDelay() time.Duration  { return cfg.raw.RetryMaxDelay }
+func (cfg *webhook) DisableAfter() int             { return cfg.raw.DisableAfter }
+func (cfg *webhook) PollInterval() time.Duration   { return cfg.raw.PollInterval }
+func (cfg *webhook) BatchSize() int                { return cfg.raw.BatchSize }
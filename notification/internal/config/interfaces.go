@@ -1,6 +1,11 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type Kafka interface {
 	Brokers() []string
@@ -10,10 +15,74 @@ type Kafka interface {
 	OrderAssembledConsumerGroupID() string
 	OrderPaidConsumerConfig() *sarama.Config
 	OrderAssembledConsumerConfig() *sarama.Config
+	OrderCancelledTopic() string
+	OrderCancelledConsumerGroupID() string
+	OrderCancelledConsumerConfig() *sarama.Config
+}
+
+type Server interface {
+	Address() string
+	ReadTimeout() time.Duration
+	ShutdownTimeout() time.Duration
+}
+
+type Database interface {
+	MigrationDirectory() string
+	DSN() string
 }
 
 type Telegram interface {
 	BotToken() string
+	SendRatePerSecond() float64
+	SendBurst() int
+}
+
+type Order interface {
+	URL() string
+	Timeout() time.Duration
+	ListLimit() int
+}
+
+type IAM interface {
+	Address() string
+	Timeout() time.Duration
+	TLS() *tls.Config
+}
+
+type Email interface {
+	Host() string
+	Address() string
+	Username() string
+	Password() string
+	From() string
+	Timeout() time.Duration
+	SendRatePerSecond() float64
+	SendBurst() int
+}
+
+type Templates interface {
+	Dir() string
+	ReloadInterval() time.Duration
+	DefaultLocale() string
+}
+
+type Delivery interface {
+	MaxAttempts() int
+	RetryBaseDelay() time.Duration
+	RetryMaxDelay() time.Duration
+	Workers() int
+	LogRetention() time.Duration
+}
+
+type Webhook interface {
+	AdminToken() string
+	Timeout() time.Duration
+	MaxAttempts() int
+	RetryBaseDelay() time.Duration
+	RetryMaxDelay() time.Duration
+	DisableAfter() int
+	PollInterval() time.Duration
+	BatchSize() int
 }
 
 type Logger interface {
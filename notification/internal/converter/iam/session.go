@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+func SessionTokensToModel(tokens *iampbv1.SessionTokens) (model.UserSession, error) {
+	sess := tokens.GetSession()
+
+	userID, err := uuid.Parse(sess.GetUserUuid())
+	if err != nil {
+		return model.UserSession{}, fmt.Errorf("parse user uuid: %w", err)
+	}
+
+	return model.UserSession{
+		UserID:           userID,
+		SessionToken:     tokens.GetSessionToken(),
+		RefreshToken:     tokens.GetRefreshToken(),
+		ExpiresAt:        sess.GetExpiresAt().AsTime(),
+		RefreshExpiresAt: sess.GetRefreshExpiresAt().AsTime(),
+		UpdatedAt:        time.Now(),
+	}, nil
+}
@@ -25,7 +25,7 @@ func (c *kafkaConverter) AssembledShipToModel(data []byte) (model.AssembledShip,
 		EventID:   uuid.MustParse(pb.GetEventUuid()),
 		OrderID:   uuid.MustParse(pb.GetOrderUuid()),
 		UserID:    uuid.MustParse(pb.GetUserUuid()),
-		BuildTime: time.Duration(pb.BuildTimeSec),
+		BuildTime: time.Duration(pb.GetBuildTimeSec()) * time.Second,
 	}, nil
 }
 
@@ -43,3 +43,16 @@ func (c *kafkaConverter) PaidOrderToModel(data []byte) (model.PaidOrder, error)
 		TransactionID: uuid.MustParse(pb.GetTransactionUuid()),
 	}, nil
 }
+
+func (c *kafkaConverter) CancelledOrderToModel(data []byte) (model.CancelledOrder, error) {
+	var pb assemblypbv1.OrderCancelledRecord
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.CancelledOrder{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.CancelledOrder{
+		EventID: uuid.MustParse(pb.GetEventUuid()),
+		OrderID: uuid.MustParse(pb.GetOrderUuid()),
+		UserID:  uuid.MustParse(pb.GetUserUuid()),
+	}, nil
+}
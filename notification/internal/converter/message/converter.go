@@ -0,0 +1,273 @@
+package converter
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// kind names the event a template set renders; template files are
+// looked up as "<locale>/<kind>.<format>.tmpl".
+type kind string
+
+const (
+	kindOrderPaid     kind = "order_paid"
+	kindShipAssembled kind = "ship_assembled"
+)
+
+// samples hold data every template must render without errors.
+var samples = map[kind]any{
+	kindOrderPaid: model.PaidOrderNotification{
+		OrderID:       uuid.NewString(),
+		UserID:        uuid.NewString(),
+		PaymentMethod: "PAYMENT_METHOD_CARD",
+		TransactionID: uuid.NewString(),
+	},
+	kindShipAssembled: model.AssembledShipNotification{
+		OrderID:   uuid.NewString(),
+		UserID:    uuid.NewString(),
+		BuildTime: 10 * time.Second,
+	},
+}
+
+//go:embed templates
+var embedded embed.FS
+
+type executor interface {
+	Execute(wr io.Writer, data any) error
+}
+
+// messageTemplate renders every representation of a single message.
+type messageTemplate struct {
+	subject  executor
+	markdown executor
+	text     executor
+	html     executor
+}
+
+type templateSet map[model.Locale]map[kind]messageTemplate
+
+type renderer struct {
+	dir      string
+	fallback model.Locale
+	current  atomic.Pointer[templateSet]
+	stamp    string
+}
+
+// NewRenderer loads and validates notification templates. Templates found
+// in dir override the built-in ones file by file; an empty dir means
+// built-in templates only. Users without a known locale get fallback.
+func NewRenderer(dir string, fallback model.Locale) (*renderer, error) {
+	const op = "message.converter.NewRenderer"
+
+	r := &renderer{dir: dir, fallback: fallback}
+	if _, err := model.ParseLocale(string(fallback)); err != nil {
+		return nil, fmt.Errorf("%s: fallback %q: %w", op, fallback, err)
+	}
+
+	set, err := r.load()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	r.current.Store(set)
+	r.stamp = r.dirStamp()
+
+	return r, nil
+}
+
+func (r *renderer) BuildPaidOrder(locale model.Locale, event model.PaidOrder) (model.Message, error) {
+	return r.render(locale, kindOrderPaid, model.PaidOrderNotification{
+		OrderID:       event.OrderID.String(),
+		UserID:        event.UserID.String(),
+		PaymentMethod: event.PaymentMethod,
+		TransactionID: event.TransactionID.String(),
+	})
+}
+
+func (r *renderer) BuildShipAssembled(locale model.Locale, event model.AssembledShip) (model.Message, error) {
+	return r.render(locale, kindShipAssembled, model.AssembledShipNotification{
+		OrderID:   event.OrderID.String(),
+		UserID:    event.UserID.String(),
+		BuildTime: event.BuildTime,
+	})
+}
+
+// Watch reloads templates from the directory whenever its files change,
+// checking every interval until ctx is done. A set that fails validation
+// is logged and ignored, so the last good templates stay in use.
+func (r *renderer) Watch(ctx context.Context, interval time.Duration) {
+	if r.dir == "" {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		stamp := r.dirStamp()
+		if stamp == r.stamp {
+			continue
+		}
+		r.stamp = stamp
+
+		set, err := r.load()
+		if err != nil {
+			logger.Error(ctx, "Failed to reload notification templates, keeping previous ones",
+				logger.String("dir", r.dir), logger.ErrorF(err))
+			continue
+		}
+		r.current.Store(set)
+		logger.Info(ctx, "Notification templates reloaded", logger.String("dir", r.dir))
+	}
+}
+
+func (r *renderer) render(locale model.Locale, k kind, data any) (model.Message, error) {
+	set := *r.current.Load()
+
+	tmpls, ok := set[locale]
+	if !ok {
+		tmpls = set[r.fallback]
+	}
+
+	return execute(tmpls[k], data)
+}
+
+// load parses templates of every kind in every locale and renders each
+// against sample data, so a broken template is reported before it is used.
+func (r *renderer) load() (*templateSet, error) {
+	set := templateSet{}
+	for _, locale := range model.Locales {
+		set[locale] = map[kind]messageTemplate{}
+
+		for k, sample := range samples {
+			mt, err := r.parse(locale, k)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := execute(mt, sample); err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", locale, k, err)
+			}
+			set[locale][k] = mt
+		}
+	}
+
+	return &set, nil
+}
+
+func (r *renderer) parse(locale model.Locale, k kind) (messageTemplate, error) {
+	var (
+		mt   messageTemplate
+		errs []error
+	)
+	for _, f := range []struct {
+		format string
+		dst    *executor
+		html   bool
+	}{
+		{format: "subject", dst: &mt.subject},
+		{format: "md", dst: &mt.markdown},
+		{format: "txt", dst: &mt.text},
+		{format: "html", dst: &mt.html, html: true},
+	} {
+		name := path.Join(string(locale), string(k)+"."+f.format+".tmpl")
+
+		src, err := r.read(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if f.html {
+			*f.dst, err = htmltemplate.New(name).Option("missingkey=error").Parse(string(src))
+		} else {
+			*f.dst, err = template.New(name).Option("missingkey=error").Parse(string(src))
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return mt, errors.Join(errs...)
+}
+
+// read prefers the file from the directory and falls back to the built-in one.
+func (r *renderer) read(name string) ([]byte, error) {
+	if r.dir != "" {
+		src, err := fs.ReadFile(os.DirFS(r.dir), name)
+		if err == nil {
+			return src, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return fs.ReadFile(embedded, path.Join("templates", name))
+}
+
+// dirStamp summarizes names, sizes and modification times of the
+// directory's files, so any change to them changes the stamp.
+func (r *renderer) dirStamp() string {
+	if r.dir == "" {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	_ = fs.WalkDir(os.DirFS(r.dir), ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil //nolint:nilerr // unreadable entries are picked up by load
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil //nolint:nilerr // the file is gone; the stamp changes anyway
+		}
+		fmt.Fprintf(&buf, "%s:%d:%d;", p, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+
+	return buf.String()
+}
+
+func execute(mt messageTemplate, data any) (model.Message, error) {
+	var msg model.Message
+	for _, r := range []struct {
+		tmpl executor
+		dst  *string
+	}{
+		{tmpl: mt.subject, dst: &msg.Subject},
+		{tmpl: mt.markdown, dst: &msg.Markdown},
+		{tmpl: mt.text, dst: &msg.Text},
+		{tmpl: mt.html, dst: &msg.HTML},
+	} {
+		var buf bytes.Buffer
+		if err := r.tmpl.Execute(&buf, data); err != nil {
+			return model.Message{}, err
+		}
+		*r.dst = buf.String()
+	}
+	msg.Subject = strings.TrimSpace(msg.Subject)
+
+	return msg, nil
+}
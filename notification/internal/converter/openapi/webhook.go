@@ -0,0 +1,122 @@
+package converter
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
+)
+
+func CreateWebhookRequestToParams(req *notificationv1.CreateWebhookSubscriptionRequest) model.CreateWebhookParams {
+	params := model.CreateWebhookParams{
+		URL:        req.URL.String(),
+		Secret:     req.Secret,
+		EventTypes: eventTypesToModel(req.EventTypes),
+	}
+	if userID, ok := req.UserUUID.Get(); ok {
+		params.UserID = &userID
+	}
+
+	return params
+}
+
+func UpdateWebhookRequestToParams(req *notificationv1.UpdateWebhookSubscriptionRequest) model.UpdateWebhookParams {
+	var params model.UpdateWebhookParams
+	if u, ok := req.URL.Get(); ok {
+		raw := u.String()
+		params.URL = &raw
+	}
+	if secret, ok := req.Secret.Get(); ok {
+		params.Secret = &secret
+	}
+	if req.EventTypes != nil {
+		params.EventTypes = eventTypesToModel(req.EventTypes)
+	}
+	if active, ok := req.Active.Get(); ok {
+		params.Active = &active
+	}
+
+	return params
+}
+
+func WebhookSubscriptionToOAPI(m model.WebhookSubscription) *notificationv1.WebhookSubscription {
+	// The URL was validated when the subscription was saved.
+	u, _ := url.Parse(m.URL)
+
+	sub := &notificationv1.WebhookSubscription{
+		SubscriptionUUID:    m.ID,
+		EventTypes:          eventTypesToOAPI(m.EventTypes),
+		Active:              m.Active,
+		ConsecutiveFailures: int32(m.ConsecutiveFailures), //nolint:gosec // bounded by the disable threshold
+		DisabledAt:          timeToOpt(m.DisabledAt),
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+	if u != nil {
+		sub.URL = *u
+	}
+	if m.UserID != nil {
+		sub.UserUUID = notificationv1.NewOptUUID(*m.UserID)
+	}
+
+	return sub
+}
+
+func WebhookSubscriptionsToOAPI(subs []model.WebhookSubscription) *notificationv1.ListWebhookSubscriptionsResponse {
+	out := make([]notificationv1.WebhookSubscription, 0, len(subs))
+	for _, s := range subs {
+		out = append(out, *WebhookSubscriptionToOAPI(s))
+	}
+
+	return &notificationv1.ListWebhookSubscriptionsResponse{Subscriptions: out}
+}
+
+func WebhookDeliveriesToOAPI(deliveries []model.WebhookDelivery) *notificationv1.ListWebhookDeliveriesResponse {
+	out := make([]notificationv1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		wd := notificationv1.WebhookDelivery{
+			DeliveryUUID:     d.ID,
+			SubscriptionUUID: d.SubscriptionID,
+			EventUUID:        d.EventID,
+			EventType:        notificationv1.WebhookEventType(d.EventType),
+			Status:           notificationv1.WebhookDeliveryStatus(d.Status),
+			Attempts:         int32(d.Attempts), //nolint:gosec // bounded by max attempts
+			NextAttemptAt:    d.NextAttemptAt,
+			CreatedAt:        d.CreatedAt,
+			DeliveredAt:      timeToOpt(d.DeliveredAt),
+		}
+		if d.ResponseCode != nil {
+			wd.ResponseCode = notificationv1.NewOptInt32(int32(*d.ResponseCode)) //nolint:gosec // HTTP status code
+		}
+		if d.LastError != nil {
+			wd.LastError = notificationv1.NewOptString(*d.LastError)
+		}
+		out = append(out, wd)
+	}
+
+	return &notificationv1.ListWebhookDeliveriesResponse{Deliveries: out}
+}
+
+func eventTypesToModel(types []notificationv1.WebhookEventType) []model.WebhookEventType {
+	out := make([]model.WebhookEventType, 0, len(types))
+	for _, t := range types {
+		out = append(out, model.WebhookEventType(t))
+	}
+	return out
+}
+
+func eventTypesToOAPI(types []model.WebhookEventType) []notificationv1.WebhookEventType {
+	out := make([]notificationv1.WebhookEventType, 0, len(types))
+	for _, t := range types {
+		out = append(out, notificationv1.WebhookEventType(t))
+	}
+	return out
+}
+
+func timeToOpt(t *time.Time) notificationv1.OptDateTime {
+	if t == nil {
+		return notificationv1.OptDateTime{}
+	}
+	return notificationv1.NewOptDateTime(*t)
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+// payload is the JSON body POSTed to webhook endpoints.
+type payload struct {
+	ID        uuid.UUID              `json:"id"`
+	Type      model.WebhookEventType `json:"type"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      any                    `json:"data"`
+}
+
+type paidOrderData struct {
+	OrderUUID       uuid.UUID `json:"order_uuid"`
+	UserUUID        uuid.UUID `json:"user_uuid"`
+	PaymentMethod   string    `json:"payment_method"`
+	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+}
+
+type assembledShipData struct {
+	OrderUUID    uuid.UUID `json:"order_uuid"`
+	UserUUID     uuid.UUID `json:"user_uuid"`
+	BuildTimeSec int64     `json:"build_time_sec"`
+}
+
+type cancelledOrderData struct {
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	UserUUID  uuid.UUID `json:"user_uuid"`
+}
+
+func PaidOrderEvent(event model.PaidOrder) (model.WebhookEvent, error) {
+	return build(event.EventID, model.WebhookEventOrderPaid, event.UserID, paidOrderData{
+		OrderUUID:       event.OrderID,
+		UserUUID:        event.UserID,
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUUID: event.TransactionID,
+	})
+}
+
+func ShipAssembledEvent(event model.AssembledShip) (model.WebhookEvent, error) {
+	return build(event.EventID, model.WebhookEventOrderAssembled, event.UserID, assembledShipData{
+		OrderUUID:    event.OrderID,
+		UserUUID:     event.UserID,
+		BuildTimeSec: int64(event.BuildTime / time.Second),
+	})
+}
+
+func CancelledOrderEvent(event model.CancelledOrder) (model.WebhookEvent, error) {
+	return build(event.EventID, model.WebhookEventOrderCancelled, event.UserID, cancelledOrderData{
+		OrderUUID: event.OrderID,
+		UserUUID:  event.UserID,
+	})
+}
+
+func build(id uuid.UUID, typ model.WebhookEventType, userID uuid.UUID, data any) (model.WebhookEvent, error) {
+	body, err := json.Marshal(payload{
+		ID:        id,
+		Type:      typ,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return model.WebhookEvent{}, err
+	}
+
+	return model.WebhookEvent{
+		ID:      id,
+		Type:    typ,
+		UserID:  userID,
+		Payload: body,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Channel string
+
+const (
+	ChannelTelegram Channel = "telegram"
+	ChannelEmail    Channel = "email"
+)
+
+func ParseChannel(s string) (Channel, error) {
+	switch c := Channel(s); c {
+	case ChannelTelegram, ChannelEmail:
+		return c, nil
+	default:
+		return "", ErrUnknownChannel
+	}
+}
+
+// Recipient is an address within a channel:
+// a chat id for Telegram, a mailbox for email.
+type Recipient struct {
+	Channel Channel
+	Address string
+}
+
+// ChannelPreference tells whether a user wants notifications
+// through a channel and where to send them.
+type ChannelPreference struct {
+	UserID    uuid.UUID
+	Channel   Channel
+	Address   string
+	Enabled   bool
+	UpdatedAt time.Time
+}
+
+func (p ChannelPreference) Recipient() Recipient {
+	return Recipient{Channel: p.Channel, Address: p.Address}
+}
+
+// Message is a notification rendered for every channel;
+// each channel picks the representation it supports.
+type Message struct {
+	Subject  string
+	Markdown string
+	Text     string
+	HTML     string
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	// The message reached the recipient.
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	// The recipient can never receive the message (bot blocked, chat removed, ...).
+	DeliveryStatusFailed DeliveryStatus = "FAILED"
+	// Delivery was interrupted; the event has to be redelivered.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+)
+
+// Delivery is a single entry of the per-recipient delivery log.
+type Delivery struct {
+	EventID   uuid.UUID
+	Recipient Recipient
+	Status    DeliveryStatus
+	Attempts  int
+	LastError string
+	UpdatedAt time.Time
+}
+
+// Done reports whether the recipient needs no further attempts.
+func (d Delivery) Done() bool {
+	return d.Status == DeliveryStatusDelivered || d.Status == DeliveryStatusFailed
+}
+
+// DeliveryPolicy controls retries of a single recipient delivery.
+type DeliveryPolicy struct {
+	// Maximum number of send attempts per recipient and event.
+	MaxAttempts int
+	// Delay before the second attempt, doubled on every next one.
+	BaseDelay time.Duration
+	// Upper bound for the backoff delay.
+	MaxDelay time.Duration
+	// Number of recipients served in parallel.
+	Concurrency int
+}
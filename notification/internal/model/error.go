@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrPermanentDelivery  = errors.New("permanent delivery failure")
+	ErrDeliveryIncomplete = errors.New("delivery incomplete")
+	ErrDeliveryNotFound   = errors.New("delivery not found")
+
+	ErrUnknownChannel = errors.New("unknown channel")
+	ErrUnknownLocale  = errors.New("unknown locale")
+	ErrInvalidAddress = errors.New("invalid address")
+	ErrUserNotLinked  = errors.New("user not linked")
+
+	ErrInvalidLinkToken = errors.New("invalid link token")
+	ErrUnauthorized     = errors.New("unauthorized")
+
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidWebhook       = errors.New("invalid webhook subscription")
+
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrOrderNotCancellable = errors.New("order cannot be cancelled")
+)
+
+// RetryAfterError is returned by a channel that asks to slow down,
+// e.g. Telegram's 429 with retry_after.
+type RetryAfterError struct {
+	After time.Duration
+	Err   error
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s: %v", e.After, e.Err)
+}
+
+func (e *RetryAfterError) Unwrap() error { return e.Err }
@@ -0,0 +1,25 @@
+package model
+
+import "strings"
+
+type Locale string
+
+const (
+	LocaleRU Locale = "ru"
+	LocaleEN Locale = "en"
+)
+
+// Locales lists the locales every notification template is provided in.
+var Locales = []Locale{LocaleRU, LocaleEN}
+
+// ParseLocale maps a language tag as reported by Telegram
+// (e.g. "en", "en-US", "ru") to a supported locale.
+func ParseLocale(tag string) (Locale, error) {
+	lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	for _, l := range Locales {
+		if string(l) == lang {
+			return l, nil
+		}
+	}
+	return "", ErrUnknownLocale
+}
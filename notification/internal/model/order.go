@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusCancelled      OrderStatus = "CANCELLED"
+)
+
+// Order is the view of an order the bot shows to its owner.
+type Order struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Status OrderStatus
+	// Total price formatted with 2 fraction digits, e.g. "123.45".
+	TotalPrice string
+	// Empty until the order is paid.
+	PaymentMethod string
+	// Zero if the order service does not report it.
+	CreatedAt time.Time
+}
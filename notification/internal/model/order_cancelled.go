@@ -0,0 +1,9 @@
+package model
+
+import "github.com/google/uuid"
+
+type CancelledOrder struct {
+	EventID uuid.UUID
+	OrderID uuid.UUID
+	UserID  uuid.UUID
+}
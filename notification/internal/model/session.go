@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserSession is the IAM session the bot calls the order API with on behalf
+// of the user who linked a Telegram chat.
+type UserSession struct {
+	UserID           uuid.UUID
+	SessionToken     string
+	RefreshToken     string
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventOrderPaid      WebhookEventType = "order.paid"
+	WebhookEventOrderAssembled WebhookEventType = "order.assembled"
+	WebhookEventOrderCancelled WebhookEventType = "order.cancelled"
+)
+
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+	// Nil means events of every user.
+	UserID              *uuid.UUID
+	Active              bool
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type CreateWebhookParams struct {
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+	UserID     *uuid.UUID
+}
+
+// UpdateWebhookParams holds a partial update; nil fields stay unchanged.
+type UpdateWebhookParams struct {
+	URL        *string
+	Secret     *string
+	EventTypes []WebhookEventType
+	Active     *bool
+}
+
+// WebhookEvent is an order lifecycle event ready to be delivered:
+// Payload is the exact JSON body that gets signed and sent.
+type WebhookEvent struct {
+	ID      uuid.UUID
+	Type    WebhookEventType
+	UserID  uuid.UUID
+	Payload []byte
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      WebhookEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	ResponseCode   *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookTask is a claimed delivery together with
+// the endpoint it has to be sent to.
+type WebhookTask struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// WebhookRequest is a signed request to a subscriber endpoint.
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	DeliveryID uuid.UUID
+	EventType  WebhookEventType
+	Payload    []byte
+}
+
+// WebhookPolicy controls retries and automatic disabling of endpoints.
+type WebhookPolicy struct {
+	// Maximum number of attempts per delivery.
+	MaxAttempts int
+	// Delay before the second attempt, doubled on every next one.
+	BaseDelay time.Duration
+	// Upper bound for the backoff delay.
+	MaxDelay time.Duration
+	// Consecutive failed attempts after which a subscription is disabled.
+	DisableAfter int
+	// How often due deliveries are polled and how many are taken at once.
+	PollInterval time.Duration
+	BatchSize    int
+	// How long a claimed delivery is hidden from other dispatchers.
+	Lease time.Duration
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+const (
+	deliveriesTable = "notification_deliveries"
+	pruneInterval   = time.Minute
+)
+
+// repository keeps the per-recipient delivery log in Postgres.
+// Entries older than retention are dropped on write, at most once
+// per prune interval.
+type repository struct {
+	pool      *pgxpool.Pool
+	sb        sq.StatementBuilderType
+	retention time.Duration
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+func NewDeliveryRepository(pool *pgxpool.Pool, retention time.Duration) *repository {
+	return &repository{
+		pool:      pool,
+		sb:        sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		retention: retention,
+	}
+}
+
+func (r *repository) Delivery(ctx context.Context, eventID uuid.UUID, rcpt model.Recipient) (model.Delivery, error) {
+	sqlStr, args, err := r.sb.
+		Select("status", "attempts", "last_error", "updated_at").
+		From(deliveriesTable).
+		Where(sq.Eq{"event_id": eventID, "channel": rcpt.Channel, "address": rcpt.Address}).
+		ToSql()
+	if err != nil {
+		return model.Delivery{}, err
+	}
+
+	d := model.Delivery{EventID: eventID, Recipient: rcpt}
+	err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(&d.Status, &d.Attempts, &d.LastError, &d.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Delivery{}, model.ErrDeliveryNotFound
+		}
+		return model.Delivery{}, err
+	}
+
+	return d, nil
+}
+
+func (r *repository) Save(ctx context.Context, d model.Delivery) error {
+	sqlStr, args, err := r.sb.
+		Insert(deliveriesTable).
+		Columns("event_id", "channel", "address", "status", "attempts", "last_error", "updated_at").
+		Values(d.EventID, d.Recipient.Channel, d.Recipient.Address, d.Status, d.Attempts, d.LastError, d.UpdatedAt).
+		Suffix(`ON CONFLICT (event_id, channel, address) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempts = EXCLUDED.attempts,
+			last_error = EXCLUDED.last_error,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, sqlStr, args...); err != nil {
+		return err
+	}
+
+	return r.prune(ctx, d.UpdatedAt)
+}
+
+func (r *repository) prune(ctx context.Context, now time.Time) error {
+	r.mu.Lock()
+	if r.retention <= 0 || now.Sub(r.prunedAt) < pruneInterval {
+		r.mu.Unlock()
+		return nil
+	}
+	r.prunedAt = now
+	r.mu.Unlock()
+
+	sqlStr, args, err := r.sb.
+		Delete(deliveriesTable).
+		Where(sq.Lt{"updated_at": now.Add(-r.retention)}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+const (
+	preferencesTable = "channel_preferences"
+	localesTable     = "user_locales"
+)
+
+var preferenceColumns = []string{"user_id", "channel", "address", "enabled", "updated_at"}
+
+// repository keeps user channel preferences and locales in Postgres.
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewPreferenceRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Save stores the preference of its user and channel. An address belongs to
+// a single user: relinking moves it from the previous owner.
+func (r *repository) Save(ctx context.Context, pref model.ChannelPreference) error {
+	del, delArgs, err := r.sb.
+		Delete(preferencesTable).
+		Where(sq.Eq{"channel": pref.Channel, "address": pref.Address}).
+		Where(sq.NotEq{"user_id": pref.UserID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	upsert, upsertArgs, err := r.sb.
+		Insert(preferencesTable).
+		Columns(preferenceColumns...).
+		Values(pref.UserID, pref.Channel, pref.Address, pref.Enabled, pref.UpdatedAt).
+		Suffix(`ON CONFLICT (user_id, channel) DO UPDATE SET
+			address = EXCLUDED.address,
+			enabled = EXCLUDED.enabled,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, del, delArgs...); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, upsert, upsertArgs...)
+		return err
+	})
+}
+
+func (r *repository) ByUser(ctx context.Context, userID uuid.UUID) ([]model.ChannelPreference, error) {
+	sqlStr, args, err := r.sb.
+		Select(preferenceColumns...).
+		From(preferencesTable).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("channel").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.ChannelPreference, error) {
+		var p model.ChannelPreference
+		err := row.Scan(&p.UserID, &p.Channel, &p.Address, &p.Enabled, &p.UpdatedAt)
+		return p, err
+	})
+}
+
+func (r *repository) UserByRecipient(ctx context.Context, rcpt model.Recipient) (uuid.UUID, error) {
+	sqlStr, args, err := r.sb.
+		Select("user_id").
+		From(preferencesTable).
+		Where(sq.Eq{"channel": rcpt.Channel, "address": rcpt.Address}).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var userID uuid.UUID
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, model.ErrUserNotLinked
+		}
+		return uuid.Nil, err
+	}
+
+	return userID, nil
+}
+
+func (r *repository) SaveLocale(ctx context.Context, userID uuid.UUID, locale model.Locale) error {
+	sqlStr, args, err := r.sb.
+		Insert(localesTable).
+		Columns("user_id", "locale").
+		Values(userID, locale).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			locale = EXCLUDED.locale,
+			updated_at = now()`).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// Locale returns the user's locale or an empty one if it is not known yet.
+func (r *repository) Locale(ctx context.Context, userID uuid.UUID) (model.Locale, error) {
+	sqlStr, args, err := r.sb.
+		Select("locale").
+		From(localesTable).
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var locale model.Locale
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&locale); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return locale, nil
+}
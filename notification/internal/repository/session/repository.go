@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+const sessionsTable = "user_sessions"
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewSessionRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Save stores the session of its user, replacing the previous one.
+func (r *repository) Save(ctx context.Context, sess model.UserSession) error {
+	q := r.sb.
+		Insert(sessionsTable).
+		Columns("user_id", "session_token", "refresh_token", "expires_at", "refresh_expires_at", "updated_at").
+		Values(sess.UserID, sess.SessionToken, sess.RefreshToken, sess.ExpiresAt, sess.RefreshExpiresAt, sess.UpdatedAt).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			session_token = EXCLUDED.session_token,
+			refresh_token = EXCLUDED.refresh_token,
+			expires_at = EXCLUDED.expires_at,
+			refresh_expires_at = EXCLUDED.refresh_expires_at,
+			updated_at = EXCLUDED.updated_at`)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// Session returns the session of the user, model.ErrUserNotLinked if the
+// user has none.
+func (r *repository) Session(ctx context.Context, userID uuid.UUID) (model.UserSession, error) {
+	q := r.sb.
+		Select("user_id", "session_token", "refresh_token", "expires_at", "refresh_expires_at", "updated_at").
+		From(sessionsTable).
+		Where(sq.Eq{"user_id": userID})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return model.UserSession{}, err
+	}
+
+	var sess model.UserSession
+	err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(
+		&sess.UserID,
+		&sess.SessionToken,
+		&sess.RefreshToken,
+		&sess.ExpiresAt,
+		&sess.RefreshExpiresAt,
+		&sess.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserSession{}, model.ErrUserNotLinked
+		}
+		return model.UserSession{}, err
+	}
+
+	return sess, nil
+}
@@ -0,0 +1,387 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+const (
+	subscriptionsTable = "webhook_subscriptions"
+	deliveriesTable    = "webhook_deliveries"
+)
+
+var subscriptionColumns = []string{
+	"id", "url", "secret", "event_types", "user_id", "active",
+	"consecutive_failures", "disabled_at", "created_at", "updated_at",
+}
+
+var deliveryColumns = []string{
+	"id", "subscription_id", "event_id", "event_type", "payload", "status",
+	"attempts", "response_code", "last_error", "next_attempt_at", "created_at", "delivered_at",
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+func NewWebhookRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *repository) CreateSubscription(
+	ctx context.Context,
+	sub model.WebhookSubscription,
+) (model.WebhookSubscription, error) {
+	q := r.sb.
+		Insert(subscriptionsTable).
+		Columns("url", "secret", "event_types", "user_id", "active").
+		Values(sub.URL, sub.Secret, eventTypesToDB(sub.EventTypes), sub.UserID, sub.Active).
+		Suffix("RETURNING " + columns(subscriptionColumns))
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return scanSubscription(r.pool.QueryRow(ctx, sqlStr, args...))
+}
+
+func (r *repository) Subscription(ctx context.Context, id uuid.UUID) (model.WebhookSubscription, error) {
+	q := r.sb.
+		Select(subscriptionColumns...).
+		From(subscriptionsTable).
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	sub, err := scanSubscription(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.WebhookSubscription{}, model.ErrSubscriptionNotFound
+		}
+		return model.WebhookSubscription{}, err
+	}
+
+	return sub, nil
+}
+
+func (r *repository) Subscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	q := r.sb.
+		Select(subscriptionColumns...).
+		From(subscriptionsTable).
+		OrderBy("created_at")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subs := make([]model.WebhookSubscription, 0)
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// UpdateSubscription overwrites the mutable fields of the subscription.
+func (r *repository) UpdateSubscription(
+	ctx context.Context,
+	sub model.WebhookSubscription,
+) (model.WebhookSubscription, error) {
+	q := r.sb.
+		Update(subscriptionsTable).
+		SetMap(sq.Eq{
+			"url":                  sub.URL,
+			"secret":               sub.Secret,
+			"event_types":          eventTypesToDB(sub.EventTypes),
+			"active":               sub.Active,
+			"consecutive_failures": sub.ConsecutiveFailures,
+			"disabled_at":          sub.DisabledAt,
+			"updated_at":           sq.Expr("now()"),
+		}).
+		Where(sq.Eq{"id": sub.ID}).
+		Suffix("RETURNING " + columns(subscriptionColumns))
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	updated, err := scanSubscription(r.pool.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.WebhookSubscription{}, model.ErrSubscriptionNotFound
+		}
+		return model.WebhookSubscription{}, err
+	}
+
+	return updated, nil
+}
+
+func (r *repository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	q := r.sb.
+		Delete(subscriptionsTable).
+		Where(sq.Eq{"id": id})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return model.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// Enqueue creates a pending delivery of the event for every active
+// subscription interested in it. Enqueuing the same event again is a no-op,
+// so a redelivered Kafka message is not sent twice.
+func (r *repository) Enqueue(ctx context.Context, event model.WebhookEvent) (int64, error) {
+	matching := r.sb.
+		Select("id").
+		Column(sq.Expr("?::uuid", event.ID)).
+		Column(sq.Expr("?::text", string(event.Type))).
+		Column(sq.Expr("?::bytea", event.Payload)).
+		From(subscriptionsTable).
+		Where(sq.Eq{"active": true}).
+		Where(sq.Expr("?::text = ANY(event_types)", string(event.Type))).
+		Where(sq.Or{sq.Eq{"user_id": nil}, sq.Eq{"user_id": event.UserID}})
+
+	q := r.sb.
+		Insert(deliveriesTable).
+		Columns("subscription_id", "event_id", "event_type", "payload").
+		Select(matching).
+		Suffix("ON CONFLICT (subscription_id, event_id) DO NOTHING")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	ct, err := r.pool.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
+
+// ClaimDue picks up to limit pending deliveries whose time has come and
+// hides them from other dispatchers until leaseUntil. Deliveries of disabled
+// subscriptions stay pending until the subscription is re-enabled.
+func (r *repository) ClaimDue(
+	ctx context.Context,
+	limit int,
+	leaseUntil time.Time,
+) ([]model.WebhookTask, error) {
+	const claim = `
+WITH due AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE d.status = 'PENDING' AND d.next_attempt_at <= now() AND s.active
+    ORDER BY d.next_attempt_at
+    LIMIT $1
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = $2
+FROM due, webhook_subscriptions s
+WHERE d.id = due.id AND s.id = d.subscription_id
+RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status,
+    d.attempts, d.response_code, d.last_error, d.next_attempt_at, d.created_at, d.delivered_at,
+    s.url, s.secret`
+
+	rows, err := r.pool.Query(ctx, claim, limit, leaseUntil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := make([]model.WebhookTask, 0, limit)
+	for rows.Next() {
+		var (
+			task model.WebhookTask
+			d    = &task.Delivery
+		)
+		if err := rows.Scan(
+			&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Payload, &d.Status,
+			&d.Attempts, &d.ResponseCode, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.DeliveredAt,
+			&task.URL, &task.Secret,
+		); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// SaveAttempt stores the outcome of a delivery attempt.
+func (r *repository) SaveAttempt(ctx context.Context, d model.WebhookDelivery) error {
+	q := r.sb.
+		Update(deliveriesTable).
+		SetMap(sq.Eq{
+			"status":          d.Status,
+			"attempts":        d.Attempts,
+			"response_code":   d.ResponseCode,
+			"last_error":      d.LastError,
+			"next_attempt_at": d.NextAttemptAt,
+			"delivered_at":    d.DeliveredAt,
+		}).
+		Where(sq.Eq{"id": d.ID})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+func (r *repository) ResetFailures(ctx context.Context, subID uuid.UUID) error {
+	q := r.sb.
+		Update(subscriptionsTable).
+		Set("consecutive_failures", 0).
+		Where(sq.Eq{"id": subID}).
+		Where(sq.NotEq{"consecutive_failures": 0})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// RegisterFailure increments the failure counter of an active subscription
+// and disables it once the counter reaches disableAfter. It reports whether
+// this failure disabled the subscription.
+func (r *repository) RegisterFailure(ctx context.Context, subID uuid.UUID, disableAfter int) (bool, error) {
+	q := r.sb.
+		Update(subscriptionsTable).
+		Set("consecutive_failures", sq.Expr("consecutive_failures + 1")).
+		Set("active", sq.Expr("consecutive_failures + 1 < ?", disableAfter)).
+		Set("disabled_at", sq.Expr("CASE WHEN consecutive_failures + 1 >= ? THEN now() END", disableAfter)).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": subID, "active": true}).
+		Suffix("RETURNING NOT active")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var disabled bool
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&disabled); err != nil {
+		// Already disabled (or deleted) concurrently: nothing to count.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return disabled, nil
+}
+
+// Deliveries returns the delivery history of the subscription, newest first.
+func (r *repository) Deliveries(ctx context.Context, subID uuid.UUID, limit int) ([]model.WebhookDelivery, error) {
+	q := r.sb.
+		Select(deliveryColumns...).
+		From(deliveriesTable).
+		Where(sq.Eq{"subscription_id": subID}).
+		OrderBy("created_at DESC").
+		Limit(uint64(limit)) //nolint:gosec // limit is validated by the service
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]model.WebhookDelivery, 0)
+	for rows.Next() {
+		var d model.WebhookDelivery
+		if err := rows.Scan(
+			&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Payload, &d.Status,
+			&d.Attempts, &d.ResponseCode, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func scanSubscription(row pgx.Row) (model.WebhookSubscription, error) {
+	var (
+		sub        model.WebhookSubscription
+		eventTypes []string
+	)
+	if err := row.Scan(
+		&sub.ID, &sub.URL, &sub.Secret, &eventTypes, &sub.UserID, &sub.Active,
+		&sub.ConsecutiveFailures, &sub.DisabledAt, &sub.CreatedAt, &sub.UpdatedAt,
+	); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	sub.EventTypes = make([]model.WebhookEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		sub.EventTypes = append(sub.EventTypes, model.WebhookEventType(t))
+	}
+
+	return sub, nil
+}
+
+func eventTypesToDB(types []model.WebhookEventType) []string {
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		out = append(out, string(t))
+	}
+	return out
+}
+
+func columns(cols []string) string {
+	return strings.Join(cols, ", ")
+}
@@ -2,6 +2,7 @@ package oaconsumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/you-humble/rocket-maintenance/notification/internal/model"
@@ -17,21 +18,29 @@ type ShipAssembledNotifier interface {
 	NotifyShipAssembled(ctx context.Context, event model.AssembledShip) error
 }
 
+// ShipAssembledPublisher enqueues webhook deliveries of the event.
+type ShipAssembledPublisher interface {
+	PublishShipAssembled(ctx context.Context, event model.AssembledShip) error
+}
+
 type ordAssembledConsumer struct {
 	consumer kafka.Consumer
 	conv     AssembledShipConverter
 	svc      ShipAssembledNotifier
+	webhooks ShipAssembledPublisher
 }
 
 func NewOrderAssembledConsumer(
 	consumer kafka.Consumer,
 	conv AssembledShipConverter,
 	svc ShipAssembledNotifier,
+	webhooks ShipAssembledPublisher,
 ) *ordAssembledConsumer {
 	return &ordAssembledConsumer{
 		consumer: consumer,
 		conv:     conv,
 		svc:      svc,
+		webhooks: webhooks,
 	}
 }
 
@@ -53,10 +62,17 @@ func (s *ordAssembledConsumer) orderAssembledHandler(ctx context.Context, msg ka
 		return fmt.Errorf("converter assembled_ship_to_model error: %w", err)
 	}
 
+	// Webhooks are only enqueued here, so they go first and do not wait
+	// for user notifications. Both are idempotent on redelivery.
+	var errs []error
+	if err := s.webhooks.PublishShipAssembled(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to publish AssembledShip webhooks", logger.ErrorF(err))
+		errs = append(errs, err)
+	}
 	if err := s.svc.NotifyShipAssembled(ctx, event); err != nil {
 		logger.Error(ctx, "Failed to notify about AssembledShip", logger.ErrorF(err))
-		return err
+		errs = append(errs, err)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
@@ -0,0 +1,63 @@
+package occonsumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/kafka"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+type CancelledOrderConverter interface {
+	CancelledOrderToModel(data []byte) (model.CancelledOrder, error)
+}
+
+// OrderCancelledPublisher enqueues webhook deliveries of the event.
+type OrderCancelledPublisher interface {
+	PublishCancelledOrder(ctx context.Context, event model.CancelledOrder) error
+}
+
+type ordCancelledConsumer struct {
+	consumer kafka.Consumer
+	conv     CancelledOrderConverter
+	webhooks OrderCancelledPublisher
+}
+
+func NewOrderCancelledConsumer(
+	consumer kafka.Consumer,
+	conv CancelledOrderConverter,
+	webhooks OrderCancelledPublisher,
+) *ordCancelledConsumer {
+	return &ordCancelledConsumer{
+		consumer: consumer,
+		conv:     conv,
+		webhooks: webhooks,
+	}
+}
+
+func (s *ordCancelledConsumer) RunOrderCancelledConsume(ctx context.Context) error {
+	logger.Info(ctx, "Starting order cancelled consumer")
+
+	if err := s.consumer.Consume(ctx, s.orderCancelledHandler); err != nil {
+		logger.Error(ctx, "Consume from order.cancelled topic error", logger.ErrorF(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *ordCancelledConsumer) orderCancelledHandler(ctx context.Context, msg kafka.Message) error {
+	event, err := s.conv.CancelledOrderToModel(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderCancelledRecord", logger.ErrorF(err))
+		return fmt.Errorf("converter cancelled_order_to_model error: %w", err)
+	}
+
+	if err := s.webhooks.PublishCancelledOrder(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to publish OrderCancelled webhooks", logger.ErrorF(err))
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package opconsumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/you-humble/rocket-maintenance/notification/internal/model"
@@ -17,21 +18,29 @@ type OrderPaidNotifier interface {
 	NotifyPaidOrder(ctx context.Context, event model.PaidOrder) error
 }
 
+// OrderPaidPublisher enqueues webhook deliveries of the event.
+type OrderPaidPublisher interface {
+	PublishPaidOrder(ctx context.Context, event model.PaidOrder) error
+}
+
 type ordPaidConsumer struct {
 	consumer kafka.Consumer
 	conv     PaidOrderConverter
 	svc      OrderPaidNotifier
+	webhooks OrderPaidPublisher
 }
 
 func NewOrderPaidConsumer(
 	consumer kafka.Consumer,
 	conv PaidOrderConverter,
 	svc OrderPaidNotifier,
+	webhooks OrderPaidPublisher,
 ) *ordPaidConsumer {
 	return &ordPaidConsumer{
 		consumer: consumer,
 		conv:     conv,
 		svc:      svc,
+		webhooks: webhooks,
 	}
 }
 
@@ -53,10 +62,17 @@ func (s *ordPaidConsumer) orderAssembledHandler(ctx context.Context, msg kafka.M
 		return fmt.Errorf("converter paid_order_to_model error: %w", err)
 	}
 
+	// Webhooks are only enqueued here, so they go first and do not wait
+	// for user notifications. Both are idempotent on redelivery.
+	var errs []error
+	if err := s.webhooks.PublishPaidOrder(ctx, event); err != nil {
+		logger.Error(ctx, "Failed to publish OrderPaid webhooks", logger.ErrorF(err))
+		errs = append(errs, err)
+	}
 	if err := s.svc.NotifyPaidOrder(ctx, event); err != nil {
 		logger.Error(ctx, "Failed to notify about OrderPaid", logger.ErrorF(err))
-		return err
+		errs = append(errs, err)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
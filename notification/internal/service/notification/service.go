@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// recordTimeout bounds a write to the delivery log.
+const recordTimeout = 5 * time.Second
+
+// Sender delivers a rendered message to an address within its channel.
+type Sender interface {
+	Send(ctx context.Context, address string, msg model.Message) error
+}
+
+// RateLimiter is shared by all recipients of a channel so the service
+// stays within the channel's global send limits.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Channel is a delivery medium notifications can be routed to.
+type Channel struct {
+	Sender  Sender
+	Limiter RateLimiter
+}
+
+// RecipientRepository gives the channels a user is reachable on and the
+// locale to write to them in.
+type RecipientRepository interface {
+	ByUser(ctx context.Context, userID uuid.UUID) ([]model.ChannelPreference, error)
+	Locale(ctx context.Context, userID uuid.UUID) (model.Locale, error)
+}
+
+// Renderer builds a message from the templates of the given locale.
+type Renderer interface {
+	BuildPaidOrder(locale model.Locale, event model.PaidOrder) (model.Message, error)
+	BuildShipAssembled(locale model.Locale, event model.AssembledShip) (model.Message, error)
+}
+
+// DeliveryLog remembers per recipient how far an event got. Delivery gives
+// model.ErrDeliveryNotFound for a recipient the event was never sent to.
+type DeliveryLog interface {
+	Delivery(ctx context.Context, eventID uuid.UUID, rcpt model.Recipient) (model.Delivery, error)
+	Save(ctx context.Context, d model.Delivery) error
+}
+
+type service struct {
+	channels    map[model.Channel]Channel
+	renderer    Renderer
+	preferences RecipientRepository
+	deliveries  DeliveryLog
+	policy      model.DeliveryPolicy
+}
+
+func NewNotificationService(
+	channels map[model.Channel]Channel,
+	renderer Renderer,
+	preferences RecipientRepository,
+	deliveries DeliveryLog,
+	policy model.DeliveryPolicy,
+) *service {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.Concurrency < 1 {
+		policy.Concurrency = 1
+	}
+
+	return &service{
+		channels:    channels,
+		renderer:    renderer,
+		preferences: preferences,
+		deliveries:  deliveries,
+		policy:      policy,
+	}
+}
+
+func (svc *service) NotifyShipAssembled(ctx context.Context, event model.AssembledShip) error {
+	const op = "notification.service.NotifyShipAssembled"
+
+	locale, err := svc.preferences.Locale(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	msg, err := svc.renderer.BuildShipAssembled(locale, event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.notify(ctx, event.EventID, event.UserID, msg); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (svc *service) NotifyPaidOrder(ctx context.Context, event model.PaidOrder) error {
+	const op = "notification.service.NotifyPaidOrder"
+
+	locale, err := svc.preferences.Locale(ctx, event.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	msg, err := svc.renderer.BuildPaidOrder(locale, event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.notify(ctx, event.EventID, event.UserID, msg); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// notify delivers msg to every enabled channel of the user independently.
+// Recipients that already got (or can never get) this event are skipped,
+// so a redelivered event only reaches the ones still pending. It fails with
+// model.ErrDeliveryIncomplete only when some recipient is still pending.
+func (svc *service) notify(ctx context.Context, eventID, userID uuid.UUID, msg model.Message) error {
+	recipients, err := svc.recipients(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		logger.Debug(ctx, "User has no enabled channels", logger.String("user_id", userID.String()))
+		return nil
+	}
+
+	var (
+		mu      sync.Mutex
+		pending int
+	)
+
+	g := new(errgroup.Group)
+	g.SetLimit(svc.policy.Concurrency)
+	for _, rcpt := range recipients {
+		d, err := svc.deliveries.Delivery(ctx, eventID, rcpt)
+		switch {
+		case errors.Is(err, model.ErrDeliveryNotFound):
+			d = model.Delivery{EventID: eventID, Recipient: rcpt}
+		case err != nil:
+			_ = g.Wait()
+			return fmt.Errorf("delivery log: %w", err)
+		case d.Done():
+			continue
+		}
+
+		g.Go(func() error {
+			if svc.deliver(ctx, d, msg).Status == model.DeliveryStatusPending {
+				mu.Lock()
+				pending++
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if pending > 0 {
+		return fmt.Errorf("%d recipient(s) pending: %w", pending, model.ErrDeliveryIncomplete)
+	}
+
+	return nil
+}
+
+func (svc *service) recipients(ctx context.Context, userID uuid.UUID) ([]model.Recipient, error) {
+	prefs, err := svc.preferences.ByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]model.Recipient, 0, len(prefs))
+	for _, p := range prefs {
+		if !p.Enabled || p.Address == "" {
+			continue
+		}
+		if _, ok := svc.channels[p.Channel]; !ok {
+			continue
+		}
+		recipients = append(recipients, p.Recipient())
+	}
+
+	return recipients, nil
+}
+
+// deliver retries a single recipient with exponential backoff until the
+// message is sent, the error is permanent or attempts are exhausted.
+// Exhausted attempts count as a permanent failure; only a cancelled
+// context leaves the delivery pending.
+func (svc *service) deliver(ctx context.Context, d model.Delivery, msg model.Message) model.Delivery {
+	ch := svc.channels[d.Recipient.Channel]
+	log := logger.With(
+		logger.String("event_id", d.EventID.String()),
+		logger.String("channel", string(d.Recipient.Channel)),
+		logger.String("address", d.Recipient.Address),
+	)
+
+	for attempt := 1; attempt <= svc.policy.MaxAttempts; attempt++ {
+		if err := ch.Limiter.Wait(ctx); err != nil {
+			return svc.record(ctx, d, model.DeliveryStatusPending, err)
+		}
+
+		d.Attempts++
+		err := ch.Sender.Send(ctx, d.Recipient.Address, msg)
+		switch {
+		case err == nil:
+			return svc.record(ctx, d, model.DeliveryStatusDelivered, nil)
+		case errors.Is(err, model.ErrPermanentDelivery):
+			log.Warn(ctx, "Permanent delivery failure", logger.ErrorF(err))
+			return svc.record(ctx, d, model.DeliveryStatusFailed, err)
+		case ctx.Err() != nil:
+			return svc.record(ctx, d, model.DeliveryStatusPending, err)
+		case attempt == svc.policy.MaxAttempts:
+			log.Error(ctx, "Delivery attempts exhausted",
+				logger.Int("attempts", d.Attempts), logger.ErrorF(err))
+			return svc.record(ctx, d, model.DeliveryStatusFailed, err)
+		}
+
+		delay := svc.backoff(attempt)
+		var retryAfter *model.RetryAfterError
+		if errors.As(err, &retryAfter) && retryAfter.After > delay {
+			delay = retryAfter.After
+		}
+
+		log.Warn(ctx, "Delivery attempt failed, retrying",
+			logger.Int("attempt", attempt), logger.Duration("delay", delay), logger.ErrorF(err))
+		d = svc.record(ctx, d, model.DeliveryStatusPending, err)
+
+		if err := wait(ctx, delay); err != nil {
+			return d
+		}
+	}
+
+	return d
+}
+
+func (svc *service) record(
+	ctx context.Context,
+	d model.Delivery,
+	status model.DeliveryStatus,
+	err error,
+) model.Delivery {
+	d.Status = status
+	d.LastError = ""
+	if err != nil {
+		d.LastError = err.Error()
+	}
+	d.UpdatedAt = time.Now()
+
+	// The entry is saved even when ctx is cancelled: it is what keeps a
+	// redelivered event from reaching the recipient again.
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+
+	if err := svc.deliveries.Save(saveCtx, d); err != nil {
+		logger.Error(ctx, "Failed to record delivery",
+			logger.String("event_id", d.EventID.String()),
+			logger.String("channel", string(d.Recipient.Channel)),
+			logger.String("status", string(d.Status)),
+			logger.ErrorF(err),
+		)
+	}
+	return d
+}
+
+func (svc *service) backoff(attempt int) time.Duration {
+	delay := svc.policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > svc.policy.MaxDelay {
+		return svc.policy.MaxDelay
+	}
+	return delay
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
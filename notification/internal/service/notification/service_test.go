@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/notification/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	chatAddress  = "100"
+	emailAddress = "user@example.com"
+)
+
+var (
+	errTransient = errors.New("connection reset")
+
+	testPolicy = model.DeliveryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+		Concurrency: 2,
+	}
+
+	paidMsg = model.Message{Subject: "Заказ оплачен", Text: "Заказ оплачен."}
+)
+
+type deps struct {
+	telegram   *mocks.MockSender
+	email      *mocks.MockSender
+	limiter    *mocks.MockRateLimiter
+	renderer   *mocks.MockRenderer
+	recipients *mocks.MockRecipientRepository
+	deliveries *mocks.MockDeliveryLog
+}
+
+func newDeps(t *testing.T) deps {
+	d := deps{
+		telegram:   mocks.NewMockSender(t),
+		email:      mocks.NewMockSender(t),
+		limiter:    mocks.NewMockRateLimiter(t),
+		renderer:   mocks.NewMockRenderer(t),
+		recipients: mocks.NewMockRecipientRepository(t),
+		deliveries: mocks.NewMockDeliveryLog(t),
+	}
+	d.limiter.
+		On("Wait", mock.Anything).
+		Return(nil).
+		Maybe()
+
+	return d
+}
+
+func newSvc(d deps, policy model.DeliveryPolicy) *service {
+	return NewNotificationService(
+		map[model.Channel]Channel{
+			model.ChannelTelegram: {Sender: d.telegram, Limiter: d.limiter},
+			model.ChannelEmail:    {Sender: d.email, Limiter: d.limiter},
+		},
+		d.renderer,
+		d.recipients,
+		d.deliveries,
+		policy,
+	)
+}
+
+func chat(userID uuid.UUID) model.ChannelPreference {
+	return model.ChannelPreference{UserID: userID, Channel: model.ChannelTelegram, Address: chatAddress, Enabled: true}
+}
+
+func email(userID uuid.UUID) model.ChannelPreference {
+	return model.ChannelPreference{UserID: userID, Channel: model.ChannelEmail, Address: emailAddress, Enabled: true}
+}
+
+// paid makes the user of event reachable on prefs and the event render to
+// paidMsg.
+func (d deps) paid(event model.PaidOrder, prefs ...model.ChannelPreference) {
+	d.recipients.
+		On("Locale", mock.Anything, event.UserID).
+		Return(model.LocaleRU, nil).
+		Once()
+	d.renderer.
+		On("BuildPaidOrder", model.LocaleRU, event).
+		Return(paidMsg, nil).
+		Once()
+	d.recipients.
+		On("ByUser", mock.Anything, event.UserID).
+		Return(prefs, nil).
+		Once()
+}
+
+// logged makes the delivery log return d for its recipient, or
+// model.ErrDeliveryNotFound for a zero d.
+func (d deps) logged(eventID uuid.UUID, rcpt model.Recipient, delivery model.Delivery) {
+	err := error(nil)
+	if delivery.Status == "" {
+		err = model.ErrDeliveryNotFound
+	}
+	d.deliveries.
+		On("Delivery", mock.Anything, eventID, rcpt).
+		Return(delivery, err).
+		Once()
+}
+
+// saved expects a delivery of rcpt to be recorded with status after
+// attempts.
+func (d deps) saved(eventID uuid.UUID, rcpt model.Recipient, status model.DeliveryStatus, attempts int) {
+	d.deliveries.
+		On("Save", mock.Anything, mock.MatchedBy(func(got model.Delivery) bool {
+			return got.EventID == eventID &&
+				got.Recipient == rcpt &&
+				got.Status == status &&
+				got.Attempts == attempts &&
+				!got.UpdatedAt.IsZero()
+		})).
+		Return(nil).
+		Once()
+}
+
+func TestServiceNotifyPaidOrder(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	t.Run("success: routed to every enabled channel", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg, mail := chat(event.UserID), email(event.UserID)
+		d.paid(event, tg, mail)
+		for _, p := range []model.ChannelPreference{tg, mail} {
+			d.logged(event.EventID, p.Recipient(), model.Delivery{})
+			d.saved(event.EventID, p.Recipient(), model.DeliveryStatusDelivered, 1)
+		}
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Return(nil).
+			Once()
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+	})
+
+	t.Run("success: disabled, blank and unknown channels are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		disabled, blank := email(event.UserID), chat(event.UserID)
+		disabled.Enabled = false
+		blank.Address = ""
+		unknown := model.ChannelPreference{UserID: event.UserID, Channel: "sms", Address: "+70000000000", Enabled: true}
+		d.paid(event, disabled, blank, unknown)
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+		d.deliveries.AssertNotCalled(t, "Delivery", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed: a permanent failure of one channel does not affect the others", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg, mail := chat(event.UserID), email(event.UserID)
+		d.paid(event, tg, mail)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+		d.logged(event.EventID, mail.Recipient(), model.Delivery{})
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(fmt.Errorf("chat not found: %w", model.ErrPermanentDelivery)).
+			Once()
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusFailed, 1)
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusDelivered, 1)
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+	})
+
+	t.Run("success: a transient failure is retried and every attempt recorded", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		mail := email(event.UserID)
+		d.paid(event, mail)
+		d.logged(event.EventID, mail.Recipient(), model.Delivery{})
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Return(errTransient).
+			Twice()
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusPending, 1)
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusPending, 2)
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusDelivered, 3)
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+	})
+
+	t.Run("success: retry_after overrides the backoff", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg := chat(event.UserID)
+		d.paid(event, tg)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(&model.RetryAfterError{After: 30 * time.Millisecond, Err: errTransient}).
+			Once()
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusPending, 1)
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusDelivered, 2)
+
+		start := time.Now()
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+		assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	})
+
+	t.Run("failed: exhausted attempts are recorded as failed", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg := chat(event.UserID)
+		d.paid(event, tg)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(errTransient).
+			Times(testPolicy.MaxAttempts)
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusPending, 1)
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusPending, 2)
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusFailed, 3)
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+	})
+
+	t.Run("success: a failure to record the delivery does not fail the event", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg := chat(event.UserID)
+		d.paid(event, tg)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.deliveries.
+			On("Save", mock.Anything, mock.Anything).
+			Return(errors.New("connection refused")).
+			Once()
+
+		require.NoError(t, newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event))
+	})
+
+	t.Run("error: the delivery log fails", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg := chat(event.UserID)
+		d.paid(event, tg)
+		errLog := errors.New("connection refused")
+		d.deliveries.
+			On("Delivery", mock.Anything, event.EventID, tg.Recipient()).
+			Return(model.Delivery{}, errLog).
+			Once()
+
+		err := newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event)
+		require.ErrorIs(t, err, errLog)
+		d.telegram.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error: the message cannot be rendered", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		errRender := errors.New("template: missing key")
+		d.recipients.
+			On("Locale", mock.Anything, event.UserID).
+			Return(model.LocaleEN, nil).
+			Once()
+		d.renderer.
+			On("BuildPaidOrder", model.LocaleEN, event).
+			Return(model.Message{}, errRender).
+			Once()
+
+		err := newSvc(d, testPolicy).NotifyPaidOrder(context.Background(), event)
+		require.ErrorIs(t, err, errRender)
+		d.recipients.AssertNotCalled(t, "ByUser", mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceRedelivery(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	sequential := testPolicy
+	sequential.Concurrency = 1
+
+	t.Run("incomplete: a cancelled send leaves the recipient pending", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.AssembledShip{EventID: uuid.New(), UserID: uuid.New()}
+		tg, mail := chat(event.UserID), email(event.UserID)
+		d.recipients.
+			On("Locale", mock.Anything, event.UserID).
+			Return(model.LocaleRU, nil).
+			Once()
+		d.renderer.
+			On("BuildShipAssembled", model.LocaleRU, event).
+			Return(paidMsg, nil).
+			Once()
+		d.recipients.
+			On("ByUser", mock.Anything, event.UserID).
+			Return([]model.ChannelPreference{tg, mail}, nil).
+			Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+		d.telegram.
+			On("Send", mock.Anything, chatAddress, paidMsg).
+			Return(nil).
+			Once()
+		d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusDelivered, 1)
+		d.logged(event.EventID, mail.Recipient(), model.Delivery{})
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Run(func(mock.Arguments) { cancel() }).
+			Return(context.Canceled).
+			Once()
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusPending, 1)
+
+		err := newSvc(d, sequential).NotifyShipAssembled(ctx, event)
+		require.ErrorIs(t, err, model.ErrDeliveryIncomplete)
+	})
+
+	t.Run("success: only the pending recipients get a redelivered event", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg, mail := chat(event.UserID), email(event.UserID)
+		d.paid(event, tg, mail)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{
+			EventID:   event.EventID,
+			Recipient: tg.Recipient(),
+			Status:    model.DeliveryStatusDelivered,
+			Attempts:  1,
+		})
+		d.logged(event.EventID, mail.Recipient(), model.Delivery{
+			EventID:   event.EventID,
+			Recipient: mail.Recipient(),
+			Status:    model.DeliveryStatusPending,
+			Attempts:  1,
+		})
+		d.email.
+			On("Send", mock.Anything, emailAddress, paidMsg).
+			Return(nil).
+			Once()
+		// The attempts go on from the logged ones.
+		d.saved(event.EventID, mail.Recipient(), model.DeliveryStatusDelivered, 2)
+
+		require.NoError(t, newSvc(d, sequential).NotifyPaidOrder(context.Background(), event))
+		d.telegram.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success: a failed recipient is not retried", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		event := model.PaidOrder{EventID: uuid.New(), UserID: uuid.New()}
+		tg := chat(event.UserID)
+		d.paid(event, tg)
+		d.logged(event.EventID, tg.Recipient(), model.Delivery{
+			EventID:   event.EventID,
+			Recipient: tg.Recipient(),
+			Status:    model.DeliveryStatusFailed,
+			Attempts:  1,
+		})
+
+		require.NoError(t, newSvc(d, sequential).NotifyPaidOrder(context.Background(), event))
+		d.telegram.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceNotifyUsesUserLocale(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	d := newDeps(t)
+	event := model.AssembledShip{EventID: uuid.New(), UserID: uuid.New()}
+	tg := chat(event.UserID)
+	shipMsg := model.Message{Subject: "Ship assembled"}
+	d.recipients.
+		On("Locale", mock.Anything, event.UserID).
+		Return(model.LocaleEN, nil).
+		Once()
+	d.renderer.
+		On("BuildShipAssembled", model.LocaleEN, event).
+		Return(shipMsg, nil).
+		Once()
+	d.recipients.
+		On("ByUser", mock.Anything, event.UserID).
+		Return([]model.ChannelPreference{tg}, nil).
+		Once()
+	d.logged(event.EventID, tg.Recipient(), model.Delivery{})
+	d.telegram.
+		On("Send", mock.Anything, chatAddress, shipMsg).
+		Return(nil).
+		Once()
+	d.saved(event.EventID, tg.Recipient(), model.DeliveryStatusDelivered, 1)
+
+	require.NoError(t, newSvc(d, testPolicy).NotifyShipAssembled(context.Background(), event))
+}
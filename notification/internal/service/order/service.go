@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+// OrderClient calls the order API with the session token of a user, so
+// only that user's orders are visible.
+type OrderClient interface {
+	Orders(ctx context.Context, token string, limit int) ([]model.Order, error)
+	Order(ctx context.Context, token string, orderID uuid.UUID) (model.Order, error)
+	Cancel(ctx context.Context, token string, orderID uuid.UUID) error
+}
+
+type UserResolver interface {
+	UserByRecipient(ctx context.Context, rcpt model.Recipient) (uuid.UUID, error)
+}
+
+type SessionRepository interface {
+	Session(ctx context.Context, userID uuid.UUID) (model.UserSession, error)
+	Save(ctx context.Context, sess model.UserSession) error
+}
+
+type SessionRefresher interface {
+	RefreshSession(ctx context.Context, refreshToken string) (model.UserSession, error)
+}
+
+type service struct {
+	orders    OrderClient
+	users     UserResolver
+	sessions  SessionRepository
+	iam       SessionRefresher
+	listLimit int
+
+	// refreshMu serializes refreshes: IAM revokes a session whose refresh
+	// token is exchanged twice.
+	refreshMu sync.Mutex
+}
+
+// NewOrderService serves order requests coming from Telegram chats on
+// behalf of the users linked to them. listLimit caps the number of orders
+// RecentOrders returns.
+func NewOrderService(
+	orders OrderClient,
+	users UserResolver,
+	sessions SessionRepository,
+	iam SessionRefresher,
+	listLimit int,
+) *service {
+	return &service{
+		orders:    orders,
+		users:     users,
+		sessions:  sessions,
+		iam:       iam,
+		listLimit: listLimit,
+	}
+}
+
+// RecentOrders returns the latest orders of the user linked to the chat.
+func (svc *service) RecentOrders(ctx context.Context, chatID int64) ([]model.Order, error) {
+	const op = "order.service.RecentOrders"
+
+	var orders []model.Order
+	err := svc.asUser(ctx, chatID, func(token string) error {
+		var err error
+		orders, err = svc.orders.Orders(ctx, token, svc.listLimit)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orders, nil
+}
+
+// Order returns an order of the user linked to the chat.
+// Orders of other users are reported as not found.
+func (svc *service) Order(ctx context.Context, chatID int64, orderID uuid.UUID) (model.Order, error) {
+	const op = "order.service.Order"
+
+	var ord model.Order
+	err := svc.asUser(ctx, chatID, func(token string) error {
+		var err error
+		ord, err = svc.orders.Order(ctx, token, orderID)
+		return err
+	})
+	if err != nil {
+		return model.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ord, nil
+}
+
+// Cancel cancels an order of the user linked to the chat. Only orders
+// awaiting payment can be cancelled.
+func (svc *service) Cancel(ctx context.Context, chatID int64, orderID uuid.UUID) error {
+	const op = "order.service.Cancel"
+
+	err := svc.asUser(ctx, chatID, func(token string) error {
+		return svc.orders.Cancel(ctx, token, orderID)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// asUser calls fn with the session token of the user linked to the chat.
+// An expired session is refreshed first, and a token the order service
+// rejects is refreshed and retried once. A session that cannot be refreshed
+// any more gives model.ErrUserNotLinked: the user has to link the chat again.
+func (svc *service) asUser(ctx context.Context, chatID int64, fn func(token string) error) error {
+	userID, err := svc.users.UserByRecipient(ctx, telegramRecipient(chatID))
+	if err != nil {
+		return err
+	}
+
+	sess, err := svc.sessions.Session(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !time.Now().Before(sess.ExpiresAt) {
+		if sess, err = svc.refresh(ctx, sess); err != nil {
+			return err
+		}
+	}
+
+	err = fn(sess.SessionToken)
+	if !errors.Is(err, model.ErrUnauthorized) {
+		return err
+	}
+
+	if sess, err = svc.refresh(ctx, sess); err != nil {
+		return err
+	}
+
+	return fn(sess.SessionToken)
+}
+
+// refresh replaces the stale session of a user with a refreshed one, unless
+// a concurrent call has already done so.
+func (svc *service) refresh(ctx context.Context, stale model.UserSession) (model.UserSession, error) {
+	svc.refreshMu.Lock()
+	defer svc.refreshMu.Unlock()
+
+	cur, err := svc.sessions.Session(ctx, stale.UserID)
+	if err != nil {
+		return model.UserSession{}, err
+	}
+	if cur.SessionToken != stale.SessionToken {
+		return cur, nil
+	}
+
+	sess, err := svc.iam.RefreshSession(ctx, cur.RefreshToken)
+	switch {
+	case errors.Is(err, model.ErrUnauthorized):
+		return model.UserSession{}, fmt.Errorf("refresh session: %w", model.ErrUserNotLinked)
+	case err != nil:
+		return model.UserSession{}, fmt.Errorf("refresh session: %w", err)
+	}
+
+	if err := svc.sessions.Save(ctx, sess); err != nil {
+		return model.UserSession{}, err
+	}
+
+	return sess, nil
+}
+
+func telegramRecipient(chatID int64) model.Recipient {
+	return model.Recipient{Channel: model.ChannelTelegram, Address: strconv.FormatInt(chatID, 10)}
+}
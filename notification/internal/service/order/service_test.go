@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/notification/internal/service/mocks"
+)
+
+const chatID int64 = 100
+
+type deps struct {
+	orders   *mocks.MockOrderClient
+	users    *mocks.MockUserResolver
+	sessions *mocks.MockSessionRepository
+	iam      *mocks.MockSessionRefresher
+}
+
+func newDeps(t *testing.T) deps {
+	return deps{
+		orders:   mocks.NewMockOrderClient(t),
+		users:    mocks.NewMockUserResolver(t),
+		sessions: mocks.NewMockSessionRepository(t),
+		iam:      mocks.NewMockSessionRefresher(t),
+	}
+}
+
+func newSvc(d deps) *service {
+	return NewOrderService(d.orders, d.users, d.sessions, d.iam, 10)
+}
+
+// linked makes the chat resolve to a user with the session.
+func (d deps) linked(sess model.UserSession) {
+	d.users.
+		On("UserByRecipient", mock.Anything, telegramRecipient(chatID)).
+		Return(sess.UserID, nil)
+	d.sessions.
+		On("Session", mock.Anything, sess.UserID).
+		Return(sess, nil).
+		Once()
+}
+
+func activeSession() model.UserSession {
+	return model.UserSession{
+		UserID:           uuid.New(),
+		SessionToken:     "session-1",
+		RefreshToken:     "refresh-1",
+		ExpiresAt:        time.Now().Add(time.Minute),
+		RefreshExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func refreshedSession(userID uuid.UUID) model.UserSession {
+	return model.UserSession{
+		UserID:           userID,
+		SessionToken:     "session-2",
+		RefreshToken:     "refresh-2",
+		ExpiresAt:        time.Now().Add(time.Minute),
+		RefreshExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestServiceRecentOrders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success: orders are listed with the user's session token", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		want := []model.Order{{ID: uuid.New(), UserID: sess.UserID, Status: model.OrderStatusPaid}}
+		d.orders.
+			On("Orders", mock.Anything, sess.SessionToken, 10).
+			Return(want, nil).
+			Once()
+
+		got, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("not linked: a chat without a user", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.users.
+			On("UserByRecipient", mock.Anything, telegramRecipient(chatID)).
+			Return(uuid.Nil, model.ErrUserNotLinked).
+			Once()
+
+		_, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.ErrorIs(t, err, model.ErrUserNotLinked)
+		d.orders.AssertNotCalled(t, "Orders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success: an expired session is refreshed before the call", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		sess.ExpiresAt = time.Now().Add(-time.Second)
+		d.linked(sess)
+		d.sessions.
+			On("Session", mock.Anything, sess.UserID).
+			Return(sess, nil).
+			Once()
+		fresh := refreshedSession(sess.UserID)
+		d.iam.
+			On("RefreshSession", mock.Anything, sess.RefreshToken).
+			Return(fresh, nil).
+			Once()
+		d.sessions.
+			On("Save", mock.Anything, fresh).
+			Return(nil).
+			Once()
+		d.orders.
+			On("Orders", mock.Anything, fresh.SessionToken, 10).
+			Return([]model.Order{}, nil).
+			Once()
+
+		_, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.NoError(t, err)
+	})
+
+	t.Run("success: a rejected token is refreshed and the call retried once", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		d.orders.
+			On("Orders", mock.Anything, sess.SessionToken, 10).
+			Return(nil, model.ErrUnauthorized).
+			Once()
+		d.sessions.
+			On("Session", mock.Anything, sess.UserID).
+			Return(sess, nil).
+			Once()
+		fresh := refreshedSession(sess.UserID)
+		d.iam.
+			On("RefreshSession", mock.Anything, sess.RefreshToken).
+			Return(fresh, nil).
+			Once()
+		d.sessions.
+			On("Save", mock.Anything, fresh).
+			Return(nil).
+			Once()
+		d.orders.
+			On("Orders", mock.Anything, fresh.SessionToken, 10).
+			Return([]model.Order{}, nil).
+			Once()
+
+		_, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.NoError(t, err)
+	})
+
+	t.Run("success: a session refreshed concurrently is reused", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		d.orders.
+			On("Orders", mock.Anything, sess.SessionToken, 10).
+			Return(nil, model.ErrUnauthorized).
+			Once()
+		fresh := refreshedSession(sess.UserID)
+		d.sessions.
+			On("Session", mock.Anything, sess.UserID).
+			Return(fresh, nil).
+			Once()
+		d.orders.
+			On("Orders", mock.Anything, fresh.SessionToken, 10).
+			Return([]model.Order{}, nil).
+			Once()
+
+		_, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.NoError(t, err)
+		d.iam.AssertNotCalled(t, "RefreshSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not linked: IAM rejects the refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		d.orders.
+			On("Orders", mock.Anything, sess.SessionToken, 10).
+			Return(nil, model.ErrUnauthorized).
+			Once()
+		d.sessions.
+			On("Session", mock.Anything, sess.UserID).
+			Return(sess, nil).
+			Once()
+		d.iam.
+			On("RefreshSession", mock.Anything, sess.RefreshToken).
+			Return(model.UserSession{}, model.ErrUnauthorized).
+			Once()
+
+		_, err := newSvc(d).RecentOrders(context.Background(), chatID)
+		require.ErrorIs(t, err, model.ErrUserNotLinked)
+		d.sessions.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		want := model.Order{ID: uuid.New(), UserID: sess.UserID, Status: model.OrderStatusPaid}
+		d.orders.
+			On("Order", mock.Anything, sess.SessionToken, want.ID).
+			Return(want, nil).
+			Once()
+
+		got, err := newSvc(d).Order(context.Background(), chatID, want.ID)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("not found: an order of another user", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		orderID := uuid.New()
+		d.orders.
+			On("Order", mock.Anything, sess.SessionToken, orderID).
+			Return(model.Order{}, model.ErrOrderNotFound).
+			Once()
+
+		_, err := newSvc(d).Order(context.Background(), chatID, orderID)
+		require.ErrorIs(t, err, model.ErrOrderNotFound)
+	})
+}
+
+func TestServiceCancel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		orderID := uuid.New()
+		d.orders.
+			On("Cancel", mock.Anything, sess.SessionToken, orderID).
+			Return(nil).
+			Once()
+
+		require.NoError(t, newSvc(d).Cancel(context.Background(), chatID, orderID))
+	})
+
+	t.Run("not cancellable: the order service refuses", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		orderID := uuid.New()
+		d.orders.
+			On("Cancel", mock.Anything, sess.SessionToken, orderID).
+			Return(model.ErrOrderNotCancellable).
+			Once()
+
+		err := newSvc(d).Cancel(context.Background(), chatID, orderID)
+		require.ErrorIs(t, err, model.ErrOrderNotCancellable)
+	})
+
+	t.Run("error: a failure other than an unauthorized token is not retried", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := activeSession()
+		d.linked(sess)
+		orderID := uuid.New()
+		failure := errors.New("connection refused")
+		d.orders.
+			On("Cancel", mock.Anything, sess.SessionToken, orderID).
+			Return(failure).
+			Once()
+
+		err := newSvc(d).Cancel(context.Background(), chatID, orderID)
+		require.ErrorIs(t, err, failure)
+		d.iam.AssertNotCalled(t, "RefreshSession", mock.Anything, mock.Anything)
+	})
+}
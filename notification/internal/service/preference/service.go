@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+type PreferenceRepository interface {
+	Save(ctx context.Context, pref model.ChannelPreference) error
+	ByUser(ctx context.Context, userID uuid.UUID) ([]model.ChannelPreference, error)
+	UserByRecipient(ctx context.Context, rcpt model.Recipient) (uuid.UUID, error)
+	SaveLocale(ctx context.Context, userID uuid.UUID, locale model.Locale) error
+}
+
+// SessionSaver stores the sessions the bot acts in on behalf of users.
+type SessionSaver interface {
+	Save(ctx context.Context, sess model.UserSession) error
+}
+
+type LinkTokenRedeemer interface {
+	RedeemLinkToken(ctx context.Context, token string) (model.UserSession, error)
+}
+
+type service struct {
+	repo     PreferenceRepository
+	sessions SessionSaver
+	iam      LinkTokenRedeemer
+}
+
+func NewPreferenceService(repo PreferenceRepository, sessions SessionSaver, iam LinkTokenRedeemer) *service {
+	return &service{repo: repo, sessions: sessions, iam: iam}
+}
+
+// LinkTelegram binds a Telegram chat to the user a link token was issued to
+// and enables the channel. The token comes from the deep link the user opens
+// from the personal cabinet and can be redeemed once; the session IAM gives
+// for it is kept to call the order API on the user's behalf.
+// languageCode is the one Telegram reports for the user; an unsupported
+// one leaves the locale unset so the default templates are used.
+func (svc *service) LinkTelegram(ctx context.Context, linkToken string, chatID int64, languageCode string) error {
+	const op = "preference.service.LinkTelegram"
+
+	sess, err := svc.iam.RedeemLinkToken(ctx, linkToken)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.sessions.Save(ctx, sess); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if locale, err := model.ParseLocale(languageCode); err == nil {
+		if err := svc.repo.SaveLocale(ctx, sess.UserID, locale); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := svc.repo.Save(ctx, model.ChannelPreference{
+		UserID:    sess.UserID,
+		Channel:   model.ChannelTelegram,
+		Address:   telegramAddress(chatID),
+		Enabled:   true,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetEmail stores and enables the email address of the user linked to the chat.
+func (svc *service) SetEmail(ctx context.Context, chatID int64, email string) error {
+	const op = "preference.service.SetEmail"
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, model.ErrInvalidAddress)
+	}
+
+	userID, err := svc.repo.UserByRecipient(ctx, telegramRecipient(chatID))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.repo.Save(ctx, model.ChannelPreference{
+		UserID:    userID,
+		Channel:   model.ChannelEmail,
+		Address:   addr.Address,
+		Enabled:   true,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetChannelEnabled toggles a channel of the user linked to the chat.
+// A channel without an address cannot be enabled.
+func (svc *service) SetChannelEnabled(ctx context.Context, chatID int64, channel model.Channel, enabled bool) error {
+	const op = "preference.service.SetChannelEnabled"
+
+	userID, err := svc.repo.UserByRecipient(ctx, telegramRecipient(chatID))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	prefs, err := svc.repo.ByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, p := range prefs {
+		if p.Channel != channel {
+			continue
+		}
+
+		p.Enabled = enabled
+		p.UpdatedAt = time.Now()
+		if err := svc.repo.Save(ctx, p); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s: %w", op, channel, model.ErrInvalidAddress)
+}
+
+// Preferences returns channel preferences of the user linked to the chat.
+func (svc *service) Preferences(ctx context.Context, chatID int64) ([]model.ChannelPreference, error) {
+	const op = "preference.service.Preferences"
+
+	userID, err := svc.repo.UserByRecipient(ctx, telegramRecipient(chatID))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	prefs, err := svc.repo.ByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return prefs, nil
+}
+
+func telegramAddress(chatID int64) string {
+	return strconv.FormatInt(chatID, 10)
+}
+
+func telegramRecipient(chatID int64) model.Recipient {
+	return model.Recipient{Channel: model.ChannelTelegram, Address: telegramAddress(chatID)}
+}
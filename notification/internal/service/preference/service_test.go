@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/notification/internal/service/mocks"
+)
+
+type deps struct {
+	repo     *mocks.MockPreferenceRepository
+	sessions *mocks.MockSessionSaver
+	iam      *mocks.MockLinkTokenRedeemer
+}
+
+func newDeps(t *testing.T) deps {
+	return deps{
+		repo:     mocks.NewMockPreferenceRepository(t),
+		sessions: mocks.NewMockSessionSaver(t),
+		iam:      mocks.NewMockLinkTokenRedeemer(t),
+	}
+}
+
+func newSvc(d deps) *service {
+	return NewPreferenceService(d.repo, d.sessions, d.iam)
+}
+
+func TestServiceLinkTelegram(t *testing.T) {
+	t.Parallel()
+
+	const (
+		chatID    int64 = 100
+		linkToken       = "LINKTOKEN"
+	)
+
+	t.Run("success: the chat is linked to the user the token was issued to", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sess := model.UserSession{
+			UserID:       uuid.New(),
+			SessionToken: "session",
+			RefreshToken: "refresh",
+			ExpiresAt:    time.Now().Add(time.Minute),
+		}
+		d.iam.
+			On("RedeemLinkToken", mock.Anything, linkToken).
+			Return(sess, nil).
+			Once()
+		d.sessions.
+			On("Save", mock.Anything, sess).
+			Return(nil).
+			Once()
+		d.repo.
+			On("SaveLocale", mock.Anything, sess.UserID, model.LocaleEN).
+			Return(nil).
+			Once()
+		d.repo.
+			On("Save", mock.Anything, mock.MatchedBy(func(p model.ChannelPreference) bool {
+				return p.UserID == sess.UserID &&
+					p.Channel == model.ChannelTelegram &&
+					p.Address == "100" &&
+					p.Enabled
+			})).
+			Return(nil).
+			Once()
+
+		require.NoError(t, newSvc(d).LinkTelegram(context.Background(), linkToken, chatID, "en"))
+	})
+
+	t.Run("invalid token: nothing is linked", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.iam.
+			On("RedeemLinkToken", mock.Anything, linkToken).
+			Return(model.UserSession{}, model.ErrInvalidLinkToken).
+			Once()
+
+		err := newSvc(d).LinkTelegram(context.Background(), linkToken, chatID, "en")
+		require.ErrorIs(t, err, model.ErrInvalidLinkToken)
+		d.sessions.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		d.repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	converter "github.com/you-humble/rocket-maintenance/notification/internal/converter/webhook"
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	minSecretLength = 16
+
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub model.WebhookSubscription) (model.WebhookSubscription, error)
+	Subscription(ctx context.Context, id uuid.UUID) (model.WebhookSubscription, error)
+	Subscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, sub model.WebhookSubscription) (model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	Enqueue(ctx context.Context, event model.WebhookEvent) (int64, error)
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]model.WebhookTask, error)
+	SaveAttempt(ctx context.Context, d model.WebhookDelivery) error
+	ResetFailures(ctx context.Context, subID uuid.UUID) error
+	RegisterFailure(ctx context.Context, subID uuid.UUID, disableAfter int) (bool, error)
+	Deliveries(ctx context.Context, subID uuid.UUID, limit int) ([]model.WebhookDelivery, error)
+}
+
+// WebhookSender POSTs a signed webhook request and returns the response
+// status code.
+type WebhookSender interface {
+	Send(ctx context.Context, req model.WebhookRequest) (int, error)
+}
+
+type service struct {
+	repo   WebhookRepository
+	sender WebhookSender
+	policy model.WebhookPolicy
+}
+
+func NewWebhookService(repo WebhookRepository, sender WebhookSender, policy model.WebhookPolicy) *service {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.BatchSize < 1 {
+		policy.BatchSize = 1
+	}
+
+	return &service{
+		repo:   repo,
+		sender: sender,
+		policy: policy,
+	}
+}
+
+func (svc *service) CreateSubscription(
+	ctx context.Context,
+	params model.CreateWebhookParams,
+) (model.WebhookSubscription, error) {
+	const op = "webhook.service.CreateSubscription"
+
+	if err := validateURL(params.URL); err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := validateSecret(params.Secret); err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+	eventTypes, err := normalizeEventTypes(params.EventTypes)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sub, err := svc.repo.CreateSubscription(ctx, model.WebhookSubscription{
+		URL:        params.URL,
+		Secret:     params.Secret,
+		EventTypes: eventTypes,
+		UserID:     params.UserID,
+		Active:     true,
+	})
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sub, nil
+}
+
+func (svc *service) Subscription(ctx context.Context, id uuid.UUID) (model.WebhookSubscription, error) {
+	const op = "webhook.service.Subscription"
+
+	sub, err := svc.repo.Subscription(ctx, id)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sub, nil
+}
+
+func (svc *service) Subscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	const op = "webhook.service.Subscriptions"
+
+	subs, err := svc.repo.Subscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return subs, nil
+}
+
+// UpdateSubscription applies a partial update. Re-enabling a subscription
+// resets its failure counter so pending deliveries are resumed.
+func (svc *service) UpdateSubscription(
+	ctx context.Context,
+	id uuid.UUID,
+	params model.UpdateWebhookParams,
+) (model.WebhookSubscription, error) {
+	const op = "webhook.service.UpdateSubscription"
+
+	sub, err := svc.repo.Subscription(ctx, id)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if params.URL != nil {
+		if err := validateURL(*params.URL); err != nil {
+			return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+		}
+		sub.URL = *params.URL
+	}
+	if params.Secret != nil {
+		if err := validateSecret(*params.Secret); err != nil {
+			return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+		}
+		sub.Secret = *params.Secret
+	}
+	if params.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(params.EventTypes)
+		if err != nil {
+			return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+		}
+		sub.EventTypes = eventTypes
+	}
+	if params.Active != nil && *params.Active != sub.Active {
+		sub.Active = *params.Active
+		if sub.Active {
+			sub.ConsecutiveFailures = 0
+			sub.DisabledAt = nil
+		} else {
+			now := time.Now()
+			sub.DisabledAt = &now
+		}
+	}
+
+	updated, err := svc.repo.UpdateSubscription(ctx, sub)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+func (svc *service) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	const op = "webhook.service.DeleteSubscription"
+
+	if err := svc.repo.DeleteSubscription(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Deliveries returns up to limit latest deliveries of the subscription.
+// A non-positive limit falls back to the default one.
+func (svc *service) Deliveries(ctx context.Context, id uuid.UUID, limit int) ([]model.WebhookDelivery, error) {
+	const op = "webhook.service.Deliveries"
+
+	switch {
+	case limit <= 0:
+		limit = defaultDeliveriesLimit
+	case limit > maxDeliveriesLimit:
+		limit = maxDeliveriesLimit
+	}
+
+	if _, err := svc.repo.Subscription(ctx, id); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deliveries, err := svc.repo.Deliveries(ctx, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return deliveries, nil
+}
+
+func (svc *service) PublishPaidOrder(ctx context.Context, event model.PaidOrder) error {
+	const op = "webhook.service.PublishPaidOrder"
+
+	we, err := converter.PaidOrderEvent(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.publish(ctx, we); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (svc *service) PublishShipAssembled(ctx context.Context, event model.AssembledShip) error {
+	const op = "webhook.service.PublishShipAssembled"
+
+	we, err := converter.ShipAssembledEvent(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.publish(ctx, we); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (svc *service) PublishCancelledOrder(ctx context.Context, event model.CancelledOrder) error {
+	const op = "webhook.service.PublishCancelledOrder"
+
+	we, err := converter.CancelledOrderEvent(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := svc.publish(ctx, we); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// publish only stores the deliveries; they are sent by the dispatcher,
+// so a slow endpoint never holds up the Kafka consumer.
+func (svc *service) publish(ctx context.Context, event model.WebhookEvent) error {
+	n, err := svc.repo.Enqueue(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug(ctx, "Webhook deliveries enqueued",
+		logger.String("event_id", event.ID.String()),
+		logger.String("event_type", string(event.Type)),
+		logger.Int64("deliveries", n),
+	)
+
+	return nil
+}
+
+// RunDispatcher sends due deliveries until ctx is cancelled. A full batch
+// is followed by the next one right away; otherwise the dispatcher waits
+// for the poll interval.
+func (svc *service) RunDispatcher(ctx context.Context) error {
+	logger.Info(ctx, "Starting webhook dispatcher")
+
+	for {
+		n, err := svc.dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "Failed to dispatch webhooks", logger.ErrorF(err))
+		}
+		if n == svc.policy.BatchSize && err == nil {
+			continue
+		}
+
+		if err := wait(ctx, svc.policy.PollInterval); err != nil {
+			return nil
+		}
+	}
+}
+
+// dispatch claims a batch of due deliveries and attempts them concurrently.
+func (svc *service) dispatch(ctx context.Context) (int, error) {
+	tasks, err := svc.repo.ClaimDue(ctx, svc.policy.BatchSize, time.Now().Add(svc.policy.Lease))
+	if err != nil {
+		return 0, err
+	}
+
+	g := new(errgroup.Group)
+	for _, task := range tasks {
+		g.Go(func() error {
+			svc.attempt(ctx, task)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return len(tasks), nil
+}
+
+// attempt sends a single delivery and records the outcome. A failure is
+// rescheduled with exponential backoff until attempts are exhausted, and
+// counts towards disabling the subscription. An attempt interrupted by
+// shutdown is not recorded: the delivery is picked up again once its
+// lease expires.
+func (svc *service) attempt(ctx context.Context, task model.WebhookTask) {
+	d := task.Delivery
+	log := logger.With(
+		logger.String("delivery_id", d.ID.String()),
+		logger.String("subscription_id", d.SubscriptionID.String()),
+		logger.String("event_type", string(d.EventType)),
+	)
+
+	code, sendErr := svc.sender.Send(ctx, model.WebhookRequest{
+		URL:        task.URL,
+		Secret:     task.Secret,
+		DeliveryID: d.ID,
+		EventType:  d.EventType,
+		Payload:    d.Payload,
+	})
+	if sendErr != nil && ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	d.Attempts++
+	d.ResponseCode = nil
+	if code != 0 {
+		d.ResponseCode = &code
+	}
+
+	switch {
+	case sendErr == nil:
+		d.Status = model.WebhookDeliveryDelivered
+		d.LastError = nil
+		d.DeliveredAt = &now
+	case d.Attempts >= svc.policy.MaxAttempts:
+		log.Error(ctx, "Webhook delivery attempts exhausted",
+			logger.Int("attempts", d.Attempts), logger.ErrorF(sendErr))
+		d.Status = model.WebhookDeliveryFailed
+		d.LastError = errorString(sendErr)
+	default:
+		delay := svc.backoff(d.Attempts)
+		log.Warn(ctx, "Webhook delivery attempt failed, retrying",
+			logger.Int("attempt", d.Attempts), logger.Duration("delay", delay), logger.ErrorF(sendErr))
+		d.Status = model.WebhookDeliveryPending
+		d.LastError = errorString(sendErr)
+		d.NextAttemptAt = now.Add(delay)
+	}
+
+	if err := svc.repo.SaveAttempt(ctx, d); err != nil {
+		log.Error(ctx, "Failed to save webhook delivery attempt", logger.ErrorF(err))
+		return
+	}
+
+	if sendErr == nil {
+		if err := svc.repo.ResetFailures(ctx, d.SubscriptionID); err != nil {
+			log.Error(ctx, "Failed to reset webhook failure counter", logger.ErrorF(err))
+		}
+		return
+	}
+
+	if svc.policy.DisableAfter < 1 {
+		return
+	}
+	disabled, err := svc.repo.RegisterFailure(ctx, d.SubscriptionID, svc.policy.DisableAfter)
+	if err != nil {
+		log.Error(ctx, "Failed to register webhook failure", logger.ErrorF(err))
+		return
+	}
+	if disabled {
+		log.Warn(ctx, "Webhook subscription disabled after repeated failures",
+			logger.Int("disable_after", svc.policy.DisableAfter))
+	}
+}
+
+func (svc *service) backoff(attempt int) time.Duration {
+	delay := svc.policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > svc.policy.MaxDelay {
+		return svc.policy.MaxDelay
+	}
+	return delay
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) URL", model.ErrInvalidWebhook)
+	}
+	return nil
+}
+
+func validateSecret(secret string) error {
+	if len(secret) < minSecretLength {
+		return fmt.Errorf("%w: secret must be at least %d characters", model.ErrInvalidWebhook, minSecretLength)
+	}
+	return nil
+}
+
+// normalizeEventTypes rejects unknown event types and drops duplicates.
+func normalizeEventTypes(types []model.WebhookEventType) ([]model.WebhookEventType, error) {
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%w: at least one event type is required", model.ErrInvalidWebhook)
+	}
+
+	out := make([]model.WebhookEventType, 0, len(types))
+	for _, t := range types {
+		switch t {
+		case model.WebhookEventOrderPaid, model.WebhookEventOrderAssembled, model.WebhookEventOrderCancelled:
+		default:
+			return nil, fmt.Errorf("%w: unknown event type %q", model.ErrInvalidWebhook, t)
+		}
+		if !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+
+	return out, nil
+}
+
+func errorString(err error) *string {
+	if err == nil {
+		return nil
+	}
+	s := err.Error()
+	return &s
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,450 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/notification/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	testSecret = "0123456789abcdef"
+	testURL    = "https://example.com/hook"
+)
+
+var testPolicy = model.WebhookPolicy{
+	MaxAttempts:  3,
+	BaseDelay:    time.Minute,
+	MaxDelay:     time.Hour,
+	DisableAfter: 2,
+	PollInterval: time.Millisecond,
+	BatchSize:    10,
+	Lease:        time.Minute,
+}
+
+type deps struct {
+	repo   *mocks.MockWebhookRepository
+	sender *mocks.MockWebhookSender
+}
+
+func newDeps(t *testing.T) deps {
+	return deps{
+		repo:   mocks.NewMockWebhookRepository(t),
+		sender: mocks.NewMockWebhookSender(t),
+	}
+}
+
+func newSvc(d deps) *service {
+	return NewWebhookService(d.repo, d.sender, testPolicy)
+}
+
+func testTask(attempts int) model.WebhookTask {
+	return model.WebhookTask{
+		Delivery: model.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: uuid.New(),
+			EventID:        uuid.New(),
+			EventType:      model.WebhookEventOrderCancelled,
+			Payload:        []byte(`{"event_type":"order.cancelled"}`),
+			Status:         model.WebhookDeliveryPending,
+			Attempts:       attempts,
+		},
+		URL:    testURL,
+		Secret: testSecret,
+	}
+}
+
+// claimed makes the repository hand out the tasks.
+func (d deps) claimed(tasks ...model.WebhookTask) {
+	d.repo.
+		On("ClaimDue", mock.Anything, testPolicy.BatchSize, mock.Anything).
+		Return(tasks, nil).
+		Once()
+}
+
+func TestServiceCreateSubscription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  model.CreateWebhookParams
+		wantErr error
+		want    []model.WebhookEventType
+	}{
+		{
+			name: "success: duplicate event types are dropped",
+			params: model.CreateWebhookParams{
+				URL:    testURL,
+				Secret: testSecret,
+				EventTypes: []model.WebhookEventType{
+					model.WebhookEventOrderPaid,
+					model.WebhookEventOrderCancelled,
+					model.WebhookEventOrderPaid,
+				},
+			},
+			want: []model.WebhookEventType{model.WebhookEventOrderPaid, model.WebhookEventOrderCancelled},
+		},
+		{
+			name: "validation: non-http url",
+			params: model.CreateWebhookParams{
+				URL:        "ftp://example.com/hook",
+				Secret:     testSecret,
+				EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid},
+			},
+			wantErr: model.ErrInvalidWebhook,
+		},
+		{
+			name: "validation: short secret",
+			params: model.CreateWebhookParams{
+				URL:        testURL,
+				Secret:     "short",
+				EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid},
+			},
+			wantErr: model.ErrInvalidWebhook,
+		},
+		{
+			name: "validation: unknown event type",
+			params: model.CreateWebhookParams{
+				URL:        testURL,
+				Secret:     testSecret,
+				EventTypes: []model.WebhookEventType{"order.shipped"},
+			},
+			wantErr: model.ErrInvalidWebhook,
+		},
+		{
+			name: "validation: no event types",
+			params: model.CreateWebhookParams{
+				URL:    testURL,
+				Secret: testSecret,
+			},
+			wantErr: model.ErrInvalidWebhook,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			if tt.wantErr == nil {
+				d.repo.
+					On("CreateSubscription", mock.Anything, model.WebhookSubscription{
+						URL:        tt.params.URL,
+						Secret:     tt.params.Secret,
+						EventTypes: tt.want,
+						Active:     true,
+					}).
+					Return(func(_ context.Context, sub model.WebhookSubscription) (model.WebhookSubscription, error) {
+						sub.ID = uuid.New()
+						return sub, nil
+					}).
+					Once()
+			}
+
+			sub, err := newSvc(d).CreateSubscription(context.Background(), tt.params)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				d.repo.AssertNotCalled(t, "CreateSubscription", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, sub.ID)
+			assert.True(t, sub.Active)
+			assert.Equal(t, tt.want, sub.EventTypes)
+		})
+	}
+}
+
+func TestServiceUpdateSubscription(t *testing.T) {
+	t.Parallel()
+
+	active, inactive := true, false
+
+	t.Run("success: re-enabling resets the failures", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		disabledAt := time.Now()
+		sub := model.WebhookSubscription{
+			ID:                  uuid.New(),
+			URL:                 testURL,
+			Secret:              testSecret,
+			EventTypes:          []model.WebhookEventType{model.WebhookEventOrderPaid},
+			ConsecutiveFailures: 2,
+			DisabledAt:          &disabledAt,
+		}
+		d.repo.
+			On("Subscription", mock.Anything, sub.ID).
+			Return(sub, nil).
+			Once()
+		want := sub
+		want.Active, want.ConsecutiveFailures, want.DisabledAt = true, 0, nil
+		d.repo.
+			On("UpdateSubscription", mock.Anything, want).
+			Return(want, nil).
+			Once()
+
+		got, err := newSvc(d).UpdateSubscription(context.Background(), sub.ID, model.UpdateWebhookParams{Active: &active})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("success: disabling stamps the time", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sub := model.WebhookSubscription{ID: uuid.New(), URL: testURL, Secret: testSecret, Active: true}
+		d.repo.
+			On("Subscription", mock.Anything, sub.ID).
+			Return(sub, nil).
+			Once()
+		d.repo.
+			On("UpdateSubscription", mock.Anything, mock.MatchedBy(func(s model.WebhookSubscription) bool {
+				return !s.Active && s.DisabledAt != nil
+			})).
+			Return(func(_ context.Context, s model.WebhookSubscription) (model.WebhookSubscription, error) {
+				return s, nil
+			}).
+			Once()
+
+		got, err := newSvc(d).UpdateSubscription(context.Background(), sub.ID, model.UpdateWebhookParams{Active: &inactive})
+		require.NoError(t, err)
+		assert.False(t, got.Active)
+	})
+
+	t.Run("validation: an invalid url is not saved", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		sub := model.WebhookSubscription{ID: uuid.New(), URL: testURL, Secret: testSecret, Active: true}
+		d.repo.
+			On("Subscription", mock.Anything, sub.ID).
+			Return(sub, nil).
+			Once()
+		bad := "example.com/hook"
+
+		_, err := newSvc(d).UpdateSubscription(context.Background(), sub.ID, model.UpdateWebhookParams{URL: &bad})
+		require.ErrorIs(t, err, model.ErrInvalidWebhook)
+		d.repo.AssertNotCalled(t, "UpdateSubscription", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found: an unknown subscription", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		id := uuid.New()
+		d.repo.
+			On("Subscription", mock.Anything, id).
+			Return(model.WebhookSubscription{}, model.ErrSubscriptionNotFound).
+			Once()
+
+		_, err := newSvc(d).UpdateSubscription(context.Background(), id, model.UpdateWebhookParams{Active: &active})
+		require.ErrorIs(t, err, model.ErrSubscriptionNotFound)
+	})
+}
+
+func TestServiceDeliveries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+	}{
+		{name: "success: the limit asked for", limit: 10, wantLimit: 10},
+		{name: "success: the default limit", limit: 0, wantLimit: defaultDeliveriesLimit},
+		{name: "success: the limit is capped", limit: 10_000, wantLimit: maxDeliveriesLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			id := uuid.New()
+			d.repo.
+				On("Subscription", mock.Anything, id).
+				Return(model.WebhookSubscription{ID: id}, nil).
+				Once()
+			d.repo.
+				On("Deliveries", mock.Anything, id, tt.wantLimit).
+				Return([]model.WebhookDelivery{}, nil).
+				Once()
+
+			_, err := newSvc(d).Deliveries(context.Background(), id, tt.limit)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestServicePublish(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	d := newDeps(t)
+	event := model.CancelledOrder{EventID: uuid.New(), OrderID: uuid.New(), UserID: uuid.New()}
+	d.repo.
+		On("Enqueue", mock.Anything, mock.MatchedBy(func(e model.WebhookEvent) bool {
+			return e.ID == event.EventID &&
+				e.Type == model.WebhookEventOrderCancelled &&
+				len(e.Payload) > 0
+		})).
+		Return(int64(2), nil).
+		Once()
+
+	require.NoError(t, newSvc(d).PublishCancelledOrder(context.Background(), event))
+	d.sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestServiceDispatch(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		attemptsDone int
+		code         int
+		sendErr      error
+		disabled     bool
+
+		wantStatus model.WebhookDeliveryStatus
+		wantDelay  time.Duration
+	}{
+		{
+			name:       "success: delivered and failures reset",
+			code:       200,
+			wantStatus: model.WebhookDeliveryDelivered,
+		},
+		{
+			name:         "failure: rescheduled with backoff",
+			attemptsDone: 1,
+			code:         503,
+			sendErr:      errors.New("endpoint responded with 503"),
+			wantStatus:   model.WebhookDeliveryPending,
+			wantDelay:    2 * time.Minute,
+		},
+		{
+			name:         "failure: attempts exhausted",
+			attemptsDone: 2,
+			sendErr:      errors.New("connection refused"),
+			wantStatus:   model.WebhookDeliveryFailed,
+		},
+		{
+			name:         "failure: the subscription is disabled",
+			attemptsDone: 2,
+			sendErr:      errors.New("connection refused"),
+			disabled:     true,
+			wantStatus:   model.WebhookDeliveryFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			task := testTask(tt.attemptsDone)
+			d.claimed(task)
+			d.sender.
+				On("Send", mock.Anything, model.WebhookRequest{
+					URL:        testURL,
+					Secret:     testSecret,
+					DeliveryID: task.Delivery.ID,
+					EventType:  task.Delivery.EventType,
+					Payload:    task.Delivery.Payload,
+				}).
+				Return(tt.code, tt.sendErr).
+				Once()
+
+			start := time.Now()
+			d.repo.
+				On("SaveAttempt", mock.Anything, mock.MatchedBy(func(got model.WebhookDelivery) bool {
+					if got.ID != task.Delivery.ID || got.Status != tt.wantStatus || got.Attempts != tt.attemptsDone+1 {
+						return false
+					}
+					if tt.code == 0 && got.ResponseCode != nil ||
+						tt.code != 0 && (got.ResponseCode == nil || *got.ResponseCode != tt.code) {
+						return false
+					}
+					if tt.sendErr == nil {
+						return got.LastError == nil && got.DeliveredAt != nil
+					}
+					if got.LastError == nil || *got.LastError != tt.sendErr.Error() {
+						return false
+					}
+					if tt.wantDelay > 0 {
+						delay := got.NextAttemptAt.Sub(start)
+						return delay >= tt.wantDelay && delay <= tt.wantDelay+time.Second
+					}
+					return true
+				})).
+				Return(nil).
+				Once()
+			if tt.sendErr == nil {
+				d.repo.
+					On("ResetFailures", mock.Anything, task.Delivery.SubscriptionID).
+					Return(nil).
+					Once()
+			} else {
+				d.repo.
+					On("RegisterFailure", mock.Anything, task.Delivery.SubscriptionID, testPolicy.DisableAfter).
+					Return(tt.disabled, nil).
+					Once()
+			}
+
+			n, err := newSvc(d).dispatch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
+
+func TestServiceDispatchShutdown(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	t.Run("success: an attempt interrupted by shutdown is not recorded", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		task := testTask(0)
+		d.claimed(task)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		d.sender.
+			On("Send", mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { cancel() }).
+			Return(0, context.Canceled).
+			Once()
+
+		n, err := newSvc(d).dispatch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		d.repo.AssertNotCalled(t, "SaveAttempt", mock.Anything, mock.Anything)
+		d.repo.AssertNotCalled(t, "RegisterFailure", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error: claiming fails", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		errClaim := errors.New("connection refused")
+		d.repo.
+			On("ClaimDue", mock.Anything, testPolicy.BatchSize, mock.Anything).
+			Return(nil, errClaim).
+			Once()
+
+		_, err := newSvc(d).dispatch(context.Background())
+		require.ErrorIs(t, err, errClaim)
+	})
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
+)
+
+var errInvalidToken = errors.New("invalid admin token")
+
+type securityHandler struct {
+	token []byte
+}
+
+// NewSecurityHandler authorizes admin API requests by a static bearer token.
+// An empty token authorizes nothing.
+func NewSecurityHandler(token string) *securityHandler {
+	return &securityHandler{token: []byte(token)}
+}
+
+func (s *securityHandler) HandleAdminToken(
+	ctx context.Context,
+	_ notificationv1.OperationName,
+	t notificationv1.AdminToken,
+) (context.Context, error) {
+	// Two empty tokens compare equal, so an empty one is refused up front.
+	if t.Token == "" || len(s.token) == 0 || subtle.ConstantTimeCompare([]byte(t.Token), s.token) != 1 {
+		return ctx, errInvalidToken
+	}
+
+	return ctx, nil
+}
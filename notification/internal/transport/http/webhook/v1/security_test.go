@@ -0,0 +1,40 @@
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
+)
+
+func TestSecurityHandlerHandleAdminToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		token     string // configured
+		presented string
+		wantErr   bool
+	}{
+		{name: "success: the configured token", token: "s3cret", presented: "s3cret"},
+		{name: "invalid: another token", token: "s3cret", presented: "s3cre7", wantErr: true},
+		{name: "invalid: an empty token", token: "s3cret", presented: "", wantErr: true},
+		{name: "invalid: an empty token when none is configured", token: "", presented: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewSecurityHandler(tt.token).HandleAdminToken(context.Background(),
+				notificationv1.CreateWebhookSubscriptionOperation, notificationv1.AdminToken{Token: tt.presented})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidToken)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	converter "github.com/you-humble/rocket-maintenance/notification/internal/converter/openapi"
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+	notificationv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/notification/v1"
+)
+
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, params model.CreateWebhookParams) (model.WebhookSubscription, error)
+	Subscription(ctx context.Context, id uuid.UUID) (model.WebhookSubscription, error)
+	Subscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	UpdateSubscription(
+		ctx context.Context,
+		id uuid.UUID,
+		params model.UpdateWebhookParams,
+	) (model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+	Deliveries(ctx context.Context, id uuid.UUID, limit int) ([]model.WebhookDelivery, error)
+}
+
+type handler struct {
+	svc WebhookService
+}
+
+func NewWebhookHandler(service WebhookService) *handler {
+	return &handler{svc: service}
+}
+
+func (h *handler) CreateWebhookSubscription(
+	ctx context.Context,
+	req *notificationv1.CreateWebhookSubscriptionRequest,
+) (notificationv1.CreateWebhookSubscriptionRes, error) {
+	sub, err := h.svc.CreateSubscription(ctx, converter.CreateWebhookRequestToParams(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWebhook):
+			return validationError(err), nil
+		default:
+			return internalError(ctx, err), nil
+		}
+	}
+
+	return converter.WebhookSubscriptionToOAPI(sub), nil
+}
+
+func (h *handler) GetWebhookSubscription(
+	ctx context.Context,
+	params notificationv1.GetWebhookSubscriptionParams,
+) (notificationv1.GetWebhookSubscriptionRes, error) {
+	sub, err := h.svc.Subscription(ctx, params.SubscriptionUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrSubscriptionNotFound):
+			return notFoundError(err), nil
+		default:
+			return internalError(ctx, err), nil
+		}
+	}
+
+	return converter.WebhookSubscriptionToOAPI(sub), nil
+}
+
+func (h *handler) ListWebhookSubscriptions(ctx context.Context) (notificationv1.ListWebhookSubscriptionsRes, error) {
+	subs, err := h.svc.Subscriptions(ctx)
+	if err != nil {
+		return internalError(ctx, err), nil
+	}
+
+	return converter.WebhookSubscriptionsToOAPI(subs), nil
+}
+
+func (h *handler) UpdateWebhookSubscription(
+	ctx context.Context,
+	req *notificationv1.UpdateWebhookSubscriptionRequest,
+	params notificationv1.UpdateWebhookSubscriptionParams,
+) (notificationv1.UpdateWebhookSubscriptionRes, error) {
+	sub, err := h.svc.UpdateSubscription(ctx, params.SubscriptionUUID, converter.UpdateWebhookRequestToParams(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWebhook):
+			return validationError(err), nil
+		case errors.Is(err, model.ErrSubscriptionNotFound):
+			return notFoundError(err), nil
+		default:
+			return internalError(ctx, err), nil
+		}
+	}
+
+	return converter.WebhookSubscriptionToOAPI(sub), nil
+}
+
+func (h *handler) DeleteWebhookSubscription(
+	ctx context.Context,
+	params notificationv1.DeleteWebhookSubscriptionParams,
+) (notificationv1.DeleteWebhookSubscriptionRes, error) {
+	if err := h.svc.DeleteSubscription(ctx, params.SubscriptionUUID); err != nil {
+		switch {
+		case errors.Is(err, model.ErrSubscriptionNotFound):
+			return notFoundError(err), nil
+		default:
+			return internalError(ctx, err), nil
+		}
+	}
+
+	return &notificationv1.DeleteWebhookSubscriptionNoContent{}, nil
+}
+
+func (h *handler) ListWebhookDeliveries(
+	ctx context.Context,
+	params notificationv1.ListWebhookDeliveriesParams,
+) (notificationv1.ListWebhookDeliveriesRes, error) {
+	deliveries, err := h.svc.Deliveries(ctx, params.SubscriptionUUID, int(params.Limit.Or(0)))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrSubscriptionNotFound):
+			return notFoundError(err), nil
+		default:
+			return internalError(ctx, err), nil
+		}
+	}
+
+	return converter.WebhookDeliveriesToOAPI(deliveries), nil
+}
+
+func validationError(err error) *notificationv1.ValidationError {
+	return &notificationv1.ValidationError{ // 422
+		Code:    notificationv1.NewOptInt32(int32(http.StatusUnprocessableEntity)),
+		Message: notificationv1.NewOptString(err.Error()),
+	}
+}
+
+func notFoundError(err error) *notificationv1.NotFoundError {
+	return &notificationv1.NotFoundError{ // 404
+		Code:    notificationv1.NewOptInt32(int32(http.StatusNotFound)),
+		Message: notificationv1.NewOptString(err.Error()),
+	}
+}
+
+// internalError hides the cause from the caller and logs it instead.
+func internalError(ctx context.Context, err error) *notificationv1.InternalServerError {
+	logger.Error(ctx, "Webhook admin API request failed", logger.ErrorF(err))
+
+	return &notificationv1.InternalServerError{ // 500
+		Code:    notificationv1.NewOptInt32(int32(http.StatusInternalServerError)),
+		Message: notificationv1.NewOptString(http.StatusText(http.StatusInternalServerError)),
+	}
+}
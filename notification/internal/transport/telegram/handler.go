@@ -0,0 +1,304 @@
+package tghandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-telegram/bot"
+	"github.com/go-telegram/bot/models"
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const cancelPrefix = "cancel:"
+
+type PreferenceService interface {
+	LinkTelegram(ctx context.Context, linkToken string, chatID int64, languageCode string) error
+	SetEmail(ctx context.Context, chatID int64, email string) error
+	SetChannelEnabled(ctx context.Context, chatID int64, channel model.Channel, enabled bool) error
+	Preferences(ctx context.Context, chatID int64) ([]model.ChannelPreference, error)
+}
+
+type OrderService interface {
+	RecentOrders(ctx context.Context, chatID int64) ([]model.Order, error)
+	Order(ctx context.Context, chatID int64, orderID uuid.UUID) (model.Order, error)
+	Cancel(ctx context.Context, chatID int64, orderID uuid.UUID) error
+}
+
+type handler struct {
+	prefs  PreferenceService
+	orders OrderService
+}
+
+func NewHandler(prefs PreferenceService, orders OrderService) *handler {
+	return &handler{prefs: prefs, orders: orders}
+}
+
+func (h *handler) Register(b *bot.Bot) {
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/start", bot.MatchTypePrefix, h.start)
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/email", bot.MatchTypePrefix, h.email)
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/enable", bot.MatchTypePrefix, h.toggle(true))
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/disable", bot.MatchTypePrefix, h.toggle(false))
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/channels", bot.MatchTypeExact, h.channels)
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/orders", bot.MatchTypeExact, h.listOrders)
+	b.RegisterHandler(bot.HandlerTypeMessageText, "/status", bot.MatchTypePrefix, h.status)
+	b.RegisterHandler(bot.HandlerTypeCallbackQueryData, cancelPrefix, bot.MatchTypePrefix, h.cancel)
+}
+
+// start handles "/start" and the deep link "/start <link_token>" which binds
+// the chat to the user the personal cabinet issued the link token to.
+func (h *handler) start(ctx context.Context, b *bot.Bot, update *models.Update) {
+	chatID := update.Message.Chat.ID
+	t := textsFor(update.Message.From)
+	logger.Info(ctx, "New user",
+		logger.String("username", update.Message.From.Username),
+		logger.Int64("chat_id", chatID),
+	)
+
+	arg := argument(update.Message.Text)
+	if arg == "" {
+		reply(ctx, b, chatID, t.start)
+		return
+	}
+
+	err := h.prefs.LinkTelegram(ctx, arg, chatID, update.Message.From.LanguageCode)
+	switch {
+	case errors.Is(err, model.ErrInvalidLinkToken):
+		reply(ctx, b, chatID, t.linkExpired)
+		return
+	case err != nil:
+		logger.Error(ctx, "Failed to link telegram chat", logger.ErrorF(err))
+		reply(ctx, b, chatID, t.failed)
+		return
+	}
+
+	reply(ctx, b, chatID, t.linked)
+}
+
+func (h *handler) email(ctx context.Context, b *bot.Bot, update *models.Update) {
+	chatID := update.Message.Chat.ID
+	t := textsFor(update.Message.From)
+
+	err := h.prefs.SetEmail(ctx, chatID, argument(update.Message.Text))
+	switch {
+	case err == nil:
+		reply(ctx, b, chatID, t.emailSaved)
+	case errors.Is(err, model.ErrInvalidAddress):
+		reply(ctx, b, chatID, t.emailInvalid)
+	default:
+		h.replyError(ctx, b, chatID, t, err)
+	}
+}
+
+func (h *handler) toggle(enabled bool) bot.HandlerFunc {
+	return func(ctx context.Context, b *bot.Bot, update *models.Update) {
+		chatID := update.Message.Chat.ID
+		t := textsFor(update.Message.From)
+
+		channel, err := model.ParseChannel(argument(update.Message.Text))
+		if err != nil {
+			reply(ctx, b, chatID, t.channelRequired)
+			return
+		}
+
+		err = h.prefs.SetChannelEnabled(ctx, chatID, channel, enabled)
+		switch {
+		case err == nil:
+			reply(ctx, b, chatID, t.channelDone)
+		case errors.Is(err, model.ErrInvalidAddress):
+			reply(ctx, b, chatID, t.channelNoAddress)
+		default:
+			h.replyError(ctx, b, chatID, t, err)
+		}
+	}
+}
+
+func (h *handler) channels(ctx context.Context, b *bot.Bot, update *models.Update) {
+	chatID := update.Message.Chat.ID
+	t := textsFor(update.Message.From)
+
+	prefs, err := h.prefs.Preferences(ctx, chatID)
+	if err != nil {
+		h.replyError(ctx, b, chatID, t, err)
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(t.channelsTitle)
+	for _, p := range prefs {
+		state := t.channelOff
+		if p.Enabled {
+			state = t.channelOn
+		}
+		fmt.Fprintf(&sb, "• %s — %s\n", p.Channel, state)
+		if p.Channel == model.ChannelEmail {
+			fmt.Fprintf(&sb, "  `%s`\n", p.Address)
+		}
+	}
+
+	reply(ctx, b, chatID, sb.String())
+}
+
+func (h *handler) listOrders(ctx context.Context, b *bot.Bot, update *models.Update) {
+	chatID := update.Message.Chat.ID
+	t := textsFor(update.Message.From)
+
+	orders, err := h.orders.RecentOrders(ctx, chatID)
+	if err != nil {
+		h.replyOrderError(ctx, b, chatID, t, err)
+		return
+	}
+	if len(orders) == 0 {
+		reply(ctx, b, chatID, t.noOrders)
+		return
+	}
+
+	var (
+		sb      strings.Builder
+		buttons [][]models.InlineKeyboardButton
+	)
+	sb.WriteString(t.ordersTitle)
+	for _, o := range orders {
+		fmt.Fprintf(&sb, "• `%s` — %s, %s\n", o.ID, t.status(o.Status), o.TotalPrice)
+		if o.Status == model.OrderStatusPendingPayment {
+			buttons = append(buttons, []models.InlineKeyboardButton{
+				cancelButton(fmt.Sprintf(t.cancelOrderFmt, shortID(o.ID)), o.ID),
+			})
+		}
+	}
+	sb.WriteString(t.ordersFooter)
+
+	replyWithKeyboard(ctx, b, chatID, sb.String(), buttons)
+}
+
+// status handles "/status <order_uuid>".
+func (h *handler) status(ctx context.Context, b *bot.Bot, update *models.Update) {
+	chatID := update.Message.Chat.ID
+	t := textsFor(update.Message.From)
+
+	orderID, err := uuid.Parse(argument(update.Message.Text))
+	if err != nil {
+		reply(ctx, b, chatID, t.statusUsage)
+		return
+	}
+
+	ord, err := h.orders.Order(ctx, chatID, orderID)
+	if err != nil {
+		h.replyOrderError(ctx, b, chatID, t, err)
+		return
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, t.orderTitleFmt, ord.ID)
+	fmt.Fprintf(&sb, t.orderStatusFmt, t.status(ord.Status))
+	fmt.Fprintf(&sb, t.orderTotalFmt, ord.TotalPrice)
+	if ord.PaymentMethod != "" {
+		fmt.Fprintf(&sb, t.orderPaymentFmt, t.paymentMethod(ord.PaymentMethod))
+	}
+
+	var buttons [][]models.InlineKeyboardButton
+	if ord.Status == model.OrderStatusPendingPayment {
+		buttons = [][]models.InlineKeyboardButton{{cancelButton(t.cancelThisOrder, ord.ID)}}
+	}
+
+	replyWithKeyboard(ctx, b, chatID, sb.String(), buttons)
+}
+
+// cancel handles presses of the inline "cancel" button; the callback data
+// is "cancel:<order_uuid>".
+func (h *handler) cancel(ctx context.Context, b *bot.Bot, update *models.Update) {
+	query := update.CallbackQuery
+	chatID := query.From.ID
+	if msg := query.Message.Message; msg != nil {
+		chatID = msg.Chat.ID
+	}
+
+	t := textsFor(&query.From)
+
+	answer := t.cancelDone
+	defer func() {
+		if _, err := b.AnswerCallbackQuery(ctx, &bot.AnswerCallbackQueryParams{
+			CallbackQueryID: query.ID,
+			Text:            answer,
+		}); err != nil {
+			logger.Error(ctx, "Failed to answer callback query", logger.ErrorF(err))
+		}
+	}()
+
+	orderID, err := uuid.Parse(strings.TrimPrefix(query.Data, cancelPrefix))
+	if err != nil {
+		answer = t.invalidOrder
+		return
+	}
+
+	if err := h.orders.Cancel(ctx, chatID, orderID); err != nil {
+		answer = t.cancelFailed
+		h.replyOrderError(ctx, b, chatID, t, err)
+		return
+	}
+
+	reply(ctx, b, chatID, fmt.Sprintf(t.orderCancelledFmt, orderID))
+}
+
+func (h *handler) replyOrderError(ctx context.Context, b *bot.Bot, chatID int64, t *texts, err error) {
+	switch {
+	case errors.Is(err, model.ErrUserNotLinked):
+		reply(ctx, b, chatID, t.notLinked)
+	case errors.Is(err, model.ErrOrderNotFound):
+		reply(ctx, b, chatID, t.orderNotFound)
+	case errors.Is(err, model.ErrOrderNotCancellable):
+		reply(ctx, b, chatID, t.orderNotCancellable)
+	default:
+		logger.Error(ctx, "Failed to process order request", logger.ErrorF(err))
+		reply(ctx, b, chatID, t.orderFailed)
+	}
+}
+
+func (h *handler) replyError(ctx context.Context, b *bot.Bot, chatID int64, t *texts, err error) {
+	if errors.Is(err, model.ErrUserNotLinked) {
+		reply(ctx, b, chatID, t.notLinked)
+		return
+	}
+
+	logger.Error(ctx, "Failed to update channel preferences", logger.ErrorF(err))
+	reply(ctx, b, chatID, t.failed)
+}
+
+// argument returns the text following the command, e.g. "a@b.c" for "/email a@b.c".
+func argument(text string) string {
+	_, arg, _ := strings.Cut(strings.TrimSpace(text), " ")
+	return strings.TrimSpace(arg)
+}
+
+func cancelButton(text string, orderID uuid.UUID) models.InlineKeyboardButton {
+	return models.InlineKeyboardButton{Text: text, CallbackData: cancelPrefix + orderID.String()}
+}
+
+// shortID is the first group of the UUID, enough to tell orders apart on a button.
+func shortID(id uuid.UUID) string {
+	return id.String()[:8]
+}
+
+func reply(ctx context.Context, b *bot.Bot, chatID int64, text string) {
+	replyWithKeyboard(ctx, b, chatID, text, nil)
+}
+
+// replyWithKeyboard sends text with an inline keyboard; no buttons means no keyboard.
+func replyWithKeyboard(ctx context.Context, b *bot.Bot, chatID int64, text string, buttons [][]models.InlineKeyboardButton) {
+	params := &bot.SendMessageParams{
+		ChatID:    chatID,
+		Text:      text,
+		ParseMode: models.ParseModeMarkdownV1,
+	}
+	if len(buttons) > 0 {
+		params.ReplyMarkup = &models.InlineKeyboardMarkup{InlineKeyboard: buttons}
+	}
+
+	if _, err := b.SendMessage(ctx, params); err != nil {
+		logger.Error(ctx, "Failed to send reply", logger.ErrorF(err))
+	}
+}
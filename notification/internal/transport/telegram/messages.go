@@ -0,0 +1,222 @@
+package tghandler
+
+import (
+	"github.com/go-telegram/bot/models"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+// fallbackLocale answers users whose language has no texts.
+const fallbackLocale = model.LocaleEN
+
+// texts are the replies of the bot in one locale. The fields ending in
+// "Fmt" are format strings.
+type texts struct {
+	start  string
+	linked string
+
+	notLinked   string
+	linkExpired string
+	failed      string
+	orderFailed string
+
+	emailSaved   string
+	emailInvalid string
+
+	channelRequired  string
+	channelDone      string
+	channelNoAddress string
+	channelsTitle    string
+	channelOn        string
+	channelOff       string
+
+	noOrders          string
+	ordersTitle       string
+	ordersFooter      string
+	cancelOrderFmt    string // the button of an order in the list
+	cancelThisOrder   string // the button under the status of an order
+	statusUsage       string
+	orderTitleFmt     string
+	orderStatusFmt    string
+	orderTotalFmt     string
+	orderPaymentFmt   string
+	cancelDone        string // the answer to the button press
+	orderCancelledFmt string
+
+	invalidOrder        string
+	cancelFailed        string
+	orderNotFound       string
+	orderNotCancellable string
+
+	statuses             map[model.OrderStatus]string
+	paymentMethods       map[string]string
+	unknownPaymentMethod string
+}
+
+var catalog = map[model.Locale]*texts{
+	model.LocaleEN: {
+		start: `
+	👋 **Hi! I am the AstraDock notification bot.**
+
+	I send the important events of your orders:
+	🚀 the ship is assembled
+	💳 the order is paid
+
+	To get notifications, open the bot with the link from your personal account — it links this chat to your account.
+	If notifications go to the wrong place, check that you are signed in to the right account.
+	`,
+		linked: `
+	✅ **The chat is linked to your account.**
+
+	/email <address> — get notifications by email too
+	/enable <telegram|email> — turn a channel on
+	/disable <telegram|email> — turn a channel off
+	/channels — current settings
+	/orders — recent orders
+	/status <order id> — status of an order
+	`,
+
+		notLinked:   "This chat is not linked to an account. Open the bot with the link from your personal account.",
+		linkExpired: "The link is invalid or already used. Get a new one in your personal account.",
+		failed:      "Could not save the settings, try again later.",
+		orderFailed: "Could not get the order, try again later.",
+
+		emailSaved:   "📧 Email saved, notifications will go there too.",
+		emailInvalid: "Invalid address. Example: /email user@example.com",
+
+		channelRequired:  "Name a channel: telegram or email.",
+		channelDone:      "Done. /channels — current settings.",
+		channelNoAddress: "This channel has no address. For email: /email <address>",
+		channelsTitle:    "**Notification channels:**\n",
+		channelOn:        "on",
+		channelOff:       "off",
+
+		noOrders:          "No orders yet.",
+		ordersTitle:       "**Recent orders:**\n",
+		ordersFooter:      "\n/status <order id> — details",
+		cancelOrderFmt:    "Cancel %s",
+		cancelThisOrder:   "Cancel order",
+		statusUsage:       "Give the order id. Example: /status 123e4567-e89b-12d3-a456-426614174000",
+		orderTitleFmt:     "**Order** `%s`\n",
+		orderStatusFmt:    "Status: %s\n",
+		orderTotalFmt:     "Total: %s\n",
+		orderPaymentFmt:   "Payment method: %s\n",
+		cancelDone:        "Order cancelled",
+		orderCancelledFmt: "❌ Order `%s` cancelled.",
+
+		invalidOrder:        "Invalid order",
+		cancelFailed:        "Could not cancel the order",
+		orderNotFound:       "Order not found.",
+		orderNotCancellable: "This order can no longer be cancelled.",
+
+		statuses: map[model.OrderStatus]string{
+			model.OrderStatusPendingPayment: "⏳ pending payment",
+			model.OrderStatusPaid:           "💳 paid",
+			model.OrderStatusCompleted:      "🚀 completed",
+			model.OrderStatusCancelled:      "❌ cancelled",
+		},
+		paymentMethods: map[string]string{
+			"PAYMENT_METHOD_CARD":           "card",
+			"PAYMENT_METHOD_SBP":            "SBP",
+			"PAYMENT_METHOD_CREDIT_CARD":    "credit card",
+			"PAYMENT_METHOD_INVESTOR_MONEY": "investor money",
+		},
+		unknownPaymentMethod: "unknown",
+	},
+	model.LocaleRU: {
+		start: `
+	👋 **Привет! Я бот уведомлений AstraDock.**
+
+	Я присылаю важные события по твоим заказам:
+	🚀 сборка корабля завершена
+	💳 заказ успешно оплачен
+
+	Чтобы получать уведомления, открой бота по ссылке из личного кабинета — она привяжет этот чат к твоему аккаунту.
+	Если уведомления приходят не туда — проверь, что ты вошёл под нужным аккаунтом.
+	`,
+		linked: `
+	✅ **Чат привязан к аккаунту.**
+
+	/email <адрес> — получать уведомления ещё и на почту
+	/enable <telegram|email> — включить канал
+	/disable <telegram|email> — выключить канал
+	/channels — текущие настройки
+	/orders — последние заказы
+	/status <номер заказа> — статус заказа
+	`,
+
+		notLinked:   "Этот чат не привязан к аккаунту. Открой бота по ссылке из личного кабинета.",
+		linkExpired: "Ссылка недействительна или уже использована. Получи новую в личном кабинете.",
+		failed:      "Не удалось сохранить настройки, попробуй позже.",
+		orderFailed: "Не удалось получить данные заказа, попробуй позже.",
+
+		emailSaved:   "📧 Почта сохранена, уведомления будут приходить и туда.",
+		emailInvalid: "Некорректный адрес. Пример: /email user@example.com",
+
+		channelRequired:  "Укажи канал: telegram или email.",
+		channelDone:      "Готово. /channels — текущие настройки.",
+		channelNoAddress: "Для этого канала не указан адрес. Для почты: /email <адрес>",
+		channelsTitle:    "**Каналы уведомлений:**\n",
+		channelOn:        "включён",
+		channelOff:       "выключен",
+
+		noOrders:          "Заказов пока нет.",
+		ordersTitle:       "**Последние заказы:**\n",
+		ordersFooter:      "\n/status <номер заказа> — подробнее",
+		cancelOrderFmt:    "Отменить %s",
+		cancelThisOrder:   "Отменить заказ",
+		statusUsage:       "Укажи номер заказа. Пример: /status 123e4567-e89b-12d3-a456-426614174000",
+		orderTitleFmt:     "**Заказ** `%s`\n",
+		orderStatusFmt:    "Статус: %s\n",
+		orderTotalFmt:     "Сумма: %s\n",
+		orderPaymentFmt:   "Способ оплаты: %s\n",
+		cancelDone:        "Заказ отменён",
+		orderCancelledFmt: "❌ Заказ `%s` отменён.",
+
+		invalidOrder:        "Некорректный заказ",
+		cancelFailed:        "Не удалось отменить заказ",
+		orderNotFound:       "Заказ не найден.",
+		orderNotCancellable: "Этот заказ уже нельзя отменить.",
+
+		statuses: map[model.OrderStatus]string{
+			model.OrderStatusPendingPayment: "⏳ ожидает оплаты",
+			model.OrderStatusPaid:           "💳 оплачен",
+			model.OrderStatusCompleted:      "🚀 выполнен",
+			model.OrderStatusCancelled:      "❌ отменён",
+		},
+		paymentMethods: map[string]string{
+			"PAYMENT_METHOD_CARD":           "карта",
+			"PAYMENT_METHOD_SBP":            "СБП",
+			"PAYMENT_METHOD_CREDIT_CARD":    "кредитная карта",
+			"PAYMENT_METHOD_INVESTOR_MONEY": "деньги инвестора",
+		},
+		unknownPaymentMethod: "неизвестно",
+	},
+}
+
+// textsFor picks the texts by the language Telegram reports for the user,
+// the way the locale of notifications is picked, with fallbackLocale for
+// the languages without texts.
+func textsFor(user *models.User) *texts {
+	if user != nil {
+		if locale, err := model.ParseLocale(user.LanguageCode); err == nil {
+			return catalog[locale]
+		}
+	}
+
+	return catalog[fallbackLocale]
+}
+
+func (t *texts) status(s model.OrderStatus) string {
+	if label, ok := t.statuses[s]; ok {
+		return label
+	}
+	return string(s)
+}
+
+func (t *texts) paymentMethod(pm string) string {
+	if label, ok := t.paymentMethods[pm]; ok {
+		return label
+	}
+	return t.unknownPaymentMethod
+}
@@ -0,0 +1,63 @@
+package tghandler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-telegram/bot/models"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/you-humble/rocket-maintenance/notification/internal/model"
+)
+
+func TestTextsFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		user *models.User
+		want model.Locale
+	}{
+		{name: "success: russian", user: &models.User{LanguageCode: "ru"}, want: model.LocaleRU},
+		{name: "success: a regional tag", user: &models.User{LanguageCode: "en-US"}, want: model.LocaleEN},
+		{name: "fallback: a language without texts", user: &models.User{LanguageCode: "de"}, want: model.LocaleEN},
+		{name: "fallback: no language", user: &models.User{}, want: model.LocaleEN},
+		{name: "fallback: no user", want: model.LocaleEN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Same(t, catalog[tt.want], textsFor(tt.user))
+		})
+	}
+}
+
+// TestCatalog makes sure every locale of the notifications has every reply.
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	for _, locale := range model.Locales {
+		t.Run(string(locale), func(t *testing.T) {
+			t.Parallel()
+
+			tx, ok := catalog[locale]
+			if !assert.True(t, ok, "no texts") {
+				return
+			}
+
+			v := reflect.ValueOf(tx).Elem()
+			for i := range v.NumField() {
+				assert.False(t, v.Field(i).IsZero(), "%s is empty", v.Type().Field(i).Name)
+			}
+
+			for _, s := range []model.OrderStatus{
+				model.OrderStatusPendingPayment, model.OrderStatusPaid, model.OrderStatusCompleted, model.OrderStatusCancelled,
+			} {
+				assert.NotEqual(t, string(s), tx.status(s), "no label of %s", s)
+			}
+			assert.Len(t, tx.paymentMethods, len(catalog[fallbackLocale].paymentMethods))
+		})
+	}
+}
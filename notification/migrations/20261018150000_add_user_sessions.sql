@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- IAM sessions the Telegram bot calls the order API with on behalf of the
+-- users who linked a chat; one per user, replaced on relink and refresh.
+CREATE TABLE IF NOT EXISTS user_sessions (
+    user_id uuid PRIMARY KEY,
+    session_token text NOT NULL,
+    refresh_token text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    refresh_expires_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_sessions;
+-- +goose StatementEnd
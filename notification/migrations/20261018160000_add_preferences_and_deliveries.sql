@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS channel_preferences (
+    user_id uuid NOT NULL,
+    channel text NOT NULL,
+    address text NOT NULL,
+    enabled boolean NOT NULL DEFAULT true,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (user_id, channel),
+    -- An address belongs to a single user.
+    CONSTRAINT channel_preferences_recipient_unique UNIQUE (channel, address),
+    CONSTRAINT channel_preferences_channel_check CHECK (channel IN ('telegram', 'email'))
+);
+
+CREATE TABLE IF NOT EXISTS user_locales (
+    user_id uuid PRIMARY KEY,
+    locale text NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Per-recipient log of event deliveries; a redelivered event skips the
+-- recipients it is done with.
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    event_id uuid NOT NULL,
+    channel text NOT NULL,
+    address text NOT NULL,
+    status text NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    updated_at timestamptz NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (event_id, channel, address),
+    CONSTRAINT notification_deliveries_status_check CHECK (status IN ('PENDING', 'DELIVERED', 'FAILED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_updated_at
+    ON notification_deliveries (updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TABLE IF EXISTS user_locales;
+DROP TABLE IF EXISTS channel_preferences;
+-- +goose StatementEnd
@@ -9,8 +9,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/you-humble/rocket-maintenance/order/internal/config"
-	"github.com/you-humble/rocket-maintenance/order/internal/transport/http/health"
+	thttp "github.com/you-humble/rocket-maintenance/order/internal/transport/http/order/v1"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
 )
@@ -82,20 +83,29 @@ func (a *app) initTables(ctx context.Context) error {
 func (a *app) initServer(ctx context.Context) error {
 	cfg := config.C()
 
-	orderServer, err := orderv1.NewServer(a.di.OrderHandler(ctx))
+	orderServer, err := orderv1.NewServer(
+		a.di.OrderHandler(ctx),
+		a.di.SecurityHandler(ctx),
+		orderv1.WithErrorHandler(thttp.ErrorHandler),
+		orderv1.WithMiddleware(thttp.RateLimitByUser(a.di.RateLimitService(ctx))),
+	)
 	if err != nil {
 		logger.Error(ctx, "failed to create a new server", logger.ErrorF(err))
 		return err
 	}
 
 	r := a.di.Router(ctx)
+	if cfg.RateLimit.TrustProxy() {
+		r.Use(middleware.RealIP)
+	}
 	r.Use(
 		middleware.Recoverer,
 		middleware.Logger,
 	)
-	r.Mount("/", orderServer)
+	r.With(thttp.RateLimitByIP(a.di.RateLimitService(ctx))).Mount("/", orderServer)
 
-	r.HandleFunc("/health", health.HealthCheck)
+	r.Get(health.LivezPath, a.di.Health(ctx).LivezHandler())
+	r.Get(health.ReadyzPath, a.di.Health(ctx).ReadyzHandler())
 
 	a.server = &http.Server{
 		Addr:              cfg.Server.Address(),
@@ -122,6 +132,21 @@ func (a *app) run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		a.di.RateLimitService(egCtx).Run(egCtx, config.C().RateLimit.SweepInterval())
+		return nil
+	})
+
+	eg.Go(func() error {
+		a.di.ExpiryService(egCtx).Run(egCtx, config.C().Expiry.SweepInterval())
+		return nil
+	})
+
+	eg.Go(func() error {
+		a.di.SagaService(egCtx).Run(egCtx, config.C().Saga.ResumeInterval())
+		return nil
+	})
+
 	eg.Go(func() error {
 		logger.Info(egCtx,
 			"🚀 inventory server listening",
@@ -135,6 +160,19 @@ func (a *app) run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		<-egCtx.Done()
+		a.di.Health(egCtx).Shutdown()
+
+		shutdownCtx, cancel := context.WithTimeout(
+			context.WithoutCancel(egCtx),
+			config.C().Server.ShutdownTimeout(),
+		)
+		defer cancel()
+
+		return a.server.Shutdown(shutdownCtx)
+	})
+
 	if err := eg.Wait(); err != nil {
 		return err
 	}
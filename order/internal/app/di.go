@@ -3,32 +3,42 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
+	iamclient "github.com/you-humble/rocket-maintenance/order/internal/client/grpc/iam/v1"
 	invclient "github.com/you-humble/rocket-maintenance/order/internal/client/grpc/inventory/v1"
 	pmtclient "github.com/you-humble/rocket-maintenance/order/internal/client/grpc/payment/v1"
 	"github.com/you-humble/rocket-maintenance/order/internal/config"
+	envconfig "github.com/you-humble/rocket-maintenance/order/internal/config/env"
 	"github.com/you-humble/rocket-maintenance/order/internal/converter"
 	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	bucketrepo "github.com/you-humble/rocket-maintenance/order/internal/repository/bucket"
 	repository "github.com/you-humble/rocket-maintenance/order/internal/repository/order"
+	sagarepo "github.com/you-humble/rocket-maintenance/order/internal/repository/saga"
 	ordconsumer "github.com/you-humble/rocket-maintenance/order/internal/service/consumer/order"
+	expirysvc "github.com/you-humble/rocket-maintenance/order/internal/service/expiry"
 	service "github.com/you-humble/rocket-maintenance/order/internal/service/order"
 	ordproducer "github.com/you-humble/rocket-maintenance/order/internal/service/producer/order"
+	ratelimitsvc "github.com/you-humble/rocket-maintenance/order/internal/service/ratelimit"
+	sagasvc "github.com/you-humble/rocket-maintenance/order/internal/service/saga"
 	thttp "github.com/you-humble/rocket-maintenance/order/internal/transport/http/order/v1"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
 	"github.com/you-humble/rocket-maintenance/platform/db/migrator"
+	grpcclient "github.com/you-humble/rocket-maintenance/platform/grpc/client"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	"github.com/you-humble/rocket-maintenance/platform/kafka"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/consumer"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/middleware"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/producer"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
 	inventorypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1"
 	paymentpbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/payment/v1"
 )
@@ -36,6 +46,8 @@ import (
 type Converter interface {
 	AssembledShipToModel(data []byte) (model.AssembledShip, error)
 	PaidOrderToModel(m model.PaidOrder) ([]byte, error)
+	CancelledOrderToPayload(m model.CancelledOrder) ([]byte, error)
+	ExpiredOrderToPayload(m model.ExpiredOrder) ([]byte, error)
 }
 
 type OrderConsumer interface {
@@ -47,84 +59,143 @@ type OrderService interface {
 	ordconsumer.Service
 }
 
+type OrderProducer interface {
+	sagasvc.SagaEventSender
+}
+
+type InventoryClient interface {
+	service.InventoryClient
+	sagasvc.StockClient
+}
+
+type SagaService interface {
+	service.OrderSagas
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type ExpiryService interface {
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type RateLimitService interface {
+	thttp.RateLimiter
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type OrderRepository interface {
+	service.OrderRepository
+	service.AdminRepository
+	expirysvc.ExpiryRepository
+	sagasvc.SagaOrderRepository
+}
+
 type di struct {
-	inventoryClient service.InventoryClient
-	paymentClient   service.PaymentClient
+	inventoryConn *grpc.ClientConn
+	paymentConn   *grpc.ClientConn
+	iamConn       *grpc.ClientConn
+
+	inventoryClient InventoryClient
+	paymentClient   sagasvc.BillingClient
+	iamClient       thttp.Authenticator
 
 	dbPool     *pgxpool.Pool
 	migrator   *migrator.Migrator
-	repository service.OrderRepository
+	repository OrderRepository
+
+	sagaRepository sagasvc.SagaRepository
+	sagas          SagaService
 
 	consumerGroup          sarama.ConsumerGroup
 	orderAssembledConsumer kafka.Consumer
 	orderConsumer          OrderConsumer
 
-	syncProducer      sarama.SyncProducer
-	orderPaidProducer kafka.Producer
-	orderProducer     service.OrderPaidSender
+	syncProducer           sarama.SyncProducer
+	orderPaidProducer      kafka.Producer
+	orderCancelledProducer kafka.Producer
+	orderExpiredProducer   kafka.Producer
+	orderProducer          OrderProducer
 
 	conv Converter
 
-	service OrderService
-	handler orderv1.Handler
+	service  OrderService
+	admin    thttp.AdminService
+	handler  orderv1.Handler
+	security orderv1.SecurityHandler
+	expiry   ExpiryService
+
+	bucketRepository ratelimitsvc.BucketRepository
+	rateLimiter      RateLimitService
 
+	health *health.Health
 	router *chi.Mux
 }
 
 func NewDI() *di { return &di{} }
 
-func (d *di) InventoryClient(ctx context.Context) service.InventoryClient {
-	if d.inventoryClient == nil {
-		cfg := config.C()
+func (d *di) InventoryConn(ctx context.Context) *grpc.ClientConn {
+	if d.inventoryConn == nil {
+		// The reads are safe to retry, and so are the reservations: they are
+		// named by the order, so a retry finds the one already made.
+		d.inventoryConn = grpcConn("Inventory Service", config.C().Inventory.Address(),
+			inventorypbv1.InventoryService_ListParts_FullMethodName,
+			inventorypbv1.InventoryService_ValidateConfiguration_FullMethodName,
+			inventorypbv1.InventoryService_ReserveStock_FullMethodName,
+			inventorypbv1.InventoryService_ReleaseStock_FullMethodName,
+		)
+	}
+
+	return d.inventoryConn
+}
 
-		invConn, err := grpc.NewClient(
-			cfg.Inventory.Address(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+func (d *di) PaymentConn(ctx context.Context) *grpc.ClientConn {
+	if d.paymentConn == nil {
+		// A retried PayOrder could charge the order twice; a refund is made
+		// once per order, so RefundPayment is safe to retry.
+		d.paymentConn = grpcConn("Payment Service", config.C().Payment.Address(),
+			paymentpbv1.PaymentService_RefundPayment_FullMethodName,
 		)
-		if err != nil {
-			panic(fmt.Sprintf("failed to connect to inventory service %s: %v",
-				cfg.Inventory.Address(), err),
-			)
-		}
+	}
 
-		closer.AddNamed("Inventory Service",
-			func(ctx context.Context) error {
-				return invConn.Close()
-			})
+	return d.paymentConn
+}
+
+func (d *di) IAMConn(ctx context.Context) *grpc.ClientConn {
+	if d.iamConn == nil {
+		d.iamConn = grpcConn("IAM Service", config.C().IAM.Address(),
+			iampbv1.IAMService_ValidateSession_FullMethodName,
+		)
+	}
+
+	return d.iamConn
+}
 
-		grpcInventoryClient := inventorypbv1.NewInventoryServiceClient(invConn)
+func (d *di) InventoryClient(ctx context.Context) InventoryClient {
+	if d.inventoryClient == nil {
+		grpcInventoryClient := inventorypbv1.NewInventoryServiceClient(d.InventoryConn(ctx))
 		d.inventoryClient = invclient.NewClient(grpcInventoryClient)
 	}
 
 	return d.inventoryClient
 }
 
-func (d *di) PaymentClient(ctx context.Context) service.PaymentClient {
+func (d *di) PaymentClient(ctx context.Context) sagasvc.BillingClient {
 	if d.paymentClient == nil {
-		cfg := config.C()
-
-		payConn, err := grpc.NewClient(
-			cfg.Payment.Address(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
-		if err != nil {
-			panic(fmt.Sprintf("failed to connect to payment service %s: %v",
-				cfg.Payment.Address(), err),
-			)
-		}
-
-		closer.AddNamed("Payment Service",
-			func(ctx context.Context) error {
-				return payConn.Close()
-			})
-
-		grpcPaymentClient := paymentpbv1.NewPaymentServiceClient(payConn)
+		grpcPaymentClient := paymentpbv1.NewPaymentServiceClient(d.PaymentConn(ctx))
 		d.paymentClient = pmtclient.NewClient(grpcPaymentClient)
 	}
 
 	return d.paymentClient
 }
 
+func (d *di) IAMClient(ctx context.Context) thttp.Authenticator {
+	if d.iamClient == nil {
+		grpcIAMClient := iampbv1.NewIAMServiceClient(d.IAMConn(ctx))
+		d.iamClient = iamclient.NewClient(grpcIAMClient)
+	}
+
+	return d.iamClient
+}
+
 func (d *di) DBPool(ctx context.Context) *pgxpool.Pool {
 	if d.dbPool == nil {
 
@@ -165,7 +236,7 @@ func (d *di) Migrator(ctx context.Context) *migrator.Migrator {
 	return d.migrator
 }
 
-func (d *di) OrderRepository(ctx context.Context) service.OrderRepository {
+func (d *di) OrderRepository(ctx context.Context) OrderRepository {
 	if d.repository == nil {
 		d.repository = repository.NewOrderRepository(d.DBPool(ctx))
 	}
@@ -264,10 +335,36 @@ func (d *di) OrderPaidProducer(ctx context.Context) kafka.Producer {
 	return d.orderPaidProducer
 }
 
-func (d *di) OrderProducer(ctx context.Context) service.OrderPaidSender {
+func (d *di) OrderCancelledProducer(ctx context.Context) kafka.Producer {
+	if d.orderCancelledProducer == nil {
+		d.orderCancelledProducer = producer.NewProducer(
+			d.SyncProducer(ctx),
+			config.C().Kafka.OrderCancelledTopic(),
+			logger.L(),
+		)
+	}
+
+	return d.orderCancelledProducer
+}
+
+func (d *di) OrderExpiredProducer(ctx context.Context) kafka.Producer {
+	if d.orderExpiredProducer == nil {
+		d.orderExpiredProducer = producer.NewProducer(
+			d.SyncProducer(ctx),
+			config.C().Kafka.OrderExpiredTopic(),
+			logger.L(),
+		)
+	}
+
+	return d.orderExpiredProducer
+}
+
+func (d *di) OrderProducer(ctx context.Context) OrderProducer {
 	if d.orderProducer == nil {
 		d.orderProducer = ordproducer.NewOrderProducer(
 			d.OrderPaidProducer(ctx),
+			d.OrderCancelledProducer(ctx),
+			d.OrderExpiredProducer(ctx),
 			d.KafkaConverter(ctx),
 		)
 	}
@@ -280,8 +377,8 @@ func (d *di) OrderService(ctx context.Context) OrderService {
 		d.service = service.NewOrderService(
 			d.OrderRepository(ctx),
 			d.InventoryClient(ctx),
-			d.PaymentClient(ctx),
-			d.OrderProducer(ctx),
+			d.SagaService(ctx),
+			config.C().Expiry.PaymentTTL(),
 			config.C().Server.BDEReadTimeout(),
 			config.C().Server.DBWriteTimeout(),
 		)
@@ -290,14 +387,127 @@ func (d *di) OrderService(ctx context.Context) OrderService {
 	return d.service
 }
 
+func (d *di) AdminService(ctx context.Context) thttp.AdminService {
+	if d.admin == nil {
+		d.admin = service.NewAdminService(
+			d.OrderRepository(ctx),
+			d.SagaService(ctx),
+			config.C().Server.BDEReadTimeout(),
+			config.C().Server.DBWriteTimeout(),
+		)
+	}
+
+	return d.admin
+}
+
+func (d *di) SagaRepository(ctx context.Context) sagasvc.SagaRepository {
+	if d.sagaRepository == nil {
+		d.sagaRepository = sagarepo.NewSagaRepository(d.DBPool(ctx))
+	}
+
+	return d.sagaRepository
+}
+
+func (d *di) SagaService(ctx context.Context) SagaService {
+	if d.sagas == nil {
+		cfg := config.C().Saga
+		d.sagas = sagasvc.NewSagaService(
+			d.SagaRepository(ctx),
+			d.OrderRepository(ctx),
+			d.InventoryClient(ctx),
+			d.PaymentClient(ctx),
+			d.OrderProducer(ctx),
+			sagasvc.Policy{
+				Lease:           cfg.Lease(),
+				RetryBackoff:    cfg.RetryBackoff(),
+				MaxRetryBackoff: cfg.MaxRetryBackoff(),
+				StuckAfter:      cfg.StuckAfter(),
+				BatchSize:       cfg.BatchSize(),
+				StepTimeout:     cfg.StepTimeout(),
+				DBTimeout:       config.C().Server.DBWriteTimeout(),
+			},
+		)
+	}
+
+	return d.sagas
+}
+
+func (d *di) ExpiryService(ctx context.Context) ExpiryService {
+	if d.expiry == nil {
+		d.expiry = expirysvc.NewExpiryService(
+			d.OrderRepository(ctx),
+			config.C().Expiry.BatchSize(),
+			config.C().Server.DBWriteTimeout(),
+		)
+	}
+
+	return d.expiry
+}
+
 func (d *di) OrderHandler(ctx context.Context) orderv1.Handler {
 	if d.handler == nil {
-		d.handler = thttp.NewOrderHandler(d.OrderService(ctx))
+		d.handler = thttp.NewOrderHandler(d.OrderService(ctx), d.AdminService(ctx))
 	}
 
 	return d.handler
 }
 
+func (d *di) SecurityHandler(ctx context.Context) orderv1.SecurityHandler {
+	if d.security == nil {
+		d.security = thttp.NewSecurityHandler(d.IAMClient(ctx))
+	}
+
+	return d.security
+}
+
+func (d *di) BucketRepository(ctx context.Context) ratelimitsvc.BucketRepository {
+	if d.bucketRepository == nil {
+		switch config.C().RateLimit.Store() {
+		case envconfig.RateLimitStorePostgres:
+			d.bucketRepository = bucketrepo.NewPostgresBucketRepository(d.DBPool(ctx))
+		default:
+			d.bucketRepository = bucketrepo.NewMemoryBucketRepository()
+		}
+	}
+
+	return d.bucketRepository
+}
+
+func (d *di) RateLimitService(ctx context.Context) RateLimitService {
+	if d.rateLimiter == nil {
+		limits, err := rateLimits(config.C().RateLimit)
+		if err != nil {
+			panic(fmt.Sprintf("invalid rate limits: %v\n", err))
+		}
+
+		d.rateLimiter = ratelimitsvc.NewRateLimitService(
+			d.BucketRepository(ctx),
+			limits,
+			config.C().Server.DBWriteTimeout(),
+		)
+	}
+
+	return d.rateLimiter
+}
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		cfg := config.C()
+
+		d.health = health.New()
+		d.health.Register("postgres", health.Ping(d.DBPool(ctx)))
+		d.health.Register("kafka", health.Kafka(
+			cfg.Kafka.Brokers(),
+			cfg.Kafka.OrderPaidProducerConfig(),
+		))
+		d.health.Register("inventory", health.GRPC(d.InventoryConn(ctx), ""))
+		d.health.Register("payment", health.GRPC(d.PaymentConn(ctx), ""))
+		d.health.Register("iam", health.GRPC(d.IAMConn(ctx), ""))
+	}
+
+	return d.health
+}
+
 func (d *di) Router(_ context.Context) *chi.Mux {
 	if d.router == nil {
 		d.router = chi.NewRouter()
@@ -305,3 +515,53 @@ func (d *di) Router(_ context.Context) *chi.Mux {
 
 	return d.router
 }
+
+func rateLimits(cfg config.RateLimit) (model.RateLimits, error) {
+	var (
+		limits model.RateLimits
+		err    error
+	)
+	if limits.IP, err = model.ParseBudget(cfg.IPBudget()); err != nil {
+		return model.RateLimits{}, fmt.Errorf("ip: %w", err)
+	}
+	if limits.User, err = model.ParseBudget(cfg.UserBudget()); err != nil {
+		return model.RateLimits{}, fmt.Errorf("user: %w", err)
+	}
+
+	limits.Routes = make(map[string]model.Budget, len(cfg.RouteBudgets()))
+	for route, s := range cfg.RouteBudgets() {
+		if limits.Routes[route], err = model.ParseBudget(s); err != nil {
+			return model.RateLimits{}, fmt.Errorf("route %s: %w", route, err)
+		}
+	}
+
+	return limits, nil
+}
+
+// grpcConn connects to a gRPC service with the client policy of the config,
+// retrying the calls of retryable methods.
+func grpcConn(name, address string, retryable ...string) *grpc.ClientConn {
+	cfg := config.C().GRPC
+
+	conn, err := grpcclient.New(address).
+		WithTimeout(cfg.Timeout()).
+		WithMethodTimeouts(cfg.MethodTimeouts()).
+		WithRetry(grpcclient.RetryPolicy{
+			MaxAttempts:    cfg.RetryMaxAttempts(),
+			InitialBackoff: cfg.RetryInitialBackoff(),
+			MaxBackoff:     cfg.RetryMaxBackoff(),
+		}, retryable...).
+		WithCircuitBreaker(cfg.BreakerFailures(), cfg.BreakerOpenTimeout()).
+		WithKeepalive(cfg.KeepaliveTime(), cfg.KeepaliveTimeout()).
+		WithTLS(cfg.TLS()).
+		Build()
+	if err != nil {
+		panic(fmt.Sprintf("failed to connect to %s %s: %v", name, address, err))
+	}
+
+	closer.AddNamed(name, func(ctx context.Context) error {
+		return conn.Close()
+	})
+
+	return conn
+}
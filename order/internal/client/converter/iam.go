@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+func ValidateSessionResponseToIdentity(res *iampbv1.ValidateSessionResponse) (*model.Identity, error) {
+	userID, err := uuid.Parse(res.GetUser().GetUuid())
+	if err != nil {
+		return nil, fmt.Errorf("parse user uuid: %w", err)
+	}
+
+	return &model.Identity{
+		UserID: userID,
+		Role:   roleFromPB(res.GetUser().GetRole()),
+	}, nil
+}
+
+// roleFromPB treats an unknown role as a customer, who has no staff rights.
+func roleFromPB(r iampbv1.Role) model.Role {
+	switch r {
+	case iampbv1.Role_ROLE_SUPPORT:
+		return model.RoleSupport
+	case iampbv1.Role_ROLE_ADMIN:
+		return model.RoleAdmin
+	default:
+		return model.RoleCustomer
+	}
+}
@@ -29,6 +29,7 @@ func partToModel(p *inventorypbv1.Part) model.Part {
 		Description:   p.Description,
 		PriceCents:    p.PriceCents,
 		StockQuantity: p.StockQuantity,
+		StockLevels:   stockLevelsToModel(p.StockLevels),
 		Category:      model.Category(p.Category),
 		Dimensions:    dimensionsToModel(p.Dimensions),
 		Manufacturer:  manufacturerToModel(p.Manufacturer),
@@ -36,9 +37,22 @@ func partToModel(p *inventorypbv1.Part) model.Part {
 		Metadata:      metadataToModel(p.Metadata),
 		CreatedAt:     tsToTimePtr(p.CreatedAt),
 		UpdatedAt:     tsToTimePtr(p.UpdatedAt),
+		Version:       p.Version,
 	}
 }
 
+func stockLevelsToModel(levels []*inventorypbv1.StockLevel) []model.StockLevel {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	out := make([]model.StockLevel, 0, len(levels))
+	for _, l := range levels {
+		out = append(out, model.StockLevel{WarehouseID: l.GetWarehouseId(), Quantity: l.GetQuantity()})
+	}
+	return out
+}
+
 func dimensionsToModel(d *inventorypbv1.Dimensions) *model.Dimensions {
 	if d == nil {
 		return nil
@@ -109,3 +123,46 @@ func PartsFilterToPB(filter model.PartsFilter) *inventorypbv1.PartsFilter {
 		Tags:                  filter.Tags,
 	}
 }
+
+func ViolationsToModel(violations []*inventorypbv1.ConfigurationViolation) []model.ConfigurationViolation {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	out := make([]model.ConfigurationViolation, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, model.ConfigurationViolation{
+			PartID:         v.GetPartUuid(),
+			Kind:           ruleKindToModel(v.GetKind()),
+			TargetPartID:   v.GetTarget().GetPartUuid(),
+			TargetCategory: model.Category(v.GetTarget().GetCategory()),
+			Message:        v.GetMessage(),
+		})
+	}
+	return out
+}
+
+func ruleKindToModel(k inventorypbv1.CompatibilityRuleKind) model.RuleKind {
+	switch k {
+	case inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_CONFLICTS_WITH:
+		return model.RuleConflictsWith
+	case inventorypbv1.CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH:
+		return model.RuleCompatibleWith
+	default:
+		return model.RuleRequires
+	}
+}
+
+func StockItemsToPB(items []model.StockItem) []*inventorypbv1.ReservedItem {
+	res := make([]*inventorypbv1.ReservedItem, len(items))
+	for i, it := range items {
+		res[i] = &inventorypbv1.ReservedItem{
+			PartUuid:        it.PartID,
+			Quantity:        it.Quantity,
+			WarehouseId:     it.WarehouseID,
+			ExpectedVersion: it.ExpectedVersion,
+		}
+	}
+
+	return res
+}
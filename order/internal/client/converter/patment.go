@@ -13,6 +13,18 @@ func PayOrderParamsToPB(params model.PayOrderParams) *paymentpbv1.PayOrderReques
 	}
 }
 
+func RefundPaymentParamsToPB(params model.RefundPaymentParams) *paymentpbv1.RefundPaymentRequest {
+	req := &paymentpbv1.RefundPaymentRequest{
+		OrderUuid: params.OrderID.String(),
+		UserUuid:  params.UserID.String(),
+	}
+	if params.TransactionID != nil {
+		req.TransactionUuid = params.TransactionID.String()
+	}
+
+	return req
+}
+
 func paymentMethodToPB(m model.PaymentMethod) paymentpbv1.PaymentMethod {
 	switch m {
 	case model.PaymentMethodUnknown:
@@ -0,0 +1,34 @@
+package iamclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/client/converter"
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	iampbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/iam/v1"
+)
+
+type client struct {
+	grpc iampbv1.IAMServiceClient
+}
+
+func NewClient(grpc iampbv1.IAMServiceClient) *client {
+	return &client{grpc: grpc}
+}
+
+// Authenticate validates a session token with IAMService. A token that IAM
+// rejects gives model.ErrUnauthorized; any other error is returned as is.
+func (c *client) Authenticate(ctx context.Context, token string) (*model.Identity, error) {
+	res, err := c.grpc.ValidateSession(ctx, &iampbv1.ValidateSessionRequest{SessionToken: token})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil, model.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	return converter.ValidateSessionResponseToIdentity(res)
+}
@@ -2,6 +2,10 @@ package invclient
 
 import (
 	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/you-humble/rocket-maintenance/order/internal/client/converter"
 	"github.com/you-humble/rocket-maintenance/order/internal/model"
@@ -16,13 +20,66 @@ func NewClient(grpc inventorypbv1.InventoryServiceClient) *client {
 	return &client{grpc: grpc}
 }
 
+// listPageSize is the largest page InventoryService returns.
+const listPageSize = 500
+
+// ListParts returns all parts matching the filter, following page tokens.
 func (c *client) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
-	parts, err := c.grpc.ListParts(ctx, &inventorypbv1.ListPartsRequest{
-		Filter: converter.PartsFilterToPB(filter),
-	})
+	req := &inventorypbv1.ListPartsRequest{
+		Filter:   converter.PartsFilterToPB(filter),
+		PageSize: listPageSize,
+	}
+
+	var parts []*inventorypbv1.Part
+	for {
+		res, err := c.grpc.ListParts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, res.GetParts()...)
+
+		if res.GetNextPageToken() == "" {
+			return converter.PartsListToModel(parts), nil
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+}
+
+// ValidateConfiguration returns the compatibility rules the parts break.
+func (c *client) ValidateConfiguration(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error) {
+	res, err := c.grpc.ValidateConfiguration(ctx, &inventorypbv1.ValidateConfigurationRequest{PartUuids: partIDs})
 	if err != nil {
 		return nil, err
 	}
+	return converter.ViolationsToModel(res.GetViolations()), nil
+}
+
+// ReserveStock sets the items aside under the reservation id; reserving an
+// id again changes nothing. Stock that is short or parts that are archived
+// give model.ErrPartsOutOfStock, unknown parts model.ErrPartNotFound and a
+// part no longer at the expected version model.ErrPartChanged.
+func (c *client) ReserveStock(ctx context.Context, reservationID string, items []model.StockItem) error {
+	_, err := c.grpc.ReserveStock(ctx, &inventorypbv1.ReserveStockRequest{
+		ReservationId: reservationID,
+		Items:         converter.StockItemsToPB(items),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition:
+		return model.ErrPartsOutOfStock
+	case codes.NotFound:
+		return model.ErrPartNotFound
+	case codes.Aborted:
+		return fmt.Errorf("%w: %s", model.ErrPartChanged, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
 
-	return converter.PartsListToModel(parts.Parts), nil
+// ReleaseStock puts the stock of the reservation back; releasing it again, or
+// an id never reserved, changes nothing.
+func (c *client) ReleaseStock(ctx context.Context, reservationID string) error {
+	_, err := c.grpc.ReleaseStock(ctx, &inventorypbv1.ReleaseStockRequest{ReservationId: reservationID})
+	return err
 }
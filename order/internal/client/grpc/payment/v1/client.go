@@ -24,3 +24,10 @@ func (c *client) PayOrder(ctx context.Context, params model.PayOrderParams) (str
 
 	return paid.TransactionUuid, nil
 }
+
+// RefundPayment gives the user back the payment for the order. Refunding an
+// order again returns the same refund.
+func (c *client) RefundPayment(ctx context.Context, params model.RefundPaymentParams) error {
+	_, err := c.grpc.RefundPayment(ctx, converter.RefundPaymentParamsToPB(params))
+	return err
+}
@@ -16,9 +16,14 @@ type config struct {
 	Server    Server
 	Inventory Client
 	Payment   Client
+	IAM       Client
+	GRPC      GRPCClient
 	Logger    Logger
 	Postgres  Database
 	Kafka     Kafka
+	RateLimit RateLimit
+	Expiry    Expiry
+	Saga      Saga
 }
 
 func Load(path ...string) error {
@@ -45,6 +50,16 @@ func Load(path ...string) error {
 		return fmt.Errorf("%s Payment: %w", op, err)
 	}
 
+	iamCfg, err := envconfig.NewIAMConfig()
+	if err != nil {
+		return fmt.Errorf("%s IAM: %w", op, err)
+	}
+
+	grpcCfg, err := envconfig.NewGRPCClientConfig()
+	if err != nil {
+		return fmt.Errorf("%s GRPC: %w", op, err)
+	}
+
 	loggerCfg, err := envconfig.NewLoggerConfig()
 	if err != nil {
 		return fmt.Errorf("%s Logger: %w", op, err)
@@ -60,13 +75,33 @@ func Load(path ...string) error {
 		return fmt.Errorf("%s Kafka: %w", op, err)
 	}
 
+	rateLimitCfg, err := envconfig.NewRateLimitConfig()
+	if err != nil {
+		return fmt.Errorf("%s RateLimit: %w", op, err)
+	}
+
+	expiryCfg, err := envconfig.NewExpiryConfig()
+	if err != nil {
+		return fmt.Errorf("%s Expiry: %w", op, err)
+	}
+
+	sagaCfg, err := envconfig.NewSagaConfig()
+	if err != nil {
+		return fmt.Errorf("%s Saga: %w", op, err)
+	}
+
 	cfg = &config{
 		Server:    serverCfg,
 		Inventory: inventoryCfg,
 		Payment:   paymentCfg,
+		IAM:       iamCfg,
+		GRPC:      grpcCfg,
 		Logger:    loggerCfg,
 		Postgres:  postgresCfg,
 		Kafka:     kafkaCfg,
+		RateLimit: rateLimitCfg,
+		Expiry:    expiryCfg,
+		Saga:      sagaCfg,
 	}
 
 	return nil
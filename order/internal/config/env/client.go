@@ -1,9 +1,13 @@
 package envconfig
 
 import (
+	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
 )
 
 // ======= Inventory =======
@@ -56,3 +60,96 @@ func (cfg *payment) Port() int    { return cfg.raw.GRPCPort }
 func (cfg *payment) Address() string {
 	return fmt.Sprintf("%s:%d", cfg.Host(), cfg.Port())
 }
+
+// ======= IAM =======
+
+type iamEnv struct {
+	GRPCHost string `env:"IAM_GRPC_HOST,required"`
+	GRPCPort int    `env:"IAM_GRPC_PORT,required"`
+}
+
+type iam struct {
+	raw iamEnv
+}
+
+func NewIAMConfig() (*iam, error) {
+	var raw iamEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+	return &iam{raw: raw}, nil
+}
+
+func (cfg *iam) Host() string { return cfg.raw.GRPCHost }
+func (cfg *iam) Port() int    { return cfg.raw.GRPCPort }
+func (cfg *iam) Address() string {
+	return fmt.Sprintf("%s:%d", cfg.Host(), cfg.Port())
+}
+
+// ======= gRPC client policy =======
+
+type grpcClientEnv struct {
+	// Timeout is the deadline of the calls without one in MethodTimeouts.
+	Timeout time.Duration `env:"GRPC_CLIENT_TIMEOUT,required"`
+	// Deadlines of single methods, like "PayOrder:10s,ListParts:3s".
+	MethodTimeouts map[string]time.Duration `env:"GRPC_CLIENT_METHOD_TIMEOUTS"`
+
+	RetryMaxAttempts    int           `env:"GRPC_CLIENT_RETRY_MAX_ATTEMPTS,required"`
+	RetryInitialBackoff time.Duration `env:"GRPC_CLIENT_RETRY_INITIAL_BACKOFF,required"`
+	RetryMaxBackoff     time.Duration `env:"GRPC_CLIENT_RETRY_MAX_BACKOFF,required"`
+
+	BreakerFailures int           `env:"GRPC_CLIENT_BREAKER_FAILURES,required"`
+	BreakerOpenFor  time.Duration `env:"GRPC_CLIENT_BREAKER_OPEN_TIMEOUT,required"`
+
+	KeepaliveTime    time.Duration `env:"GRPC_CLIENT_KEEPALIVE_TIME,required"`
+	KeepaliveTimeout time.Duration `env:"GRPC_CLIENT_KEEPALIVE_TIMEOUT,required"`
+
+	// TLSCA signs the certificates of the services; with TLSCert and TLSKey
+	// the client presents its own certificate for mutual TLS.
+	TLSEnabled        bool          `env:"GRPC_CLIENT_TLS_ENABLED" envDefault:"false"`
+	TLSCA             string        `env:"GRPC_CLIENT_TLS_CA_FILE"`
+	TLSCert           string        `env:"GRPC_CLIENT_TLS_CERT_FILE"`
+	TLSKey            string        `env:"GRPC_CLIENT_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"GRPC_CLIENT_TLS_RELOAD_INTERVAL" envDefault:"1m"`
+}
+
+type grpcClient struct {
+	raw grpcClientEnv
+	tls *tls.Config
+}
+
+func NewGRPCClientConfig() (*grpcClient, error) {
+	var raw grpcClientEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	cfg := &grpcClient{raw: raw}
+	if raw.TLSEnabled {
+		var err error
+		cfg.tls, err = tlsconfig.Client(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, tlsconfig.WithReloadInterval(raw.TLSReloadInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *grpcClient) Timeout() time.Duration                   { return cfg.raw.Timeout }
+func (cfg *grpcClient) MethodTimeouts() map[string]time.Duration { return cfg.raw.MethodTimeouts }
+func (cfg *grpcClient) RetryMaxAttempts() int                    { return cfg.raw.RetryMaxAttempts }
+func (cfg *grpcClient) RetryInitialBackoff() time.Duration       { return cfg.raw.RetryInitialBackoff }
+func (cfg *grpcClient) RetryMaxBackoff() time.Duration           { return cfg.raw.RetryMaxBackoff }
+func (cfg *grpcClient) BreakerFailures() int                     { return cfg.raw.BreakerFailures }
+func (cfg *grpcClient) BreakerOpenTimeout() time.Duration        { return cfg.raw.BreakerOpenFor }
+func (cfg *grpcClient) KeepaliveTime() time.Duration             { return cfg.raw.KeepaliveTime }
+func (cfg *grpcClient) KeepaliveTimeout() time.Duration          { return cfg.raw.KeepaliveTimeout }
+
+// TLS is the config of the connections, nil when they are in plaintext. The
+// services are verified by the host names they are reached at.
+func (cfg *grpcClient) TLS() *tls.Config { return cfg.tls }
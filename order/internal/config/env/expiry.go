@@ -0,0 +1,36 @@
+package envconfig
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type expiryEnv struct {
+	// PaymentTTL is how long a new order waits for the payment.
+	PaymentTTL    time.Duration `env:"PAYMENT_TTL,required"`
+	SweepInterval time.Duration `env:"EXPIRY_SWEEP_INTERVAL,required"`
+	BatchSize     uint64        `env:"EXPIRY_BATCH_SIZE,required"`
+}
+
+type expiry struct {
+	raw expiryEnv
+}
+
+func NewExpiryConfig() (*expiry, error) {
+	var raw expiryEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.PaymentTTL <= 0 || raw.SweepInterval <= 0 || raw.BatchSize == 0 {
+		return nil, errors.New("PAYMENT_TTL, EXPIRY_SWEEP_INTERVAL and EXPIRY_BATCH_SIZE must be positive")
+	}
+
+	return &expiry{raw: raw}, nil
+}
+
+func (cfg *expiry) PaymentTTL() time.Duration    { return cfg.raw.PaymentTTL }
+func (cfg *expiry) SweepInterval() time.Duration { return cfg.raw.SweepInterval }
+func (cfg *expiry) BatchSize() uint64            { return cfg.raw.BatchSize }
@@ -1,19 +1,41 @@
 package envconfig
 
 import (
+	"crypto/tls"
+	"time"
+
 	"github.com/IBM/sarama"
 	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/kafka/security"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
 )
 
 type kafkaEnv struct {
 	Brokers                 []string `env:"KAFKA_BROKERS,required"`
 	OrderPaidTopicName      string   `env:"ORDER_PAID_TOPIC_NAME,required"`
+	OrderCancelledTopicName string   `env:"ORDER_CANCELLED_TOPIC_NAME,required"`
+	OrderExpiredTopicName   string   `env:"ORDER_EXPIRED_TOPIC_NAME,required"`
 	OrderAssembledTopicName string   `env:"ORDER_ASSEMBLED_TOPIC_NAME,required"`
 	ConsumerGroupID         string   `env:"ORDER_ASSEMBLED_CONSUMER_GROUP_ID,required"`
+
+	TLSEnabled bool   `env:"KAFKA_TLS_ENABLED" envDefault:"false"`
+	TLSCA      string `env:"KAFKA_TLS_CA_FILE"`
+	// With a client certificate the brokers can authenticate the service by
+	// it instead of SASL.
+	TLSCert           string        `env:"KAFKA_TLS_CERT_FILE"`
+	TLSKey            string        `env:"KAFKA_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `env:"KAFKA_TLS_RELOAD_INTERVAL" envDefault:"1m"`
+
+	// SCRAM-SHA-256 or SCRAM-SHA-512; empty turns SASL off.
+	SASLMechanism string `env:"KAFKA_SASL_MECHANISM"`
+	SASLUsername  string `env:"KAFKA_SASL_USERNAME"`
+	SASLPassword  string `env:"KAFKA_SASL_PASSWORD"`
 }
 
 type kafka struct {
-	raw kafkaEnv
+	raw      kafkaEnv
+	security *security.Config
 }
 
 func NewKafkaConfig() (*kafka, error) {
@@ -21,11 +43,36 @@ func NewKafkaConfig() (*kafka, error) {
 	if err := env.Parse(&raw); err != nil {
 		return nil, err
 	}
-	return &kafka{raw: raw}, nil
+
+	var tlsCfg *tls.Config
+	if raw.TLSEnabled {
+		var err error
+		tlsCfg, err = tlsconfig.Client(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, tlsconfig.WithReloadInterval(raw.TLSReloadInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sec, err := security.New(tlsCfg, security.SASL{
+		Mechanism: raw.SASLMechanism,
+		Username:  raw.SASLUsername,
+		Password:  raw.SASLPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafka{raw: raw, security: sec}, nil
 }
 
 func (cfg *kafka) Brokers() []string           { return cfg.raw.Brokers }
 func (cfg *kafka) OrderPaidTopic() string      { return cfg.raw.OrderPaidTopicName }
+func (cfg *kafka) OrderCancelledTopic() string { return cfg.raw.OrderCancelledTopicName }
+func (cfg *kafka) OrderExpiredTopic() string   { return cfg.raw.OrderExpiredTopicName }
 func (cfg *kafka) OrderAssembledTopic() string { return cfg.raw.OrderAssembledTopicName }
 func (cfg *kafka) ConsumerGroupID() string     { return cfg.raw.ConsumerGroupID }
 
@@ -35,7 +82,7 @@ func (cfg *kafka) OrderAssembledConsumerConfig() *sarama.Config {
 	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 
-	return config
+	return cfg.security.Apply(config)
 }
 
 func (cfg *kafka) OrderPaidProducerConfig() *sarama.Config {
@@ -43,5 +90,5 @@ func (cfg *kafka) OrderPaidProducerConfig() *sarama.Config {
 	config.Version = sarama.V4_0_0_0
 	config.Producer.Return.Successes = true
 
-	return config
+	return cfg.security.Apply(config)
 }
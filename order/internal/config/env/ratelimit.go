@@ -0,0 +1,51 @@
+package envconfig
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+type rateLimitEnv struct {
+	Store string `env:"RATE_LIMIT_STORE,required"`
+	// Budgets are "<requests>/<duration>", like "60/1m", or "off".
+	IP   string `env:"RATE_LIMIT_IP,required"`
+	User string `env:"RATE_LIMIT_USER,required"`
+	// Budgets of single operations, like "PayOrder:10/1m,CreateOrder:30/1m".
+	Routes        map[string]string `env:"RATE_LIMIT_ROUTES"`
+	TrustProxy    bool              `env:"RATE_LIMIT_TRUST_PROXY,required"`
+	SweepInterval time.Duration     `env:"RATE_LIMIT_SWEEP_INTERVAL,required"`
+}
+
+type rateLimit struct {
+	raw rateLimitEnv
+}
+
+func NewRateLimitConfig() (*rateLimit, error) {
+	var raw rateLimitEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	switch raw.Store {
+	case RateLimitStoreMemory, RateLimitStorePostgres:
+	default:
+		return nil, fmt.Errorf("RATE_LIMIT_STORE must be %s or %s, got %q",
+			RateLimitStoreMemory, RateLimitStorePostgres, raw.Store)
+	}
+
+	return &rateLimit{raw: raw}, nil
+}
+
+func (cfg *rateLimit) Store() string                   { return cfg.raw.Store }
+func (cfg *rateLimit) IPBudget() string                { return cfg.raw.IP }
+func (cfg *rateLimit) UserBudget() string              { return cfg.raw.User }
+func (cfg *rateLimit) RouteBudgets() map[string]string { return cfg.raw.Routes }
+func (cfg *rateLimit) TrustProxy() bool                { return cfg.raw.TrustProxy }
+func (cfg *rateLimit) SweepInterval() time.Duration    { return cfg.raw.SweepInterval }
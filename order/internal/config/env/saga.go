@@ -0,0 +1,52 @@
+package envconfig
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type sagaEnv struct {
+	ResumeInterval  time.Duration `env:"SAGA_RESUME_INTERVAL,required"`
+	BatchSize       uint64        `env:"SAGA_BATCH_SIZE,required"`
+	Lease           time.Duration `env:"SAGA_LEASE,required"`
+	StepTimeout     time.Duration `env:"SAGA_STEP_TIMEOUT,required"`
+	RetryBackoff    time.Duration `env:"SAGA_RETRY_BACKOFF,required"`
+	MaxRetryBackoff time.Duration `env:"SAGA_MAX_RETRY_BACKOFF,required"`
+	StuckAfter      time.Duration `env:"SAGA_STUCK_AFTER,required"`
+}
+
+type saga struct {
+	raw sagaEnv
+}
+
+func NewSagaConfig() (*saga, error) {
+	var raw sagaEnv
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.ResumeInterval <= 0 || raw.BatchSize == 0 || raw.StepTimeout <= 0 || raw.RetryBackoff <= 0 ||
+		raw.StuckAfter <= 0 {
+		return nil, errors.New("SAGA_RESUME_INTERVAL, SAGA_BATCH_SIZE, SAGA_STEP_TIMEOUT, SAGA_RETRY_BACKOFF and " +
+			"SAGA_STUCK_AFTER must be positive")
+	}
+	// A saga whose lease ran out may be taken over in the middle of a step.
+	if raw.Lease <= raw.StepTimeout {
+		return nil, errors.New("SAGA_LEASE must be longer than SAGA_STEP_TIMEOUT")
+	}
+	if raw.MaxRetryBackoff < raw.RetryBackoff {
+		return nil, errors.New("SAGA_MAX_RETRY_BACKOFF must not be shorter than SAGA_RETRY_BACKOFF")
+	}
+
+	return &saga{raw: raw}, nil
+}
+
+func (cfg *saga) ResumeInterval() time.Duration  { return cfg.raw.ResumeInterval }
+func (cfg *saga) BatchSize() uint64              { return cfg.raw.BatchSize }
+func (cfg *saga) Lease() time.Duration           { return cfg.raw.Lease }
+func (cfg *saga) StepTimeout() time.Duration     { return cfg.raw.StepTimeout }
+func (cfg *saga) RetryBackoff() time.Duration    { return cfg.raw.RetryBackoff }
+func (cfg *saga) MaxRetryBackoff() time.Duration { return cfg.raw.MaxRetryBackoff }
+func (cfg *saga) StuckAfter() time.Duration      { return cfg.raw.StuckAfter }
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -12,6 +13,19 @@ type Client interface {
 	Address() string
 }
 
+type GRPCClient interface {
+	Timeout() time.Duration
+	MethodTimeouts() map[string]time.Duration
+	RetryMaxAttempts() int
+	RetryInitialBackoff() time.Duration
+	RetryMaxBackoff() time.Duration
+	BreakerFailures() int
+	BreakerOpenTimeout() time.Duration
+	KeepaliveTime() time.Duration
+	KeepaliveTimeout() time.Duration
+	TLS() *tls.Config
+}
+
 type Server interface {
 	Client
 	ReadTimeout() time.Duration
@@ -33,8 +47,35 @@ type Database interface {
 type Kafka interface {
 	Brokers() []string
 	OrderPaidTopic() string
+	OrderCancelledTopic() string
+	OrderExpiredTopic() string
 	OrderAssembledTopic() string
 	ConsumerGroupID() string
 	OrderAssembledConsumerConfig() *sarama.Config
 	OrderPaidProducerConfig() *sarama.Config
 }
+
+type RateLimit interface {
+	Store() string
+	IPBudget() string
+	UserBudget() string
+	RouteBudgets() map[string]string
+	TrustProxy() bool
+	SweepInterval() time.Duration
+}
+
+type Expiry interface {
+	PaymentTTL() time.Duration
+	SweepInterval() time.Duration
+	BatchSize() uint64
+}
+
+type Saga interface {
+	ResumeInterval() time.Duration
+	BatchSize() uint64
+	Lease() time.Duration
+	StepTimeout() time.Duration
+	RetryBackoff() time.Duration
+	MaxRetryBackoff() time.Duration
+	StuckAfter() time.Duration
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/you-humble/rocket-maintenance/order/internal/model"
 	assemblypbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/assembly/v1"
@@ -32,6 +33,37 @@ func (c *kafkaConverter) PaidOrderToModel(m model.PaidOrder) ([]byte, error) {
 	return payload, nil
 }
 
+func (c *kafkaConverter) CancelledOrderToPayload(m model.CancelledOrder) ([]byte, error) {
+	pb := &assemblypbv1.OrderCancelledRecord{
+		EventUuid: m.EventID.String(),
+		OrderUuid: m.OrderID.String(),
+		UserUuid:  m.UserID.String(),
+	}
+
+	payload, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
+
+func (c *kafkaConverter) ExpiredOrderToPayload(m model.ExpiredOrder) ([]byte, error) {
+	pb := &assemblypbv1.OrderExpiredRecord{
+		EventUuid: m.EventID.String(),
+		OrderUuid: m.OrderID.String(),
+		UserUuid:  m.UserID.String(),
+		ExpiresAt: timestamppb.New(m.ExpiresAt),
+	}
+
+	payload, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	return payload, nil
+}
+
 func (c *kafkaConverter) AssembledShipToModel(data []byte) (model.AssembledShip, error) {
 	var pb assemblypbv1.AssembledShipRecord
 	if err := proto.Unmarshal(data, &pb); err != nil {
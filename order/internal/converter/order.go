@@ -2,6 +2,8 @@ package converter
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -44,9 +46,9 @@ func CategoryToPB(c model.Category) inventorypbv1.Category {
 	}
 }
 
-func CreateOrderRequestToParams(req *orderv1.CreateOrderRequest) model.CreateOrderParams {
+func CreateOrderRequestToParams(userID uuid.UUID, req *orderv1.CreateOrderRequest) model.CreateOrderParams {
 	return model.CreateOrderParams{
-		UserID:  req.UserUUID,
+		UserID:  userID,
 		PartIDs: req.PartUuids,
 	}
 }
@@ -58,9 +60,10 @@ func CreateOrderResultToResponse(res *model.CreateOrderResult) orderv1.CreateOrd
 	}
 }
 
-func PayOrderRequestToParams(ordID uuid.UUID, req *orderv1.PayOrderRequest) model.PayOrderParams {
+func PayOrderRequestToParams(ordID, userID uuid.UUID, req *orderv1.PayOrderRequest) model.PayOrderParams {
 	return model.PayOrderParams{
 		ID:            ordID,
+		UserID:        userID,
 		PaymentMethod: OAPIToPaymentMethod(req.PaymentMethod),
 	}
 }
@@ -81,12 +84,72 @@ func OrderToOAPI(m *model.Order) *orderv1.Order {
 		UserUUID:        m.UserID,
 		PartUuids:       append([]uuid.UUID(nil), m.PartIDs...),
 		TotalPrice:      formatCents(m.TotalPrice),
+		UnitPrices:      unitPricesToOAPI(m.UnitPrices),
 		TransactionUUID: transactionIDToOptNilUUID(m.TransactionID),
 		PaymentMethod:   paymentMethodToOptNil(m.PaymentMethod),
 		Status:          orderStatusToOAPI(m.Status),
+		CreatedAt:       createdAtToOpt(m.CreatedAt),
+		ExpiresAt:       expiresAtToOptNil(m.ExpiresAt),
 	}
 }
 
+func OrdersToOAPI(orders []model.Order) *orderv1.ListOrdersResponse {
+	res := make([]orderv1.Order, len(orders))
+	for i := range orders {
+		res[i] = *OrderToOAPI(&orders[i])
+	}
+
+	return &orderv1.ListOrdersResponse{Orders: res}
+}
+
+func AdminListOrdersParamsToFilter(params orderv1.AdminListOrdersParams, defaultLimit int32) model.OrdersFilter {
+	filter := model.OrdersFilter{Limit: uint64(params.Limit.Or(defaultLimit))}
+	if userID, ok := params.UserUUID.Get(); ok {
+		filter.UserID = &userID
+	}
+	if status, ok := params.Status.Get(); ok {
+		s := model.OrderStatus(status)
+		filter.Status = &s
+	}
+
+	return filter
+}
+
+func AdminOrderActionRequestToParams(ordID uuid.UUID, req *orderv1.AdminOrderActionRequest) model.ForceTransitionParams {
+	return model.ForceTransitionParams{
+		OrderID: ordID,
+		Reason:  req.Reason,
+	}
+}
+
+func unitPricesToOAPI(prices []int64) []string {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	out := make([]string, len(prices))
+	for i, p := range prices {
+		out[i] = formatCents(p)
+	}
+	return out
+}
+
+func createdAtToOpt(t time.Time) orderv1.OptDateTime {
+	if t.IsZero() {
+		return orderv1.OptDateTime{}
+	}
+
+	return orderv1.NewOptDateTime(t)
+}
+
+func expiresAtToOptNil(t *time.Time) orderv1.OptNilDateTime {
+	if t == nil {
+		return orderv1.OptNilDateTime{}
+	}
+
+	return orderv1.NewOptNilDateTime(*t)
+}
+
 func transactionIDToOptNilUUID(id *uuid.UUID) orderv1.OptNilUUID {
 	if id == nil {
 		return orderv1.OptNilUUID{
@@ -140,3 +203,37 @@ func formatCents(cents int64) string {
 	}
 	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
 }
+
+func ViolationsToOAPI(violations []model.ConfigurationViolation) []orderv1.ConfigurationViolation {
+	out := make([]orderv1.ConfigurationViolation, 0, len(violations))
+	for _, v := range violations {
+		ov := orderv1.ConfigurationViolation{
+			Rule:    ruleKindToOAPI(v.Kind),
+			Message: v.Message,
+		}
+		if id, err := uuid.Parse(v.PartID); err == nil {
+			ov.PartUUID = id
+		}
+		if id, err := uuid.Parse(v.TargetPartID); err == nil {
+			ov.TargetPartUUID = orderv1.NewOptUUID(id)
+		}
+		if v.TargetCategory != model.CategoryUnknown {
+			ov.TargetCategory = orderv1.NewOptString(
+				strings.TrimPrefix(CategoryToPB(v.TargetCategory).String(), "CATEGORY_"),
+			)
+		}
+		out = append(out, ov)
+	}
+	return out
+}
+
+func ruleKindToOAPI(k model.RuleKind) orderv1.CompatibilityRule {
+	switch k {
+	case model.RuleConflictsWith:
+		return orderv1.CompatibilityRuleCONFLICTSWITH
+	case model.RuleCompatibleWith:
+		return orderv1.CompatibilityRuleCOMPATIBLEWITH
+	default:
+		return orderv1.CompatibilityRuleREQUIRES
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+)
+
+func SagaToOAPI(m *model.Saga) *orderv1.Saga {
+	if m == nil {
+		return nil
+	}
+
+	s := &orderv1.Saga{
+		SagaUUID:  m.ID,
+		Type:      orderv1.SagaType(m.Type),
+		OrderUUID: m.OrderID,
+		Status:    orderv1.SagaStatus(m.Status),
+		Step:      string(m.Step),
+		Attempts:  int32(m.Attempts),
+		NextRunAt: m.NextRunAt,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.LastError != "" {
+		s.LastError = orderv1.NewOptString(m.LastError)
+	}
+
+	return s
+}
+
+func SagasToOAPI(sagas []model.Saga) *orderv1.ListSagasResponse {
+	res := make([]orderv1.Saga, len(sagas))
+	for i := range sagas {
+		res[i] = *SagaToOAPI(&sagas[i])
+	}
+
+	return &orderv1.ListSagasResponse{Sagas: res}
+}
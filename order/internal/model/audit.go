@@ -0,0 +1,38 @@
+package model
+
+import "github.com/google/uuid"
+
+// AdminAction is an action on orders that are not the caller's own.
+type AdminAction string
+
+const (
+	AdminActionViewOrder     AdminAction = "view_order"
+	AdminActionListOrders    AdminAction = "list_orders"
+	AdminActionCancelOrder   AdminAction = "cancel_order"
+	AdminActionCompleteOrder AdminAction = "complete_order"
+	AdminActionListSagas     AdminAction = "list_sagas"
+)
+
+// AuditEntry records an admin action and who made it.
+type AuditEntry struct {
+	ActorID   uuid.UUID
+	ActorRole Role
+	Action    AdminAction
+	// Order the action was made on; nil for a listing.
+	OrderID *uuid.UUID
+	// Reason given for a forced transition; empty for reads.
+	Reason string
+}
+
+// OrdersFilter selects orders of all users. Nil fields do not filter.
+type OrdersFilter struct {
+	UserID *uuid.UUID
+	Status *OrderStatus
+	Limit  uint64
+}
+
+// ForceTransitionParams is a forced change of the status of an order.
+type ForceTransitionParams struct {
+	OrderID uuid.UUID
+	Reason  string
+}
@@ -0,0 +1,42 @@
+package model
+
+import "strings"
+
+// RuleKind is the kind of a compatibility rule of a part.
+type RuleKind string
+
+const (
+	RuleRequires       RuleKind = "requires"
+	RuleConflictsWith  RuleKind = "conflicts_with"
+	RuleCompatibleWith RuleKind = "compatible_with"
+)
+
+// ConfigurationViolation is a compatibility rule broken by the parts of an order.
+type ConfigurationViolation struct {
+	// Part whose rule is broken.
+	PartID string
+	Kind   RuleKind
+	// For RuleRequires, the missing part or category; otherwise the ordered
+	// part that breaks the rule.
+	TargetPartID   string
+	TargetCategory Category
+	Message        string
+}
+
+// IncompatiblePartsError lists the compatibility rules broken by the parts
+// of an order; it matches ErrIncompatibleParts.
+type IncompatiblePartsError struct {
+	Violations []ConfigurationViolation
+}
+
+func (e *IncompatiblePartsError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return ErrIncompatibleParts.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e *IncompatiblePartsError) Unwrap() error {
+	return ErrIncompatibleParts
+}
@@ -14,4 +14,7 @@ var (
 	ErrPartsOutOfStock    = errors.New("parts out of stock")
 	ErrUnknownStatus      = errors.New("unknown status")
 	ErrPartNotFound       = errors.New("part not found")
+	ErrPartChanged        = errors.New("part changed") // 409
+	ErrIncompatibleParts  = errors.New("incompatible parts")
+	ErrSagaLost           = errors.New("saga taken over")
 )
@@ -0,0 +1,18 @@
+package model
+
+import "github.com/google/uuid"
+
+// Role of a user, assigned in the IAM Service.
+type Role string
+
+const (
+	RoleCustomer Role = "customer"
+	RoleSupport  Role = "support"
+	RoleAdmin    Role = "admin"
+)
+
+// Identity is the authenticated caller of the order API.
+type Identity struct {
+	UserID uuid.UUID
+	Role   Role
+}
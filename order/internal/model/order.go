@@ -35,11 +35,19 @@ type Order struct {
 	PartIDs []uuid.UUID
 	// Total price calculated based on selected spacecraft parts.
 	TotalPrice int64
+	// Catalog price of each part of PartIDs, in the same order, at the time
+	// the order was created. Empty for orders created before prices were kept.
+	UnitPrices []int64
 	// UUID of the payment transaction (present if the order is paid).
 	TransactionID *uuid.UUID
 	// Payment method used to pay for the order (present if the order is paid).
 	PaymentMethod *PaymentMethod
 	Status        OrderStatus
+	// Time the order was created.
+	CreatedAt time.Time
+	// Payment deadline: an order still pending payment after it is cancelled.
+	// Nil for orders created before deadlines were kept.
+	ExpiresAt *time.Time
 }
 
 type CreateOrderParams struct {
@@ -70,6 +78,19 @@ type PaidOrder struct {
 	TransactionID uuid.UUID
 }
 
+type CancelledOrder struct {
+	EventID uuid.UUID
+	OrderID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type ExpiredOrder struct {
+	EventID   uuid.UUID
+	OrderID   uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
+
 type AssembledShip struct {
 	EventID   uuid.UUID
 	OrderID   uuid.UUID
@@ -25,6 +25,9 @@ type Part struct {
 	PriceCents int64
 	// Quantity of this part currently available in stock.
 	StockQuantity int64
+	// Stock of the part in each warehouse that holds some; the quantities
+	// add up to StockQuantity.
+	StockLevels []StockLevel
 	// Category of the part.
 	Category Category
 	// Physical dimensions and weight of the part.
@@ -40,6 +43,15 @@ type Part struct {
 	CreatedAt *time.Time
 	// Timestamp when the part was last updated.
 	UpdatedAt *time.Time
+	// Version of the part; a stock change must name it as expected_version.
+	Version int64
+}
+
+type StockLevel struct {
+	// ID of the warehouse.
+	WarehouseID string
+	// Quantity of the part in the warehouse.
+	Quantity int64
 }
 
 type Dimensions struct {
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Budget is a token bucket that holds up to Requests tokens and refills
+// Requests tokens every Per. Every request takes one token.
+type Budget struct {
+	Requests int
+	Per      time.Duration
+}
+
+// ParseBudget parses a budget written as "<requests>/<duration>", like "60/1m".
+// "off" is the zero budget, which does not limit.
+func ParseBudget(s string) (Budget, error) {
+	if strings.TrimSpace(s) == "off" {
+		return Budget{}, nil
+	}
+
+	reqs, per, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Budget{}, fmt.Errorf("budget %q: want <requests>/<duration>", s)
+	}
+
+	n, err := strconv.Atoi(reqs)
+	if err != nil || n <= 0 {
+		return Budget{}, fmt.Errorf("budget %q: requests must be a positive integer", s)
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return Budget{}, fmt.Errorf("budget %q: duration must be positive", s)
+	}
+
+	return Budget{Requests: n, Per: d}, nil
+}
+
+func (b Budget) String() string {
+	return fmt.Sprintf("%d/%s", b.Requests, b.Per)
+}
+
+// Rate is the number of tokens added per second.
+func (b Budget) Rate() float64 {
+	return float64(b.Requests) / b.Per.Seconds()
+}
+
+// Take refills a bucket that had tokens elapsed ago and takes one token if
+// there is one. It returns the tokens left.
+func (b Budget) Take(tokens float64, elapsed time.Duration) (float64, bool) {
+	tokens = math.Min(float64(b.Requests), tokens+math.Max(elapsed.Seconds(), 0)*b.Rate())
+	if tokens < 1 {
+		return tokens, false
+	}
+
+	return tokens - 1, true
+}
+
+// Result describes a bucket that has tokens left after a request.
+func (b Budget) Result(tokens float64, allowed bool) RateLimitResult {
+	res := RateLimitResult{
+		Allowed:   allowed,
+		Limit:     b.Requests,
+		Remaining: int(math.Max(math.Floor(tokens), 0)),
+		Reset:     b.wait(float64(b.Requests) - tokens),
+	}
+	if !allowed {
+		res.RetryAfter = b.wait(1 - tokens)
+	}
+
+	return res
+}
+
+// wait is the time it takes to add tokens.
+func (b Budget) wait(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil(tokens / b.Rate() * float64(time.Second)))
+}
+
+// RateLimitResult is the state of the bucket a request was charged to.
+type RateLimitResult struct {
+	Allowed bool
+	// Limit is the size of the bucket.
+	Limit     int
+	Remaining int
+	// RetryAfter is the time until the next request is allowed; zero if
+	// this one was.
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+}
+
+// RateLimits are the budgets of the order API. A request is charged to the
+// budget of the client IP and then to the budget of its user and route.
+type RateLimits struct {
+	IP   Budget
+	User Budget
+	// Routes overrides User for the operations it names, like "PayOrder".
+	Routes map[string]Budget
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// SagaType is a transition of an order that spans other services.
+	SagaType string
+	// SagaStatus is how far a saga got.
+	SagaStatus string
+	// SagaStep names a step of a saga.
+	SagaStep string
+)
+
+const (
+	// SagaCreateOrder reserves the stock of the parts, then creates the order.
+	SagaCreateOrder SagaType = "CREATE_ORDER"
+	// SagaPayOrder charges the user, marks the order paid and announces it.
+	SagaPayOrder SagaType = "PAY_ORDER"
+	// SagaReleaseOrder gives back the stock of a cancelled order, refunds it
+	// if it was paid and announces that it was cancelled.
+	SagaReleaseOrder SagaType = "RELEASE_ORDER"
+	// SagaExpireOrder releases an order that was not paid in time, like
+	// SagaReleaseOrder, and announces that it expired.
+	SagaExpireOrder SagaType = "EXPIRE_ORDER"
+)
+
+const (
+	// SagaRunning runs the steps forward.
+	SagaRunning SagaStatus = "RUNNING"
+	// SagaCompensating undoes the steps done, last first.
+	SagaCompensating SagaStatus = "COMPENSATING"
+	// SagaCompleted ran every step.
+	SagaCompleted SagaStatus = "COMPLETED"
+	// SagaCompensated undid every step it had done.
+	SagaCompensated SagaStatus = "COMPENSATED"
+)
+
+const (
+	SagaStepReserveStock     SagaStep = "reserve_stock"
+	SagaStepCreateOrder      SagaStep = "create_order"
+	SagaStepPay              SagaStep = "pay"
+	SagaStepMarkPaid         SagaStep = "mark_paid"
+	SagaStepPublishPaid      SagaStep = "publish_paid"
+	SagaStepReleaseStock     SagaStep = "release_stock"
+	SagaStepRefundPayment    SagaStep = "refund_payment"
+	SagaStepPublishCancelled SagaStep = "publish_cancelled"
+	SagaStepPublishExpired   SagaStep = "publish_expired"
+)
+
+// Finished reports whether the saga has nothing left to do.
+func (s SagaStatus) Finished() bool {
+	return s == SagaCompleted || s == SagaCompensated
+}
+
+// Saga is the persisted progress of a transition of an order.
+type Saga struct {
+	ID      uuid.UUID
+	Type    SagaType
+	OrderID uuid.UUID
+	Status  SagaStatus
+	// Step to run next while running, or to undo next while compensating;
+	// empty once the saga is finished.
+	Step SagaStep
+	Data SagaData
+	// Failed tries of the current step.
+	Attempts int
+	// Error of the last failed try, or the one that made the saga compensate.
+	LastError string
+	// Time before which no one else picks the saga up: the lease of whoever
+	// runs it, or the backoff before the next try.
+	NextRunAt time.Time
+	// Version of the row the saga was read with; a save with a stale
+	// version is refused.
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SagaData is what the steps of a saga need and what they learned.
+type SagaData struct {
+	UserID     uuid.UUID
+	PartIDs    []uuid.UUID
+	UnitPrices []int64
+	TotalPrice int64
+	ExpiresAt  *time.Time
+	// Versions of the parts the order was priced with; the stock is only
+	// reserved while the parts still have them.
+	PartVersions  map[uuid.UUID]int64
+	PaymentMethod PaymentMethod
+	// Set once the payment went through.
+	TransactionID *uuid.UUID
+}
+
+// StockItem is a quantity of a part to set aside for an order.
+type StockItem struct {
+	PartID   string
+	Quantity int64
+	// Warehouse to take the stock from; empty lets inventory take it from
+	// whichever warehouses hold the part.
+	WarehouseID string
+	// Version the part must still have.
+	ExpectedVersion int64
+}
+
+type RefundPaymentParams struct {
+	OrderID       uuid.UUID
+	UserID        uuid.UUID
+	TransactionID *uuid.UUID
+}
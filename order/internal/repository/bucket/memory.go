@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// memoryRepository keeps the buckets in the process. Every replica limits
+// on its own, so the budgets are per replica.
+type memoryRepository struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemoryBucketRepository() *memoryRepository {
+	return &memoryRepository{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (r *memoryRepository) Take(_ context.Context, key string, budget model.Budget) (model.RateLimitResult, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(budget.Requests), updatedAt: now}
+		r.buckets[key] = b
+	}
+
+	var allowed bool
+	b.tokens, allowed = budget.Take(b.tokens, now.Sub(b.updatedAt))
+	b.updatedAt = now
+
+	return budget.Result(b.tokens, allowed), nil
+}
+
+func (r *memoryRepository) Sweep(_ context.Context, idle time.Duration) (int64, error) {
+	before := r.now().Add(-idle)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var n int64
+	for key, b := range r.buckets {
+		if b.updatedAt.Before(before) {
+			delete(r.buckets, key)
+			n++
+		}
+	}
+
+	return n, nil
+}
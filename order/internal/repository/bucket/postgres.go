@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+// postgresRepository keeps the buckets in Postgres, so the replicas of the
+// service share the budgets. A take is a single upsert, and the refill is
+// measured by the clock of the database.
+type postgresRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresBucketRepository(pool *pgxpool.Pool) *postgresRepository {
+	return &postgresRepository{pool: pool}
+}
+
+// Take mirrors model.Budget.Take: $2 is the size of the bucket and $3 the
+// tokens added per second.
+func (r *postgresRepository) Take(ctx context.Context, key string, budget model.Budget) (model.RateLimitResult, error) {
+	const take = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, true, now())
+ON CONFLICT (key) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST($2::float8, b.tokens + GREATEST(EXTRACT(EPOCH FROM now() - b.updated_at)::float8, 0) * $3::float8) >= 1
+        THEN LEAST($2::float8, b.tokens + GREATEST(EXTRACT(EPOCH FROM now() - b.updated_at)::float8, 0) * $3::float8) - 1
+        ELSE LEAST($2::float8, b.tokens + GREATEST(EXTRACT(EPOCH FROM now() - b.updated_at)::float8, 0) * $3::float8)
+    END,
+    allowed = LEAST($2::float8, b.tokens + GREATEST(EXTRACT(EPOCH FROM now() - b.updated_at)::float8, 0) * $3::float8) >= 1,
+    updated_at = now()
+RETURNING tokens, allowed`
+
+	var (
+		tokens  float64
+		allowed bool
+	)
+	if err := r.pool.QueryRow(ctx, take, key, budget.Requests, budget.Rate()).Scan(&tokens, &allowed); err != nil {
+		return model.RateLimitResult{}, err
+	}
+
+	return budget.Result(tokens, allowed), nil
+}
+
+func (r *postgresRepository) Sweep(ctx context.Context, idle time.Duration) (int64, error) {
+	const sweep = `DELETE FROM rate_limit_buckets WHERE updated_at < now() - make_interval(secs => $1)`
+
+	ct, err := r.pool.Exec(ctx, sweep, idle.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
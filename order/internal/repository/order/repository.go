@@ -7,11 +7,22 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/you-humble/rocket-maintenance/order/internal/model"
 )
 
+var orderColumns = []string{
+	"id", "user_id", "part_ids", "total_price", "unit_prices", "transaction_id", "payment_method", "status", "created_at",
+	"expires_at",
+}
+
+// execer is a pool or a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
 type repository struct {
 	pool *pgxpool.Pool
 	sb   sq.StatementBuilderType
@@ -24,11 +35,21 @@ func NewOrderRepository(pool *pgxpool.Pool) *repository {
 	}
 }
 
+// Create inserts the order and returns its id. An order whose id is already
+// set is inserted at most once: creating it again changes nothing.
 func (r *repository) Create(ctx context.Context, ord *model.Order) (uuid.UUID, error) {
+	if ord.ID != uuid.Nil {
+		return ord.ID, r.createWithID(ctx, ord)
+	}
+
 	q := r.sb.
 		Insert("orders").
-		Columns("user_id", "part_ids", "total_price", "transaction_id", "payment_method", "status").
-		Values(ord.UserID, ord.PartIDs, ord.TotalPrice, ord.TransactionID, ord.PaymentMethod, ord.Status).
+		Columns(
+			"user_id", "part_ids", "total_price", "unit_prices", "transaction_id", "payment_method", "status", "expires_at",
+		).
+		Values(
+			ord.UserID, ord.PartIDs, ord.TotalPrice, ord.UnitPrices, ord.TransactionID, ord.PaymentMethod, ord.Status, ord.ExpiresAt,
+		).
 		Suffix("RETURNING id")
 
 	sqlStr, args, err := q.ToSql()
@@ -44,9 +65,31 @@ func (r *repository) Create(ctx context.Context, ord *model.Order) (uuid.UUID, e
 	return orderID, nil
 }
 
+func (r *repository) createWithID(ctx context.Context, ord *model.Order) error {
+	q := r.sb.
+		Insert("orders").
+		Columns(
+			"id", "user_id", "part_ids", "total_price", "unit_prices", "transaction_id", "payment_method", "status",
+			"expires_at",
+		).
+		Values(
+			ord.ID, ord.UserID, ord.PartIDs, ord.TotalPrice, ord.UnitPrices, ord.TransactionID, ord.PaymentMethod,
+			ord.Status, ord.ExpiresAt,
+		).
+		Suffix("ON CONFLICT (id) DO NOTHING")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
 func (r *repository) OrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error) {
 	q := r.sb.
-		Select("id", "user_id", "part_ids", "total_price", "transaction_id", "payment_method", "status").
+		Select(orderColumns...).
 		From("orders").
 		Where(sq.Eq{"id": id})
 
@@ -56,16 +99,7 @@ func (r *repository) OrderByID(ctx context.Context, id uuid.UUID) (*model.Order,
 	}
 
 	var ord model.Order
-	err = r.pool.QueryRow(ctx, sqlStr, args...).Scan(
-		&ord.ID,
-		&ord.UserID,
-		&ord.PartIDs,
-		&ord.TotalPrice,
-		&ord.TransactionID,
-		&ord.PaymentMethod,
-		&ord.Status,
-	)
-	if err != nil {
+	if err := scanOrder(r.pool.QueryRow(ctx, sqlStr, args...), &ord); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrOrderNotFound
 		}
@@ -75,7 +109,224 @@ func (r *repository) OrderByID(ctx context.Context, id uuid.UUID) (*model.Order,
 	return &ord, nil
 }
 
+// OrdersByUser returns at most limit orders of the user, newest first.
+func (r *repository) OrdersByUser(ctx context.Context, userID uuid.UUID, limit uint64) ([]model.Order, error) {
+	return r.Orders(ctx, model.OrdersFilter{UserID: &userID, Limit: limit})
+}
+
+// Orders returns at most filter.Limit orders that match the filter, newest first.
+func (r *repository) Orders(ctx context.Context, filter model.OrdersFilter) ([]model.Order, error) {
+	where := sq.And{}
+	if filter.UserID != nil {
+		where = append(where, sq.Eq{"user_id": *filter.UserID})
+	}
+	if filter.Status != nil {
+		where = append(where, sq.Eq{"status": *filter.Status})
+	}
+
+	q := r.sb.
+		Select(orderColumns...).
+		From("orders").
+		Where(where).
+		OrderBy("created_at DESC", "id").
+		Limit(filter.Limit)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]model.Order, 0, filter.Limit)
+	for rows.Next() {
+		var ord model.Order
+		if err := scanOrder(rows, &ord); err != nil {
+			return nil, err
+		}
+		orders = append(orders, ord)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
 func (r *repository) Update(ctx context.Context, upd *model.Order) error {
+	return r.update(ctx, r.pool, upd, nil)
+}
+
+// UpdateFrom updates the order only while it has status from. An order with
+// another status gives model.ErrOrderConflict.
+func (r *repository) UpdateFrom(ctx context.Context, upd *model.Order, from model.OrderStatus) error {
+	return r.update(ctx, r.pool, upd, &from)
+}
+
+// RevertPayment puts a paid order back to pending payment, forgetting its
+// payment, if it was paid by the transaction. Any other order is left as is.
+func (r *repository) RevertPayment(ctx context.Context, id, transactionID uuid.UUID) error {
+	q := r.sb.
+		Update("orders").
+		Set("status", model.StatusPendingPayment).
+		Set("transaction_id", nil).
+		Set("payment_method", nil).
+		Where(sq.Eq{"id": id, "status": model.StatusPaid, "transaction_id": transactionID})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// UpdateWithAudit updates the order while it has status from and records the
+// entry in one transaction. An order with another status gives
+// model.ErrOrderConflict and nothing is recorded.
+func (r *repository) UpdateWithAudit(
+	ctx context.Context,
+	upd *model.Order,
+	from model.OrderStatus,
+	entry model.AuditEntry,
+) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := r.update(ctx, tx, upd, &from); err != nil {
+			return err
+		}
+		return r.addAuditEntry(ctx, tx, entry)
+	})
+}
+
+// Cancel cancels the order while it has status from. The order gets a release
+// saga in the same statement, so its stock is never left reserved and its
+// OrderCancelled event is never lost. An order with another status gives
+// model.ErrOrderConflict.
+func (r *repository) Cancel(ctx context.Context, id uuid.UUID, from model.OrderStatus) error {
+	return r.cancel(ctx, r.pool, id, from)
+}
+
+// CancelWithAudit cancels the order like Cancel and records the entry in one
+// transaction. An order with another status gives model.ErrOrderConflict and
+// nothing is recorded.
+func (r *repository) CancelWithAudit(
+	ctx context.Context,
+	id uuid.UUID,
+	from model.OrderStatus,
+	entry model.AuditEntry,
+) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := r.cancel(ctx, tx, id, from); err != nil {
+			return err
+		}
+		return r.addAuditEntry(ctx, tx, entry)
+	})
+}
+
+// AddAuditEntry records an admin action.
+func (r *repository) AddAuditEntry(ctx context.Context, entry model.AuditEntry) error {
+	return r.addAuditEntry(ctx, r.pool, entry)
+}
+
+// ExpireOrders cancels up to limit orders whose payment deadline has passed
+// and returns them. Orders locked by another sweeper are skipped, so the
+// replicas of the service expire disjoint batches. Each cancelled order gets
+// an expire saga in the same statement, so its stock is never left reserved
+// and its OrderExpired event is never lost.
+func (r *repository) ExpireOrders(ctx context.Context, limit uint64) ([]model.ExpiredOrder, error) {
+	const expire = `
+WITH due AS (
+    SELECT id
+    FROM orders
+    WHERE status = 'PENDING_PAYMENT' AND expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+), expired AS (
+    UPDATE orders o
+    SET status = 'CANCELLED'
+    FROM due
+    WHERE o.id = due.id
+    RETURNING o.id, o.user_id, o.expires_at
+), sagas AS (
+    INSERT INTO order_sagas (type, order_id, status, step, data)
+    SELECT $2, id, $3, $4, jsonb_build_object('user_id', user_id, 'expires_at', expires_at)
+    FROM expired
+)
+SELECT id, user_id, expires_at
+FROM expired`
+
+	rows, err := r.pool.Query(ctx, expire,
+		limit, model.SagaExpireOrder, model.SagaRunning, model.SagaStepReleaseStock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	expired := make([]model.ExpiredOrder, 0, limit)
+	for rows.Next() {
+		var ord model.ExpiredOrder
+		if err := rows.Scan(&ord.OrderID, &ord.UserID, &ord.ExpiresAt); err != nil {
+			return nil, err
+		}
+		expired = append(expired, ord)
+	}
+
+	return expired, rows.Err()
+}
+
+func (r *repository) cancel(ctx context.Context, db execer, id uuid.UUID, from model.OrderStatus) error {
+	const cancel = `
+WITH cancelled AS (
+    UPDATE orders
+    SET status = 'CANCELLED'
+    WHERE id = $1 AND status = $2
+    RETURNING id, user_id
+), sagas AS (
+    INSERT INTO order_sagas (type, order_id, status, step, data)
+    SELECT $3, id, $4, $5, jsonb_build_object('user_id', user_id)
+    FROM cancelled
+)
+SELECT id
+FROM cancelled`
+
+	ct, err := db.Exec(ctx, cancel,
+		id, from, model.SagaReleaseOrder, model.SagaRunning, model.SagaStepReleaseStock,
+	)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() > 0 {
+		return nil
+	}
+
+	return r.missingOrConflict(ctx, id)
+}
+
+func (r *repository) addAuditEntry(ctx context.Context, db execer, entry model.AuditEntry) error {
+	q := r.sb.
+		Insert("order_audit_log").
+		Columns("actor_id", "actor_role", "action", "order_id", "reason").
+		Values(entry.ActorID, entry.ActorRole, entry.Action, entry.OrderID, entry.Reason)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(ctx, sqlStr, args...)
+	return err
+}
+
+// update sets the non-zero fields of upd; with from set, only while the
+// order has that status.
+func (r *repository) update(ctx context.Context, db execer, upd *model.Order, from *model.OrderStatus) error {
 	if upd.ID == uuid.Nil {
 		return errors.New("empty order id")
 	}
@@ -108,23 +359,56 @@ func (r *repository) Update(ctx context.Context, upd *model.Order) error {
 		return nil
 	}
 
+	where := sq.Eq{"id": upd.ID}
+	if from != nil {
+		where["status"] = *from
+	}
+
 	q := r.sb.
 		Update("orders").
 		SetMap(set).
-		Where(sq.Eq{"id": upd.ID})
+		Where(where)
 
 	sqlStr, args, err := q.ToSql()
 	if err != nil {
 		return err
 	}
 
-	ct, err := r.pool.Exec(ctx, sqlStr, args...)
+	ct, err := db.Exec(ctx, sqlStr, args...)
 	if err != nil {
 		return err
 	}
-	if ct.RowsAffected() == 0 {
+	if ct.RowsAffected() > 0 {
+		return nil
+	}
+	if from == nil {
 		return model.ErrOrderNotFound
 	}
 
-	return nil
+	return r.missingOrConflict(ctx, upd.ID)
+}
+
+// missingOrConflict tells why a conditional write of the order changed
+// nothing: model.ErrOrderNotFound when there is no such order, or
+// model.ErrOrderConflict when it has another status.
+func (r *repository) missingOrConflict(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.OrderByID(ctx, id); err != nil {
+		return err
+	}
+	return model.ErrOrderConflict
+}
+
+func scanOrder(row pgx.Row, ord *model.Order) error {
+	return row.Scan(
+		&ord.ID,
+		&ord.UserID,
+		&ord.PartIDs,
+		&ord.TotalPrice,
+		&ord.UnitPrices,
+		&ord.TransactionID,
+		&ord.PaymentMethod,
+		&ord.Status,
+		&ord.CreatedAt,
+		&ord.ExpiresAt,
+	)
 }
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+var sagaColumns = []string{
+	"id", "type", "order_id", "status", "step", "data", "attempts", "last_error", "next_run_at", "version",
+	"created_at", "updated_at",
+}
+
+var unfinished = sq.Eq{"status": []model.SagaStatus{model.SagaRunning, model.SagaCompensating}}
+
+// sagaData is how model.SagaData is kept in the data column.
+type sagaData struct {
+	UserID        uuid.UUID           `json:"user_id,omitzero"`
+	PartIDs       []uuid.UUID         `json:"part_ids,omitempty"`
+	UnitPrices    []int64             `json:"unit_prices,omitempty"`
+	TotalPrice    int64               `json:"total_price,omitempty"`
+	ExpiresAt     *time.Time          `json:"expires_at,omitempty"`
+	PartVersions  map[uuid.UUID]int64 `json:"part_versions,omitempty"`
+	PaymentMethod model.PaymentMethod `json:"payment_method,omitempty"`
+	TransactionID *uuid.UUID          `json:"transaction_id,omitempty"`
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+	sb   sq.StatementBuilderType
+}
+
+// NewSagaRepository keeps the sagas of orders in Postgres. Every write of a
+// saga bumps its version, and a write with a stale version is refused, so two
+// replicas never run the same saga.
+func NewSagaRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+		sb:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create inserts the saga with version 1.
+func (r *repository) Create(ctx context.Context, s *model.Saga) error {
+	data, err := json.Marshal(sagaDataFromModel(s.Data))
+	if err != nil {
+		return err
+	}
+
+	q := r.sb.
+		Insert("order_sagas").
+		Columns("id", "type", "order_id", "status", "step", "data", "next_run_at", "version").
+		Values(s.ID, s.Type, s.OrderID, s.Status, s.Step, data, s.NextRunAt, 1).
+		Suffix("RETURNING created_at, updated_at")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&s.CreatedAt, &s.UpdatedAt); err != nil {
+		return err
+	}
+	s.Version = 1
+
+	return nil
+}
+
+// Save writes the progress of the saga if the row still has s.Version, and
+// bumps the version. A row written by someone else since gives
+// model.ErrSagaLost.
+func (r *repository) Save(ctx context.Context, s *model.Saga) error {
+	data, err := json.Marshal(sagaDataFromModel(s.Data))
+	if err != nil {
+		return err
+	}
+
+	q := r.sb.
+		Update("order_sagas").
+		SetMap(sq.Eq{
+			"status":      s.Status,
+			"step":        s.Step,
+			"data":        data,
+			"attempts":    s.Attempts,
+			"last_error":  s.LastError,
+			"next_run_at": s.NextRunAt,
+			"version":     sq.Expr("version + 1"),
+			"updated_at":  sq.Expr("now()"),
+		}).
+		Where(sq.Eq{"id": s.ID, "version": s.Version}).
+		Suffix("RETURNING version, updated_at")
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&s.Version, &s.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrSagaLost
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDue takes up to limit unfinished sagas whose next run is due, oldest
+// due first, and leases them until lease from now. Sagas locked by another
+// replica are skipped.
+func (r *repository) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]model.Saga, error) {
+	const claim = `
+WITH due AS (
+    SELECT id
+    FROM order_sagas
+    WHERE status IN ('RUNNING', 'COMPENSATING') AND next_run_at <= now()
+    ORDER BY next_run_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE order_sagas s
+SET next_run_at = now() + make_interval(secs => $2),
+    version = s.version + 1,
+    updated_at = now()
+FROM due
+WHERE s.id = due.id
+RETURNING s.id, s.type, s.order_id, s.status, s.step, s.data, s.attempts, s.last_error, s.next_run_at,
+    s.version, s.created_at, s.updated_at`
+
+	return r.query(ctx, claim, limit, lease.Seconds())
+}
+
+// StartedBefore returns up to limit unfinished sagas started before t,
+// oldest first.
+func (r *repository) StartedBefore(ctx context.Context, t time.Time, limit uint64) ([]model.Saga, error) {
+	q := r.sb.
+		Select(sagaColumns...).
+		From("order_sagas").
+		Where(sq.And{unfinished, sq.Lt{"created_at": t}}).
+		OrderBy("created_at", "id").
+		Limit(limit)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(ctx, sqlStr, args...)
+}
+
+// CountStartedBefore counts the unfinished sagas started before t.
+func (r *repository) CountStartedBefore(ctx context.Context, t time.Time) (int64, error) {
+	q := r.sb.
+		Select("count(*)").
+		From("order_sagas").
+		Where(sq.And{unfinished, sq.Lt{"created_at": t}})
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	if err := r.pool.QueryRow(ctx, sqlStr, args...).Scan(&n); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *repository) query(ctx context.Context, sqlStr string, args ...any) ([]model.Saga, error) {
+	rows, err := r.pool.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sagas []model.Saga
+	for rows.Next() {
+		var (
+			s    model.Saga
+			data []byte
+		)
+		if err := rows.Scan(
+			&s.ID, &s.Type, &s.OrderID, &s.Status, &s.Step, &data, &s.Attempts, &s.LastError, &s.NextRunAt,
+			&s.Version, &s.CreatedAt, &s.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		var d sagaData
+		if err := json.Unmarshal(data, &d); err != nil {
+			return nil, err
+		}
+		s.Data = sagaDataToModel(d)
+
+		sagas = append(sagas, s)
+	}
+
+	return sagas, rows.Err()
+}
+
+func sagaDataFromModel(d model.SagaData) sagaData {
+	return sagaData{
+		UserID:        d.UserID,
+		PartIDs:       d.PartIDs,
+		UnitPrices:    d.UnitPrices,
+		TotalPrice:    d.TotalPrice,
+		ExpiresAt:     d.ExpiresAt,
+		PartVersions:  d.PartVersions,
+		PaymentMethod: d.PaymentMethod,
+		TransactionID: d.TransactionID,
+	}
+}
+
+func sagaDataToModel(d sagaData) model.SagaData {
+	return model.SagaData{
+		UserID:        d.UserID,
+		PartIDs:       d.PartIDs,
+		UnitPrices:    d.UnitPrices,
+		TotalPrice:    d.TotalPrice,
+		ExpiresAt:     d.ExpiresAt,
+		PartVersions:  d.PartVersions,
+		PaymentMethod: d.PaymentMethod,
+		TransactionID: d.TransactionID,
+	}
+}
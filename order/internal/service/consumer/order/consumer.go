@@ -2,6 +2,7 @@ package ordconsumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -52,6 +53,15 @@ func (s *service) shipAssembledHandler(ctx context.Context, msg kafka.Message) e
 	}
 
 	if err := s.svc.Complete(ctx, payload.OrderID); err != nil {
+		// The order is no longer paid, e.g. it was cancelled and refunded
+		// while the ship was assembled; redelivery would not change that.
+		if errors.Is(err, model.ErrOrderConflict) {
+			logger.Warn(ctx, "assembled ship of an order that is not paid",
+				logger.String("order_id", payload.OrderID.String()),
+				logger.ErrorF(err),
+			)
+			return nil
+		}
 		logger.Error(ctx, "consumer.CompleteOrder", logger.ErrorF(err))
 		return err
 	}
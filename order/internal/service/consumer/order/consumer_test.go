@@ -0,0 +1,79 @@
+package ordconsumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/consumer/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/kafka"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+func TestShipAssembledHandler(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	ordID := uuid.New()
+	value := []byte("assembled")
+
+	tests := []struct {
+		name    string
+		setup   func(conv *mocks.MockConverter, svc *mocks.MockService)
+		wantErr bool
+	}{
+		{
+			name: "success: a paid order is completed",
+			setup: func(conv *mocks.MockConverter, svc *mocks.MockService) {
+				conv.On("AssembledShipToModel", value).Return(model.AssembledShip{OrderID: ordID}, nil).Once()
+				svc.On("Complete", context.Background(), ordID).Return(nil).Once()
+			},
+		},
+		{
+			name: "success: a cancelled order is acked and stays cancelled",
+			setup: func(conv *mocks.MockConverter, svc *mocks.MockService) {
+				conv.On("AssembledShipToModel", value).Return(model.AssembledShip{OrderID: ordID}, nil).Once()
+				svc.On("Complete", context.Background(), ordID).
+					Return(fmt.Errorf("order.service.Complete: %w", model.ErrOrderConflict)).
+					Once()
+			},
+		},
+		{
+			name: "service error: redelivered",
+			setup: func(conv *mocks.MockConverter, svc *mocks.MockService) {
+				conv.On("AssembledShipToModel", value).Return(model.AssembledShip{OrderID: ordID}, nil).Once()
+				svc.On("Complete", context.Background(), ordID).Return(errors.New("db is down")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "converter error: a message that cannot be decoded",
+			setup: func(conv *mocks.MockConverter, svc *mocks.MockService) {
+				conv.On("AssembledShipToModel", value).Return(model.AssembledShip{}, errors.New("bad payload")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conv, svc := mocks.NewMockConverter(t), mocks.NewMockService(t)
+			tt.setup(conv, svc)
+
+			err := NewOrderConsumer(nil, conv, svc).shipAssembledHandler(context.Background(), kafka.Message{Value: value})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+type ExpiryRepository interface {
+	// ExpireOrders cancels up to limit orders past their payment deadline
+	// and returns them.
+	ExpireOrders(ctx context.Context, limit uint64) ([]model.ExpiredOrder, error)
+}
+
+type service struct {
+	repo      ExpiryRepository
+	batchSize uint64
+	timeout   time.Duration
+}
+
+// NewExpiryService cancels the orders that were not paid in time, batchSize
+// orders per transaction. The stock and the OrderExpired event of each order
+// are left to the expire saga written with its cancellation.
+func NewExpiryService(
+	repository ExpiryRepository,
+	batchSize uint64,
+	writeDBTimeout time.Duration,
+) *service {
+	return &service{
+		repo:      repository,
+		batchSize: batchSize,
+		timeout:   writeDBTimeout,
+	}
+}
+
+// Run expires orders every interval until ctx is done.
+func (svc *service) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := svc.Expire(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "expire unpaid orders", logger.ErrorF(err))
+		}
+	}
+}
+
+// Expire cancels the expired orders batch by batch until none is left and
+// returns how many it cancelled.
+func (svc *service) Expire(ctx context.Context) (int, error) {
+	const op string = "expiry.service.Expire"
+
+	var total int
+	for {
+		expired, err := svc.expireBatch(ctx)
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+		total += len(expired)
+
+		if uint64(len(expired)) < svc.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info(ctx, "unpaid orders expired", logger.Int("count", total))
+	}
+
+	return total, nil
+}
+
+func (svc *service) expireBatch(ctx context.Context) ([]model.ExpiredOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, svc.timeout)
+	defer cancel()
+
+	expired, err := svc.repo.ExpireOrders(ctx, svc.batchSize)
+	if err != nil {
+		logger.Error(ctx, "repository expire orders", logger.ErrorF(err))
+		return nil, err
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	batchSize      = 2
+	dbWriteTimeout = 5 * time.Second
+)
+
+type deps struct {
+	repository *mocks.MockExpiryRepository
+}
+
+func expiredOrders(n int) []model.ExpiredOrder {
+	orders := make([]model.ExpiredOrder, n)
+	for i := range orders {
+		orders[i] = model.ExpiredOrder{
+			OrderID:   uuid.New(),
+			UserID:    uuid.New(),
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}
+	}
+	return orders
+}
+
+func TestServiceExpire(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	full, rest := expiredOrders(batchSize), expiredOrders(1)
+
+	tests := []struct {
+		name      string
+		setup     func(d deps)
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "success: nothing to expire",
+			setup: func(d deps) {
+				d.repository.EXPECT().ExpireOrders(mock.Anything, uint64(batchSize)).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "success: batches are taken until one is not full",
+			setup: func(d deps) {
+				d.repository.EXPECT().ExpireOrders(mock.Anything, uint64(batchSize)).Return(full, nil).Once()
+				d.repository.EXPECT().ExpireOrders(mock.Anything, uint64(batchSize)).Return(rest, nil).Once()
+			},
+			wantCount: batchSize + 1,
+		},
+		{
+			name: "error: the repository fails",
+			setup: func(d deps) {
+				d.repository.EXPECT().
+					ExpireOrders(mock.Anything, uint64(batchSize)).
+					Return(nil, errors.New("db down")).
+					Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockExpiryRepository(t),
+			}
+			tt.setup(d)
+
+			svc := NewExpiryService(d.repository, batchSize, dbWriteTimeout)
+
+			n, err := svc.Expire(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCount, n)
+		})
+	}
+}
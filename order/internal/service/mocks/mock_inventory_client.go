@@ -105,3 +105,71 @@ func (_c *MockInventoryClient_ListParts_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateConfiguration provides a mock function for the type MockInventoryClient
+func (_mock *MockInventoryClient) ValidateConfiguration(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error) {
+	ret := _mock.Called(ctx, partIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfiguration")
+	}
+
+	var r0 []model.ConfigurationViolation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]model.ConfigurationViolation, error)); ok {
+		return returnFunc(ctx, partIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []model.ConfigurationViolation); ok {
+		r0 = returnFunc(ctx, partIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ConfigurationViolation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, partIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInventoryClient_ValidateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateConfiguration'
+type MockInventoryClient_ValidateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partIDs []string
+func (_e *MockInventoryClient_Expecter) ValidateConfiguration(ctx interface{}, partIDs interface{}) *MockInventoryClient_ValidateConfiguration_Call {
+	return &MockInventoryClient_ValidateConfiguration_Call{Call: _e.mock.On("ValidateConfiguration", ctx, partIDs)}
+}
+
+func (_c *MockInventoryClient_ValidateConfiguration_Call) Run(run func(ctx context.Context, partIDs []string)) *MockInventoryClient_ValidateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInventoryClient_ValidateConfiguration_Call) Return(configurationViolations []model.ConfigurationViolation, err error) *MockInventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(configurationViolations, err)
+	return _c
+}
+
+func (_c *MockInventoryClient_ValidateConfiguration_Call) RunAndReturn(run func(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error)) *MockInventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
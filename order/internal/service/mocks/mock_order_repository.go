@@ -39,70 +39,65 @@ func (_m *MockOrderRepository) EXPECT() *MockOrderRepository_Expecter {
 	return &MockOrderRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockOrderRepository
-func (_mock *MockOrderRepository) Create(ctx context.Context, ord *model.Order) (uuid.UUID, error) {
-	ret := _mock.Called(ctx, ord)
+// Cancel provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) Cancel(ctx context.Context, id uuid.UUID, from model.OrderStatus) error {
+	ret := _mock.Called(ctx, id, from)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Cancel")
 	}
 
-	var r0 uuid.UUID
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order) (uuid.UUID, error)); ok {
-		return returnFunc(ctx, ord)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order) uuid.UUID); ok {
-		r0 = returnFunc(ctx, ord)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order) error); ok {
-		r1 = returnFunc(ctx, ord)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.OrderStatus) error); ok {
+		r0 = returnFunc(ctx, id, from)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockOrderRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockOrderRepository_Create_Call struct {
+// MockOrderRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockOrderRepository_Cancel_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Cancel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ord *model.Order
-func (_e *MockOrderRepository_Expecter) Create(ctx interface{}, ord interface{}) *MockOrderRepository_Create_Call {
-	return &MockOrderRepository_Create_Call{Call: _e.mock.On("Create", ctx, ord)}
+//   - id uuid.UUID
+//   - from model.OrderStatus
+func (_e *MockOrderRepository_Expecter) Cancel(ctx interface{}, id interface{}, from interface{}) *MockOrderRepository_Cancel_Call {
+	return &MockOrderRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, id, from)}
 }
 
-func (_c *MockOrderRepository_Create_Call) Run(run func(ctx context.Context, ord *model.Order)) *MockOrderRepository_Create_Call {
+func (_c *MockOrderRepository_Cancel_Call) Run(run func(ctx context.Context, id uuid.UUID, from model.OrderStatus)) *MockOrderRepository_Cancel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.Order
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*model.Order)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.OrderStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatus)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockOrderRepository_Create_Call) Return(uUID uuid.UUID, err error) *MockOrderRepository_Create_Call {
-	_c.Call.Return(uUID, err)
+func (_c *MockOrderRepository_Cancel_Call) Return(err error) *MockOrderRepository_Cancel_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockOrderRepository_Create_Call) RunAndReturn(run func(ctx context.Context, ord *model.Order) (uuid.UUID, error)) *MockOrderRepository_Create_Call {
+func (_c *MockOrderRepository_Cancel_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, from model.OrderStatus) error) *MockOrderRepository_Cancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -175,6 +170,80 @@ func (_c *MockOrderRepository_OrderByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// OrdersByUser provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) OrdersByUser(ctx context.Context, userID uuid.UUID, limit uint64) ([]model.Order, error) {
+	ret := _mock.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrdersByUser")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) ([]model.Order, error)); ok {
+		return returnFunc(ctx, userID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) []model.Order); ok {
+		r0 = returnFunc(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint64) error); ok {
+		r1 = returnFunc(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_OrdersByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrdersByUser'
+type MockOrderRepository_OrdersByUser_Call struct {
+	*mock.Call
+}
+
+// OrdersByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit uint64
+func (_e *MockOrderRepository_Expecter) OrdersByUser(ctx interface{}, userID interface{}, limit interface{}) *MockOrderRepository_OrdersByUser_Call {
+	return &MockOrderRepository_OrdersByUser_Call{Call: _e.mock.On("OrdersByUser", ctx, userID, limit)}
+}
+
+func (_c *MockOrderRepository_OrdersByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit uint64)) *MockOrderRepository_OrdersByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_OrdersByUser_Call) Return(orders []model.Order, err error) *MockOrderRepository_OrdersByUser_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_OrdersByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, limit uint64) ([]model.Order, error)) *MockOrderRepository_OrdersByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) Update(ctx context.Context, upd *model.Order) error {
 	ret := _mock.Called(ctx, upd)
@@ -231,3 +300,66 @@ func (_c *MockOrderRepository_Update_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateFrom provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) UpdateFrom(ctx context.Context, upd *model.Order, from model.OrderStatus) error {
+	ret := _mock.Called(ctx, upd, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFrom")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, model.OrderStatus) error); ok {
+		r0 = returnFunc(ctx, upd, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderRepository_UpdateFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFrom'
+type MockOrderRepository_UpdateFrom_Call struct {
+	*mock.Call
+}
+
+// UpdateFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upd *model.Order
+//   - from model.OrderStatus
+func (_e *MockOrderRepository_Expecter) UpdateFrom(ctx interface{}, upd interface{}, from interface{}) *MockOrderRepository_UpdateFrom_Call {
+	return &MockOrderRepository_UpdateFrom_Call{Call: _e.mock.On("UpdateFrom", ctx, upd, from)}
+}
+
+func (_c *MockOrderRepository_UpdateFrom_Call) Run(run func(ctx context.Context, upd *model.Order, from model.OrderStatus)) *MockOrderRepository_UpdateFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 model.OrderStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateFrom_Call) Return(err error) *MockOrderRepository_UpdateFrom_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderRepository_UpdateFrom_Call) RunAndReturn(run func(ctx context.Context, upd *model.Order, from model.OrderStatus) error) *MockOrderRepository_UpdateFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
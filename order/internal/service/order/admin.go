@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/policy"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+type AdminRepository interface {
+	OrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error)
+	Orders(ctx context.Context, filter model.OrdersFilter) ([]model.Order, error)
+	// UpdateWithAudit updates the order while it has status from, or gives
+	// model.ErrOrderConflict, and records the entry with the update.
+	UpdateWithAudit(ctx context.Context, upd *model.Order, from model.OrderStatus, entry model.AuditEntry) error
+	// CancelWithAudit cancels the order while it has status from, or gives
+	// model.ErrOrderConflict, and writes its release saga and the entry with
+	// it.
+	CancelWithAudit(ctx context.Context, id uuid.UUID, from model.OrderStatus, entry model.AuditEntry) error
+	AddAuditEntry(ctx context.Context, entry model.AuditEntry) error
+}
+
+// adminService acts on the orders of any user on behalf of support and
+// admin staff. Every action is authorized by the policy and recorded in the
+// audit log; a read is recorded before it is made, so a read that cannot be
+// recorded is not made.
+type adminService struct {
+	repo           AdminRepository
+	sagas          OrderSagas
+	readDBTimeout  time.Duration
+	writeDBTimeout time.Duration
+}
+
+func NewAdminService(
+	repository AdminRepository,
+	sagas OrderSagas,
+	readDBTimeout time.Duration,
+	writeDBTimeout time.Duration,
+) *adminService {
+	return &adminService{
+		repo:           repository,
+		sagas:          sagas,
+		readDBTimeout:  readDBTimeout,
+		writeDBTimeout: writeDBTimeout,
+	}
+}
+
+func (svc *adminService) Orders(
+	ctx context.Context,
+	actor model.Identity,
+	filter model.OrdersFilter,
+) ([]model.Order, error) {
+	const op string = "order.service.admin.Orders"
+	log := logger.With(auditFields(actor, model.AdminActionListOrders, nil)...)
+
+	if filter.Limit == 0 {
+		log.Error(ctx, "wrong params")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrValidation)
+	}
+
+	if err := svc.authorizeAndRecord(ctx, actor, model.AdminActionListOrders, nil); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, svc.readDBTimeout)
+	defer cancel()
+
+	orders, err := svc.repo.Orders(ctx, filter)
+	if err != nil {
+		log.Error(ctx, "repository orders", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orders, nil
+}
+
+func (svc *adminService) Order(ctx context.Context, actor model.Identity, ordID uuid.UUID) (*model.Order, error) {
+	const op string = "order.service.admin.Order"
+	log := logger.With(auditFields(actor, model.AdminActionViewOrder, &ordID)...)
+
+	if err := svc.authorizeAndRecord(ctx, actor, model.AdminActionViewOrder, &ordID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, svc.readDBTimeout)
+	defer cancel()
+
+	ord, err := svc.repo.OrderByID(ctx, ordID)
+	if err != nil {
+		log.Error(ctx, "repository order by id", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ord, nil
+}
+
+// ForceCancel cancels an order that is not completed yet, paid or not. The
+// release saga written with the cancellation gives back its stock, refunds it
+// if it was paid and emits OrderCancelled.
+func (svc *adminService) ForceCancel(
+	ctx context.Context,
+	actor model.Identity,
+	params model.ForceTransitionParams,
+) error {
+	const op string = "order.service.admin.ForceCancel"
+
+	if err := svc.forceTransition(ctx, actor, model.AdminActionCancelOrder, params,
+		svc.repo.CancelWithAudit, model.StatusPendingPayment, model.StatusPaid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// StuckSagas returns up to limit sagas still unfinished long after they
+// started, oldest first.
+func (svc *adminService) StuckSagas(ctx context.Context, actor model.Identity, limit uint64) ([]model.Saga, error) {
+	const op string = "order.service.admin.StuckSagas"
+	log := logger.With(auditFields(actor, model.AdminActionListSagas, nil)...)
+
+	if limit == 0 {
+		log.Error(ctx, "wrong params")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrValidation)
+	}
+
+	if err := svc.authorizeAndRecord(ctx, actor, model.AdminActionListSagas, nil); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sagas, err := svc.sagas.StuckSagas(ctx, limit)
+	if err != nil {
+		log.Error(ctx, "saga stuck sagas", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sagas, nil
+}
+
+// ForceComplete completes a paid order without waiting for the assembly.
+func (svc *adminService) ForceComplete(
+	ctx context.Context,
+	actor model.Identity,
+	params model.ForceTransitionParams,
+) error {
+	const op string = "order.service.admin.ForceComplete"
+
+	complete := func(ctx context.Context, id uuid.UUID, from model.OrderStatus, entry model.AuditEntry) error {
+		return svc.repo.UpdateWithAudit(ctx, &model.Order{ID: id, Status: model.StatusCompleted}, from, entry)
+	}
+
+	if err := svc.forceTransition(ctx, actor, model.AdminActionCompleteOrder, params,
+		complete, model.StatusPaid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// transition moves the order on from the status it was read with and records
+// the entry in the same transaction, or gives model.ErrOrderConflict.
+type transition func(ctx context.Context, id uuid.UUID, from model.OrderStatus, entry model.AuditEntry) error
+
+// forceTransition makes the transition of an order that has one of the
+// statuses from and records the action with it.
+func (svc *adminService) forceTransition(
+	ctx context.Context,
+	actor model.Identity,
+	action model.AdminAction,
+	params model.ForceTransitionParams,
+	move transition,
+	from ...model.OrderStatus,
+) error {
+	log := logger.With(auditFields(actor, action, &params.OrderID)...)
+
+	if err := policy.Authorize(actor, action); err != nil {
+		log.Warn(ctx, "admin action denied")
+		return err
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	if reason == "" {
+		log.Error(ctx, "empty reason")
+		return fmt.Errorf("%w: reason is required", model.ErrValidation)
+	}
+
+	rdbCtx, rdbCancel := context.WithTimeout(ctx, svc.readDBTimeout)
+	defer rdbCancel()
+
+	ord, err := svc.repo.OrderByID(rdbCtx, params.OrderID)
+	if err != nil {
+		log.Error(ctx, "repository order by id", logger.ErrorF(err))
+		return err
+	}
+
+	allowed := false
+	for _, s := range from {
+		allowed = allowed || ord.Status == s
+	}
+	if !allowed {
+		log.Error(ctx, "order conflict", logger.String("order_status", string(ord.Status)))
+		return fmt.Errorf("%w: order is %s", model.ErrOrderConflict, ord.Status)
+	}
+
+	wdbCtx, wdbCancel := context.WithTimeout(ctx, svc.writeDBTimeout)
+	defer wdbCancel()
+
+	// The order may have moved on since it was read, e.g. been paid by a
+	// saga; then the update is refused rather than overwriting it.
+	if err := move(wdbCtx, ord.ID, ord.Status, model.AuditEntry{
+		ActorID:   actor.UserID,
+		ActorRole: actor.Role,
+		Action:    action,
+		OrderID:   &ord.ID,
+		Reason:    reason,
+	}); err != nil {
+		log.Error(ctx, "repository update order with audit", logger.ErrorF(err))
+		return err
+	}
+
+	log.Info(ctx, "admin action", logger.String("reason", reason))
+
+	return nil
+}
+
+func (svc *adminService) authorizeAndRecord(
+	ctx context.Context,
+	actor model.Identity,
+	action model.AdminAction,
+	ordID *uuid.UUID,
+) error {
+	log := logger.With(auditFields(actor, action, ordID)...)
+
+	if err := policy.Authorize(actor, action); err != nil {
+		log.Warn(ctx, "admin action denied")
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, svc.writeDBTimeout)
+	defer cancel()
+
+	if err := svc.repo.AddAuditEntry(ctx, model.AuditEntry{
+		ActorID:   actor.UserID,
+		ActorRole: actor.Role,
+		Action:    action,
+		OrderID:   ordID,
+	}); err != nil {
+		log.Error(ctx, "repository add audit entry", logger.ErrorF(err))
+		return err
+	}
+
+	log.Info(ctx, "admin action")
+
+	return nil
+}
+
+func auditFields(actor model.Identity, action model.AdminAction, ordID *uuid.UUID) []logger.Field {
+	fields := []logger.Field{
+		logger.String("actor_id", actor.UserID.String()),
+		logger.String("actor_role", string(actor.Role)),
+		logger.String("action", string(action)),
+	}
+	if ordID != nil {
+		fields = append(fields, logger.String("order_id", ordID.String()))
+	}
+
+	return fields
+}
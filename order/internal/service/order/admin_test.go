@@ -0,0 +1,489 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/mocks"
+)
+
+type adminDeps struct {
+	repository *mocks.MockAdminRepository
+	sagas      *mocks.MockOrderSagas
+}
+
+func newAdminDeps(t *testing.T) adminDeps {
+	return adminDeps{
+		repository: mocks.NewMockAdminRepository(t),
+		sagas:      mocks.NewMockOrderSagas(t),
+	}
+}
+
+func newAdminSvc(d adminDeps) *adminService {
+	return NewAdminService(d.repository, d.sagas, dbReadTimeout, dbWriteTimeout)
+}
+
+var (
+	customer = model.Identity{UserID: uuid.New(), Role: model.RoleCustomer}
+	support  = model.Identity{UserID: uuid.New(), Role: model.RoleSupport}
+	admin    = model.Identity{UserID: uuid.New(), Role: model.RoleAdmin}
+)
+
+func TestAdminServiceOrders(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	status := model.StatusPaid
+	filter := model.OrdersFilter{UserID: &userID, Status: &status, Limit: 10}
+	orders := []model.Order{{ID: uuid.New(), UserID: userID, Status: status}}
+
+	type testCase struct {
+		name   string
+		actor  model.Identity
+		filter model.OrdersFilter
+		setup  func(d adminDeps)
+		assert func(t *testing.T, res []model.Order, err error, d adminDeps)
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: the listing is recorded before it is made",
+			actor:  support,
+			filter: filter,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("AddAuditEntry", mock.Anything, model.AuditEntry{
+						ActorID:   support.UserID,
+						ActorRole: model.RoleSupport,
+						Action:    model.AdminActionListOrders,
+					}).
+					Return(nil).
+					Once()
+				d.repository.
+					On("Orders", mock.Anything, filter).
+					Return(orders, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.Order, err error, d adminDeps) {
+				require.NoError(t, err)
+				assert.Equal(t, orders, res)
+			},
+		},
+		{
+			name:   "forbidden: customers cannot list all orders",
+			actor:  customer,
+			filter: filter,
+			assert: func(t *testing.T, res []model.Order, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				assert.Nil(t, res)
+				d.repository.AssertNotCalled(t, "AddAuditEntry", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "audit failure: nothing is read",
+			actor:  admin,
+			filter: filter,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("AddAuditEntry", mock.Anything, mock.Anything).
+					Return(errors.New("db is down")).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.Order, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				d.repository.AssertNotCalled(t, "Orders", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "validation error: zero limit",
+			actor:  admin,
+			filter: model.OrdersFilter{},
+			assert: func(t *testing.T, res []model.Order, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newAdminDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			res, err := newAdminSvc(d).Orders(context.Background(), tt.actor, tt.filter)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
+
+func TestAdminServiceOrder(t *testing.T) {
+	t.Parallel()
+
+	ordID := uuid.New()
+	ord := &model.Order{ID: ordID, UserID: uuid.New(), Status: model.StatusPaid}
+
+	t.Run("success: an order of another user is returned", func(t *testing.T) {
+		t.Parallel()
+
+		d := newAdminDeps(t)
+		d.repository.
+			On("AddAuditEntry", mock.Anything, model.AuditEntry{
+				ActorID:   support.UserID,
+				ActorRole: model.RoleSupport,
+				Action:    model.AdminActionViewOrder,
+				OrderID:   &ordID,
+			}).
+			Return(nil).
+			Once()
+		d.repository.
+			On("OrderByID", mock.Anything, ordID).
+			Return(ord, nil).
+			Once()
+
+		res, err := newAdminSvc(d).Order(context.Background(), support, ordID)
+		require.NoError(t, err)
+		assert.Equal(t, ord, res)
+	})
+
+	t.Run("forbidden: customers cannot view orders of others", func(t *testing.T) {
+		t.Parallel()
+
+		d := newAdminDeps(t)
+
+		res, err := newAdminSvc(d).Order(context.Background(), customer, ordID)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, model.ErrForbidden)
+		assert.Nil(t, res)
+	})
+}
+
+func TestAdminServiceForceCancel(t *testing.T) {
+	t.Parallel()
+
+	ordID := uuid.New()
+	userID := uuid.New()
+
+	type testCase struct {
+		name   string
+		actor  model.Identity
+		reason string
+		setup  func(d adminDeps)
+		assert func(t *testing.T, err error, d adminDeps)
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: a paid order is cancelled with an audit entry",
+			actor:  support,
+			reason: "  customer called support  ",
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, UserID: userID, Status: model.StatusPaid}, nil).
+					Once()
+				d.repository.
+					On("CancelWithAudit", mock.Anything, ordID, model.StatusPaid, model.AuditEntry{
+						ActorID:   support.UserID,
+						ActorRole: model.RoleSupport,
+						Action:    model.AdminActionCancelOrder,
+						OrderID:   &ordID,
+						Reason:    "customer called support",
+					}).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "repository error: the cancellation cannot be written",
+			actor:  admin,
+			reason: "fraud",
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, UserID: userID, Status: model.StatusPendingPayment}, nil).
+					Once()
+				d.repository.
+					On("CancelWithAudit", mock.Anything, ordID, model.StatusPendingPayment, mock.Anything).
+					Return(errors.New("db is down")).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:   "validation error: blank reason",
+			actor:  admin,
+			reason: " \t ",
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrValidation)
+				d.repository.AssertNotCalled(t, "OrderByID", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "forbidden: customers cannot force-cancel",
+			actor:  customer,
+			reason: "mine now",
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+			},
+		},
+		{
+			name:   "conflict: a completed order stays completed",
+			actor:  admin,
+			reason: "too late",
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, UserID: userID, Status: model.StatusCompleted}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
+				d.repository.AssertNotCalled(t, "CancelWithAudit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "conflict: an order paid since it was read is not cancelled",
+			actor:  admin,
+			reason: "customer asked",
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, UserID: userID, Status: model.StatusPendingPayment}, nil).
+					Once()
+				d.repository.
+					On("CancelWithAudit", mock.Anything, ordID, model.StatusPendingPayment, mock.Anything).
+					Return(model.ErrOrderConflict).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
+			},
+		},
+		{
+			name:   "not found: unknown order",
+			actor:  admin,
+			reason: "duplicate",
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(nil, model.ErrOrderNotFound).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newAdminDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			err := newAdminSvc(d).ForceCancel(context.Background(), tt.actor, model.ForceTransitionParams{
+				OrderID: ordID,
+				Reason:  tt.reason,
+			})
+			tt.assert(t, err, d)
+		})
+	}
+}
+
+func TestAdminServiceForceComplete(t *testing.T) {
+	t.Parallel()
+
+	ordID := uuid.New()
+	params := model.ForceTransitionParams{OrderID: ordID, Reason: "assembly finished offline"}
+
+	type testCase struct {
+		name   string
+		actor  model.Identity
+		setup  func(d adminDeps)
+		assert func(t *testing.T, err error, d adminDeps)
+	}
+
+	tests := []testCase{
+		{
+			name:  "success: admin completes a paid order",
+			actor: admin,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, Status: model.StatusPaid}, nil).
+					Once()
+				d.repository.
+					On("UpdateWithAudit", mock.Anything,
+						&model.Order{ID: ordID, Status: model.StatusCompleted},
+						model.StatusPaid,
+						mock.MatchedBy(func(e model.AuditEntry) bool {
+							return e.Action == model.AdminActionCompleteOrder && e.ActorID == admin.UserID &&
+								e.Reason == params.Reason
+						})).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "forbidden: support cannot complete orders",
+			actor: support,
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				d.repository.AssertNotCalled(t, "OrderByID", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:  "conflict: an unpaid order cannot be completed",
+			actor: admin,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, Status: model.StatusPendingPayment}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
+			},
+		},
+		{
+			name:  "repository failure: the error is returned",
+			actor: admin,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{ID: ordID, Status: model.StatusPaid}, nil).
+					Once()
+				d.repository.
+					On("UpdateWithAudit", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("db is down")).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d adminDeps) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newAdminDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			err := newAdminSvc(d).ForceComplete(context.Background(), tt.actor, params)
+			tt.assert(t, err, d)
+		})
+	}
+}
+
+func TestAdminServiceStuckSagas(t *testing.T) {
+	t.Parallel()
+
+	stuck := []model.Saga{{
+		ID:      uuid.New(),
+		Type:    model.SagaPayOrder,
+		OrderID: uuid.New(),
+		Status:  model.SagaRunning,
+		Step:    model.SagaStepPublishPaid,
+	}}
+
+	type testCase struct {
+		name   string
+		actor  model.Identity
+		limit  uint64
+		setup  func(d adminDeps)
+		assert func(t *testing.T, res []model.Saga, err error, d adminDeps)
+	}
+
+	tests := []testCase{
+		{
+			name:  "success: the listing is recorded before it is made",
+			actor: support,
+			limit: 20,
+			setup: func(d adminDeps) {
+				d.repository.
+					On("AddAuditEntry", mock.Anything, model.AuditEntry{
+						ActorID:   support.UserID,
+						ActorRole: model.RoleSupport,
+						Action:    model.AdminActionListSagas,
+					}).
+					Return(nil).
+					Once()
+				d.sagas.
+					On("StuckSagas", mock.Anything, uint64(20)).
+					Return(stuck, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res []model.Saga, err error, d adminDeps) {
+				require.NoError(t, err)
+				assert.Equal(t, stuck, res)
+			},
+		},
+		{
+			name:  "forbidden: customers cannot list sagas",
+			actor: customer,
+			limit: 20,
+			assert: func(t *testing.T, res []model.Saga, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				d.sagas.AssertNotCalled(t, "StuckSagas", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:  "validation error: zero limit",
+			actor: admin,
+			assert: func(t *testing.T, res []model.Saga, err error, d adminDeps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrValidation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newAdminDeps(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			res, err := newAdminSvc(d).StuckSagas(context.Background(), tt.actor, tt.limit)
+			tt.assert(t, res, err, d)
+		})
+	}
+}
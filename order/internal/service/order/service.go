@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,46 +12,63 @@ import (
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
+// maxReserveAttempts bounds the tries to reserve the stock of an order whose
+// parts changed, but not their prices, while it was being created.
+const maxReserveAttempts = 3
+
 type OrderRepository interface {
-	Create(ctx context.Context, ord *model.Order) (uuid.UUID, error)
 	OrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error)
+	OrdersByUser(ctx context.Context, userID uuid.UUID, limit uint64) ([]model.Order, error)
 	Update(ctx context.Context, upd *model.Order) error
+	// UpdateFrom updates the order only while it has status from, or gives
+	// model.ErrOrderConflict.
+	UpdateFrom(ctx context.Context, upd *model.Order, from model.OrderStatus) error
+	// Cancel cancels the order while it has status from, or gives
+	// model.ErrOrderConflict, and writes its release saga with it.
+	Cancel(ctx context.Context, id uuid.UUID, from model.OrderStatus) error
 }
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+	ValidateConfiguration(ctx context.Context, partIDs []string) ([]model.ConfigurationViolation, error)
 }
 
-type PaymentClient interface {
-	PayOrder(ctx context.Context, params model.PayOrderParams) (string, error)
-}
-
-type OrderPaidSender interface {
-	SendOrderPaid(ctx context.Context, event model.PaidOrder) error
+// OrderSagas runs the transitions of orders that span other services, and
+// undoes what was done when one cannot finish.
+type OrderSagas interface {
+	// CreateOrder reserves the stock of the order, while the parts still
+	// have the versions it was priced with, and creates it.
+	CreateOrder(ctx context.Context, ord *model.Order, partVersions map[uuid.UUID]int64) error
+	// PayOrder charges for the order, marks it paid and announces it.
+	PayOrder(ctx context.Context, ord *model.Order, method model.PaymentMethod) (uuid.UUID, error)
+	// StuckSagas returns the sagas still unfinished long after they started.
+	StuckSagas(ctx context.Context, limit uint64) ([]model.Saga, error)
 }
 
 type service struct {
 	repo           OrderRepository
 	inventory      InventoryClient
-	payment        PaymentClient
-	producer       OrderPaidSender
+	sagas          OrderSagas
+	paymentTTL     time.Duration
 	readDBTimeout  time.Duration
 	writeDBTimeout time.Duration
 }
 
+// NewOrderService serves the orders of users. A new order has to be paid
+// within paymentTTL. Creating, paying and cancelling orders run as sagas.
 func NewOrderService(
 	repository OrderRepository,
 	inventory InventoryClient,
-	payment PaymentClient,
-	producer OrderPaidSender,
+	sagas OrderSagas,
+	paymentTTL time.Duration,
 	readDBTimeout time.Duration,
 	writeDBTimeout time.Duration,
 ) *service {
 	return &service{
 		repo:           repository,
 		inventory:      inventory,
-		payment:        payment,
-		producer:       producer,
+		sagas:          sagas,
+		paymentTTL:     paymentTTL,
 		readDBTimeout:  readDBTimeout,
 		writeDBTimeout: writeDBTimeout,
 	}
@@ -89,21 +107,7 @@ func (svc *service) Create(
 		return nil, fmt.Errorf("%s: %w", op, model.ErrPartNotFound)
 	}
 
-	var totalPrice int64
-	endedParts := make([]string, 0, len(params.PartIDs))
-	for _, p := range parts {
-		if p.StockQuantity <= 0 {
-			log.Warn(ctx, "ended parts",
-				logger.String("part_id", p.ID),
-				logger.Int("stock_quantity", int(p.StockQuantity)),
-			)
-			endedParts = append(endedParts, p.ID)
-			continue
-		}
-
-		totalPrice += p.PriceCents
-	}
-
+	prices, partVersions, endedParts := priceParts(parts)
 	if len(endedParts) > 0 {
 		log.Warn(ctx, "len ended parts",
 			logger.Int("number_ended_parts", len(endedParts)),
@@ -111,21 +115,114 @@ func (svc *service) Create(
 		return nil, fmt.Errorf("%s: %w %v", op, model.ErrPartsOutOfStock, endedParts)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, svc.writeDBTimeout)
-	defer cancel()
+	violations, err := svc.inventory.ValidateConfiguration(ctx, partIDs)
+	if err != nil {
+		log.Error(ctx, "validate configuration", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, model.ErrBadGateway)
+	}
+	if len(violations) > 0 {
+		log.Warn(ctx, "incompatible parts",
+			logger.Int("number_violations", len(violations)),
+		)
+		return nil, fmt.Errorf("%s: %w", op, &model.IncompatiblePartsError{Violations: violations})
+	}
 
-	ordID, err := svc.repo.Create(ctx, &model.Order{
+	// Unit prices follow the requested order of the parts, not the one of inventory.
+	var totalPrice int64
+	unitPrices := make([]int64, len(partIDs))
+	for i, id := range partIDs {
+		unitPrices[i] = prices[id]
+		totalPrice += prices[id]
+	}
+
+	expiresAt := time.Now().Add(svc.paymentTTL)
+
+	ord := &model.Order{
 		UserID:     params.UserID,
 		PartIDs:    params.PartIDs,
 		TotalPrice: totalPrice,
+		UnitPrices: unitPrices,
 		Status:     model.StatusPendingPayment,
-	})
+		ExpiresAt:  &expiresAt,
+	}
+	// The version of a part also moves with its stock, so an order of a part
+	// that others order too may find it changed though its price did not.
+	// The reservation is tried again at the new versions as long as the
+	// order is still priced right.
+	for attempt := 1; ; attempt++ {
+		err := svc.sagas.CreateOrder(ctx, ord, partVersionsByID(params.PartIDs, partVersions))
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, model.ErrPartChanged) || attempt == maxReserveAttempts {
+			log.Error(ctx, "saga create order", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if partVersions, err = svc.repricedVersions(ctx, partIDs, prices); err != nil {
+			log.Warn(ctx, "parts changed since they were priced", logger.ErrorF(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// The saga of the failed try gave back its reservation under the
+		// order id, so the next try reserves under a new one.
+		ord.ID = uuid.Nil
+	}
+
+	return &model.CreateOrderResult{ID: ord.ID, TotalPrice: totalPrice}, nil
+}
+
+// repricedVersions reads the parts again and returns their versions if the
+// parts are still in stock at prices, or model.ErrPartChanged if not.
+func (svc *service) repricedVersions(
+	ctx context.Context,
+	partIDs []string,
+	prices map[string]int64,
+) (map[string]int64, error) {
+	parts, err := svc.inventory.ListParts(ctx, model.PartsFilter{IDs: partIDs})
 	if err != nil {
-		log.Error(ctx, "repository create order", logger.ErrorF(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%w: list parts: %v", model.ErrBadGateway, err)
+	}
+	if len(parts) != len(prices) {
+		return nil, model.ErrPartNotFound
+	}
+
+	current, versions, endedParts := priceParts(parts)
+	if len(endedParts) > 0 {
+		return nil, fmt.Errorf("%w %v", model.ErrPartsOutOfStock, endedParts)
+	}
+	for id, price := range prices {
+		if current[id] != price {
+			return nil, fmt.Errorf("%w: price of part %s", model.ErrPartChanged, id)
+		}
+	}
+
+	return versions, nil
+}
+
+// priceParts returns the prices and the versions of the parts in stock by
+// id, and the ids of the parts out of stock.
+func priceParts(parts []model.Part) (prices, versions map[string]int64, ended []string) {
+	prices = make(map[string]int64, len(parts))
+	versions = make(map[string]int64, len(parts))
+	for _, p := range parts {
+		if p.StockQuantity <= 0 {
+			ended = append(ended, p.ID)
+			continue
+		}
+		prices[p.ID] = p.PriceCents
+		versions[p.ID] = p.Version
 	}
 
-	return &model.CreateOrderResult{ID: ordID, TotalPrice: totalPrice}, nil
+	return prices, versions, ended
+}
+
+func partVersionsByID(ids []uuid.UUID, versions map[string]int64) map[uuid.UUID]int64 {
+	res := make(map[uuid.UUID]int64, len(versions))
+	for _, id := range ids {
+		res[id] = versions[id.String()]
+	}
+
+	return res
 }
 
 func (svc *service) Pay(
@@ -148,6 +245,11 @@ func (svc *service) Pay(
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if ord.UserID != params.UserID {
+		log.Error(ctx, "order of another user")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrForbidden)
+	}
+
 	log = logger.With(
 		logger.String("order_status", string(ord.Status)),
 	)
@@ -161,53 +263,27 @@ func (svc *service) Pay(
 		return nil, fmt.Errorf("%s: %w", op, model.ErrUnknownStatus)
 	}
 
-	params.UserID = ord.UserID
-	log = logger.With(logger.String("user_id", ord.UserID.String()))
-
-	transactionIDStr, err := svc.payment.PayOrder(ctx, params)
-	if err != nil {
-		log.Error(ctx, "payment pay order", logger.ErrorF(err))
-		return nil, fmt.Errorf("%s: %w", op, model.ErrBadGateway)
+	// The sweeper cancels expired orders in batches, so one may still be
+	// pending for a while after its deadline.
+	if ord.ExpiresAt != nil && !time.Now().Before(*ord.ExpiresAt) {
+		log.Error(ctx, "payment deadline passed", logger.String("expires_at", ord.ExpiresAt.String()))
+		return nil, fmt.Errorf("%s: %w: payment deadline passed", op, model.ErrOrderConflict)
 	}
 
-	transactionID, err := uuid.Parse(transactionIDStr)
+	transactionID, err := svc.sagas.PayOrder(ctx, ord, params.PaymentMethod)
 	if err != nil {
-		log.Error(ctx, "parse transaction id",
-			logger.String("transaction_id", transactionIDStr),
-			logger.ErrorF(err),
-		)
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	ord.TransactionID = &transactionID
-	ord.PaymentMethod = &params.PaymentMethod
-	ord.Status = model.StatusPaid
-
-	wdbCtx, wdbCancel := context.WithTimeout(ctx, svc.writeDBTimeout)
-	defer wdbCancel()
-
-	if err := svc.repo.Update(wdbCtx, ord); err != nil {
-		log.Error(ctx, "repository update order", logger.ErrorF(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	if err := svc.producer.SendOrderPaid(ctx, model.PaidOrder{
-		EventID:       uuid.New(),
-		OrderID:       ord.ID,
-		UserID:        ord.UserID,
-		PaymentMethod: *ord.PaymentMethod,
-		TransactionID: *ord.TransactionID,
-	}); err != nil {
-		log.Error(ctx, "send paid order", logger.ErrorF(err))
+		log.Error(ctx, "saga pay order", logger.ErrorF(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return &model.PayOrderResult{TransactionID: transactionID}, nil
 }
 
-func (svc *service) OrderByID(ctx context.Context, ordID uuid.UUID) (*model.Order, error) {
+func (svc *service) OrderByID(ctx context.Context, userID, ordID uuid.UUID) (*model.Order, error) {
 	const op string = "order.service.OrderByID"
 	log := logger.With(
 		logger.String("order_id", ordID.String()),
+		logger.String("user_id", userID.String()),
 	)
 
 	ctx, cancel := context.WithTimeout(ctx, svc.readDBTimeout)
@@ -219,13 +295,43 @@ func (svc *service) OrderByID(ctx context.Context, ordID uuid.UUID) (*model.Orde
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if ord.UserID != userID {
+		log.Error(ctx, "order of another user")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrForbidden)
+	}
+
 	return ord, nil
 }
 
-func (svc *service) Cancel(ctx context.Context, ordID uuid.UUID) error {
+func (svc *service) ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]model.Order, error) {
+	const op string = "order.service.ListByUser"
+	log := logger.With(
+		logger.String("user_id", userID.String()),
+		logger.Int("limit", limit),
+	)
+
+	if userID == uuid.Nil || limit <= 0 {
+		log.Error(ctx, "wrong params")
+		return nil, fmt.Errorf("%s: %w", op, model.ErrValidation)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, svc.readDBTimeout)
+	defer cancel()
+
+	orders, err := svc.repo.OrdersByUser(ctx, userID, uint64(limit))
+	if err != nil {
+		log.Error(ctx, "repository orders by user", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orders, nil
+}
+
+func (svc *service) Cancel(ctx context.Context, userID, ordID uuid.UUID) error {
 	const op string = "order.service.Cancel"
 	log := logger.With(
 		logger.String("order_id", ordID.String()),
+		logger.String("user_id", userID.String()),
 	)
 
 	rdbCtx, rdbCancel := context.WithTimeout(ctx, svc.readDBTimeout)
@@ -237,19 +343,29 @@ func (svc *service) Cancel(ctx context.Context, ordID uuid.UUID) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if ord.UserID != userID {
+		log.Error(ctx, "order of another user")
+		return fmt.Errorf("%s: %w", op, model.ErrForbidden)
+	}
+
 	log = logger.With(logger.String("order_status", string(ord.Status)))
 
 	switch ord.Status {
 	case model.StatusPendingPayment:
-		ord.Status = model.StatusCancelled
-
 		wdbCtx, wdbCancel := context.WithTimeout(ctx, svc.writeDBTimeout)
 		defer wdbCancel()
 
-		if err := svc.repo.Update(wdbCtx, ord); err != nil {
-			log.Error(ctx, "repository update order", logger.ErrorF(err))
+		// The order may get paid since it was read; then it is not cancelled.
+		// The stock and the OrderCancelled event are left to the release
+		// saga written with the cancellation.
+		if err := svc.repo.Cancel(wdbCtx, ord.ID, model.StatusPendingPayment); err != nil {
+			log.Error(ctx, "repository cancel order", logger.ErrorF(err))
 			return fmt.Errorf("%s: %w", op, err)
 		}
+	case model.StatusCancelled:
+		// Cancelled already, e.g. by a retry of this call; its release saga
+		// was written with the cancellation.
+		log.Info(ctx, "order already cancelled")
 	case model.StatusPaid:
 		log.Error(ctx, "order conflict: already paid")
 		return fmt.Errorf("%s: %w", op, model.ErrOrderConflict)
@@ -264,6 +380,9 @@ func (svc *service) Cancel(ctx context.Context, ordID uuid.UUID) error {
 	return nil
 }
 
+// Complete completes a paid order once its ship is assembled. An order that
+// is not paid, e.g. one cancelled and refunded before the ship was ready,
+// stays as it is and gives model.ErrOrderConflict.
 func (svc *service) Complete(ctx context.Context, ordID uuid.UUID) error {
 	const op string = "order.service.Complete"
 	log := logger.With(
@@ -273,10 +392,10 @@ func (svc *service) Complete(ctx context.Context, ordID uuid.UUID) error {
 	wdbCtx, wdbCancel := context.WithTimeout(ctx, svc.writeDBTimeout)
 	defer wdbCancel()
 
-	if err := svc.repo.Update(wdbCtx, &model.Order{
+	if err := svc.repo.UpdateFrom(wdbCtx, &model.Order{
 		ID:     ordID,
 		Status: model.StatusCompleted,
-	}); err != nil {
+	}, model.StatusPaid); err != nil {
 		log.Error(ctx, "repository update order", logger.ErrorF(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
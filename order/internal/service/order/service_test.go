@@ -17,6 +17,7 @@ import (
 )
 
 const (
+	paymentTTL     = 30 * time.Minute
 	dbReadTimeout  = 5 * time.Second
 	dbWriteTimeout = 5 * time.Second
 )
@@ -27,16 +28,15 @@ func TestServiceCreate(t *testing.T) {
 	type deps struct {
 		repository *mocks.MockOrderRepository
 		inventory  *mocks.MockInventoryClient
-		payment    *mocks.MockPaymentClient
-		producer   *mocks.MockOrderPaidSender
+		sagas      *mocks.MockOrderSagas
 	}
 
 	newSvc := func(d deps) *service {
 		return NewOrderService(
 			d.repository,
 			d.inventory,
-			d.payment,
-			d.producer,
+			d.sagas,
+			paymentTTL,
 			dbReadTimeout,
 			dbWriteTimeout,
 		)
@@ -73,7 +73,7 @@ func TestServiceCreate(t *testing.T) {
 
 				d.repository.AssertExpectations(t)
 				d.inventory.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 			},
 		},
 		{
@@ -92,7 +92,7 @@ func TestServiceCreate(t *testing.T) {
 
 				d.repository.AssertExpectations(t)
 				d.inventory.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 			},
 		},
 		{
@@ -115,7 +115,7 @@ func TestServiceCreate(t *testing.T) {
 				assert.ErrorIs(t, err, model.ErrBadGateway)
 				assert.Nil(t, res)
 
-				d.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.inventory.AssertExpectations(t)
 			},
 		},
@@ -138,7 +138,7 @@ func TestServiceCreate(t *testing.T) {
 				assert.ErrorIs(t, err, model.ErrPartNotFound)
 				assert.Nil(t, res)
 
-				d.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.inventory.AssertExpectations(t)
 			},
 		},
@@ -162,12 +162,12 @@ func TestServiceCreate(t *testing.T) {
 				assert.ErrorIs(t, err, model.ErrPartsOutOfStock)
 				assert.Nil(t, res)
 
-				d.repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.inventory.AssertExpectations(t)
 			},
 		},
 		{
-			name: "repository error: Create returns error",
+			name: "incompatible parts: inventory reports violations",
 			params: model.CreateOrderParams{
 				UserID:  userID,
 				PartIDs: []uuid.UUID{partID1, partID2},
@@ -180,28 +180,96 @@ func TestServiceCreate(t *testing.T) {
 						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2},
 					}, nil).
 					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, mock.Anything).
+					Return([]model.ConfigurationViolation{{
+						PartID:         partID1.String(),
+						Kind:           model.RuleRequires,
+						TargetCategory: model.CategoryEngine,
+						Message:        "Fuel Cell requires a part of category ENGINE",
+					}}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrIncompatibleParts)
+				assert.Nil(t, res)
 
-				d.repository.
-					On("Create", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+				var incompatible *model.IncompatiblePartsError
+				require.ErrorAs(t, err, &incompatible)
+				require.Len(t, incompatible.Violations, 1)
+				assert.Equal(t, partID1.String(), incompatible.Violations[0].PartID)
+
+				d.sagas.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "inventory bad gateway: ValidateConfiguration returns error",
+			params: model.CreateOrderParams{
+				UserID:  userID,
+				PartIDs: []uuid.UUID{partID1, partID2},
+			},
+			setup: func(d deps) {
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 1},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2},
+					}, nil).
+					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, mock.Anything).
+					Return(nil, errors.New("inventory is down")).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrBadGateway)
+				assert.Nil(t, res)
+
+				d.sagas.AssertNotCalled(t, "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "saga error: stock cannot be reserved",
+			params: model.CreateOrderParams{
+				UserID:  userID,
+				PartIDs: []uuid.UUID{partID1, partID2},
+			},
+			setup: func(d deps) {
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 1},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2},
+					}, nil).
+					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, []string{partID1.String(), partID2.String()}).
+					Return(nil, nil).
+					Once()
+
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
 						return o.UserID == userID &&
 							len(o.PartIDs) == 2 &&
 							o.TotalPrice == price1+price2 &&
 							o.Status == model.StatusPendingPayment
-					})).
-					Return(uuid.Nil, errors.New("db write failed")).
+					}), mock.Anything).
+					Return(model.ErrPartsOutOfStock).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
 				require.Error(t, err)
-				assert.NotErrorIs(t, err, model.ErrBadGateway)
+				assert.ErrorIs(t, err, model.ErrPartsOutOfStock)
 				assert.Nil(t, res)
 
-				d.repository.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 				d.inventory.AssertExpectations(t)
 			},
 		},
 		{
-			name: "success: creates order with total price and pending status",
+			name: "success: the stock is reserved again at the new versions of parts whose price held",
 			params: model.CreateOrderParams{
 				UserID:  userID,
 				PartIDs: []uuid.UUID{partID1, partID2},
@@ -210,22 +278,151 @@ func TestServiceCreate(t *testing.T) {
 				d.inventory.
 					On("ListParts", mock.Anything, mock.Anything).
 					Return([]model.Part{
-						{ID: partID1.String(), PriceCents: price1, StockQuantity: 1},
-						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2},
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 3, Version: 2},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2, Version: 4},
 					}, nil).
 					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.Anything, map[uuid.UUID]int64{partID1: 2, partID2: 4}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.Order).ID = uuid.New()
+					}).
+					Return(model.ErrPartChanged).
+					Once()
 
-				d.repository.
-					On("Create", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+				// Someone else took stock of the first part.
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 2, Version: 3},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2, Version: 4},
+					}, nil).
+					Once()
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						// A new order id, so a new reservation.
+						return o.ID == uuid.Nil && o.TotalPrice == price1+price2
+					}), map[uuid.UUID]int64{partID1: 3, partID2: 4}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.Order).ID = orderID
+					}).
+					Return(nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				assert.Equal(t, orderID, res.ID)
+				assert.Equal(t, price1+price2, res.TotalPrice)
+			},
+		},
+		{
+			name: "part changed: the price moved since the order was priced",
+			params: model.CreateOrderParams{
+				UserID:  userID,
+				PartIDs: []uuid.UUID{partID1, partID2},
+			},
+			setup: func(d deps) {
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 3, Version: 2},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2, Version: 4},
+					}, nil).
+					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).
+					Return(model.ErrPartChanged).
+					Once()
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1 + 100, StockQuantity: 3, Version: 3},
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2, Version: 4},
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartChanged)
+				assert.Nil(t, res)
+
+				d.sagas.AssertNumberOfCalls(t, "CreateOrder", 1)
+			},
+		},
+		{
+			name: "part changed: the reservation keeps losing the race",
+			params: model.CreateOrderParams{
+				UserID:  userID,
+				PartIDs: []uuid.UUID{partID1},
+			},
+			setup: func(d deps) {
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 3, Version: 2},
+					}, nil).
+					Times(maxReserveAttempts)
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).
+					Return(model.ErrPartChanged).
+					Times(maxReserveAttempts)
+			},
+			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrPartChanged)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "success: creates order with total price, unit prices and pending status",
+			params: model.CreateOrderParams{
+				UserID:  userID,
+				PartIDs: []uuid.UUID{partID1, partID2},
+			},
+			setup: func(d deps) {
+				// Inventory does not keep the order of the requested IDs.
+				d.inventory.
+					On("ListParts", mock.Anything, mock.Anything).
+					Return([]model.Part{
+						{ID: partID2.String(), PriceCents: price2, StockQuantity: 2, Version: 4},
+						{ID: partID1.String(), PriceCents: price1, StockQuantity: 1, Version: 2},
+					}, nil).
+					Once()
+				d.inventory.
+					On("ValidateConfiguration", mock.Anything, []string{partID1.String(), partID2.String()}).
+					Return(nil, nil).
+					Once()
+
+				d.sagas.
+					On("CreateOrder", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
 						return o.UserID == userID &&
 							assert.ElementsMatch(t,
 								[]uuid.UUID{partID1, partID2},
 								o.PartIDs,
 							) &&
 							o.TotalPrice == price1+price2 &&
-							o.Status == model.StatusPendingPayment
-					})).
-					Return(orderID, nil).
+							assert.Equal(t, []int64{price1, price2}, o.UnitPrices) &&
+							o.Status == model.StatusPendingPayment &&
+							assert.NotNil(t, o.ExpiresAt) &&
+							assert.WithinDuration(t, time.Now().Add(paymentTTL), *o.ExpiresAt, time.Minute)
+					}), map[uuid.UUID]int64{partID1: 2, partID2: 4}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.Order).ID = orderID
+					}).
+					Return(nil).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.CreateOrderResult, err error, d deps) {
@@ -234,7 +431,7 @@ func TestServiceCreate(t *testing.T) {
 				assert.Equal(t, orderID, res.ID)
 				assert.Equal(t, price1+price2, res.TotalPrice)
 
-				d.repository.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 				d.inventory.AssertExpectations(t)
 			},
 		},
@@ -247,8 +444,7 @@ func TestServiceCreate(t *testing.T) {
 			d := deps{
 				repository: mocks.NewMockOrderRepository(t),
 				inventory:  mocks.NewMockInventoryClient(t),
-				payment:    mocks.NewMockPaymentClient(t),
-				producer:   mocks.NewMockOrderPaidSender(t),
+				sagas:      mocks.NewMockOrderSagas(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
@@ -271,16 +467,15 @@ func TestServicePay(t *testing.T) {
 	type deps struct {
 		repository *mocks.MockOrderRepository
 		inventory  *mocks.MockInventoryClient
-		payment    *mocks.MockPaymentClient
-		producer   *mocks.MockOrderPaidSender
+		sagas      *mocks.MockOrderSagas
 	}
 
 	newSvc := func(d deps) *service {
 		return NewOrderService(
 			d.repository,
 			d.inventory,
-			d.payment,
-			d.producer,
+			d.sagas,
+			paymentTTL,
 			dbReadTimeout,
 			dbWriteTimeout,
 		)
@@ -302,6 +497,7 @@ func TestServicePay(t *testing.T) {
 			name: "repository error: OrderByID fails",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -314,14 +510,41 @@ func TestServicePay(t *testing.T) {
 				require.Error(t, err)
 				assert.Nil(t, res)
 
-				d.payment.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name: "forbidden: order of another user",
+			params: model.PayOrderParams{
+				ID:            ordID,
+				UserID:        uuid.New(),
+				PaymentMethod: model.PaymentMethodCard,
+			},
+			setup: func(d deps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{
+						ID:     ordID,
+						UserID: userID,
+						Status: model.StatusPendingPayment,
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				assert.Nil(t, res)
+
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
+				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			},
+		},
 		{
 			name: "conflict: already paid",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -339,7 +562,7 @@ func TestServicePay(t *testing.T) {
 				assert.ErrorIs(t, err, model.ErrOrderConflict)
 				assert.Nil(t, res)
 
-				d.payment.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.repository.AssertExpectations(t)
 			},
 		},
@@ -347,6 +570,7 @@ func TestServicePay(t *testing.T) {
 			name: "conflict: cancelled",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -364,39 +588,43 @@ func TestServicePay(t *testing.T) {
 				assert.ErrorIs(t, err, model.ErrOrderConflict)
 				assert.Nil(t, res)
 
-				d.payment.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.repository.AssertExpectations(t)
 			},
 		},
 		{
-			name: "unknown status",
+			name: "conflict: payment deadline passed",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
+				expiresAt := time.Now().Add(-time.Minute)
 				d.repository.
 					On("OrderByID", mock.Anything, ordID).
 					Return(&model.Order{
-						ID:     ordID,
-						UserID: userID,
-						Status: model.OrderStatus("weird_status"),
+						ID:        ordID,
+						UserID:    userID,
+						Status:    model.StatusPendingPayment,
+						ExpiresAt: &expiresAt,
 					}, nil).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
 				require.Error(t, err)
-				assert.ErrorIs(t, err, model.ErrUnknownStatus)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
 				assert.Nil(t, res)
 
-				d.payment.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything)
-				d.repository.AssertExpectations(t)
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
+				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 			},
 		},
 		{
-			name: "payment bad gateway: PayOrder returns error",
+			name: "unknown status",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -405,31 +633,24 @@ func TestServicePay(t *testing.T) {
 					Return(&model.Order{
 						ID:     ordID,
 						UserID: userID,
-						Status: model.StatusPendingPayment,
+						Status: model.OrderStatus("weird_status"),
 					}, nil).
 					Once()
-
-				d.payment.
-					On("PayOrder", mock.Anything, mock.MatchedBy(func(p model.PayOrderParams) bool {
-						return p.ID == ordID && p.UserID == userID && p.PaymentMethod == model.PaymentMethodCard
-					})).
-					Return("", errors.New("payment provider timeout")).
-					Once()
 			},
 			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
 				require.Error(t, err)
-				assert.ErrorIs(t, err, model.ErrBadGateway)
+				assert.ErrorIs(t, err, model.ErrUnknownStatus)
 				assert.Nil(t, res)
 
-				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				d.sagas.AssertNotCalled(t, "PayOrder", mock.Anything, mock.Anything, mock.Anything)
 				d.repository.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
 			},
 		},
 		{
-			name: "payment returns invalid transaction id",
+			name: "saga bad gateway: payment fails",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -442,24 +663,28 @@ func TestServicePay(t *testing.T) {
 					}, nil).
 					Once()
 
-				d.payment.
-					On("PayOrder", mock.Anything, mock.Anything).
-					Return("not-a-uuid", nil).
+				d.sagas.
+					On("PayOrder", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						return o.ID == ordID && o.UserID == userID
+					}), model.PaymentMethodCard).
+					Return(uuid.Nil, model.ErrBadGateway).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
 				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrBadGateway)
 				assert.Nil(t, res)
 
 				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 				d.repository.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 			},
 		},
 		{
-			name: "repository error: Update fails after successful payment",
+			name: "saga conflict: order changed during the payment",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
@@ -472,61 +697,44 @@ func TestServicePay(t *testing.T) {
 					}, nil).
 					Once()
 
-				d.payment.
-					On("PayOrder", mock.Anything, mock.Anything).
-					Return(txID.String(), nil).
-					Once()
-
-				d.repository.
-					On("Update", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
-						// Verify the service mutates order state correctly before persisting.
-						return o.ID == ordID &&
-							o.Status == model.StatusPaid &&
-							o.TransactionID != nil && *o.TransactionID == txID &&
-							o.PaymentMethod != nil && *o.PaymentMethod == model.PaymentMethodCard
-					})).
-					Return(errors.New("db update failed")).
+				d.sagas.
+					On("PayOrder", mock.Anything, mock.Anything, model.PaymentMethodCard).
+					Return(uuid.Nil, model.ErrOrderConflict).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
 				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
 				assert.Nil(t, res)
 
 				d.repository.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 			},
 		},
 		{
 			name: "success: pending -> paid with transaction id",
 			params: model.PayOrderParams{
 				ID:            ordID,
+				UserID:        userID,
 				PaymentMethod: model.PaymentMethodCard,
 			},
 			setup: func(d deps) {
+				expiresAt := time.Now().Add(paymentTTL)
 				d.repository.
 					On("OrderByID", mock.Anything, ordID).
 					Return(&model.Order{
-						ID:     ordID,
-						UserID: userID,
-						Status: model.StatusPendingPayment,
+						ID:        ordID,
+						UserID:    userID,
+						Status:    model.StatusPendingPayment,
+						ExpiresAt: &expiresAt,
 					}, nil).
 					Once()
 
-				d.payment.
-					On("PayOrder", mock.Anything, mock.MatchedBy(func(p model.PayOrderParams) bool {
-						return p.ID == ordID && p.UserID == userID
-					})).
-					Return(txID.String(), nil).
-					Once()
-
-				d.repository.
-					On("Update", mock.Anything, mock.AnythingOfType("*model.Order")).
-					Return(nil).
-					Once()
-
-				d.producer.
-					On("SendOrderPaid", mock.Anything, mock.AnythingOfType("model.PaidOrder")).
-					Return(nil).
+				d.sagas.
+					On("PayOrder", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						return o.ID == ordID && o.UserID == userID
+					}), model.PaymentMethodCard).
+					Return(txID, nil).
 					Once()
 			},
 			assert: func(t *testing.T, res *model.PayOrderResult, err error, d deps) {
@@ -535,7 +743,7 @@ func TestServicePay(t *testing.T) {
 				assert.Equal(t, txID, res.TransactionID)
 
 				d.repository.AssertExpectations(t)
-				d.payment.AssertExpectations(t)
+				d.sagas.AssertExpectations(t)
 			},
 		},
 	}
@@ -547,8 +755,7 @@ func TestServicePay(t *testing.T) {
 			d := deps{
 				repository: mocks.NewMockOrderRepository(t),
 				inventory:  mocks.NewMockInventoryClient(t),
-				payment:    mocks.NewMockPaymentClient(t),
-				producer:   mocks.NewMockOrderPaidSender(t),
+				sagas:      mocks.NewMockOrderSagas(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
@@ -571,16 +778,15 @@ func TestServiceOrderByID(t *testing.T) {
 	type deps struct {
 		repository *mocks.MockOrderRepository
 		inventory  *mocks.MockInventoryClient
-		payment    *mocks.MockPaymentClient
-		producer   *mocks.MockOrderPaidSender
+		sagas      *mocks.MockOrderSagas
 	}
 
 	newSvc := func(d deps) *service {
 		return NewOrderService(
 			d.repository,
 			d.inventory,
-			d.payment,
-			d.producer,
+			d.sagas,
+			paymentTTL,
 			dbReadTimeout,
 			dbWriteTimeout,
 		)
@@ -593,6 +799,8 @@ func TestServiceOrderByID(t *testing.T) {
 		assert func(t *testing.T, got *model.Order, err error, d deps)
 	}
 
+	userID := uuid.New()
+
 	if err := gofakeit.Seed(0); err != nil {
 		t.Fatalf("seed: %v", err)
 	}
@@ -604,7 +812,7 @@ func TestServiceOrderByID(t *testing.T) {
 			setup: func(d deps) {
 				expected := &model.Order{
 					ID:     uuid.New(),
-					UserID: uuid.New(),
+					UserID: userID,
 					PartIDs: []uuid.UUID{
 						uuid.New(),
 						uuid.New(),
@@ -632,6 +840,25 @@ func TestServiceOrderByID(t *testing.T) {
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name:  "forbidden: order of another user",
+			ordID: uuid.New(),
+			setup: func(d deps) {
+				d.repository.
+					On("OrderByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+					Return(&model.Order{
+						ID:     uuid.New(),
+						UserID: uuid.New(),
+						Status: model.StatusPendingPayment,
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, got *model.Order, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				assert.Nil(t, got)
+			},
+		},
 		{
 			name:  "error: repository returns error",
 			ordID: uuid.New(),
@@ -657,8 +884,123 @@ func TestServiceOrderByID(t *testing.T) {
 			d := deps{
 				repository: mocks.NewMockOrderRepository(t),
 				inventory:  mocks.NewMockInventoryClient(t),
-				payment:    mocks.NewMockPaymentClient(t),
-				producer:   mocks.NewMockOrderPaidSender(t),
+				sagas:      mocks.NewMockOrderSagas(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			svc := newSvc(d)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			got, err := svc.OrderByID(ctx, userID, tt.ordID)
+			tt.assert(t, got, err, d)
+		})
+	}
+}
+
+func TestServiceListByUser(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		repository *mocks.MockOrderRepository
+		inventory  *mocks.MockInventoryClient
+		sagas      *mocks.MockOrderSagas
+	}
+
+	newSvc := func(d deps) *service {
+		return NewOrderService(
+			d.repository,
+			d.inventory,
+			d.sagas,
+			paymentTTL,
+			dbReadTimeout,
+			dbWriteTimeout,
+		)
+	}
+
+	userID := uuid.New()
+
+	type testCase struct {
+		name   string
+		userID uuid.UUID
+		limit  int
+		setup  func(d deps)
+		assert func(t *testing.T, got []model.Order, err error, d deps)
+	}
+
+	tests := []testCase{
+		{
+			name:   "success: returns user orders from repository",
+			userID: userID,
+			limit:  10,
+			setup: func(d deps) {
+				d.repository.
+					On("OrdersByUser", mock.Anything, userID, uint64(10)).
+					Return([]model.Order{
+						{ID: uuid.New(), UserID: userID, Status: model.StatusPaid},
+						{ID: uuid.New(), UserID: userID, Status: model.StatusPendingPayment},
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, got []model.Order, err error, d deps) {
+				require.NoError(t, err)
+				require.Len(t, got, 2)
+				assert.Equal(t, userID, got[0].UserID)
+
+				d.repository.AssertExpectations(t)
+			},
+		},
+		{
+			name:   "validation error: empty user id",
+			userID: uuid.Nil,
+			limit:  10,
+			assert: func(t *testing.T, got []model.Order, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrValidation)
+				assert.Nil(t, got)
+			},
+		},
+		{
+			name:   "validation error: non-positive limit",
+			userID: userID,
+			limit:  0,
+			assert: func(t *testing.T, got []model.Order, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrValidation)
+				assert.Nil(t, got)
+			},
+		},
+		{
+			name:   "error: repository returns error",
+			userID: userID,
+			limit:  5,
+			setup: func(d deps) {
+				d.repository.
+					On("OrdersByUser", mock.Anything, userID, uint64(5)).
+					Return(([]model.Order)(nil), gofakeit.Error()).
+					Once()
+			},
+			assert: func(t *testing.T, got []model.Order, err error, d deps) {
+				require.Error(t, err)
+				assert.Nil(t, got)
+
+				d.repository.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := deps{
+				repository: mocks.NewMockOrderRepository(t),
+				inventory:  mocks.NewMockInventoryClient(t),
+				sagas:      mocks.NewMockOrderSagas(t),
 			}
 
 			if tt.setup != nil {
@@ -670,7 +1012,7 @@ func TestServiceOrderByID(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 			defer cancel()
 
-			got, err := svc.OrderByID(ctx, tt.ordID)
+			got, err := svc.ListByUser(ctx, tt.userID, tt.limit)
 			tt.assert(t, got, err, d)
 		})
 	}
@@ -682,16 +1024,15 @@ func TestServiceCancel(t *testing.T) {
 	type deps struct {
 		repository *mocks.MockOrderRepository
 		inventory  *mocks.MockInventoryClient
-		payment    *mocks.MockPaymentClient
-		producer   *mocks.MockOrderPaidSender
+		sagas      *mocks.MockOrderSagas
 	}
 
 	newSvc := func(d deps) *service {
 		return NewOrderService(
 			d.repository,
 			d.inventory,
-			d.payment,
-			d.producer,
+			d.sagas,
+			paymentTTL,
 			dbReadTimeout,
 			dbWriteTimeout,
 		)
@@ -720,7 +1061,26 @@ func TestServiceCancel(t *testing.T) {
 			assert: func(t *testing.T, err error, d deps) {
 				require.Error(t, err)
 				d.repository.AssertExpectations(t)
-				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				d.repository.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:  "forbidden: order of another user",
+			ordID: ordID,
+			setup: func(d deps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{
+						ID:     ordID,
+						UserID: uuid.New(),
+						Status: model.StatusPendingPayment,
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				d.repository.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
 			},
 		},
 		{
@@ -740,7 +1100,7 @@ func TestServiceCancel(t *testing.T) {
 				require.Error(t, err)
 				assert.ErrorIs(t, err, model.ErrOrderConflict)
 				d.repository.AssertExpectations(t)
-				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				d.repository.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
 			},
 		},
 		{
@@ -760,11 +1120,11 @@ func TestServiceCancel(t *testing.T) {
 				require.Error(t, err)
 				assert.ErrorIs(t, err, model.ErrUnknownStatus)
 				d.repository.AssertExpectations(t)
-				d.repository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				d.repository.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
 			},
 		},
 		{
-			name:  "repository error: Update fails",
+			name:  "repository error: Cancel fails",
 			ordID: ordID,
 			setup: func(d deps) {
 				d.repository.
@@ -777,9 +1137,7 @@ func TestServiceCancel(t *testing.T) {
 					Once()
 
 				d.repository.
-					On("Update", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
-						return o.ID == ordID && o.Status == model.StatusCancelled
-					})).
+					On("Cancel", mock.Anything, ordID, model.StatusPendingPayment).
 					Return(errors.New("db update failed")).
 					Once()
 			},
@@ -788,6 +1146,30 @@ func TestServiceCancel(t *testing.T) {
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name:  "conflict: order paid since it was read",
+			ordID: ordID,
+			setup: func(d deps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{
+						ID:     ordID,
+						UserID: userID,
+						Status: model.StatusPendingPayment,
+					}, nil).
+					Once()
+
+				d.repository.
+					On("Cancel", mock.Anything, ordID, model.StatusPendingPayment).
+					Return(model.ErrOrderConflict).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
+				d.repository.AssertExpectations(t)
+			},
+		},
 		{
 			name:  "success: pending -> cancelled",
 			ordID: ordID,
@@ -802,9 +1184,7 @@ func TestServiceCancel(t *testing.T) {
 					Once()
 
 				d.repository.
-					On("Update", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
-						return o.ID == ordID && o.Status == model.StatusCancelled
-					})).
+					On("Cancel", mock.Anything, ordID, model.StatusPendingPayment).
 					Return(nil).
 					Once()
 			},
@@ -813,6 +1193,25 @@ func TestServiceCancel(t *testing.T) {
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name:  "success: a retry of a cancelled order changes nothing",
+			ordID: ordID,
+			setup: func(d deps) {
+				d.repository.
+					On("OrderByID", mock.Anything, ordID).
+					Return(&model.Order{
+						ID:     ordID,
+						UserID: userID,
+						Status: model.StatusCancelled,
+					}, nil).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.NoError(t, err)
+				d.repository.AssertExpectations(t)
+				d.repository.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -822,8 +1221,7 @@ func TestServiceCancel(t *testing.T) {
 			d := deps{
 				repository: mocks.NewMockOrderRepository(t),
 				inventory:  mocks.NewMockInventoryClient(t),
-				payment:    mocks.NewMockPaymentClient(t),
-				producer:   mocks.NewMockOrderPaidSender(t),
+				sagas:      mocks.NewMockOrderSagas(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
@@ -834,7 +1232,7 @@ func TestServiceCancel(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 			defer cancel()
 
-			err := svc.Cancel(ctx, tt.ordID)
+			err := svc.Cancel(ctx, userID, tt.ordID)
 			tt.assert(t, err, d)
 		})
 	}
@@ -846,16 +1244,15 @@ func TestServiceComplete(t *testing.T) {
 	type deps struct {
 		repository *mocks.MockOrderRepository
 		inventory  *mocks.MockInventoryClient
-		payment    *mocks.MockPaymentClient
-		producer   *mocks.MockOrderPaidSender
+		sagas      *mocks.MockOrderSagas
 	}
 
 	newSvc := func(d deps) *service {
 		return NewOrderService(
 			d.repository,
 			d.inventory,
-			d.payment,
-			d.producer,
+			d.sagas,
+			paymentTTL,
 			dbReadTimeout,
 			dbWriteTimeout,
 		)
@@ -872,13 +1269,13 @@ func TestServiceComplete(t *testing.T) {
 
 	tests := []testCase{
 		{
-			name:  "repository error: Update fails",
+			name:  "repository error: UpdateFrom fails",
 			ordID: ordID,
 			setup: func(d deps) {
 				d.repository.
-					On("Update", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+					On("UpdateFrom", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
 						return o.ID == ordID && o.Status == model.StatusCompleted
-					})).
+					}), model.StatusPaid).
 					Return(errors.New("db update failed")).
 					Once()
 			},
@@ -887,14 +1284,29 @@ func TestServiceComplete(t *testing.T) {
 				d.repository.AssertExpectations(t)
 			},
 		},
+		{
+			name:  "conflict: a cancelled order stays cancelled",
+			ordID: ordID,
+			setup: func(d deps) {
+				d.repository.
+					On("UpdateFrom", mock.Anything, mock.Anything, model.StatusPaid).
+					Return(model.ErrOrderConflict).
+					Once()
+			},
+			assert: func(t *testing.T, err error, d deps) {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, model.ErrOrderConflict)
+				d.repository.AssertExpectations(t)
+			},
+		},
 		{
 			name:  "success: paid -> completed",
 			ordID: ordID,
 			setup: func(d deps) {
 				d.repository.
-					On("Update", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+					On("UpdateFrom", mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
 						return o.ID == ordID && o.Status == model.StatusCompleted
-					})).
+					}), model.StatusPaid).
 					Return(nil).
 					Once()
 			},
@@ -912,8 +1324,7 @@ func TestServiceComplete(t *testing.T) {
 			d := deps{
 				repository: mocks.NewMockOrderRepository(t),
 				inventory:  mocks.NewMockInventoryClient(t),
-				payment:    mocks.NewMockPaymentClient(t),
-				producer:   mocks.NewMockOrderPaidSender(t),
+				sagas:      mocks.NewMockOrderSagas(t),
 			}
 			if tt.setup != nil {
 				tt.setup(d)
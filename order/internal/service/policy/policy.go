@@ -0,0 +1,36 @@
+// Package policy decides which admin actions the role of a caller allows.
+package policy
+
+import (
+	"fmt"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+// grants lists the admin actions of each role. Customers have none: they
+// reach only their own orders through the customer API.
+var grants = map[model.Role]map[model.AdminAction]bool{
+	model.RoleSupport: {
+		model.AdminActionViewOrder:   true,
+		model.AdminActionListOrders:  true,
+		model.AdminActionCancelOrder: true,
+		model.AdminActionListSagas:   true,
+	},
+	model.RoleAdmin: {
+		model.AdminActionViewOrder:     true,
+		model.AdminActionListOrders:    true,
+		model.AdminActionCancelOrder:   true,
+		model.AdminActionCompleteOrder: true,
+		model.AdminActionListSagas:     true,
+	},
+}
+
+// Authorize returns model.ErrForbidden unless the role of the caller allows
+// the action.
+func Authorize(id model.Identity, action model.AdminAction) error {
+	if !grants[id.Role][action] {
+		return fmt.Errorf("%w: role %q may not %s", model.ErrForbidden, id.Role, action)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package policy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	actions := []model.AdminAction{
+		model.AdminActionViewOrder,
+		model.AdminActionListOrders,
+		model.AdminActionCancelOrder,
+		model.AdminActionCompleteOrder,
+		model.AdminActionListSagas,
+	}
+
+	tests := []struct {
+		role    model.Role
+		allowed []model.AdminAction
+	}{
+		{role: model.RoleCustomer},
+		{role: ""},
+		{
+			role: model.RoleSupport,
+			allowed: []model.AdminAction{
+				model.AdminActionViewOrder, model.AdminActionListOrders, model.AdminActionCancelOrder,
+				model.AdminActionListSagas,
+			},
+		},
+		{role: model.RoleAdmin, allowed: actions},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			t.Parallel()
+
+			id := model.Identity{UserID: uuid.New(), Role: tt.role}
+			for _, a := range actions {
+				err := Authorize(id, a)
+				if slices.Contains(tt.allowed, a) {
+					assert.NoError(t, err, a)
+				} else {
+					assert.ErrorIs(t, err, model.ErrForbidden, a)
+				}
+			}
+		})
+	}
+}
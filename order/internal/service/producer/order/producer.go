@@ -10,15 +10,24 @@ import (
 
 type Converter interface {
 	PaidOrderToModel(m model.PaidOrder) ([]byte, error)
+	CancelledOrderToPayload(m model.CancelledOrder) ([]byte, error)
+	ExpiredOrderToPayload(m model.ExpiredOrder) ([]byte, error)
 }
 
 type service struct {
-	producer kafka.Producer
-	conv     Converter
+	paidProducer      kafka.Producer
+	cancelledProducer kafka.Producer
+	expiredProducer   kafka.Producer
+	conv              Converter
 }
 
-func NewOrderProducer(producer kafka.Producer, conv Converter) *service {
-	return &service{producer: producer, conv: conv}
+func NewOrderProducer(paidProducer, cancelledProducer, expiredProducer kafka.Producer, conv Converter) *service {
+	return &service{
+		paidProducer:      paidProducer,
+		cancelledProducer: cancelledProducer,
+		expiredProducer:   expiredProducer,
+		conv:              conv,
+	}
 }
 
 func (s *service) SendOrderPaid(ctx context.Context, event model.PaidOrder) error {
@@ -27,9 +36,35 @@ func (s *service) SendOrderPaid(ctx context.Context, event model.PaidOrder) erro
 		return fmt.Errorf("converter paid_order_to_proto error: %w", err)
 	}
 
-	if err := s.producer.Send(ctx, event.OrderID[:], payload); err != nil {
+	if err := s.paidProducer.Send(ctx, event.OrderID[:], payload); err != nil {
 		return fmt.Errorf("producer to order.paid topic error: %w", err)
 	}
 
 	return nil
 }
+
+func (s *service) SendOrderCancelled(ctx context.Context, event model.CancelledOrder) error {
+	payload, err := s.conv.CancelledOrderToPayload(event)
+	if err != nil {
+		return fmt.Errorf("converter cancelled_order_to_proto error: %w", err)
+	}
+
+	if err := s.cancelledProducer.Send(ctx, event.OrderID[:], payload); err != nil {
+		return fmt.Errorf("producer to order.cancelled topic error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) SendOrderExpired(ctx context.Context, event model.ExpiredOrder) error {
+	payload, err := s.conv.ExpiredOrderToPayload(event)
+	if err != nil {
+		return fmt.Errorf("converter expired_order_to_proto error: %w", err)
+	}
+
+	if err := s.expiredProducer.Send(ctx, event.OrderID[:], payload); err != nil {
+		return fmt.Errorf("producer to order.expired topic error: %w", err)
+	}
+
+	return nil
+}
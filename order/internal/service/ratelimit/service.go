@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+type BucketRepository interface {
+	// Take takes a token from the bucket of key, creating a full bucket of
+	// the budget if there is none.
+	Take(ctx context.Context, key string, budget model.Budget) (model.RateLimitResult, error)
+	// Sweep removes the buckets last used before now-idle.
+	Sweep(ctx context.Context, idle time.Duration) (int64, error)
+}
+
+type service struct {
+	repo    BucketRepository
+	limits  model.RateLimits
+	timeout time.Duration
+	// idle is the longest refill period of the budgets; a bucket unused for
+	// that long is full and can be dropped.
+	idle time.Duration
+}
+
+// NewRateLimitService charges requests to token buckets. A budget with zero
+// requests does not limit.
+func NewRateLimitService(repository BucketRepository, limits model.RateLimits, timeout time.Duration) *service {
+	idle := max(limits.IP.Per, limits.User.Per)
+	for _, b := range limits.Routes {
+		idle = max(idle, b.Per)
+	}
+
+	return &service{
+		repo:    repository,
+		limits:  limits,
+		timeout: timeout,
+		idle:    idle,
+	}
+}
+
+// AllowIP charges a request to the budget of the client IP. It runs before
+// authentication, so it also limits callers without a valid session.
+func (svc *service) AllowIP(ctx context.Context, ip string) (model.RateLimitResult, error) {
+	const op string = "ratelimit.service.AllowIP"
+
+	res, err := svc.take(ctx, "ip:"+ip, svc.limits.IP)
+	if err != nil {
+		logger.Error(ctx, "repository take",
+			logger.String("ip", ip),
+			logger.ErrorF(err),
+		)
+		return model.RateLimitResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// AllowUser charges a request of the user to the budget of the route. The
+// routes without a budget of their own share the default user budget.
+func (svc *service) AllowUser(ctx context.Context, userID uuid.UUID, route string) (model.RateLimitResult, error) {
+	const op string = "ratelimit.service.AllowUser"
+
+	key, budget := "user:"+userID.String(), svc.limits.User
+	if b, ok := svc.limits.Routes[route]; ok {
+		key, budget = key+":"+route, b
+	}
+
+	res, err := svc.take(ctx, key, budget)
+	if err != nil {
+		logger.Error(ctx, "repository take",
+			logger.String("user_id", userID.String()),
+			logger.String("route", route),
+			logger.ErrorF(err),
+		)
+		return model.RateLimitResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (svc *service) take(ctx context.Context, key string, budget model.Budget) (model.RateLimitResult, error) {
+	if budget.Requests == 0 {
+		return model.RateLimitResult{Allowed: true}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, svc.timeout)
+	defer cancel()
+
+	return svc.repo.Take(ctx, key, budget)
+}
+
+// Run removes full buckets every interval until ctx is done.
+func (svc *service) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := svc.Sweep(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "sweep rate limit buckets", logger.ErrorF(err))
+		}
+	}
+}
+
+// Sweep removes the buckets that have been full for a while.
+func (svc *service) Sweep(ctx context.Context) error {
+	const op string = "ratelimit.service.Sweep"
+
+	ctx, cancel := context.WithTimeout(ctx, svc.timeout)
+	defer cancel()
+
+	n, err := svc.repo.Sweep(ctx, svc.idle)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n > 0 {
+		logger.Debug(ctx, "rate limit buckets swept", logger.Int64("count", n))
+	}
+
+	return nil
+}
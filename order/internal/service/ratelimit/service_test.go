@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const timeout = 5 * time.Second
+
+var limits = model.RateLimits{
+	IP:   model.Budget{Requests: 300, Per: time.Minute},
+	User: model.Budget{Requests: 120, Per: time.Minute},
+	Routes: map[string]model.Budget{
+		"PayOrder": {Requests: 10, Per: time.Hour},
+	},
+}
+
+func TestServiceAllowIP(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	allowed := model.RateLimitResult{Allowed: true, Limit: 300, Remaining: 299}
+
+	tests := []struct {
+		name    string
+		limits  model.RateLimits
+		setup   func(repo *mocks.MockBucketRepository)
+		want    model.RateLimitResult
+		wantErr bool
+	}{
+		{
+			name:   "success: the request is charged to the ip bucket",
+			limits: limits,
+			setup: func(repo *mocks.MockBucketRepository) {
+				repo.EXPECT().
+					Take(mock.Anything, "ip:10.0.0.1", limits.IP).
+					Return(allowed, nil).
+					Once()
+			},
+			want: allowed,
+		},
+		{
+			name:   "success: a zero budget does not limit",
+			limits: model.RateLimits{User: limits.User},
+			setup:  func(*mocks.MockBucketRepository) {},
+			want:   model.RateLimitResult{Allowed: true},
+		},
+		{
+			name:   "error: the repository fails",
+			limits: limits,
+			setup: func(repo *mocks.MockBucketRepository) {
+				repo.EXPECT().
+					Take(mock.Anything, "ip:10.0.0.1", limits.IP).
+					Return(model.RateLimitResult{}, errors.New("db down")).
+					Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockBucketRepository(t)
+			tt.setup(repo)
+
+			res, err := NewRateLimitService(repo, tt.limits, timeout).AllowIP(context.Background(), "10.0.0.1")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestServiceAllowUser(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	userID := uuid.New()
+	denied := model.RateLimitResult{Limit: 10, RetryAfter: 6 * time.Minute, Reset: 6 * time.Minute}
+
+	tests := []struct {
+		name    string
+		route   string
+		setup   func(repo *mocks.MockBucketRepository)
+		want    model.RateLimitResult
+		wantErr bool
+	}{
+		{
+			name:  "success: a route without a budget uses the user bucket",
+			route: "GetOrder",
+			setup: func(repo *mocks.MockBucketRepository) {
+				repo.EXPECT().
+					Take(mock.Anything, "user:"+userID.String(), limits.User).
+					Return(model.RateLimitResult{Allowed: true, Limit: 120}, nil).
+					Once()
+			},
+			want: model.RateLimitResult{Allowed: true, Limit: 120},
+		},
+		{
+			name:  "success: a route with a budget has a bucket of its own",
+			route: "PayOrder",
+			setup: func(repo *mocks.MockBucketRepository) {
+				repo.EXPECT().
+					Take(mock.Anything, "user:"+userID.String()+":PayOrder", limits.Routes["PayOrder"]).
+					Return(denied, nil).
+					Once()
+			},
+			want: denied,
+		},
+		{
+			name:  "error: the repository fails",
+			route: "GetOrder",
+			setup: func(repo *mocks.MockBucketRepository) {
+				repo.EXPECT().
+					Take(mock.Anything, mock.Anything, mock.Anything).
+					Return(model.RateLimitResult{}, errors.New("db down")).
+					Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockBucketRepository(t)
+			tt.setup(repo)
+
+			res, err := NewRateLimitService(repo, limits, timeout).AllowUser(context.Background(), userID, tt.route)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestServiceSweep(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	t.Run("success: buckets idle for the longest period are swept", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockBucketRepository(t)
+		repo.EXPECT().Sweep(mock.Anything, time.Hour).Return(3, nil).Once()
+
+		require.NoError(t, NewRateLimitService(repo, limits, timeout).Sweep(context.Background()))
+	})
+
+	t.Run("error: the repository fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockBucketRepository(t)
+		repo.EXPECT().Sweep(mock.Anything, time.Hour).Return(0, errors.New("db down")).Once()
+
+		require.Error(t, NewRateLimitService(repo, limits, timeout).Sweep(context.Background()))
+	})
+}
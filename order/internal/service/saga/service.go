@@ -0,0 +1,504 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const meterName = "github.com/you-humble/rocket-maintenance/order/internal/service/saga"
+
+type SagaRepository interface {
+	Create(ctx context.Context, s *model.Saga) error
+	// Save writes the progress of the saga if it still has the version it
+	// was read with, or gives model.ErrSagaLost.
+	Save(ctx context.Context, s *model.Saga) error
+	// ClaimDue leases up to limit unfinished sagas whose next run is due.
+	ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]model.Saga, error)
+	// StartedBefore returns up to limit unfinished sagas started before t,
+	// oldest first.
+	StartedBefore(ctx context.Context, t time.Time, limit uint64) ([]model.Saga, error)
+	CountStartedBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+type SagaOrderRepository interface {
+	// Create inserts the order; creating an order with the same id again
+	// changes nothing.
+	Create(ctx context.Context, ord *model.Order) (uuid.UUID, error)
+	OrderByID(ctx context.Context, id uuid.UUID) (*model.Order, error)
+	// UpdateFrom updates the order only while it has status from.
+	UpdateFrom(ctx context.Context, upd *model.Order, from model.OrderStatus) error
+	// RevertPayment puts the order back to pending payment if it was paid by
+	// the transaction.
+	RevertPayment(ctx context.Context, id, transactionID uuid.UUID) error
+}
+
+type StockClient interface {
+	ReserveStock(ctx context.Context, reservationID string, items []model.StockItem) error
+	ReleaseStock(ctx context.Context, reservationID string) error
+}
+
+type BillingClient interface {
+	PayOrder(ctx context.Context, params model.PayOrderParams) (string, error)
+	RefundPayment(ctx context.Context, params model.RefundPaymentParams) error
+}
+
+type SagaEventSender interface {
+	SendOrderPaid(ctx context.Context, event model.PaidOrder) error
+	SendOrderCancelled(ctx context.Context, event model.CancelledOrder) error
+	SendOrderExpired(ctx context.Context, event model.ExpiredOrder) error
+}
+
+// Policy is how the sagas are run and watched.
+type Policy struct {
+	// Lease is how long a saga is left to whoever runs it before another
+	// replica may take it over. It has to outlast any step.
+	Lease time.Duration
+	// RetryBackoff is the wait before the first retry of a failed step; it
+	// doubles with each try up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// StuckAfter is the age past which an unfinished saga counts as stuck.
+	StuckAfter time.Duration
+	// BatchSize is how many due sagas a replica claims at once.
+	BatchSize uint64
+	// StepTimeout bounds each step and each compensation.
+	StepTimeout time.Duration
+	// DBTimeout bounds each read or write of the sagas.
+	DBTimeout time.Duration
+}
+
+// step is a step of a saga. undo reverts do and is nil when there is nothing
+// to revert; both may run more than once, so they have to be idempotent.
+type step struct {
+	name model.SagaStep
+	do   func(ctx context.Context, s *model.Saga) error
+	undo func(ctx context.Context, s *model.Saga) error
+	// retry marks a step past the point of no return: when it fails it is
+	// tried again later instead of undoing the saga.
+	retry bool
+}
+
+type instruments struct {
+	finished metric.Int64Counter
+	stuck    metric.Int64Gauge
+}
+
+type service struct {
+	sagas     SagaRepository
+	orders    SagaOrderRepository
+	inventory StockClient
+	payment   BillingClient
+	producer  SagaEventSender
+	policy    Policy
+	flows     map[model.SagaType][]step
+	metrics   instruments
+	now       func() time.Time
+}
+
+// NewSagaService runs the transitions of orders that span other services as
+// sagas kept in the database. A step that fails undoes the steps done before
+// it, last first; a step past the point of no return is retried instead. The
+// sagas left behind by a crash are resumed by Run, which also runs the sagas
+// the order repository writes along with a cancellation or an expiry.
+func NewSagaService(
+	sagas SagaRepository,
+	orders SagaOrderRepository,
+	inventory StockClient,
+	payment BillingClient,
+	producer SagaEventSender,
+	policy Policy,
+) *service {
+	meter := otel.GetMeterProvider().Meter(meterName)
+
+	// The instruments of the global provider never fail to be created; on
+	// error they are no-ops.
+	finished, _ := meter.Int64Counter("order.sagas.finished",
+		metric.WithDescription("Number of sagas that completed or were compensated."),
+	)
+	stuck, _ := meter.Int64Gauge("order.sagas.stuck",
+		metric.WithDescription("Number of unfinished sagas older than the stuck threshold."),
+	)
+
+	svc := &service{
+		sagas:     sagas,
+		orders:    orders,
+		inventory: inventory,
+		payment:   payment,
+		producer:  producer,
+		policy:    policy,
+		metrics:   instruments{finished: finished, stuck: stuck},
+		now:       time.Now,
+	}
+	svc.flows = map[model.SagaType][]step{
+		model.SagaCreateOrder: {
+			{name: model.SagaStepReserveStock, do: svc.reserveStock, undo: svc.releaseStock},
+			{name: model.SagaStepCreateOrder, do: svc.createOrder, undo: svc.cancelOrder},
+		},
+		model.SagaPayOrder: {
+			{name: model.SagaStepPay, do: svc.pay, undo: svc.refund},
+			{name: model.SagaStepMarkPaid, do: svc.markPaid, undo: svc.unmarkPaid},
+			{name: model.SagaStepPublishPaid, do: svc.publishPaid, retry: true},
+		},
+		model.SagaReleaseOrder: {
+			{name: model.SagaStepReleaseStock, do: svc.releaseStock, retry: true},
+			{name: model.SagaStepRefundPayment, do: svc.refundIfPaid, retry: true},
+			{name: model.SagaStepPublishCancelled, do: svc.publishCancelled, retry: true},
+		},
+		model.SagaExpireOrder: {
+			{name: model.SagaStepReleaseStock, do: svc.releaseStock, retry: true},
+			{name: model.SagaStepRefundPayment, do: svc.refundIfPaid, retry: true},
+			{name: model.SagaStepPublishExpired, do: svc.publishExpired, retry: true},
+		},
+	}
+
+	return svc
+}
+
+// CreateOrder reserves the stock of the parts of ord, as long as they still
+// have the versions in partVersions, and creates it, with a new id unless
+// ord has one. When the order cannot be created the stock is given back and
+// the error says why.
+func (svc *service) CreateOrder(ctx context.Context, ord *model.Order, partVersions map[uuid.UUID]int64) error {
+	const op string = "saga.service.CreateOrder"
+
+	if ord.ID == uuid.Nil {
+		ord.ID = uuid.New()
+	}
+
+	s := svc.newSaga(model.SagaCreateOrder, ord.ID, model.SagaData{
+		UserID:       ord.UserID,
+		PartIDs:      ord.PartIDs,
+		UnitPrices:   ord.UnitPrices,
+		TotalPrice:   ord.TotalPrice,
+		ExpiresAt:    ord.ExpiresAt,
+		PartVersions: partVersions,
+	})
+	if err := svc.start(ctx, s); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PayOrder charges the user for ord, marks it paid and announces it, and
+// returns the transaction. When the order cannot be marked paid the payment
+// is refunded. Only the announcement may be left to run in the background.
+func (svc *service) PayOrder(ctx context.Context, ord *model.Order, method model.PaymentMethod) (uuid.UUID, error) {
+	const op string = "saga.service.PayOrder"
+
+	s := svc.newSaga(model.SagaPayOrder, ord.ID, model.SagaData{
+		UserID:        ord.UserID,
+		PaymentMethod: method,
+	})
+	if err := svc.start(ctx, s); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if s.Data.TransactionID == nil {
+		return uuid.Nil, fmt.Errorf("%s: saga ended without a transaction", op)
+	}
+
+	return *s.Data.TransactionID, nil
+}
+
+// StuckSagas returns up to limit sagas that are still unfinished past the
+// stuck threshold, oldest first.
+func (svc *service) StuckSagas(ctx context.Context, limit uint64) ([]model.Saga, error) {
+	const op string = "saga.service.StuckSagas"
+
+	ctx, cancel := context.WithTimeout(ctx, svc.policy.DBTimeout)
+	defer cancel()
+
+	sagas, err := svc.sagas.StartedBefore(ctx, svc.now().Add(-svc.policy.StuckAfter), limit)
+	if err != nil {
+		logger.Error(ctx, "repository sagas started before", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sagas, nil
+}
+
+// Run resumes the due sagas and reports the stuck ones every interval until
+// ctx is done.
+func (svc *service) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := svc.Resume(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "resume sagas", logger.ErrorF(err))
+		}
+		if err := svc.reportStuck(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "report stuck sagas", logger.ErrorF(err))
+		}
+	}
+}
+
+// Resume claims the due sagas batch by batch until none is left, runs each
+// as far as it goes and returns how many it claimed. A saga found running a
+// step that is undone on failure was cut short by a crash, so it is undone.
+func (svc *service) Resume(ctx context.Context) (int, error) {
+	const op string = "saga.service.Resume"
+
+	var total int
+	for {
+		sagas, err := svc.claimDue(ctx)
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+		total += len(sagas)
+
+		for i := range sagas {
+			s := &sagas[i]
+			if s.Status == model.SagaRunning && !svc.retried(s) {
+				s.Status, s.Attempts, s.LastError = model.SagaCompensating, 0, "interrupted"
+			}
+
+			// A failed step is already logged and recorded on the saga.
+			_ = svc.advance(ctx, s)
+		}
+
+		if uint64(len(sagas)) < svc.policy.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info(ctx, "sagas resumed", logger.Int("count", total))
+	}
+
+	return total, nil
+}
+
+func (svc *service) newSaga(typ model.SagaType, ordID uuid.UUID, data model.SagaData) *model.Saga {
+	return &model.Saga{
+		ID:        uuid.New(),
+		Type:      typ,
+		OrderID:   ordID,
+		Status:    model.SagaRunning,
+		Step:      svc.flows[typ][0].name,
+		Data:      data,
+		NextRunAt: svc.now().Add(svc.policy.Lease),
+	}
+}
+
+// start records the saga and runs it. The caller may go away, but a saga
+// half done is better finished than left to the lease, so it runs without
+// the cancellation of ctx.
+func (svc *service) start(ctx context.Context, s *model.Saga) error {
+	ctx = context.WithoutCancel(ctx)
+
+	if err := svc.record(ctx, s); err != nil {
+		return err
+	}
+
+	return svc.advance(ctx, s)
+}
+
+func (svc *service) record(ctx context.Context, s *model.Saga) error {
+	ctx, cancel := context.WithTimeout(ctx, svc.policy.DBTimeout)
+	defer cancel()
+
+	if err := svc.sagas.Create(ctx, s); err != nil {
+		svc.log(s).Error(ctx, "repository create saga", logger.ErrorF(err))
+		return err
+	}
+
+	return nil
+}
+
+// advance runs the saga from where it is until it finishes or waits for a
+// retry, saving it after every step. It returns the error of the step that
+// made the saga compensate, or the one that stopped it from being saved.
+func (svc *service) advance(ctx context.Context, s *model.Saga) error {
+	steps := svc.flows[s.Type]
+
+	var cause error
+	for !s.Status.Finished() {
+		i := stepIndex(steps, s.Step)
+		if i < 0 {
+			err := fmt.Errorf("saga %s has no step %q", s.Type, s.Step)
+			svc.log(s).Error(ctx, "unknown saga step", logger.ErrorF(err))
+			return err
+		}
+		st := steps[i]
+
+		switch s.Status {
+		case model.SagaRunning:
+			err := svc.runStep(ctx, st.do, s)
+			switch {
+			case err == nil:
+				s.Attempts, s.LastError = 0, ""
+				if i+1 < len(steps) {
+					s.Step = steps[i+1].name
+				} else {
+					s.Status, s.Step = model.SagaCompleted, ""
+				}
+			case st.retry:
+				svc.log(s).Warn(ctx, "saga step failed, will retry", logger.ErrorF(err))
+				return svc.retryLater(ctx, s, err)
+			default:
+				// The failed step may have done part of its work, so it is
+				// undone too.
+				svc.log(s).Warn(ctx, "saga step failed, compensating", logger.ErrorF(err))
+				cause = err
+				s.Status, s.Attempts, s.LastError = model.SagaCompensating, 0, err.Error()
+			}
+		case model.SagaCompensating:
+			if st.undo != nil {
+				if err := svc.runStep(ctx, st.undo, s); err != nil {
+					svc.log(s).Warn(ctx, "saga compensation failed, will retry", logger.ErrorF(err))
+					if saveErr := svc.retryLater(ctx, s, err); saveErr != nil {
+						return saveErr
+					}
+					return cause
+				}
+			}
+			s.Attempts = 0
+			if i > 0 {
+				s.Step = steps[i-1].name
+			} else {
+				s.Status, s.Step = model.SagaCompensated, ""
+			}
+		}
+
+		s.NextRunAt = svc.now().Add(svc.policy.Lease)
+		if err := svc.save(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	svc.metrics.finished.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("saga.type", string(s.Type)),
+		attribute.String("saga.status", string(s.Status)),
+	))
+	if s.Status == model.SagaCompensated {
+		svc.log(s).Info(ctx, "saga compensated", logger.String("cause", s.LastError))
+	}
+
+	return cause
+}
+
+func (svc *service) runStep(ctx context.Context, fn func(context.Context, *model.Saga) error, s *model.Saga) error {
+	ctx, cancel := context.WithTimeout(ctx, svc.policy.StepTimeout)
+	defer cancel()
+
+	return fn(ctx, s)
+}
+
+// retryLater records the failed try and puts the saga off by the backoff.
+func (svc *service) retryLater(ctx context.Context, s *model.Saga, err error) error {
+	s.Attempts++
+	s.LastError = err.Error()
+	s.NextRunAt = svc.now().Add(svc.backoff(s.Attempts))
+
+	return svc.save(ctx, s)
+}
+
+func (svc *service) backoff(attempts int) time.Duration {
+	d := svc.policy.RetryBackoff
+	for i := 1; i < attempts && d < svc.policy.MaxRetryBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, svc.policy.MaxRetryBackoff)
+}
+
+func (svc *service) save(ctx context.Context, s *model.Saga) error {
+	ctx, cancel := context.WithTimeout(ctx, svc.policy.DBTimeout)
+	defer cancel()
+
+	if err := svc.sagas.Save(ctx, s); err != nil {
+		if errors.Is(err, model.ErrSagaLost) {
+			svc.log(s).Warn(ctx, "saga taken over")
+		} else {
+			svc.log(s).Error(ctx, "repository save saga", logger.ErrorF(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (svc *service) claimDue(ctx context.Context) ([]model.Saga, error) {
+	ctx, cancel := context.WithTimeout(ctx, svc.policy.DBTimeout)
+	defer cancel()
+
+	sagas, err := svc.sagas.ClaimDue(ctx, svc.policy.BatchSize, svc.policy.Lease)
+	if err != nil {
+		logger.Error(ctx, "repository claim due sagas", logger.ErrorF(err))
+		return nil, err
+	}
+
+	return sagas, nil
+}
+
+// reportStuck records how many sagas are stuck and warns when there are any.
+func (svc *service) reportStuck(ctx context.Context) error {
+	dbCtx, cancel := context.WithTimeout(ctx, svc.policy.DBTimeout)
+	defer cancel()
+
+	n, err := svc.sagas.CountStartedBefore(dbCtx, svc.now().Add(-svc.policy.StuckAfter))
+	if err != nil {
+		return err
+	}
+
+	svc.metrics.stuck.Record(ctx, n)
+	if n > 0 {
+		logger.Warn(ctx, "sagas stuck",
+			logger.Int64("count", n),
+			logger.Duration("stuck_after", svc.policy.StuckAfter),
+		)
+	}
+
+	return nil
+}
+
+// retried reports whether the current step of the saga is retried on failure.
+func (svc *service) retried(s *model.Saga) bool {
+	steps := svc.flows[s.Type]
+	if i := stepIndex(steps, s.Step); i >= 0 {
+		return steps[i].retry
+	}
+	return false
+}
+
+// sagaLogger is the part of the logger the sagas log with.
+type sagaLogger interface {
+	Info(ctx context.Context, msg string, fields ...logger.Field)
+	Warn(ctx context.Context, msg string, fields ...logger.Field)
+	Error(ctx context.Context, msg string, fields ...logger.Field)
+}
+
+func (svc *service) log(s *model.Saga) sagaLogger {
+	return logger.With(
+		logger.String("saga_id", s.ID.String()),
+		logger.String("saga_type", string(s.Type)),
+		logger.String("saga_step", string(s.Step)),
+		logger.String("order_id", s.OrderID.String()),
+	)
+}
+
+func stepIndex(steps []step, name model.SagaStep) int {
+	for i := range steps {
+		if steps[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
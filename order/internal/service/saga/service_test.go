@@ -0,0 +1,608 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/order/internal/service/mocks"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+var policy = Policy{
+	Lease:           time.Minute,
+	RetryBackoff:    time.Second,
+	MaxRetryBackoff: 10 * time.Second,
+	StuckAfter:      time.Hour,
+	BatchSize:       2,
+	StepTimeout:     5 * time.Second,
+	DBTimeout:       5 * time.Second,
+}
+
+type deps struct {
+	sagas     *mocks.MockSagaRepository
+	orders    *mocks.MockSagaOrderRepository
+	inventory *mocks.MockStockClient
+	payment   *mocks.MockBillingClient
+	producer  *mocks.MockSagaEventSender
+	// saves are the sagas as they were saved, in order.
+	saves *[]model.Saga
+}
+
+func newDeps(t *testing.T) deps {
+	return deps{
+		sagas:     mocks.NewMockSagaRepository(t),
+		orders:    mocks.NewMockSagaOrderRepository(t),
+		inventory: mocks.NewMockStockClient(t),
+		payment:   mocks.NewMockBillingClient(t),
+		producer:  mocks.NewMockSagaEventSender(t),
+		saves:     new([]model.Saga),
+	}
+}
+
+func newSvc(d deps) *service {
+	return NewSagaService(d.sagas, d.orders, d.inventory, d.payment, d.producer, policy)
+}
+
+// recordSaves lets every create and save of a saga through and keeps the
+// saves.
+func recordSaves(d deps) {
+	d.sagas.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Maybe()
+	d.sagas.EXPECT().Save(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, s *model.Saga) {
+			*d.saves = append(*d.saves, *s)
+		}).
+		Return(nil).
+		Maybe()
+}
+
+type progress struct {
+	status model.SagaStatus
+	step   model.SagaStep
+}
+
+func progressOf(saves []model.Saga) []progress {
+	res := make([]progress, len(saves))
+	for i, s := range saves {
+		res[i] = progress{status: s.Status, step: s.Step}
+	}
+	return res
+}
+
+func TestServiceCreateOrder(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	userID := uuid.New()
+	partA, partB := uuid.New(), uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	newOrder := func() *model.Order {
+		return &model.Order{
+			UserID:     userID,
+			PartIDs:    []uuid.UUID{partA, partB, partA},
+			TotalPrice: 300,
+			UnitPrices: []int64{100, 100, 100},
+			Status:     model.StatusPendingPayment,
+			ExpiresAt:  &expiresAt,
+		}
+	}
+	versions := map[uuid.UUID]int64{partA: 3, partB: 7}
+	items := []model.StockItem{
+		{PartID: partA.String(), Quantity: 2, ExpectedVersion: 3},
+		{PartID: partB.String(), Quantity: 1, ExpectedVersion: 7},
+	}
+
+	tests := []struct {
+		name         string
+		setup        func(d deps)
+		wantErr      error
+		wantProgress []progress
+	}{
+		{
+			name: "success: the stock is reserved under the order id, then the order is created",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.inventory.EXPECT().ReserveStock(mock.Anything, mock.Anything, items).Return(nil).Once()
+				d.orders.EXPECT().
+					Create(mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						return o.ID != uuid.Nil &&
+							o.UserID == userID &&
+							o.TotalPrice == 300 &&
+							o.Status == model.StatusPendingPayment &&
+							o.ExpiresAt.Equal(expiresAt)
+					})).
+					Return(uuid.Nil, nil).
+					Once()
+			},
+			wantProgress: []progress{
+				{model.SagaRunning, model.SagaStepCreateOrder},
+				{model.SagaCompleted, ""},
+			},
+		},
+		{
+			name: "out of stock: the reservation is released",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.inventory.EXPECT().ReserveStock(mock.Anything, mock.Anything, items).
+					Return(model.ErrPartsOutOfStock).Once()
+				d.inventory.EXPECT().ReleaseStock(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			wantErr: model.ErrPartsOutOfStock,
+			wantProgress: []progress{
+				{model.SagaCompensating, model.SagaStepReserveStock},
+				{model.SagaCompensated, ""},
+			},
+		},
+		{
+			name: "part changed: nothing is reserved and the order is not created",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.inventory.EXPECT().ReserveStock(mock.Anything, mock.Anything, items).
+					Return(model.ErrPartChanged).Once()
+				d.inventory.EXPECT().ReleaseStock(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			wantErr: model.ErrPartChanged,
+			wantProgress: []progress{
+				{model.SagaCompensating, model.SagaStepReserveStock},
+				{model.SagaCompensated, ""},
+			},
+		},
+		{
+			name: "create fails: the order is cancelled if it exists and the stock is released",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.inventory.EXPECT().ReserveStock(mock.Anything, mock.Anything, items).Return(nil).Once()
+				d.orders.EXPECT().Create(mock.Anything, mock.Anything).
+					Return(uuid.Nil, errors.New("db is down")).Once()
+				d.orders.EXPECT().
+					UpdateFrom(mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						return o.Status == model.StatusCancelled
+					}), model.StatusPendingPayment).
+					Return(model.ErrOrderNotFound).
+					Once()
+				d.inventory.EXPECT().ReleaseStock(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			wantErr: errors.New("db is down"),
+			wantProgress: []progress{
+				{model.SagaRunning, model.SagaStepCreateOrder},
+				{model.SagaCompensating, model.SagaStepCreateOrder},
+				{model.SagaCompensating, model.SagaStepReserveStock},
+				{model.SagaCompensated, ""},
+			},
+		},
+		{
+			name: "release fails: the saga waits to compensate again",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.inventory.EXPECT().ReserveStock(mock.Anything, mock.Anything, items).
+					Return(errors.New("inventory unavailable")).Once()
+				d.inventory.EXPECT().ReleaseStock(mock.Anything, mock.Anything).
+					Return(errors.New("inventory unavailable")).Once()
+			},
+			wantErr: model.ErrBadGateway,
+			wantProgress: []progress{
+				{model.SagaCompensating, model.SagaStepReserveStock},
+				{model.SagaCompensating, model.SagaStepReserveStock},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			tt.setup(d)
+
+			ord := newOrder()
+			err := newSvc(d).CreateOrder(context.Background(), ord, versions)
+			switch {
+			case tt.wantErr == nil:
+				require.NoError(t, err)
+			case errors.Is(tt.wantErr, model.ErrPartsOutOfStock),
+				errors.Is(tt.wantErr, model.ErrPartChanged),
+				errors.Is(tt.wantErr, model.ErrBadGateway):
+				require.ErrorIs(t, err, tt.wantErr)
+			default:
+				require.ErrorContains(t, err, tt.wantErr.Error())
+			}
+
+			assert.NotEqual(t, uuid.Nil, ord.ID)
+			assert.Equal(t, tt.wantProgress, progressOf(*d.saves))
+			for _, s := range *d.saves {
+				assert.Equal(t, ord.ID, s.OrderID)
+			}
+		})
+	}
+
+	t.Run("the saga cannot be recorded: nothing is done", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sagas.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db is down")).Once()
+
+		err := newSvc(d).CreateOrder(context.Background(), newOrder(), versions)
+		require.Error(t, err)
+	})
+}
+
+func TestServicePayOrder(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	ord := &model.Order{ID: uuid.New(), UserID: uuid.New(), Status: model.StatusPendingPayment}
+	txID := uuid.New()
+
+	payOK := func(d deps) {
+		d.payment.EXPECT().
+			PayOrder(mock.Anything, model.PayOrderParams{
+				ID:            ord.ID,
+				UserID:        ord.UserID,
+				PaymentMethod: model.PaymentMethodCard,
+			}).
+			Return(txID.String(), nil).
+			Once()
+	}
+	refund := func(d deps, tx *uuid.UUID) {
+		d.payment.EXPECT().
+			RefundPayment(mock.Anything, model.RefundPaymentParams{
+				OrderID:       ord.ID,
+				UserID:        ord.UserID,
+				TransactionID: tx,
+			}).
+			Return(nil).
+			Once()
+	}
+
+	tests := []struct {
+		name         string
+		setup        func(d deps)
+		wantErr      error
+		wantProgress []progress
+	}{
+		{
+			name: "success: paid, marked paid and announced",
+			setup: func(d deps) {
+				recordSaves(d)
+				payOK(d)
+				d.orders.EXPECT().
+					UpdateFrom(mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+						return o.ID == ord.ID &&
+							o.Status == model.StatusPaid &&
+							*o.TransactionID == txID &&
+							*o.PaymentMethod == model.PaymentMethodCard
+					}), model.StatusPendingPayment).
+					Return(nil).
+					Once()
+				d.producer.EXPECT().
+					SendOrderPaid(mock.Anything, mock.MatchedBy(func(e model.PaidOrder) bool {
+						return e.EventID != uuid.Nil && e.OrderID == ord.ID && e.TransactionID == txID
+					})).
+					Return(nil).
+					Once()
+			},
+			wantProgress: []progress{
+				{model.SagaRunning, model.SagaStepMarkPaid},
+				{model.SagaRunning, model.SagaStepPublishPaid},
+				{model.SagaCompleted, ""},
+			},
+		},
+		{
+			name: "conflict: an order cancelled during the payment is refunded",
+			setup: func(d deps) {
+				recordSaves(d)
+				payOK(d)
+				d.orders.EXPECT().UpdateFrom(mock.Anything, mock.Anything, model.StatusPendingPayment).
+					Return(model.ErrOrderConflict).Once()
+				d.orders.EXPECT().RevertPayment(mock.Anything, ord.ID, txID).Return(nil).Once()
+				refund(d, &txID)
+			},
+			wantErr: model.ErrOrderConflict,
+			wantProgress: []progress{
+				{model.SagaRunning, model.SagaStepMarkPaid},
+				{model.SagaCompensating, model.SagaStepMarkPaid},
+				{model.SagaCompensating, model.SagaStepPay},
+				{model.SagaCompensated, ""},
+			},
+		},
+		{
+			name: "payment fails: it is refunded in case it went through",
+			setup: func(d deps) {
+				recordSaves(d)
+				d.payment.EXPECT().PayOrder(mock.Anything, mock.Anything).
+					Return("", errors.New("payment provider timeout")).Once()
+				refund(d, nil)
+			},
+			wantErr: model.ErrBadGateway,
+			wantProgress: []progress{
+				{model.SagaCompensating, model.SagaStepPay},
+				{model.SagaCompensated, ""},
+			},
+		},
+		{
+			name: "announcement fails: the payment stands and the event is retried later",
+			setup: func(d deps) {
+				recordSaves(d)
+				payOK(d)
+				d.orders.EXPECT().UpdateFrom(mock.Anything, mock.Anything, model.StatusPendingPayment).
+					Return(nil).Once()
+				d.producer.EXPECT().SendOrderPaid(mock.Anything, mock.Anything).
+					Return(errors.New("kafka unavailable")).Once()
+			},
+			wantProgress: []progress{
+				{model.SagaRunning, model.SagaStepMarkPaid},
+				{model.SagaRunning, model.SagaStepPublishPaid},
+				{model.SagaRunning, model.SagaStepPublishPaid},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := newDeps(t)
+			tt.setup(d)
+
+			got, err := newSvc(d).PayOrder(context.Background(), ord, model.PaymentMethodCard)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, uuid.Nil, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, txID, got)
+			}
+
+			saves := *d.saves
+			assert.Equal(t, tt.wantProgress, progressOf(saves))
+			if last := saves[len(saves)-1]; last.Status == model.SagaRunning {
+				assert.Equal(t, 1, last.Attempts)
+				assert.Equal(t, "kafka unavailable", last.LastError)
+				assert.WithinDuration(t, time.Now().Add(policy.RetryBackoff), last.NextRunAt, time.Second)
+			}
+		})
+	}
+}
+
+func TestServiceReleaseOrder(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	userID, txID := uuid.New(), uuid.New()
+	release := func() model.Saga {
+		return model.Saga{
+			ID:      uuid.New(),
+			Type:    model.SagaReleaseOrder,
+			OrderID: uuid.New(),
+			Status:  model.SagaRunning,
+			Step:    model.SagaStepReleaseStock,
+			Data:    model.SagaData{UserID: userID},
+			Version: 2,
+		}
+	}
+
+	t.Run("success: the stock is released, a paid order refunded and the cancellation announced", func(t *testing.T) {
+		t.Parallel()
+
+		s := release()
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.inventory.EXPECT().ReleaseStock(mock.Anything, s.OrderID.String()).Return(nil).Once()
+		d.orders.EXPECT().OrderByID(mock.Anything, s.OrderID).
+			Return(&model.Order{ID: s.OrderID, UserID: userID, TransactionID: &txID}, nil).Once()
+		d.payment.EXPECT().
+			RefundPayment(mock.Anything, model.RefundPaymentParams{OrderID: s.OrderID, UserID: userID, TransactionID: &txID}).
+			Return(nil).
+			Once()
+		d.producer.EXPECT().
+			SendOrderCancelled(mock.Anything, model.CancelledOrder{EventID: s.ID, OrderID: s.OrderID, UserID: userID}).
+			Return(nil).
+			Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []progress{
+			{model.SagaRunning, model.SagaStepRefundPayment},
+			{model.SagaRunning, model.SagaStepPublishCancelled},
+			{model.SagaCompleted, ""},
+		}, progressOf(*d.saves))
+	})
+
+	t.Run("a failed announcement is left to retry", func(t *testing.T) {
+		t.Parallel()
+
+		s := release()
+		s.Step = model.SagaStepPublishCancelled
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.producer.EXPECT().SendOrderCancelled(mock.Anything, mock.Anything).
+			Return(errors.New("kafka is down")).Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+
+		saves := *d.saves
+		assert.Equal(t, []progress{{model.SagaRunning, model.SagaStepPublishCancelled}}, progressOf(saves))
+		assert.Equal(t, 1, saves[0].Attempts)
+	})
+
+	t.Run("a failed release is left to retry", func(t *testing.T) {
+		t.Parallel()
+
+		s := release()
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.inventory.EXPECT().ReleaseStock(mock.Anything, s.OrderID.String()).
+			Return(errors.New("inventory unavailable")).Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []progress{{model.SagaRunning, model.SagaStepReleaseStock}}, progressOf(*d.saves))
+	})
+}
+
+func TestServiceResume(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	txID := uuid.New()
+	pay := func(step model.SagaStep) model.Saga {
+		return model.Saga{
+			ID:      uuid.New(),
+			Type:    model.SagaPayOrder,
+			OrderID: uuid.New(),
+			Status:  model.SagaRunning,
+			Step:    step,
+			Data: model.SagaData{
+				UserID:        uuid.New(),
+				PaymentMethod: model.PaymentMethodSBP,
+				TransactionID: &txID,
+			},
+			Version: 2,
+		}
+	}
+
+	t.Run("a saga cut short in a step that is undone is compensated", func(t *testing.T) {
+		t.Parallel()
+
+		s := pay(model.SagaStepMarkPaid)
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.orders.EXPECT().RevertPayment(mock.Anything, s.OrderID, txID).Return(nil).Once()
+		d.payment.EXPECT().RefundPayment(mock.Anything, mock.Anything).Return(nil).Once()
+
+		n, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []progress{
+			{model.SagaCompensating, model.SagaStepPay},
+			{model.SagaCompensated, ""},
+		}, progressOf(*d.saves))
+	})
+
+	t.Run("a saga past the point of no return is run forward", func(t *testing.T) {
+		t.Parallel()
+
+		s := pay(model.SagaStepPublishPaid)
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.producer.EXPECT().
+			SendOrderPaid(mock.Anything, mock.MatchedBy(func(e model.PaidOrder) bool {
+				return e.EventID == s.ID && e.OrderID == s.OrderID && e.TransactionID == txID
+			})).
+			Return(nil).
+			Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []progress{{model.SagaCompleted, ""}}, progressOf(*d.saves))
+	})
+
+	t.Run("an expire saga releases the order and announces it", func(t *testing.T) {
+		t.Parallel()
+
+		expiresAt := time.Now().Add(-time.Minute)
+		s := model.Saga{
+			ID:      uuid.New(),
+			Type:    model.SagaExpireOrder,
+			OrderID: uuid.New(),
+			Status:  model.SagaRunning,
+			Step:    model.SagaStepReleaseStock,
+			Data:    model.SagaData{UserID: uuid.New(), ExpiresAt: &expiresAt},
+			Version: 2,
+		}
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return([]model.Saga{s}, nil).Once()
+		d.inventory.EXPECT().ReleaseStock(mock.Anything, s.OrderID.String()).Return(nil).Once()
+		d.orders.EXPECT().OrderByID(mock.Anything, s.OrderID).
+			Return(&model.Order{ID: s.OrderID, UserID: s.Data.UserID, Status: model.StatusCancelled}, nil).Once()
+		d.producer.EXPECT().
+			SendOrderExpired(mock.Anything, model.ExpiredOrder{
+				EventID:   s.ID,
+				OrderID:   s.OrderID,
+				UserID:    s.Data.UserID,
+				ExpiresAt: expiresAt,
+			}).
+			Return(errors.New("kafka is down")).
+			Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+
+		saves := *d.saves
+		assert.Equal(t, []progress{
+			{model.SagaRunning, model.SagaStepRefundPayment},
+			{model.SagaRunning, model.SagaStepPublishExpired},
+			{model.SagaRunning, model.SagaStepPublishExpired},
+		}, progressOf(saves))
+		assert.Equal(t, 1, saves[len(saves)-1].Attempts)
+	})
+
+	t.Run("batches are claimed until one is not full", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		recordSaves(d)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).
+			Return([]model.Saga{pay(model.SagaStepPublishPaid), pay(model.SagaStepPublishPaid)}, nil).Once()
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).Return(nil, nil).Once()
+		d.producer.EXPECT().SendOrderPaid(mock.Anything, mock.Anything).Return(nil).Twice()
+
+		n, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("a saga taken over by another replica is left to it", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).
+			Return([]model.Saga{pay(model.SagaStepPublishPaid)}, nil).Once()
+		d.producer.EXPECT().SendOrderPaid(mock.Anything, mock.Anything).Return(nil).Once()
+		d.sagas.EXPECT().Save(mock.Anything, mock.Anything).Return(model.ErrSagaLost).Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("error: the claim fails", func(t *testing.T) {
+		t.Parallel()
+
+		d := newDeps(t)
+		d.sagas.EXPECT().ClaimDue(mock.Anything, policy.BatchSize, policy.Lease).
+			Return(nil, errors.New("db is down")).Once()
+
+		_, err := newSvc(d).Resume(context.Background())
+		require.Error(t, err)
+	})
+}
+
+func TestServiceBackoff(t *testing.T) {
+	t.Parallel()
+
+	svc := newSvc(newDeps(t))
+	for attempts, want := range map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		4:  8 * time.Second,
+		5:  10 * time.Second,
+		50: 10 * time.Second,
+	} {
+		assert.Equal(t, want, svc.backoff(attempts), "attempts %d", attempts)
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+)
+
+// The reservation of the stock of an order is named by the id of the order,
+// so every saga of the order reserves and releases the same stock.
+
+func (svc *service) reserveStock(ctx context.Context, s *model.Saga) error {
+	// An order lists a part once per unit.
+	quantities := make(map[uuid.UUID]int64, len(s.Data.PartIDs))
+	parts := make([]uuid.UUID, 0, len(s.Data.PartIDs))
+	for _, id := range s.Data.PartIDs {
+		if quantities[id] == 0 {
+			parts = append(parts, id)
+		}
+		quantities[id]++
+	}
+
+	items := make([]model.StockItem, len(parts))
+	for i, id := range parts {
+		items[i] = model.StockItem{
+			PartID:          id.String(),
+			Quantity:        quantities[id],
+			ExpectedVersion: s.Data.PartVersions[id],
+		}
+	}
+
+	err := svc.inventory.ReserveStock(ctx, s.OrderID.String(), items)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, model.ErrPartsOutOfStock),
+		errors.Is(err, model.ErrPartNotFound),
+		errors.Is(err, model.ErrPartChanged):
+		return err
+	default:
+		return fmt.Errorf("%w: reserve stock: %v", model.ErrBadGateway, err)
+	}
+}
+
+func (svc *service) releaseStock(ctx context.Context, s *model.Saga) error {
+	if err := svc.inventory.ReleaseStock(ctx, s.OrderID.String()); err != nil {
+		return fmt.Errorf("%w: release stock: %v", model.ErrBadGateway, err)
+	}
+	return nil
+}
+
+func (svc *service) createOrder(ctx context.Context, s *model.Saga) error {
+	_, err := svc.orders.Create(ctx, &model.Order{
+		ID:         s.OrderID,
+		UserID:     s.Data.UserID,
+		PartIDs:    s.Data.PartIDs,
+		TotalPrice: s.Data.TotalPrice,
+		UnitPrices: s.Data.UnitPrices,
+		Status:     model.StatusPendingPayment,
+		ExpiresAt:  s.Data.ExpiresAt,
+	})
+	return err
+}
+
+// cancelOrder cancels the order if the failed create went through after all.
+func (svc *service) cancelOrder(ctx context.Context, s *model.Saga) error {
+	err := svc.orders.UpdateFrom(ctx, &model.Order{
+		ID:     s.OrderID,
+		Status: model.StatusCancelled,
+	}, model.StatusPendingPayment)
+	if errors.Is(err, model.ErrOrderNotFound) || errors.Is(err, model.ErrOrderConflict) {
+		return nil
+	}
+	return err
+}
+
+func (svc *service) pay(ctx context.Context, s *model.Saga) error {
+	transactionIDStr, err := svc.payment.PayOrder(ctx, model.PayOrderParams{
+		ID:            s.OrderID,
+		UserID:        s.Data.UserID,
+		PaymentMethod: s.Data.PaymentMethod,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: pay order: %v", model.ErrBadGateway, err)
+	}
+
+	transactionID, err := uuid.Parse(transactionIDStr)
+	if err != nil {
+		return fmt.Errorf("%w: parse transaction id %q: %v", model.ErrBadGateway, transactionIDStr, err)
+	}
+	s.Data.TransactionID = &transactionID
+
+	return nil
+}
+
+// refund gives the payment back. The transaction is unknown when the payment
+// went through but its answer was lost; the order still names the payment.
+func (svc *service) refund(ctx context.Context, s *model.Saga) error {
+	if err := svc.payment.RefundPayment(ctx, model.RefundPaymentParams{
+		OrderID:       s.OrderID,
+		UserID:        s.Data.UserID,
+		TransactionID: s.Data.TransactionID,
+	}); err != nil {
+		return fmt.Errorf("%w: refund payment: %v", model.ErrBadGateway, err)
+	}
+	return nil
+}
+
+// markPaid marks the order paid only while it is pending, so an order that
+// expired or was cancelled during the payment is refunded instead.
+func (svc *service) markPaid(ctx context.Context, s *model.Saga) error {
+	return svc.orders.UpdateFrom(ctx, &model.Order{
+		ID:            s.OrderID,
+		TransactionID: s.Data.TransactionID,
+		PaymentMethod: &s.Data.PaymentMethod,
+		Status:        model.StatusPaid,
+	}, model.StatusPendingPayment)
+}
+
+// unmarkPaid undoes markPaid if the failed update went through after all.
+func (svc *service) unmarkPaid(ctx context.Context, s *model.Saga) error {
+	if s.Data.TransactionID == nil {
+		return nil
+	}
+	return svc.orders.RevertPayment(ctx, s.OrderID, *s.Data.TransactionID)
+}
+
+// publishPaid announces the payment. The event is named by the saga, so its
+// retries are the same event to the consumers.
+func (svc *service) publishPaid(ctx context.Context, s *model.Saga) error {
+	return svc.producer.SendOrderPaid(ctx, model.PaidOrder{
+		EventID:       s.ID,
+		OrderID:       s.OrderID,
+		UserID:        s.Data.UserID,
+		PaymentMethod: s.Data.PaymentMethod,
+		TransactionID: *s.Data.TransactionID,
+	})
+}
+
+// publishCancelled announces that the order was cancelled. The event is named
+// by the saga, like the one of publishPaid.
+func (svc *service) publishCancelled(ctx context.Context, s *model.Saga) error {
+	if s.Data.UserID == uuid.Nil {
+		return errors.New("saga has no user")
+	}
+
+	return svc.producer.SendOrderCancelled(ctx, model.CancelledOrder{
+		EventID: s.ID,
+		OrderID: s.OrderID,
+		UserID:  s.Data.UserID,
+	})
+}
+
+// publishExpired announces that the order was not paid in time. The event is
+// named by the saga, like the one of publishPaid.
+func (svc *service) publishExpired(ctx context.Context, s *model.Saga) error {
+	if s.Data.ExpiresAt == nil {
+		return errors.New("saga has no payment deadline")
+	}
+
+	return svc.producer.SendOrderExpired(ctx, model.ExpiredOrder{
+		EventID:   s.ID,
+		OrderID:   s.OrderID,
+		UserID:    s.Data.UserID,
+		ExpiresAt: *s.Data.ExpiresAt,
+	})
+}
+
+func (svc *service) refundIfPaid(ctx context.Context, s *model.Saga) error {
+	ord, err := svc.orders.OrderByID(ctx, s.OrderID)
+	if errors.Is(err, model.ErrOrderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ord.TransactionID == nil {
+		return nil
+	}
+
+	s.Data.UserID, s.Data.TransactionID = ord.UserID, ord.TransactionID
+	return svc.refund(ctx, s)
+}
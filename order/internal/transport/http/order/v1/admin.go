@@ -0,0 +1,226 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/converter"
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+)
+
+// AdminService acts on the orders of any user; the role of the actor
+// decides what it may do.
+type AdminService interface {
+	Orders(ctx context.Context, actor model.Identity, filter model.OrdersFilter) ([]model.Order, error)
+	Order(ctx context.Context, actor model.Identity, ordID uuid.UUID) (*model.Order, error)
+	ForceCancel(ctx context.Context, actor model.Identity, params model.ForceTransitionParams) error
+	ForceComplete(ctx context.Context, actor model.Identity, params model.ForceTransitionParams) error
+	StuckSagas(ctx context.Context, actor model.Identity, limit uint64) ([]model.Saga, error)
+}
+
+func (h *handler) AdminListOrders(ctx context.Context, params orderv1.AdminListOrdersParams) (orderv1.AdminListOrdersRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	orders, err := h.admin.Orders(ctx, *identity, converter.AdminListOrdersParamsToFilter(params, defaultListLimit))
+	if err != nil {
+		return mapErrorToAdminListOrdersRes(err), nil
+	}
+
+	return converter.OrdersToOAPI(orders), nil
+}
+
+func (h *handler) AdminGetOrder(ctx context.Context, params orderv1.AdminGetOrderParams) (orderv1.AdminGetOrderRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	ord, err := h.admin.Order(ctx, *identity, params.OrderUUID)
+	if err != nil {
+		return mapErrorToAdminGetOrderRes(err), nil
+	}
+
+	return converter.OrderToOAPI(ord), nil
+}
+
+func (h *handler) AdminCancelOrder(
+	ctx context.Context,
+	req *orderv1.AdminOrderActionRequest,
+	params orderv1.AdminCancelOrderParams,
+) (orderv1.AdminCancelOrderRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	err := h.admin.ForceCancel(ctx, *identity, converter.AdminOrderActionRequestToParams(params.OrderUUID, req))
+	if err != nil {
+		return mapErrorToAdminCancelOrderRes(err), nil
+	}
+
+	return &orderv1.AdminCancelOrderNoContent{}, nil
+}
+
+func (h *handler) AdminCompleteOrder(
+	ctx context.Context,
+	req *orderv1.AdminOrderActionRequest,
+	params orderv1.AdminCompleteOrderParams,
+) (orderv1.AdminCompleteOrderRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	err := h.admin.ForceComplete(ctx, *identity, converter.AdminOrderActionRequestToParams(params.OrderUUID, req))
+	if err != nil {
+		return mapErrorToAdminCompleteOrderRes(err), nil
+	}
+
+	return &orderv1.AdminCompleteOrderNoContent{}, nil
+}
+
+func (h *handler) AdminListStuckSagas(
+	ctx context.Context,
+	params orderv1.AdminListStuckSagasParams,
+) (orderv1.AdminListStuckSagasRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	sagas, err := h.admin.StuckSagas(ctx, *identity, uint64(params.Limit.Or(defaultListLimit)))
+	if err != nil {
+		return mapErrorToAdminListStuckSagasRes(err), nil
+	}
+
+	return converter.SagasToOAPI(sagas), nil
+}
+
+func mapErrorToAdminListOrdersRes(err error) orderv1.AdminListOrdersRes {
+	switch {
+	case errors.Is(err, model.ErrValidation):
+		return &orderv1.BadRequestError{ // 400
+			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
+
+func mapErrorToAdminGetOrderRes(err error) orderv1.AdminGetOrderRes {
+	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}
+	case errors.Is(err, model.ErrOrderNotFound):
+		return &orderv1.NotFoundError{ // 404
+			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
+
+//nolint:dupl
+func mapErrorToAdminCancelOrderRes(err error) orderv1.AdminCancelOrderRes {
+	switch {
+	case errors.Is(err, model.ErrValidation):
+		return &orderv1.BadRequestError{ // 400
+			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}
+	case errors.Is(err, model.ErrOrderNotFound):
+		return &orderv1.NotFoundError{ // 404
+			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrOrderConflict):
+		return &orderv1.ConflictError{ // 409
+			Code:    orderv1.NewOptInt32(int32(http.StatusConflict)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
+
+//nolint:dupl
+func mapErrorToAdminCompleteOrderRes(err error) orderv1.AdminCompleteOrderRes {
+	switch {
+	case errors.Is(err, model.ErrValidation):
+		return &orderv1.BadRequestError{ // 400
+			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}
+	case errors.Is(err, model.ErrOrderNotFound):
+		return &orderv1.NotFoundError{ // 404
+			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrOrderConflict):
+		return &orderv1.ConflictError{ // 409
+			Code:    orderv1.NewOptInt32(int32(http.StatusConflict)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
+
+func mapErrorToAdminListStuckSagasRes(err error) orderv1.AdminListStuckSagasRes {
+	switch {
+	case errors.Is(err, model.ErrValidation):
+		return &orderv1.BadRequestError{ // 400
+			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// ErrorHandler writes the errors raised before an operation handler runs,
+// such as a failed authentication or an undecodable request, with the code
+// and message fields of the API error responses.
+func ErrorHandler(ctx context.Context, w http.ResponseWriter, _ *http.Request, err error) {
+	code := ogenerrors.ErrorCode(err)
+	msg := err.Error()
+
+	var secErr *ogenerrors.SecurityError
+	switch {
+	case errors.Is(err, model.ErrBadGateway):
+		logger.Error(ctx, "authenticate request", logger.ErrorF(err))
+		code, msg = http.StatusBadGateway, model.ErrBadGateway.Error()
+	case errors.As(err, &secErr):
+		msg = model.ErrUnauthorized.Error()
+	}
+
+	writeError(w, code, msg)
+}
+
+// writeError writes an API error response.
+func writeError(w http.ResponseWriter, code int, msg string) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	e.ObjStart()
+	e.FieldStart("code")
+	e.Int(code)
+	e.FieldStart("message")
+	e.StrEscape(msg)
+	e.ObjEnd()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(e.Bytes())
+}
@@ -21,20 +21,29 @@ type OrderService interface {
 		ctx context.Context,
 		params model.PayOrderParams,
 	) (*model.PayOrderResult, error)
-	OrderByID(ctx context.Context, ordID uuid.UUID) (*model.Order, error)
-	Cancel(ctx context.Context, ordID uuid.UUID) error
+	OrderByID(ctx context.Context, userID, ordID uuid.UUID) (*model.Order, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]model.Order, error)
+	Cancel(ctx context.Context, userID, ordID uuid.UUID) error
 }
 
+const defaultListLimit = 10
+
 type handler struct {
-	svc OrderService
+	svc   OrderService
+	admin AdminService
 }
 
-func NewOrderHandler(service OrderService) *handler {
-	return &handler{svc: service}
+func NewOrderHandler(service OrderService, admin AdminService) *handler {
+	return &handler{svc: service, admin: admin}
 }
 
 func (h *handler) CreateOrder(ctx context.Context, req *orderv1.CreateOrderRequest) (orderv1.CreateOrderRes, error) {
-	res, err := h.svc.Create(ctx, converter.CreateOrderRequestToParams(req))
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	res, err := h.svc.Create(ctx, converter.CreateOrderRequestToParams(identity.UserID, req))
 	if err != nil {
 		return mapErrorToCreateOrderRes(err), nil
 	}
@@ -43,6 +52,11 @@ func (h *handler) CreateOrder(ctx context.Context, req *orderv1.CreateOrderReque
 }
 
 func (h *handler) PayOrder(ctx context.Context, req *orderv1.PayOrderRequest, params orderv1.PayOrderParams) (orderv1.PayOrderRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
 	ordID, err := uuid.Parse(params.OrderUUID.String())
 	if err != nil {
 		return &orderv1.BadRequestError{ // 400
@@ -51,7 +65,7 @@ func (h *handler) PayOrder(ctx context.Context, req *orderv1.PayOrderRequest, pa
 		}, nil
 	}
 
-	res, err := h.svc.Pay(ctx, converter.PayOrderRequestToParams(ordID, req))
+	res, err := h.svc.Pay(ctx, converter.PayOrderRequestToParams(ordID, identity.UserID, req))
 	if err != nil {
 		return mapErrorToPayOrderRes(err), nil
 	}
@@ -60,6 +74,11 @@ func (h *handler) PayOrder(ctx context.Context, req *orderv1.PayOrderRequest, pa
 }
 
 func (h *handler) GetOrderByUUID(ctx context.Context, params orderv1.GetOrderByUUIDParams) (orderv1.GetOrderByUUIDRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
 	ordID, err := uuid.Parse(params.OrderUUID.String())
 	if err != nil {
 		return &orderv1.BadRequestError{ // 400
@@ -68,7 +87,7 @@ func (h *handler) GetOrderByUUID(ctx context.Context, params orderv1.GetOrderByU
 		}, nil
 	}
 
-	ord, err := h.svc.OrderByID(ctx, ordID)
+	ord, err := h.svc.OrderByID(ctx, identity.UserID, ordID)
 	if err != nil {
 		return mapErrorToGetOrderRes(err), nil
 	}
@@ -76,7 +95,33 @@ func (h *handler) GetOrderByUUID(ctx context.Context, params orderv1.GetOrderByU
 	return converter.OrderToOAPI(ord), nil
 }
 
+func (h *handler) ListOrders(ctx context.Context, params orderv1.ListOrdersParams) (orderv1.ListOrdersRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
+	if userID, ok := params.UserUUID.Get(); ok && userID != identity.UserID {
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(model.ErrForbidden.Error()),
+		}, nil
+	}
+
+	orders, err := h.svc.ListByUser(ctx, identity.UserID, int(params.Limit.Or(defaultListLimit)))
+	if err != nil {
+		return mapErrorToListOrdersRes(err), nil
+	}
+
+	return converter.OrdersToOAPI(orders), nil
+}
+
 func (h *handler) CancelOrder(ctx context.Context, params orderv1.CancelOrderParams) (orderv1.CancelOrderRes, error) {
+	identity, ok := identityFromContext(ctx)
+	if !ok {
+		return unauthorized(), nil
+	}
+
 	ordID, err := uuid.Parse(params.OrderUUID.String())
 	if err != nil {
 		return &orderv1.BadRequestError{ // 400
@@ -85,13 +130,22 @@ func (h *handler) CancelOrder(ctx context.Context, params orderv1.CancelOrderPar
 		}, nil
 	}
 
-	if err := h.svc.Cancel(ctx, ordID); err != nil {
+	if err := h.svc.Cancel(ctx, identity.UserID, ordID); err != nil {
 		return mapErrorToCancelOrderRes(err), nil
 	}
 
 	return &orderv1.CancelOrderNoContent{}, nil
 }
 
+// unauthorized is returned when an operation runs without the identity the
+// security handler puts into the context.
+func unauthorized() *orderv1.UnauthorizedError {
+	return &orderv1.UnauthorizedError{ // 401
+		Code:    orderv1.NewOptInt32(int32(http.StatusUnauthorized)),
+		Message: orderv1.NewOptString(model.ErrUnauthorized.Error()),
+	}
+}
+
 //nolint:dupl
 func mapErrorToCreateOrderRes(err error) orderv1.CreateOrderRes {
 	switch {
@@ -105,11 +159,29 @@ func mapErrorToCreateOrderRes(err error) orderv1.CreateOrderRes {
 			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
 			Message: orderv1.NewOptString(err.Error()),
 		}
+	case errors.Is(err, model.ErrPartChanged):
+		return &orderv1.ConflictError{ // 409
+			Code:    orderv1.NewOptInt32(int32(http.StatusConflict)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
 	case errors.Is(err, model.ErrPartsOutOfStock):
 		return &orderv1.ValidationError{ // 422
 			Code:    orderv1.NewOptInt32(int32(http.StatusUnprocessableEntity)),
 			Message: orderv1.NewOptString(err.Error()),
 		}
+	case errors.Is(err, model.ErrIncompatibleParts):
+		res := &orderv1.ValidationError{ // 422
+			Code:    orderv1.NewOptInt32(int32(http.StatusUnprocessableEntity)),
+			Message: orderv1.NewOptString(model.ErrIncompatibleParts.Error()),
+		}
+		var incompatible *model.IncompatiblePartsError
+		if errors.As(err, &incompatible) {
+			res.Violations = converter.ViolationsToOAPI(incompatible.Violations)
+			for _, v := range incompatible.Violations {
+				res.Details = append(res.Details, v.Message)
+			}
+		}
+		return res
 	case errors.Is(err, model.ErrBadGateway):
 		return &orderv1.BadGatewayError{ // 502
 			Code:    orderv1.NewOptInt32(int32(http.StatusBadGateway)),
@@ -136,6 +208,11 @@ func mapErrorToPayOrderRes(err error) orderv1.PayOrderRes {
 			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
 			Message: orderv1.NewOptString(err.Error()),
 		}
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
 	case errors.Is(err, model.ErrOrderNotFound):
 		return &orderv1.NotFoundError{ // 404
 			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
@@ -166,6 +243,11 @@ func mapErrorToPayOrderRes(err error) orderv1.PayOrderRes {
 
 func mapErrorToGetOrderRes(err error) orderv1.GetOrderByUUIDRes {
 	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
 	case errors.Is(err, model.ErrOrderNotFound):
 		return &orderv1.NotFoundError{ // 404
 			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
@@ -179,8 +261,28 @@ func mapErrorToGetOrderRes(err error) orderv1.GetOrderByUUIDRes {
 	}
 }
 
+func mapErrorToListOrdersRes(err error) orderv1.ListOrdersRes {
+	switch {
+	case errors.Is(err, model.ErrValidation):
+		return &orderv1.BadRequestError{ // 400
+			Code:    orderv1.NewOptInt32(int32(http.StatusBadRequest)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	default:
+		return &orderv1.InternalServerError{ // 500
+			Code:    orderv1.NewOptInt32(int32(http.StatusInternalServerError)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
+	}
+}
+
 func mapErrorToCancelOrderRes(err error) orderv1.CancelOrderRes {
 	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return &orderv1.ForbiddenError{ // 403
+			Code:    orderv1.NewOptInt32(int32(http.StatusForbidden)),
+			Message: orderv1.NewOptString(err.Error()),
+		}
 	case errors.Is(err, model.ErrOrderNotFound):
 		return &orderv1.NotFoundError{ // 404
 			Code:    orderv1.NewOptInt32(int32(http.StatusNotFound)),
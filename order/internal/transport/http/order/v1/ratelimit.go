@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogen-go/ogen/middleware"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+)
+
+// RateLimiter charges requests to token buckets.
+type RateLimiter interface {
+	AllowIP(ctx context.Context, ip string) (model.RateLimitResult, error)
+	AllowUser(ctx context.Context, userID uuid.UUID, route string) (model.RateLimitResult, error)
+}
+
+type responseHeaderKey struct{}
+
+// RateLimitByIP is the router middleware that charges every request to the
+// budget of the client IP before it is authenticated. It also gives
+// RateLimitByUser the headers of the response.
+//
+// Both middlewares fail open: a request whose bucket cannot be read is
+// served without the rate limit headers.
+func RateLimitByIP(limiter RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), responseHeaderKey{}, w.Header())
+
+			res, err := limiter.AllowIP(ctx, clientIP(r))
+			if err == nil {
+				setRateLimitHeaders(w.Header(), res)
+				if !res.Allowed {
+					writeError(w, http.StatusTooManyRequests, model.ErrRateLimited.Error())
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RateLimitByUser is the API middleware that charges an authenticated
+// request to the budget of its user and operation. It runs after the
+// security handler, which puts the caller into the context.
+func RateLimitByUser(limiter RateLimiter) orderv1.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		identity, ok := identityFromContext(req.Context)
+		if !ok {
+			return next(req)
+		}
+
+		res, err := limiter.AllowUser(req.Context, identity.UserID, req.OperationName)
+		if err != nil {
+			return next(req)
+		}
+
+		if h, ok := req.Context.Value(responseHeaderKey{}).(http.Header); ok {
+			setRateLimitHeaders(h, res)
+		}
+		if !res.Allowed {
+			return middleware.Response{Type: &orderv1.RateLimitError{ // 429
+				Code:    orderv1.NewOptInt32(int32(http.StatusTooManyRequests)),
+				Message: orderv1.NewOptString(model.ErrRateLimited.Error()),
+			}}, nil
+		}
+
+		return next(req)
+	}
+}
+
+// setRateLimitHeaders describes the bucket; a request that no budget
+// limits gets none.
+func setRateLimitHeaders(h http.Header, res model.RateLimitResult) {
+	if res.Limit == 0 {
+		return
+	}
+
+	h.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	h.Set("X-RateLimit-Reset", strconv.Itoa(seconds(res.Reset)))
+	if !res.Allowed {
+		h.Set("Retry-After", strconv.Itoa(max(seconds(res.RetryAfter), 1)))
+	}
+}
+
+// seconds rounds d up to whole seconds.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// clientIP is the host of the remote address; behind a trusted proxy the
+// router rewrites it from the forwarding headers.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
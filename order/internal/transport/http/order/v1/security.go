@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+	orderv1 "github.com/you-humble/rocket-maintenance/shared/pkg/openapi/order/v1"
+)
+
+// Authenticator resolves a session token to the caller it was issued to.
+// It returns model.ErrUnauthorized for a token that is not valid.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*model.Identity, error)
+}
+
+type identityKey struct{}
+
+type securityHandler struct {
+	auth Authenticator
+}
+
+// NewSecurityHandler authenticates API requests by the session token sent in
+// the Authorization header or in the session cookie.
+func NewSecurityHandler(auth Authenticator) *securityHandler {
+	return &securityHandler{auth: auth}
+}
+
+func (s *securityHandler) HandleBearerAuth(
+	ctx context.Context,
+	_ orderv1.OperationName,
+	t orderv1.BearerAuth,
+) (context.Context, error) {
+	return s.authenticate(ctx, t.Token)
+}
+
+func (s *securityHandler) HandleSessionCookie(
+	ctx context.Context,
+	_ orderv1.OperationName,
+	t orderv1.SessionCookie,
+) (context.Context, error) {
+	return s.authenticate(ctx, t.APIKey)
+}
+
+func (s *securityHandler) authenticate(ctx context.Context, token string) (context.Context, error) {
+	identity, err := s.auth.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, model.ErrUnauthorized) {
+			return ctx, err
+		}
+		return ctx, fmt.Errorf("%w: authenticate: %w", model.ErrBadGateway, err)
+	}
+
+	ctx = context.WithValue(ctx, identityKey{}, identity)
+	return logger.ContextWithUserID(ctx, identity.UserID.String()), nil
+}
+
+func identityFromContext(ctx context.Context) (*model.Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*model.Identity)
+	return identity, ok && identity != nil
+}
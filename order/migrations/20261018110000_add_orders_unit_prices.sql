@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS unit_prices bigint[] NULL;
+
+ALTER TABLE orders ADD CONSTRAINT orders_unit_prices_match_part_ids CHECK (
+    unit_prices IS NULL OR cardinality(unit_prices) = cardinality(part_ids)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_unit_prices_match_part_ids;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS unit_prices;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_audit_log (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_id uuid NOT NULL,
+    actor_role text NOT NULL,
+    action text NOT NULL,
+    order_id uuid NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_audit_log_order_id ON order_audit_log (order_id, created_at)
+    WHERE order_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_order_audit_log_actor_id ON order_audit_log (actor_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Buckets are cheap to lose, so the table skips the write-ahead log.
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    key text PRIMARY KEY,
+    tokens double precision NOT NULL,
+    -- Whether the last take got a token.
+    allowed boolean NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets (updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_limit_buckets;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS expires_at timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS idx_orders_pending_expires_at ON orders (expires_at)
+    WHERE status = 'PENDING_PAYMENT';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_pending_expires_at;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
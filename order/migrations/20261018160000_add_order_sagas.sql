@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_sagas (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type text NOT NULL,
+    -- The order may not exist yet: the saga that creates it starts first.
+    order_id uuid NOT NULL,
+    status text NOT NULL,
+    step text NOT NULL,
+    data jsonb NOT NULL DEFAULT '{}',
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    next_run_at timestamptz NOT NULL DEFAULT now(),
+    version bigint NOT NULL DEFAULT 1,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_sagas_unfinished_next_run_at ON order_sagas (next_run_at)
+    WHERE status IN ('RUNNING', 'COMPENSATING');
+
+CREATE INDEX IF NOT EXISTS idx_order_sagas_unfinished_created_at ON order_sagas (created_at)
+    WHERE status IN ('RUNNING', 'COMPENSATING');
+
+CREATE INDEX IF NOT EXISTS idx_order_sagas_order_id ON order_sagas (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_sagas;
+-- +goose StatementEnd
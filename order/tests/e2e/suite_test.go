@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"os"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -24,10 +26,14 @@ import (
 	"github.com/you-humble/rocket-maintenance/order/internal/app"
 	"github.com/you-humble/rocket-maintenance/order/internal/converter"
 	"github.com/you-humble/rocket-maintenance/order/internal/model"
+	bucketrepo "github.com/you-humble/rocket-maintenance/order/internal/repository/bucket"
 	repository "github.com/you-humble/rocket-maintenance/order/internal/repository/order"
+	sagarepo "github.com/you-humble/rocket-maintenance/order/internal/repository/saga"
 	ordconsumer "github.com/you-humble/rocket-maintenance/order/internal/service/consumer/order"
+	expirysvc "github.com/you-humble/rocket-maintenance/order/internal/service/expiry"
 	service "github.com/you-humble/rocket-maintenance/order/internal/service/order"
 	ordproducer "github.com/you-humble/rocket-maintenance/order/internal/service/producer/order"
+	sagasvc "github.com/you-humble/rocket-maintenance/order/internal/service/saga"
 	"github.com/you-humble/rocket-maintenance/platform/db/migrator"
 	"github.com/you-humble/rocket-maintenance/platform/kafka"
 	"github.com/you-humble/rocket-maintenance/platform/kafka/consumer"
@@ -57,6 +63,8 @@ const (
 
 	topicPaid       = "order.paid"
 	topicAssembled  = "order.assembled"
+	topicCancelled  = "order.cancelled"
+	topicExpired    = "order.expired"
 	consumerGroupID = "order-group-order-assembled"
 )
 
@@ -70,9 +78,15 @@ var (
 	kafkaC       tc.Container
 	kafkaBrokers []string
 
-	repo        service.OrderRepository
+	repo        app.OrderRepository
+	ordProducer app.OrderProducer
 	ordSvc      app.OrderService
-	ordConsumer app.OrderConsumer
+	sagaSvc     interface {
+		app.SagaService
+		Resume(ctx context.Context) (int, error)
+	}
+	inventoryClient *stubInventoryClient
+	ordConsumer     app.OrderConsumer
 )
 
 func TestIntegration(t *testing.T) {
@@ -129,9 +143,11 @@ var _ = BeforeSuite(func() {
 	Expect(os.Setenv("ORDER_ASSEMBLED_CONSUMER_GROUP_ID", "order-service-it")).To(Succeed())
 	Expect(os.Setenv("ORDER_PAID_TOPIC_NAME", topicPaid)).To(Succeed())
 	Expect(os.Setenv("ORDER_ASSEMBLED_TOPIC_NAME", topicAssembled)).To(Succeed())
+	Expect(os.Setenv("ORDER_CANCELLED_TOPIC_NAME", topicCancelled)).To(Succeed())
+	Expect(os.Setenv("ORDER_EXPIRED_TOPIC_NAME", topicExpired)).To(Succeed())
 
 	By("creating kafka topics")
-	Expect(createTopics(ctx, kafkaBrokers, topicPaid, topicAssembled)).To(Succeed())
+	Expect(createTopics(ctx, kafkaBrokers, topicPaid, topicAssembled, topicCancelled, topicExpired)).To(Succeed())
 
 	By("creating repository")
 	repo = repository.NewOrderRepository(pool)
@@ -144,12 +160,30 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	opProducer := producer.NewProducer(p, topicPaid, logger.L())
+	ocProducer := producer.NewProducer(p, topicCancelled, logger.L())
+	oeProducer := producer.NewProducer(p, topicExpired, logger.L())
 	conv := converter.NewKafkaCoverter()
 
-	producer := ordproducer.NewOrderProducer(opProducer, conv)
-
-	paymentClient := newStubPaymentClient()
-	ordSvc = service.NewOrderService(repo, nil, paymentClient, producer, 2*time.Second, 2*time.Second)
+	ordProducer = ordproducer.NewOrderProducer(opProducer, ocProducer, oeProducer, conv)
+
+	inventoryClient = newStubInventoryClient()
+	sagaSvc = sagasvc.NewSagaService(
+		sagarepo.NewSagaRepository(pool),
+		repo,
+		inventoryClient,
+		newStubPaymentClient(),
+		ordProducer,
+		sagasvc.Policy{
+			Lease:           30 * time.Second,
+			RetryBackoff:    time.Second,
+			MaxRetryBackoff: time.Minute,
+			StuckAfter:      time.Hour,
+			BatchSize:       10,
+			StepTimeout:     5 * time.Second,
+			DBTimeout:       2 * time.Second,
+		},
+	)
+	ordSvc = service.NewOrderService(repo, nil, sagaSvc, 30*time.Minute, 2*time.Second, 2*time.Second)
 
 	orderAssembledConsumerConfig := sarama.NewConfig()
 	orderAssembledConsumerConfig.Version = sarama.V4_0_0_0
@@ -194,7 +228,7 @@ var _ = AfterSuite(func() {
 
 var _ = BeforeEach(func() {
 	By("cleaning orders table")
-	_, err := pool.Exec(ctx, "TRUNCATE TABLE orders RESTART IDENTITY CASCADE")
+	_, err := pool.Exec(ctx, "TRUNCATE TABLE orders, order_audit_log, order_sagas, rate_limit_buckets RESTART IDENTITY CASCADE")
 	Expect(err).NotTo(HaveOccurred())
 })
 
@@ -208,6 +242,7 @@ var _ = Describe("Order repository", func() {
 				UserID:        userID,
 				PartIDs:       []uuid.UUID{partID},
 				TotalPrice:    12345,
+				UnitPrices:    []int64{12345},
 				TransactionID: nil,
 				PaymentMethod: nil,
 				Status:        model.StatusPendingPayment,
@@ -251,6 +286,7 @@ var _ = Describe("Order repository", func() {
 			Expect(gotOrd.UserID).To(Equal(userID))
 			Expect(gotOrd.PartIDs).To(Equal([]uuid.UUID{partID}))
 			Expect(gotOrd.TotalPrice).To(Equal(int64(12345)))
+			Expect(gotOrd.UnitPrices).To(Equal([]int64{12345}))
 			Expect(gotOrd.TransactionID).To(BeNil())
 			Expect(gotOrd.PaymentMethod).To(BeNil())
 			Expect(gotOrd.Status).To(Equal(model.StatusPendingPayment))
@@ -392,6 +428,426 @@ var _ = Describe("Order repository", func() {
 			}).WithTimeout(15 * time.Second).WithPolling(200 * time.Millisecond).Should(Succeed())
 		})
 	})
+
+	Context("Admin", func() {
+		It("filters orders of all users by user and status", func() {
+			userA, userB := uuid.New(), uuid.New()
+			for _, o := range []struct {
+				user   uuid.UUID
+				status model.OrderStatus
+			}{
+				{userA, model.StatusPendingPayment},
+				{userA, model.StatusCancelled},
+				{userB, model.StatusPendingPayment},
+			} {
+				_, err := repo.Create(ctx, &model.Order{
+					UserID:     o.user,
+					PartIDs:    []uuid.UUID{uuid.New()},
+					TotalPrice: 100,
+					Status:     o.status,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			all, err := repo.Orders(ctx, model.OrdersFilter{Limit: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(all).To(HaveLen(3))
+
+			pending := model.StatusPendingPayment
+			got, err := repo.Orders(ctx, model.OrdersFilter{UserID: &userA, Status: &pending, Limit: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(HaveLen(1))
+			Expect(got[0].UserID).To(Equal(userA))
+			Expect(got[0].Status).To(Equal(model.StatusPendingPayment))
+		})
+
+		It("updates the order and records the audit entry together", func() {
+			id, err := repo.Create(ctx, &model.Order{
+				UserID:     uuid.New(),
+				PartIDs:    []uuid.UUID{uuid.New()},
+				TotalPrice: 100,
+				Status:     model.StatusPendingPayment,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			actorID := uuid.New()
+			err = repo.UpdateWithAudit(ctx, &model.Order{ID: id, Status: model.StatusCancelled}, model.StatusPendingPayment,
+				model.AuditEntry{
+					ActorID:   actorID,
+					ActorRole: model.RoleSupport,
+					Action:    model.AdminActionCancelOrder,
+					OrderID:   &id,
+					Reason:    "duplicate order",
+				})
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := repo.OrderByID(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Status).To(Equal(model.StatusCancelled))
+
+			var gotActor uuid.UUID
+			var gotRole, gotAction, gotReason string
+			err = pool.QueryRow(ctx,
+				`SELECT actor_id, actor_role, action, reason FROM order_audit_log WHERE order_id = $1`,
+				id,
+			).Scan(&gotActor, &gotRole, &gotAction, &gotReason)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotActor).To(Equal(actorID))
+			Expect(gotRole).To(Equal(string(model.RoleSupport)))
+			Expect(gotAction).To(Equal(string(model.AdminActionCancelOrder)))
+			Expect(gotReason).To(Equal("duplicate order"))
+		})
+
+		It("records nothing when the order is missing", func() {
+			id := uuid.New()
+			err := repo.UpdateWithAudit(ctx, &model.Order{ID: id, Status: model.StatusCancelled}, model.StatusPendingPayment,
+				model.AuditEntry{
+					ActorID:   uuid.New(),
+					ActorRole: model.RoleAdmin,
+					Action:    model.AdminActionCancelOrder,
+					OrderID:   &id,
+					Reason:    "gone",
+				})
+			Expect(err).To(Equal(model.ErrOrderNotFound))
+
+			var n int
+			Expect(pool.QueryRow(ctx, `SELECT count(*) FROM order_audit_log`).Scan(&n)).To(Succeed())
+			Expect(n).To(BeZero())
+		})
+
+		It("leaves an order that moved on since it was read", func() {
+			id, err := repo.Create(ctx, &model.Order{
+				UserID:     uuid.New(),
+				PartIDs:    []uuid.UUID{uuid.New()},
+				TotalPrice: 100,
+				Status:     model.StatusPendingPayment,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = repo.UpdateWithAudit(ctx, &model.Order{ID: id, Status: model.StatusCancelled}, model.StatusPaid,
+				model.AuditEntry{
+					ActorID:   uuid.New(),
+					ActorRole: model.RoleAdmin,
+					Action:    model.AdminActionCancelOrder,
+					OrderID:   &id,
+					Reason:    "stale read",
+				})
+			Expect(err).To(Equal(model.ErrOrderConflict))
+
+			got, err := repo.OrderByID(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Status).To(Equal(model.StatusPendingPayment))
+
+			var n int
+			Expect(pool.QueryRow(ctx, `SELECT count(*) FROM order_audit_log`).Scan(&n)).To(Succeed())
+			Expect(n).To(BeZero())
+		})
+	})
+
+	Context("Cancel", func() {
+		create := func() (uuid.UUID, uuid.UUID) {
+			userID := uuid.New()
+			id, err := repo.Create(ctx, &model.Order{
+				UserID:     userID,
+				PartIDs:    []uuid.UUID{uuid.New()},
+				TotalPrice: 100,
+				Status:     model.StatusPendingPayment,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return id, userID
+		}
+
+		It("cancels the order and writes its release saga together", func() {
+			id, userID := create()
+
+			Expect(repo.Cancel(ctx, id, model.StatusPendingPayment)).To(Succeed())
+
+			got, err := repo.OrderByID(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Status).To(Equal(model.StatusCancelled))
+
+			sagas, err := sagarepo.NewSagaRepository(pool).ClaimDue(ctx, 10, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sagas).To(HaveLen(1))
+			Expect(sagas[0].OrderID).To(Equal(id))
+			Expect(sagas[0].Type).To(Equal(model.SagaReleaseOrder))
+			Expect(sagas[0].Status).To(Equal(model.SagaRunning))
+			Expect(sagas[0].Step).To(Equal(model.SagaStepReleaseStock))
+			Expect(sagas[0].Data.UserID).To(Equal(userID))
+		})
+
+		It("writes neither the saga nor the audit entry for an order that moved on", func() {
+			id, _ := create()
+
+			err := repo.CancelWithAudit(ctx, id, model.StatusPaid, model.AuditEntry{
+				ActorID:   uuid.New(),
+				ActorRole: model.RoleAdmin,
+				Action:    model.AdminActionCancelOrder,
+				OrderID:   &id,
+				Reason:    "stale read",
+			})
+			Expect(err).To(Equal(model.ErrOrderConflict))
+
+			var sagas, entries int
+			Expect(pool.QueryRow(ctx, `SELECT count(*) FROM order_sagas`).Scan(&sagas)).To(Succeed())
+			Expect(pool.QueryRow(ctx, `SELECT count(*) FROM order_audit_log`).Scan(&entries)).To(Succeed())
+			Expect(sagas).To(BeZero())
+			Expect(entries).To(BeZero())
+		})
+
+		It("gives ErrOrderNotFound for a missing order", func() {
+			Expect(repo.Cancel(ctx, uuid.New(), model.StatusPendingPayment)).To(Equal(model.ErrOrderNotFound))
+		})
+	})
+})
+
+var _ = Describe("Order expiry", func() {
+	create := func(status model.OrderStatus, expiresAt time.Time) uuid.UUID {
+		id, err := repo.Create(ctx, &model.Order{
+			UserID:     uuid.New(),
+			PartIDs:    []uuid.UUID{uuid.New()},
+			TotalPrice: 100,
+			Status:     status,
+			ExpiresAt:  &expiresAt,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return id
+	}
+
+	It("cancels the unpaid orders past their deadline in batches", func() {
+		past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+		expired1 := create(model.StatusPendingPayment, past)
+		expired2 := create(model.StatusPendingPayment, past.Add(-time.Minute))
+		pending := create(model.StatusPendingPayment, future)
+		completed := create(model.StatusCompleted, past)
+
+		n, err := expirysvc.NewExpiryService(repo, 1, 2*time.Second).Expire(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(2))
+
+		for id, status := range map[uuid.UUID]model.OrderStatus{
+			expired1:  model.StatusCancelled,
+			expired2:  model.StatusCancelled,
+			pending:   model.StatusPendingPayment,
+			completed: model.StatusCompleted,
+		} {
+			got, err := repo.OrderByID(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Status).To(Equal(status))
+		}
+
+		got, err := repo.OrderByID(ctx, pending)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.ExpiresAt).NotTo(BeNil())
+		Expect(*got.ExpiresAt).To(BeTemporally("~", future, time.Millisecond))
+	})
+
+	It("writes an expire saga for every expired order", func() {
+		expired := create(model.StatusPendingPayment, time.Now().Add(-time.Minute))
+		create(model.StatusPendingPayment, time.Now().Add(time.Hour))
+
+		got, err := repo.ExpireOrders(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(HaveLen(1))
+		Expect(got[0].OrderID).To(Equal(expired))
+
+		sagas, err := sagarepo.NewSagaRepository(pool).ClaimDue(ctx, 10, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sagas).To(HaveLen(1))
+		Expect(sagas[0].OrderID).To(Equal(expired))
+		Expect(sagas[0].Type).To(Equal(model.SagaExpireOrder))
+		Expect(sagas[0].Status).To(Equal(model.SagaRunning))
+		Expect(sagas[0].Step).To(Equal(model.SagaStepReleaseStock))
+		Expect(sagas[0].Data.UserID).To(Equal(got[0].UserID))
+		Expect(sagas[0].Data.ExpiresAt).NotTo(BeNil())
+		Expect(*sagas[0].Data.ExpiresAt).To(BeTemporally("~", got[0].ExpiresAt, time.Millisecond))
+	})
+
+	It("skips the orders locked by another transaction", func() {
+		locked := create(model.StatusPendingPayment, time.Now().Add(-time.Minute))
+		free := create(model.StatusPendingPayment, time.Now().Add(-time.Minute))
+
+		tx, err := pool.Begin(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = tx.Rollback(ctx) }()
+		_, err = tx.Exec(ctx, `SELECT id FROM orders WHERE id = $1 FOR UPDATE`, locked)
+		Expect(err).NotTo(HaveOccurred())
+
+		expired, err := repo.ExpireOrders(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(HaveLen(1))
+		Expect(expired[0].OrderID).To(Equal(free))
+
+		Expect(tx.Rollback(ctx)).To(Succeed())
+
+		expired, err = repo.ExpireOrders(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(HaveLen(1))
+		Expect(expired[0].OrderID).To(Equal(locked))
+	})
+})
+
+var _ = Describe("Order sagas", func() {
+	It("releases and announces the expired orders on resume", func() {
+		expiresAt := time.Now().Add(-time.Minute)
+		id, err := repo.Create(ctx, &model.Order{
+			UserID:     uuid.New(),
+			PartIDs:    []uuid.UUID{uuid.New()},
+			TotalPrice: 100,
+			Status:     model.StatusPendingPayment,
+			ExpiresAt:  &expiresAt,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		expired, err := repo.ExpireOrders(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(HaveLen(1))
+
+		n, err := sagaSvc.Resume(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(1))
+		Expect(inventoryClient.Released()).To(ContainElement(id.String()))
+
+		var status model.SagaStatus
+		err = pool.QueryRow(ctx, `SELECT status FROM order_sagas WHERE order_id = $1`, id).Scan(&status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(Equal(model.SagaCompleted))
+	})
+
+	It("leases the claimed sagas to a single replica", func() {
+		sagas := sagarepo.NewSagaRepository(pool)
+		s := &model.Saga{
+			ID:        uuid.New(),
+			Type:      model.SagaReleaseOrder,
+			OrderID:   uuid.New(),
+			Status:    model.SagaRunning,
+			Step:      model.SagaStepReleaseStock,
+			NextRunAt: time.Now().Add(-time.Second),
+		}
+		Expect(sagas.Create(ctx, s)).To(Succeed())
+
+		claimed, err := sagas.ClaimDue(ctx, 10, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(HaveLen(1))
+
+		again, err := sagas.ClaimDue(ctx, 10, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(BeEmpty())
+
+		By("refusing the save of the replica that lost the saga")
+		Expect(sagas.Save(ctx, s)).To(MatchError(model.ErrSagaLost))
+
+		claimed[0].Status, claimed[0].Step = model.SagaCompleted, ""
+		Expect(sagas.Save(ctx, &claimed[0])).To(Succeed())
+	})
+
+	It("keeps the part versions a create saga reserves with", func() {
+		sagas := sagarepo.NewSagaRepository(pool)
+		partID, orderID := uuid.New(), uuid.New()
+		Expect(sagas.Create(ctx, &model.Saga{
+			ID:      uuid.New(),
+			Type:    model.SagaCreateOrder,
+			OrderID: orderID,
+			Status:  model.SagaRunning,
+			Step:    model.SagaStepReserveStock,
+			Data: model.SagaData{
+				PartIDs:      []uuid.UUID{partID},
+				PartVersions: map[uuid.UUID]int64{partID: 3},
+			},
+			NextRunAt: time.Now().Add(-time.Second),
+		})).To(Succeed())
+
+		claimed, err := sagas.ClaimDue(ctx, 10, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		i := slices.IndexFunc(claimed, func(s model.Saga) bool { return s.OrderID == orderID })
+		Expect(i).NotTo(Equal(-1))
+		Expect(claimed[i].Data.PartVersions).To(Equal(map[uuid.UUID]int64{partID: 3}))
+
+		claimed[i].Status, claimed[i].Step = model.SagaCompleted, ""
+		Expect(sagas.Save(ctx, &claimed[i])).To(Succeed())
+	})
+
+	It("reports the unfinished sagas past the stuck age", func() {
+		sagas := sagarepo.NewSagaRepository(pool)
+		for _, status := range []model.SagaStatus{model.SagaCompensating, model.SagaCompleted} {
+			Expect(sagas.Create(ctx, &model.Saga{
+				ID:        uuid.New(),
+				Type:      model.SagaCreateOrder,
+				OrderID:   uuid.New(),
+				Status:    status,
+				Step:      model.SagaStepReserveStock,
+				NextRunAt: time.Now().Add(time.Hour),
+			})).To(Succeed())
+		}
+
+		n, err := sagas.CountStartedBefore(ctx, time.Now().Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(BeEquivalentTo(1))
+
+		stuck, err := sagas.StartedBefore(ctx, time.Now().Add(time.Minute), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stuck).To(HaveLen(1))
+		Expect(stuck[0].Status).To(Equal(model.SagaCompensating))
+
+		stuck, err = sagas.StartedBefore(ctx, time.Now().Add(-time.Minute), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stuck).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Rate limit bucket repository", func() {
+	budget := model.Budget{Requests: 3, Per: time.Hour}
+
+	It("denies the request once the bucket is empty", func() {
+		buckets := bucketrepo.NewPostgresBucketRepository(pool)
+
+		for remaining := 2; remaining >= 0; remaining-- {
+			res, err := buckets.Take(ctx, "user:a", budget)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Limit).To(Equal(3))
+			Expect(res.Remaining).To(Equal(remaining))
+		}
+
+		res, err := buckets.Take(ctx, "user:a", budget)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Allowed).To(BeFalse())
+		Expect(res.Remaining).To(BeZero())
+		Expect(res.RetryAfter).To(BeNumerically(">", 0))
+		Expect(res.RetryAfter).To(BeNumerically("<=", 20*time.Minute))
+
+		res, err = buckets.Take(ctx, "user:b", budget)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Allowed).To(BeTrue())
+	})
+
+	It("sweeps the buckets idle for longer than the period", func() {
+		buckets := bucketrepo.NewPostgresBucketRepository(pool)
+
+		for _, key := range []string{"ip:old", "ip:new"} {
+			_, err := buckets.Take(ctx, key, budget)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := pool.Exec(ctx,
+			`UPDATE rate_limit_buckets SET updated_at = now() - interval '2 hours' WHERE key = 'ip:old'`,
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		n, err := buckets.Sweep(ctx, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(int64(1)))
+
+		var keys []string
+		rows, err := pool.Query(ctx, `SELECT key FROM rate_limit_buckets`)
+		Expect(err).NotTo(HaveOccurred())
+		for rows.Next() {
+			var key string
+			Expect(rows.Scan(&key)).To(Succeed())
+			keys = append(keys, key)
+		}
+		Expect(rows.Err()).NotTo(HaveOccurred())
+		Expect(keys).To(ConsistOf("ip:new"))
+	})
 })
 
 func runKafka(ctx context.Context) (tc.Container, []string, error) {
@@ -524,3 +980,31 @@ func newStubPaymentClient() *stubPaymentClient { return &stubPaymentClient{} }
 func (c *stubPaymentClient) PayOrder(ctx context.Context, params model.PayOrderParams) (string, error) {
 	return uuid.NewString(), nil
 }
+
+func (c *stubPaymentClient) RefundPayment(ctx context.Context, params model.RefundPaymentParams) error {
+	return nil
+}
+
+type stubInventoryClient struct {
+	mu       sync.Mutex
+	released []string
+}
+
+func newStubInventoryClient() *stubInventoryClient { return &stubInventoryClient{} }
+
+func (c *stubInventoryClient) ReserveStock(ctx context.Context, reservationID string, items []model.StockItem) error {
+	return nil
+}
+
+func (c *stubInventoryClient) ReleaseStock(ctx context.Context, reservationID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.released = append(c.released, reservationID)
+	return nil
+}
+
+func (c *stubInventoryClient) Released() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.released...)
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"errors"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/you-humble/rocket-maintenance/payment/internal/config"
 	"github.com/you-humble/rocket-maintenance/platform/closer"
+	grpcserver "github.com/you-humble/rocket-maintenance/platform/grpc/server"
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
 type app struct {
 	di       *di
 	listener net.Listener
-	server   *grpc.Server
+	server   *grpcserver.Server
 }
 
 func New(ctx context.Context) (*app, error) {
@@ -127,8 +129,24 @@ func (a *app) run(ctx context.Context) error {
 }
 
 //nolint:contextcheck
-func gracefulShutdown(ctx context.Context, s *grpc.Server) {
+func gracefulShutdown(ctx context.Context, s *grpcserver.Server) {
 	logger.Info(ctx, "🛑 Shutting down gRPC server...")
-	s.GracefulStop()
+
+	// The server stops first: its calls in flight still use the resources
+	// that the closer releases.
+	if err := s.Shutdown(context.WithoutCancel(ctx)); err != nil {
+		logger.Error(ctx, "❌ Error during server shutdown", logger.ErrorF(err))
+	}
+
+	closeCtx, cancel := context.WithTimeout(
+		context.Background(), // do not inherit cancellation from ctx
+		10*time.Second,
+	)
+	defer cancel()
+
+	if err := closer.CloseAll(closeCtx); err != nil {
+		logger.Error(ctx, "❌ Error during server shutdown", logger.ErrorF(err))
+		return
+	}
 	logger.Info(ctx, "✅ Server stopped")
 }
@@ -2,14 +2,13 @@ package app
 
 import (
 	"context"
+	"fmt"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
-
+	"github.com/you-humble/rocket-maintenance/payment/internal/config"
 	service "github.com/you-humble/rocket-maintenance/payment/internal/service/payment"
-	"github.com/you-humble/rocket-maintenance/payment/internal/transport/grpc/interceptors"
 	tgrpc "github.com/you-humble/rocket-maintenance/payment/internal/transport/grpc/payment/v1"
-	"github.com/you-humble/rocket-maintenance/platform/grpc/health"
+	grpcserver "github.com/you-humble/rocket-maintenance/platform/grpc/server"
+	"github.com/you-humble/rocket-maintenance/platform/health"
 	paymentpbv1 "github.com/you-humble/rocket-maintenance/shared/pkg/proto/payment/v1"
 )
 
@@ -17,7 +16,8 @@ type di struct {
 	service tgrpc.PaymentService
 	handler paymentpbv1.PaymentServiceServer
 
-	server *grpc.Server
+	server *grpcserver.Server
+	health *health.Health
 }
 
 func NewDI() *di { return &di{} }
@@ -38,20 +38,30 @@ func (d *di) PaymentHandler(ctx context.Context) paymentpbv1.PaymentServiceServe
 	return d.handler
 }
 
-func (d *di) Server(ctx context.Context) *grpc.Server {
+func (d *di) Server(ctx context.Context) *grpcserver.Server {
 	if d.server == nil {
-		d.server = grpc.NewServer(
-			grpc.ChainUnaryInterceptor(
-				interceptors.UnaryLogging(),
-				interceptors.RejectNilRequest(),
-			),
-		)
-		paymentpbv1.RegisterPaymentServiceServer(d.server, d.PaymentHandler(ctx))
-
-		reflection.Register(d.server)
+		srv, err := grpcserver.New("gRPC server").
+			WithValidation().
+			WithHealth(d.Health(ctx)).
+			WithReflection().
+			WithTLS(config.C().Server.TLS()).
+			Build()
+		if err != nil {
+			panic(fmt.Sprintf("failed to build grpc server: %v\n", err))
+		}
+		paymentpbv1.RegisterPaymentServiceServer(srv, d.PaymentHandler(ctx))
 
-		health.RegisterService(d.server)
+		d.server = srv
 	}
 
 	return d.server
 }
+
+func (d *di) Health(ctx context.Context) *health.Health {
+	if d.health == nil {
+		// The service has no dependencies: it is ready until it shuts down.
+		d.health = health.New()
+	}
+
+	return d.health
+}
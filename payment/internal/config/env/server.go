@@ -1,18 +1,32 @@
 package envconfig
 
 import (
+	"crypto/tls"
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig"
 )
 
 type grpcServerEnv struct {
 	Host string `env:"GRPC_HOST,required"`
 	Port int    `env:"GRPC_PORT,required"`
+
+	TLSEnabled bool   `env:"GRPC_TLS_ENABLED" envDefault:"false"`
+	TLSCert    string `env:"GRPC_TLS_CERT_FILE"`
+	TLSKey     string `env:"GRPC_TLS_KEY_FILE"`
+	// TLSCA signs the certificates of the clients; with TLSClientAuth a
+	// client must present one.
+	TLSCA             string        `env:"GRPC_TLS_CA_FILE"`
+	TLSClientAuth     bool          `env:"GRPC_TLS_CLIENT_AUTH" envDefault:"false"`
+	TLSReloadInterval time.Duration `env:"GRPC_TLS_RELOAD_INTERVAL" envDefault:"1m"`
 }
 
 type grpcServer struct {
 	raw grpcServerEnv
+	tls *tls.Config
 }
 
 func NewGRPCerverConfig() (*grpcServer, error) {
@@ -20,7 +34,26 @@ func NewGRPCerverConfig() (*grpcServer, error) {
 	if err := env.Parse(&raw); err != nil {
 		return nil, err
 	}
-	return &grpcServer{raw: raw}, nil
+
+	cfg := &grpcServer{raw: raw}
+	if raw.TLSEnabled {
+		opts := []tlsconfig.Option{tlsconfig.WithReloadInterval(raw.TLSReloadInterval)}
+		if raw.TLSClientAuth {
+			opts = append(opts, tlsconfig.WithClientAuth())
+		}
+
+		var err error
+		cfg.tls, err = tlsconfig.Server(tlsconfig.Files{
+			CertFile: raw.TLSCert,
+			KeyFile:  raw.TLSKey,
+			CAFile:   raw.TLSCA,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
 }
 
 func (cfg *grpcServer) Host() string { return cfg.raw.Host }
@@ -28,3 +61,6 @@ func (cfg *grpcServer) Port() int    { return cfg.raw.Port }
 func (cfg *grpcServer) Address() string {
 	return fmt.Sprintf("%s:%d", cfg.Host(), cfg.Port())
 }
+
+// TLS is the config of the server, nil when it serves in plaintext.
+func (cfg *grpcServer) TLS() *tls.Config { return cfg.tls }
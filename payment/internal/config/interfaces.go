@@ -1,9 +1,12 @@
 package config
 
+import "crypto/tls"
+
 type Server interface {
 	Host() string
 	Port() int
 	Address() string
+	TLS() *tls.Config
 }
 
 type Logger interface {
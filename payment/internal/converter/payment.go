@@ -44,3 +44,20 @@ func PayOrderRespToPB(res *model.PayOrderResult) *paymentpbv1.PayOrderResponse {
 		TransactionUuid: res.TransactionUUID,
 	}
 }
+
+func RefundPaymentParamsFromPB(req *paymentpbv1.RefundPaymentRequest) (model.RefundPaymentParams, error) {
+	if req == nil {
+		return model.RefundPaymentParams{}, errors.New("request is nil")
+	}
+	return model.RefundPaymentParams{
+		OrderID:       req.GetOrderUuid(),
+		UserID:        req.GetUserUuid(),
+		TransactionID: req.GetTransactionUuid(),
+	}, nil
+}
+
+func RefundPaymentRespToPB(res *model.RefundPaymentResult) *paymentpbv1.RefundPaymentResponse {
+	return &paymentpbv1.RefundPaymentResponse{
+		RefundUuid: res.RefundUUID,
+	}
+}
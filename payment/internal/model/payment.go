@@ -35,6 +35,27 @@ type PayOrderResult struct {
 	TransactionUUID string
 }
 
+type RefundPaymentParams struct {
+	OrderID string
+	UserID  string
+	// TransactionID is empty when the transaction of the payment is unknown.
+	TransactionID string
+}
+
+func (p RefundPaymentParams) Validate() error {
+	if p.OrderID == "" {
+		return errors.New("order_id is required")
+	}
+	if p.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	return nil
+}
+
+type RefundPaymentResult struct {
+	RefundUUID string
+}
+
 func (m Method) String() string {
 	switch m {
 	case MethodUnknown:
@@ -10,6 +10,10 @@ import (
 	"github.com/you-humble/rocket-maintenance/platform/logger"
 )
 
+// refundNamespace derives the UUID of the refund of an order from the
+// order, so that a retried refund returns the same refund.
+var refundNamespace = uuid.MustParse("6f1c2a4e-3b8d-4e5f-9a7c-2d1e0b9f8c6a")
+
 type service struct{}
 
 func NewPaymentService() *service {
@@ -34,3 +38,24 @@ func (s *service) PayOrder(ctx context.Context, params model.PayOrderParams) (*m
 	log.Info(ctx, "payment succeeded", logger.String("transaction_id", txID))
 	return &model.PayOrderResult{TransactionUUID: txID}, nil
 }
+
+// RefundPayment returns the money paid for the order. The service keeps no
+// state, so the refund of an order is identified by the order itself.
+func (s *service) RefundPayment(ctx context.Context, params model.RefundPaymentParams) (*model.RefundPaymentResult, error) {
+	const op = "payment.service.RefundPayment"
+	log := logger.With(
+		logger.String("order_id", params.OrderID),
+		logger.String("user_id", params.UserID),
+		logger.String("transaction_id", params.TransactionID),
+	)
+
+	if err := params.Validate(); err != nil {
+		log.Error(ctx, "validation failed", logger.ErrorF(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	refundID := uuid.NewSHA1(refundNamespace, []byte(params.OrderID)).String()
+
+	log.Info(ctx, "refund succeeded", logger.String("refund_id", refundID))
+	return &model.RefundPaymentResult{RefundUUID: refundID}, nil
+}
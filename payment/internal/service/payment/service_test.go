@@ -109,3 +109,66 @@ func TestServicePayOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceRefundPayment(t *testing.T) {
+	sut := &service{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params model.RefundPaymentParams
+
+		wantErrMsg string
+	}{
+		{
+			name: "ok/with transaction",
+			params: model.RefundPaymentParams{
+				OrderID:       "order-1",
+				UserID:        "user-1",
+				TransactionID: uuid.NewString(),
+			},
+		},
+		{
+			name: "ok/transaction unknown",
+			params: model.RefundPaymentParams{
+				OrderID: "order-1",
+				UserID:  "user-1",
+			},
+		},
+		{
+			name: "validation/order_id required",
+			params: model.RefundPaymentParams{
+				UserID: "user-1",
+			},
+			wantErrMsg: "payment.service.RefundPayment: order_id is required",
+		},
+		{
+			name: "validation/user_id required",
+			params: model.RefundPaymentParams{
+				OrderID: "order-1",
+			},
+			wantErrMsg: "payment.service.RefundPayment: user_id is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := sut.RefundPayment(ctx, tt.params)
+
+			if tt.wantErrMsg != "" {
+				require.EqualError(t, err, tt.wantErrMsg)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			_, parseErr := uuid.Parse(res.RefundUUID)
+			require.NoError(t, parseErr, "refund uuid must be valid")
+
+			again, err := sut.RefundPayment(ctx, tt.params)
+			require.NoError(t, err)
+			require.Equal(t, res.RefundUUID, again.RefundUUID, "a retried refund must be the same refund")
+		})
+	}
+}
@@ -1,23 +0,0 @@
-package interceptors
-
-import (
-	"context"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-)
-
-func RejectNilRequest() grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req any,
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (any, error) {
-		if req == nil {
-			return nil, status.Error(codes.InvalidArgument, "request is nil")
-		}
-		return handler(ctx, req)
-	}
-}
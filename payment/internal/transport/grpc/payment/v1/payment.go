@@ -15,6 +15,7 @@ import (
 
 type PaymentService interface {
 	PayOrder(ctx context.Context, params model.PayOrderParams) (*model.PayOrderResult, error)
+	RefundPayment(ctx context.Context, params model.RefundPaymentParams) (*model.RefundPaymentResult, error)
 }
 
 type handler struct {
@@ -42,6 +43,25 @@ func (h *handler) PayOrder(ctx context.Context, req *paymentpbv1.PayOrderRequest
 	return converter.PayOrderRespToPB(res), nil
 }
 
+func (h *handler) RefundPayment(
+	ctx context.Context,
+	req *paymentpbv1.RefundPaymentRequest,
+) (*paymentpbv1.RefundPaymentResponse, error) {
+	cmd, err := converter.RefundPaymentParamsFromPB(req)
+	if err != nil {
+		logger.Error(ctx, "refund-payment params from proto", logger.ErrorF(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.svc.RefundPayment(ctx, cmd)
+	if err != nil {
+		logger.Error(ctx, "refund-payment", logger.ErrorF(err))
+		return nil, mapError(err)
+	}
+
+	return converter.RefundPaymentRespToPB(res), nil
+}
+
 func mapError(err error) error {
 	if err == nil {
 		return nil
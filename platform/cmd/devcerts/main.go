@@ -0,0 +1,51 @@
+// Command devcerts writes a local CA and a certificate for each service, for
+// running the services over mutual TLS in development. A CA already in the
+// output directory is reused, so running it again rotates the certificates
+// of the services.
+//
+//	devcerts [-out DIR] [-services LIST] [-hosts LIST]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig/devca"
+)
+
+const defaultServices = "inventory,payment,iam,order,notification,assembly,kafka"
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	fs := flag.NewFlagSet("devcerts", flag.ContinueOnError)
+	out := fs.String("out", "deploy/certs", "write the files to `DIR`")
+	services := fs.String("services", defaultServices, "comma-separated names of the services")
+	hosts := fs.String("hosts", "", "comma-separated extra DNS names and IPs of every certificate")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if err := devca.Generate(*out, split(*services), split(*hosts)...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("certificates written to %s\n", *out)
+	return 0
+}
+
+func split(s string) []string {
+	var parts []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	return parts
+}
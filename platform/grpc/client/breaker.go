@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// ErrCircuitOpen is returned without calling the server while the circuit
+// breaker of the connection is open. Its code is Unavailable.
+var ErrCircuitOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	// stateHalfOpen lets a single probe call through; its result closes or
+	// reopens the circuit.
+	stateHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case stateOpen:
+		return "open"
+	case stateHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// breaker is a circuit breaker shared by all methods of a connection: when
+// the server is down, it is down for every method.
+type breaker struct {
+	target   string
+	failures int
+	openFor  time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failed   int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(target string, failures int, openFor time.Duration) *breaker {
+	return &breaker{
+		target:   target,
+		failures: failures,
+		openFor:  openFor,
+		now:      time.Now,
+	}
+}
+
+func (b *breaker) unary() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !b.allow() {
+			return ErrCircuitOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+			// A call the caller cancelled says nothing about the server.
+			b.release()
+			return err
+		}
+		b.record(ctx, path.Base(method), serverFailure(err))
+
+		return err
+	}
+}
+
+// allow reports whether a call may go to the server.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.openFor {
+			return false
+		}
+		b.state = stateHalfOpen
+		b.probing = true
+		return true
+	case stateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// release lets another call probe a half-open circuit.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) record(ctx context.Context, method string, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	from := b.state
+	switch {
+	case !failed:
+		b.state, b.failed, b.probing = stateClosed, 0, false
+	case b.state == stateHalfOpen:
+		b.state, b.openedAt, b.probing = stateOpen, b.now(), false
+	default:
+		b.failed++
+		if b.state == stateClosed && b.failed >= b.failures {
+			b.state, b.openedAt = stateOpen, b.now()
+		}
+	}
+
+	if b.state != from {
+		logger.Warn(ctx, "grpc client circuit breaker",
+			logger.String("target", b.target),
+			logger.String("method", method),
+			logger.String("from", from.String()),
+			logger.String("to", b.state.String()),
+		)
+	}
+}
+
+// serverFailure reports whether err says the server is down or overloaded,
+// rather than that it rejected the request.
+func serverFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// clock is a time source the test moves by hand.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+const (
+	breakerFailures = 3
+	breakerOpenFor  = 10 * time.Second
+)
+
+func newTestBreaker() (*breaker, *clock) {
+	clk := &clock{now: time.Now()}
+	b := newBreaker("payment:50051", breakerFailures, breakerOpenFor)
+	b.now = clk.Now
+	return b, clk
+}
+
+// call makes a call through the breaker that fails with err.
+func call(ctx context.Context, b *breaker, err error) (callErr error, reached bool) {
+	callErr = b.unary()(ctx, testMethod, nil, nil, nil,
+		func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			reached = true
+			return err
+		})
+	return callErr, reached
+}
+
+// open fails the calls until the circuit opens.
+func open(t *testing.T, b *breaker) {
+	t.Helper()
+
+	for range breakerFailures {
+		_, reached := call(context.Background(), b, codeErr(codes.Unavailable))
+		require.True(t, reached)
+	}
+	require.Equal(t, stateOpen, b.state)
+}
+
+func TestBreaker(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	t.Run("success: stays closed below the failure limit", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := newTestBreaker()
+		for range breakerFailures - 1 {
+			call(context.Background(), b, codeErr(codes.Unavailable))
+		}
+		// A success resets the count.
+		call(context.Background(), b, nil)
+		for range breakerFailures - 1 {
+			call(context.Background(), b, codeErr(codes.DeadlineExceeded))
+		}
+
+		_, reached := call(context.Background(), b, nil)
+		assert.True(t, reached)
+		assert.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("success: errors of the request do not count", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := newTestBreaker()
+		for _, c := range []codes.Code{codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.PermissionDenied} {
+			for range breakerFailures {
+				call(context.Background(), b, codeErr(c))
+			}
+		}
+
+		assert.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("error: opens after the failure limit and fails fast", func(t *testing.T) {
+		t.Parallel()
+
+		b, clk := newTestBreaker()
+		open(t, b)
+
+		clk.Add(breakerOpenFor - time.Nanosecond)
+		err, reached := call(context.Background(), b, nil)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.False(t, reached)
+	})
+
+	t.Run("success: a probe after the open period closes the circuit", func(t *testing.T) {
+		t.Parallel()
+
+		b, clk := newTestBreaker()
+		open(t, b)
+		clk.Add(breakerOpenFor)
+
+		err, reached := call(context.Background(), b, nil)
+		require.NoError(t, err)
+		assert.True(t, reached)
+		assert.Equal(t, stateClosed, b.state)
+
+		// The failure count starts over.
+		for range breakerFailures - 1 {
+			call(context.Background(), b, codeErr(codes.Unavailable))
+		}
+		assert.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("error: a failed probe reopens the circuit for another period", func(t *testing.T) {
+		t.Parallel()
+
+		b, clk := newTestBreaker()
+		open(t, b)
+		clk.Add(breakerOpenFor)
+
+		_, reached := call(context.Background(), b, codeErr(codes.Unavailable))
+		require.True(t, reached)
+		assert.Equal(t, stateOpen, b.state)
+
+		clk.Add(breakerOpenFor / 2)
+		_, reached = call(context.Background(), b, nil)
+		assert.False(t, reached)
+
+		clk.Add(breakerOpenFor / 2)
+		_, reached = call(context.Background(), b, nil)
+		assert.True(t, reached)
+		assert.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("error: a single probe at a time while half-open", func(t *testing.T) {
+		t.Parallel()
+
+		b, clk := newTestBreaker()
+		open(t, b)
+		clk.Add(breakerOpenFor)
+
+		require.True(t, b.allow())
+		assert.Equal(t, stateHalfOpen, b.state)
+		assert.False(t, b.allow(), "a second probe was let through")
+
+		b.record(context.Background(), "GetPayment", false)
+		assert.Equal(t, stateClosed, b.state)
+		assert.True(t, b.allow())
+	})
+
+	t.Run("success: a cancelled probe lets another one through", func(t *testing.T) {
+		t.Parallel()
+
+		b, clk := newTestBreaker()
+		open(t, b)
+		clk.Add(breakerOpenFor)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, reached := call(ctx, b, codeErr(codes.Canceled))
+		require.True(t, reached)
+		assert.Equal(t, stateHalfOpen, b.state)
+
+		_, reached = call(context.Background(), b, nil)
+		assert.True(t, reached)
+		assert.Equal(t, stateClosed, b.state)
+	})
+}
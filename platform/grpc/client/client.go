@@ -0,0 +1,155 @@
+// Package client builds gRPC client connections that do not hang on a slow
+// or failing server: every call gets a deadline, idempotent calls are retried
+// with backoff, and a circuit breaker fails calls fast while the server is
+// down. Calls are logged and measured.
+package client
+
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Builder configures a client connection. The zero values of its settings
+// turn the matching feature off.
+type Builder struct {
+	target string
+
+	timeout        time.Duration
+	methodTimeouts map[string]time.Duration
+
+	retry        RetryPolicy
+	retryMethods map[string]struct{}
+
+	breakerFailures int
+	breakerOpenFor  time.Duration
+
+	keepalive *keepalive.ClientParameters
+	creds     credentials.TransportCredentials
+
+	interceptors []grpc.UnaryClientInterceptor
+	dialOptions  []grpc.DialOption
+}
+
+// New starts a builder of a connection to target, like "localhost:50051".
+func New(target string) *Builder {
+	return &Builder{
+		target:         target,
+		methodTimeouts: make(map[string]time.Duration),
+		retryMethods:   make(map[string]struct{}),
+		creds:          insecure.NewCredentials(),
+	}
+}
+
+// WithTimeout sets the deadline of the calls without a timeout of their own.
+// A shorter deadline of the caller's context wins.
+func (b *Builder) WithTimeout(d time.Duration) *Builder {
+	b.timeout = d
+	return b
+}
+
+// WithMethodTimeouts sets the deadlines of single methods, keyed by the full
+// method name, like "/payment.v1.PaymentService/PayOrder", or by the method
+// name alone, like "PayOrder".
+func (b *Builder) WithMethodTimeouts(timeouts map[string]time.Duration) *Builder {
+	for method, d := range timeouts {
+		b.methodTimeouts[method] = d
+	}
+	return b
+}
+
+// WithRetry retries the calls of the given methods that fail with
+// Unavailable. Only idempotent methods may be listed: a call the server did
+// execute may still fail on the way back.
+func (b *Builder) WithRetry(policy RetryPolicy, methods ...string) *Builder {
+	b.retry = policy
+	for _, m := range methods {
+		b.retryMethods[m] = struct{}{}
+	}
+	return b
+}
+
+// WithCircuitBreaker opens the circuit after failures consecutive attempts
+// fail because of the server, retries included, and keeps it open for
+// openFor. While it is open the calls fail with ErrCircuitOpen without
+// reaching the server; then a single call probes whether it is back.
+func (b *Builder) WithCircuitBreaker(failures int, openFor time.Duration) *Builder {
+	b.breakerFailures = failures
+	b.breakerOpenFor = openFor
+	return b
+}
+
+// WithKeepalive pings the server after time without activity during a call
+// and closes the connection if the ping is not answered within timeout. The
+// server must permit pings that often: by default a gRPC server allows one
+// every 5 minutes and closes the connection of a client that pings more.
+func (b *Builder) WithKeepalive(time, timeout time.Duration) *Builder {
+	b.keepalive = &keepalive.ClientParameters{
+		Time:    time,
+		Timeout: timeout,
+	}
+	return b
+}
+
+// WithTLS connects over TLS with cfg, like the one of tlsconfig.Client. A nil
+// cfg keeps the default insecure credentials.
+func (b *Builder) WithTLS(cfg *tls.Config) *Builder {
+	if cfg != nil {
+		b.creds = credentials.NewTLS(cfg)
+	}
+	return b
+}
+
+// WithTransportCredentials replaces the default insecure credentials.
+func (b *Builder) WithTransportCredentials(creds credentials.TransportCredentials) *Builder {
+	b.creds = creds
+	return b
+}
+
+// WithInterceptors adds interceptors that run inside the built-in ones, once
+// per attempt.
+func (b *Builder) WithInterceptors(interceptors ...grpc.UnaryClientInterceptor) *Builder {
+	b.interceptors = append(b.interceptors, interceptors...)
+	return b
+}
+
+// WithDialOptions adds raw dial options.
+func (b *Builder) WithDialOptions(opts ...grpc.DialOption) *Builder {
+	b.dialOptions = append(b.dialOptions, opts...)
+	return b
+}
+
+// Build creates the connection. Like grpc.NewClient it does not connect
+// until the first call.
+func (b *Builder) Build() (*grpc.ClientConn, error) {
+	// The first interceptor is the outermost one: a call is logged and
+	// measured once, with its deadline spanning all of its attempts, and the
+	// breaker sees every attempt.
+	chain := []grpc.UnaryClientInterceptor{
+		UnaryLogging(),
+		UnaryMetrics(b.target),
+		unaryTimeout(b.timeout, b.methodTimeouts),
+	}
+	if len(b.retryMethods) > 0 && b.retry.MaxAttempts > 1 {
+		chain = append(chain, unaryRetry(b.retry, b.retryMethods))
+	}
+	if b.breakerFailures > 0 {
+		chain = append(chain, newBreaker(b.target, b.breakerFailures, b.breakerOpenFor).unary())
+	}
+	chain = append(chain, b.interceptors...)
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(b.creds),
+		grpc.WithChainUnaryInterceptor(chain...),
+	}
+	if b.keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*b.keepalive))
+	}
+	opts = append(opts, b.dialOptions...)
+
+	return grpc.NewClient(b.target, opts...)
+}
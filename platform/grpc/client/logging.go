@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// UnaryLogging logs every call with its code and duration. Successful calls
+// are logged at debug level: the server logs them too.
+func UnaryLogging() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		log := logger.With(
+			logger.String("target", cc.Target()),
+			logger.String("method", path.Base(method)),
+		)
+
+		d := time.Since(start)
+		if err != nil {
+			log.Error(ctx, "grpc client",
+				logger.String("code", status.Code(err).String()),
+				logger.Duration("dur", d),
+				logger.ErrorF(err),
+			)
+			return err
+		}
+
+		log.Debug(ctx, "grpc client",
+			logger.String("code", "OK"),
+			logger.Duration("dur", d),
+		)
+		return nil
+	}
+}
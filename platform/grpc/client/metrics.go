@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const meterName = "github.com/you-humble/rocket-maintenance/platform/grpc/client"
+
+// UnaryMetrics counts the calls and records their duration with the global
+// OpenTelemetry meter provider, by target, method and code. Nothing is
+// recorded until the service installs a provider.
+func UnaryMetrics(target string) grpc.UnaryClientInterceptor {
+	meter := otel.GetMeterProvider().Meter(meterName)
+
+	// The instruments of the global provider never fail to be created; on
+	// error they are no-ops.
+	calls, _ := meter.Int64Counter("rpc.client.calls",
+		metric.WithDescription("Number of finished gRPC client calls."),
+	)
+	duration, _ := meter.Float64Histogram("rpc.client.duration",
+		metric.WithDescription("Duration of gRPC client calls."),
+		metric.WithUnit("s"),
+	)
+
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		attrs := metric.WithAttributes(
+			attribute.String("rpc.target", target),
+			attribute.String("rpc.method", path.Base(method)),
+			attribute.String("rpc.grpc.status_code", status.Code(err).String()),
+		)
+		calls.Add(ctx, 1, attrs)
+		duration.Record(ctx, time.Since(start).Seconds(), attrs)
+
+		return err
+	}
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// RetryPolicy is the retry of idempotent calls. The backoff before the n-th
+// retry is random up to min(InitialBackoff*2^(n-1), MaxBackoff).
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt too; below 2 there is no retry.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff << (retry - 1)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d) + 1
+}
+
+func unaryRetry(policy RetryPolicy, methods map[string]struct{}) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := methods[method]; !ok {
+			if _, ok := methods[path.Base(method)]; !ok {
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+		}
+
+		var err error
+		for attempt := 1; ; attempt++ {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if !retryable(err) || attempt == policy.MaxAttempts {
+				return err
+			}
+
+			wait := policy.backoff(attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+				return err
+			}
+
+			logger.Warn(ctx, "grpc client retry",
+				logger.String("method", path.Base(method)),
+				logger.Int("attempt", attempt),
+				logger.Duration("backoff", wait),
+				logger.ErrorF(err),
+			)
+
+			t := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+		}
+	}
+}
+
+// retryable reports whether the call failed before the server could act on
+// it. An open circuit is not retried: it stays open for longer than a backoff.
+func retryable(err error) bool {
+	if err == nil || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	return status.Code(err) == codes.Unavailable
+}
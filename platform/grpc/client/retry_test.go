@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const testMethod = "/payment.v1.PaymentService/GetPayment"
+
+// invoker answers the attempts with the errors in turn and repeats the last
+// one when they run out.
+type invoker struct {
+	mu       sync.Mutex
+	errs     []error
+	attempts int
+}
+
+func newInvoker(errs ...error) *invoker {
+	return &invoker{errs: errs}
+}
+
+func (i *invoker) invoke(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.attempts++
+	if len(i.errs) == 0 {
+		return nil
+	}
+	err := i.errs[0]
+	if len(i.errs) > 1 {
+		i.errs = i.errs[1:]
+	}
+	return err
+}
+
+func (i *invoker) calls() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.attempts
+}
+
+func codeErr(c codes.Code) error {
+	return status.Error(c, c.String())
+}
+
+func TestUnaryRetry(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	retried := map[string]struct{}{"GetPayment": {}}
+
+	type testCase struct {
+		name         string
+		methods      map[string]struct{}
+		errs         []error
+		wantCode     codes.Code
+		wantAttempts int
+	}
+
+	tests := []testCase{
+		{
+			name:         "success: the first attempt",
+			methods:      retried,
+			wantCode:     codes.OK,
+			wantAttempts: 1,
+		},
+		{
+			name:         "success: Unavailable is retried",
+			methods:      retried,
+			errs:         []error{codeErr(codes.Unavailable), codeErr(codes.Unavailable), nil},
+			wantCode:     codes.OK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "success: the full method name is matched too",
+			methods:      map[string]struct{}{testMethod: {}},
+			errs:         []error{codeErr(codes.Unavailable), nil},
+			wantCode:     codes.OK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "error: the attempts run out",
+			methods:      retried,
+			errs:         []error{codeErr(codes.Unavailable)},
+			wantCode:     codes.Unavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:         "error: a method not listed is not retried",
+			methods:      map[string]struct{}{"PayOrder": {}},
+			errs:         []error{codeErr(codes.Unavailable)},
+			wantCode:     codes.Unavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "error: an open circuit is not retried",
+			methods:      retried,
+			errs:         []error{ErrCircuitOpen},
+			wantCode:     codes.Unavailable,
+			wantAttempts: 1,
+		},
+	}
+	for _, c := range []codes.Code{
+		codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted,
+		codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.Aborted,
+	} {
+		tests = append(tests, testCase{
+			name:         "error: " + c.String() + " is not retried",
+			methods:      retried,
+			errs:         []error{codeErr(c)},
+			wantCode:     c,
+			wantAttempts: 1,
+		})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			inv := newInvoker(tt.errs...)
+			err := unaryRetry(policy, tt.methods)(context.Background(), testMethod, nil, nil, nil, inv.invoke)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantAttempts, inv.calls())
+		})
+	}
+}
+
+func TestUnaryRetryContext(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	// The backoff is far longer than the test may take.
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	retried := map[string]struct{}{"GetPayment": {}}
+
+	t.Run("error: a cancelled context stops the backoff", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		inv := newInvoker(codeErr(codes.Unavailable))
+
+		done := make(chan error, 1)
+		go func() {
+			done <- unaryRetry(policy, retried)(ctx, testMethod, nil, nil, nil, inv.invoke)
+		}()
+
+		require.Eventually(t, func() bool { return inv.calls() == 1 }, time.Second, time.Millisecond)
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		case <-time.After(time.Second):
+			t.Fatal("the retry did not stop on cancellation")
+		}
+		assert.Equal(t, 1, inv.calls())
+	})
+
+	t.Run("error: no retry when the backoff outlasts the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		inv := newInvoker(codeErr(codes.Unavailable))
+
+		start := time.Now()
+		err := unaryRetry(policy, retried)(ctx, testMethod, nil, nil, nil, inv.invoke)
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, inv.calls())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	tests := []struct {
+		retry int
+		upTo  time.Duration
+	}{
+		{retry: 1, upTo: 10 * time.Millisecond},
+		{retry: 2, upTo: 20 * time.Millisecond},
+		{retry: 3, upTo: 40 * time.Millisecond},
+		{retry: 4, upTo: 50 * time.Millisecond},
+		{retry: 64, upTo: 50 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		for range 100 {
+			d := policy.backoff(tt.retry)
+			require.Positive(t, d, "retry %d", tt.retry)
+			require.LessOrEqual(t, d, tt.upTo, "retry %d", tt.retry)
+		}
+	}
+
+	assert.Zero(t, RetryPolicy{}.backoff(1))
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func unaryTimeout(def time.Duration, methods map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		timeout := methodTimeout(def, methods, method)
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func methodTimeout(def time.Duration, methods map[string]time.Duration, method string) time.Duration {
+	if d, ok := methods[method]; ok {
+		return d
+	}
+	if d, ok := methods[path.Base(method)]; ok {
+		return d
+	}
+	return def
+}
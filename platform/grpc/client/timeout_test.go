@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryTimeout(t *testing.T) {
+	t.Parallel()
+
+	methods := map[string]time.Duration{
+		"GetPayment":                          2 * time.Second,
+		"/payment.v1.PaymentService/PayOrder": 3 * time.Second,
+	}
+
+	tests := []struct {
+		name     string
+		def      time.Duration
+		method   string
+		caller   time.Duration // 0: the caller sets no deadline
+		wantLeft time.Duration // 0: the call has no deadline
+	}{
+		{name: "success: the default", def: time.Second, method: "/payment.v1.PaymentService/CancelPayment", wantLeft: time.Second},
+		{name: "success: by method name", def: time.Second, method: testMethod, wantLeft: 2 * time.Second},
+		{name: "success: by full method name", def: time.Second, method: "/payment.v1.PaymentService/PayOrder", wantLeft: 3 * time.Second},
+		{name: "success: a shorter deadline of the caller wins", def: time.Second, method: testMethod, caller: 500 * time.Millisecond, wantLeft: 500 * time.Millisecond},
+		{name: "success: a longer deadline of the caller loses", def: time.Second, method: testMethod, caller: time.Minute, wantLeft: 2 * time.Second},
+		{name: "success: no timeout", method: "/payment.v1.PaymentService/CancelPayment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.caller > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.caller)
+				defer cancel()
+			}
+
+			var (
+				deadline time.Time
+				ok       bool
+			)
+			start := time.Now()
+			err := unaryTimeout(tt.def, methods)(ctx, tt.method, nil, nil, nil,
+				func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+					deadline, ok = ctx.Deadline()
+					return nil
+				})
+			require.NoError(t, err)
+
+			if tt.wantLeft == 0 {
+				assert.False(t, ok, "unexpected deadline")
+				return
+			}
+			require.True(t, ok, "no deadline")
+			assert.WithinDuration(t, start.Add(tt.wantLeft), deadline, 100*time.Millisecond)
+		})
+	}
+}
@@ -2,31 +2,106 @@ package health
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/health"
 )
 
-// Server implements the gRPC Health Checking Protocol (GRPC Health v1)
+// watchInterval is how often a Watch stream re-checks the status.
+const watchInterval = 5 * time.Second
+
+// ServiceInfoProvider lists the services of a server, like *grpc.Server.
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+// Server implements the gRPC Health Checking Protocol (GRPC Health v1). The
+// status follows the readiness of the service: NOT_SERVING while a
+// dependency fails or the service shuts down. It knows the server as a
+// whole, under the empty name, and every service registered on services.
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	health   *health.Health
+	services ServiceInfoProvider
+}
+
+func NewServer(h *health.Health, services ServiceInfoProvider) *Server {
+	return &Server{health: h, services: services}
 }
 
-// Check implements the standard grpc health check protocol
+// Check implements the standard grpc health check protocol: an unknown
+// service is NotFound.
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if !s.known(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: s.status(ctx),
 	}, nil
 }
 
-// Watch implements the standard grpc health check protocol
+// Watch implements the standard grpc health check protocol: it sends the
+// current status and then every change of it until the client leaves. An
+// unknown service is SERVICE_UNKNOWN, as it may be registered later. On
+// shutdown it sends NOT_SERVING and ends, so that it does not hold up a
+// graceful stop of the server.
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	ctx := stream.Context()
+
+	t := time.NewTicker(watchInterval)
+	defer t.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		st := grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		if s.known(req.GetService()) {
+			st = s.status(ctx)
+		}
+		if st != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.health.ShuttingDown():
+			if last == grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+				return nil
+			}
+			return stream.Send(&grpc_health_v1.HealthCheckResponse{
+				Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+			})
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Server) known(service string) bool {
+	if service == "" {
+		return true
+	}
+	_, ok := s.services.GetServiceInfo()[service]
+	return ok
+}
+
+func (s *Server) status(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if !s.health.Ready(ctx).Up() {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
 }
 
 // RegisterService registers the health service with the gRPC server
-func RegisterService(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+func RegisterService(s *grpc.Server, h *health.Health) {
+	grpc_health_v1.RegisterHealthServer(s, NewServer(h, s))
 }
@@ -0,0 +1,143 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/health"
+)
+
+const inventoryService = "inventory.v1.InventoryService"
+
+type services map[string]grpc.ServiceInfo
+
+func (s services) GetServiceInfo() map[string]grpc.ServiceInfo { return s }
+
+func newServer(check health.Check) (*Server, *health.Health) {
+	h := health.New(health.WithCacheTTL(0))
+	h.Register("postgres", check)
+
+	return NewServer(h, services{inventoryService: {}}), h
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name       string
+		service    string
+		check      health.Check
+		shutdown   bool
+		wantCode   codes.Code
+		wantStatus grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{name: "success: the server as a whole", check: up, wantStatus: grpc_health_v1.HealthCheckResponse_SERVING},
+		{name: "success: a registered service", service: inventoryService, check: up, wantStatus: grpc_health_v1.HealthCheckResponse_SERVING},
+		{name: "success: a failing dependency", check: down, wantStatus: grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{name: "success: shutting down", service: inventoryService, check: up, shutdown: true, wantStatus: grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{name: "error: an unknown service", service: "payment.v1.PaymentService", check: up, wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, h := newServer(tt.check)
+			if tt.shutdown {
+				h.Shutdown()
+			}
+
+			resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: tt.service})
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.GetStatus())
+		})
+	}
+}
+
+// watchStream records the statuses a Watch sends.
+type watchStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent chan grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+func newWatchStream(ctx context.Context) *watchStream {
+	return &watchStream{ctx: ctx, sent: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10)}
+}
+
+func (w *watchStream) Context() context.Context { return w.ctx }
+
+func (w *watchStream) Send(resp *grpc_health_v1.HealthCheckResponse) error {
+	w.sent <- resp.GetStatus()
+	return nil
+}
+
+func (w *watchStream) next(t *testing.T) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	select {
+	case st := <-w.sent:
+		return st
+	case <-time.After(time.Second):
+		t.Fatal("no status sent")
+		return grpc_health_v1.HealthCheckResponse_UNKNOWN
+	}
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+
+	up := func(context.Context) error { return nil }
+
+	t.Run("success: an unknown service is SERVICE_UNKNOWN", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newServer(up)
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := newWatchStream(ctx)
+
+		done := make(chan error, 1)
+		go func() {
+			done <- s.Watch(&grpc_health_v1.HealthCheckRequest{Service: "payment.v1.PaymentService"}, stream)
+		}()
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, stream.next(t))
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("success: shutdown sends NOT_SERVING and ends the stream", func(t *testing.T) {
+		t.Parallel()
+
+		s, h := newServer(up)
+		stream := newWatchStream(context.Background())
+
+		done := make(chan error, 1)
+		go func() {
+			done <- s.Watch(&grpc_health_v1.HealthCheckRequest{Service: inventoryService}, stream)
+		}()
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, stream.next(t))
+		h.Shutdown()
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, stream.next(t))
+		require.NoError(t, <-done)
+	})
+}
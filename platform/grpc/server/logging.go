@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// UnaryLogging logs every call with its code and duration.
+func UnaryLogging() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logCall(ctx, info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// StreamLogging logs every stream with its code and duration when it ends.
+func StreamLogging() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		logCall(ss.Context(), info.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, method string, d time.Duration, err error) {
+	log := logger.With(logger.String("method", path.Base(method)))
+
+	if err != nil {
+		log.Error(ctx, "grpc",
+			logger.String("code", status.Code(err).String()),
+			logger.Duration("dur", d),
+			logger.ErrorF(err),
+		)
+		return
+	}
+
+	log.Info(ctx, "grpc",
+		logger.String("code", "OK"),
+		logger.Duration("dur", d),
+	)
+}
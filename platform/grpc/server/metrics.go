@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const meterName = "github.com/you-humble/rocket-maintenance/platform/grpc/server"
+
+type instruments struct {
+	calls    metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// newInstruments creates the instruments with the global OpenTelemetry
+// meter provider. Nothing is recorded until the service installs a provider.
+func newInstruments() instruments {
+	meter := otel.GetMeterProvider().Meter(meterName)
+
+	// The instruments of the global provider never fail to be created; on
+	// error they are no-ops.
+	calls, _ := meter.Int64Counter("rpc.server.calls",
+		metric.WithDescription("Number of finished gRPC server calls."),
+	)
+	duration, _ := meter.Float64Histogram("rpc.server.duration",
+		metric.WithDescription("Duration of gRPC server calls."),
+		metric.WithUnit("s"),
+	)
+
+	return instruments{calls: calls, duration: duration}
+}
+
+func (i instruments) record(ctx context.Context, method string, start time.Time, err error) {
+	attrs := metric.WithAttributes(
+		attribute.String("rpc.method", path.Base(method)),
+		attribute.String("rpc.grpc.status_code", status.Code(err).String()),
+	)
+	i.calls.Add(ctx, 1, attrs)
+	i.duration.Record(ctx, time.Since(start).Seconds(), attrs)
+}
+
+// UnaryMetrics counts the calls and records their duration by method and
+// code.
+func UnaryMetrics() grpc.UnaryServerInterceptor {
+	m := newInstruments()
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.record(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamMetrics counts the streams and records their duration by method and
+// code.
+func StreamMetrics() grpc.StreamServerInterceptor {
+	m := newInstruments()
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		m.record(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"path"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+var errPanic = status.Error(codes.Internal, "internal error")
+
+// UnaryRecovery turns a panic of the handler into an Internal error of the
+// call, so that a bug fails one call rather than the process.
+func UnaryRecovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(ctx, info.FullMethod, r)
+				resp, err = nil, errPanic
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery turns a panic of the handler into an Internal error of the
+// stream.
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(ss.Context(), info.FullMethod, r)
+				err = errPanic
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func logPanic(ctx context.Context, method string, r any) {
+	logger.Error(ctx, "grpc panic",
+		logger.String("method", path.Base(method)),
+		logger.Any("panic", r),
+		logger.String("stack", string(debug.Stack())),
+	)
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+func TestUnaryRecovery(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	errNotFound := status.Error(codes.NotFound, "order not found")
+
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantResp any
+		wantErr  error
+	}{
+		{
+			name:     "success: the response of the handler",
+			handler:  func(context.Context, any) (any, error) { return "ok", nil },
+			wantResp: "ok",
+		},
+		{
+			name:    "success: the error of the handler",
+			handler: func(context.Context, any) (any, error) { return nil, errNotFound },
+			wantErr: errNotFound,
+		},
+		{
+			name:    "internal: a panic",
+			handler: func(context.Context, any) (any, error) { panic("nil map") },
+			wantErr: errPanic,
+		},
+		{
+			name:    "internal: a panic with an error",
+			handler: func(context.Context, any) (any, error) { panic(errors.New("index out of range")) },
+			wantErr: errPanic,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				resp any
+				err  error
+			)
+			require.NotPanics(t, func() {
+				resp, err = UnaryRecovery()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/Echo"}, tt.handler)
+			})
+
+			assert.Equal(t, tt.wantResp, resp)
+			assert.Equal(t, tt.wantErr, err)
+			if errors.Is(tt.wantErr, errPanic) {
+				assert.Equal(t, codes.Internal, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestStreamRecovery(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	ss := &recvStream{}
+	info := &grpc.StreamServerInfo{FullMethod: "/test.v1.TestService/Stream"}
+
+	err := StreamRecovery()(nil, ss, info, func(any, grpc.ServerStream) error { panic("nil map") })
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	err = StreamRecovery()(nil, ss, info, func(any, grpc.ServerStream) error { return nil })
+	assert.NoError(t, err)
+}
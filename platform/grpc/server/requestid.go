@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	// RequestIDHeader carries the id of a request. A request without one gets
+	// a new id; either way it is sent back in the response header.
+	RequestIDHeader = "x-request-id"
+	// traceParentHeader is the W3C Trace Context header.
+	traceParentHeader = "traceparent"
+)
+
+// traceParent matches "version-trace_id-parent_id-flags".
+var traceParent = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+func unaryRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := withRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+
+		return handler(ctx, req)
+	}
+}
+
+func streamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := withRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, id))
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withRequestID puts the request id and the trace id of the incoming
+// metadata in the context of the logger.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	id := first(md, RequestIDHeader)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	ctx = logger.ContextWithRequestID(ctx, id)
+
+	if m := traceParent.FindStringSubmatch(first(md, traceParentHeader)); m != nil {
+		ctx = logger.ContextWithTraceID(ctx, m[1])
+	}
+
+	return ctx, id
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
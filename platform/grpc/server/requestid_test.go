@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+func TestWithRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		md          metadata.MD
+		wantID      string // empty: a new id
+		wantTraceID string
+	}{
+		{name: "success: the id of the caller", md: metadata.Pairs(RequestIDHeader, "req-1"), wantID: "req-1"},
+		{name: "success: a new id without one", md: metadata.MD{}},
+		{name: "success: a new id without metadata"},
+		{
+			name:        "success: the trace id of the traceparent",
+			md:          metadata.Pairs(RequestIDHeader, "req-1", traceParentHeader, "00-"+traceID+"-00f067aa0ba902b7-01"),
+			wantID:      "req-1",
+			wantTraceID: traceID,
+		},
+		{
+			name:   "success: a malformed traceparent is ignored",
+			md:     metadata.Pairs(RequestIDHeader, "req-1", traceParentHeader, "00-"+traceID+"-01"),
+			wantID: "req-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			ctx, id := withRequestID(ctx)
+			if tt.wantID == "" {
+				assert.NoError(t, uuid.Validate(id), "id %q", id)
+			} else {
+				assert.Equal(t, tt.wantID, id)
+			}
+			assert.Equal(t, id, ctx.Value(logger.Key("request_id")))
+
+			if tt.wantTraceID == "" {
+				assert.Nil(t, ctx.Value(logger.Key("trace_id")))
+			} else {
+				assert.Equal(t, tt.wantTraceID, ctx.Value(logger.Key("trace_id")))
+			}
+		})
+	}
+}
+
+func TestStreamRequestID(t *testing.T) {
+	t.Parallel()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "req-1"))
+	ss := &headerStream{ctx: ctx}
+
+	var got any
+	err := streamRequestID()(nil, ss, nil, func(_ any, ss grpc.ServerStream) error {
+		got = ss.Context().Value(logger.Key("request_id"))
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "req-1", got)
+	assert.Equal(t, []string{"req-1"}, ss.header.Get(RequestIDHeader))
+}
+
+// headerStream is a server stream that records its header.
+type headerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *headerStream) Context() context.Context { return s.ctx }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
@@ -0,0 +1,200 @@
+// Package server builds gRPC servers with the same behaviour in every
+// service: calls get a request id, are logged and measured, a panic fails
+// the call instead of the process, and requests are validated against the
+// protovalidate rules of their .proto files. The server reports its health
+// and stops gracefully on shutdown.
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/you-humble/rocket-maintenance/platform/closer"
+	grpchealth "github.com/you-humble/rocket-maintenance/platform/grpc/health"
+	"github.com/you-humble/rocket-maintenance/platform/health"
+)
+
+const defaultShutdownTimeout = 10 * time.Second
+
+// Builder configures a server.
+type Builder struct {
+	name string
+
+	validate   bool
+	health     *health.Health
+	reflection bool
+	tls        *tls.Config
+
+	shutdownTimeout time.Duration
+
+	unary   []grpc.UnaryServerInterceptor
+	stream  []grpc.StreamServerInterceptor
+	options []grpc.ServerOption
+}
+
+// New starts a builder of a server; name identifies it in the logs of the
+// shutdown.
+func New(name string) *Builder {
+	return &Builder{
+		name:            name,
+		shutdownTimeout: defaultShutdownTimeout,
+	}
+}
+
+// WithValidation rejects the requests that break the protovalidate rules of
+// their messages with InvalidArgument, before they reach the handler.
+func (b *Builder) WithValidation() *Builder {
+	b.validate = true
+	return b
+}
+
+// WithHealth registers the gRPC health service backed by h. The server
+// reports NOT_SERVING from the start of its shutdown.
+func (b *Builder) WithHealth(h *health.Health) *Builder {
+	b.health = h
+	return b
+}
+
+// WithReflection registers the server reflection service.
+func (b *Builder) WithReflection() *Builder {
+	b.reflection = true
+	return b
+}
+
+// WithTLS serves over TLS with cfg, like the one of tlsconfig.Server. A nil
+// cfg keeps the server in plaintext.
+func (b *Builder) WithTLS(cfg *tls.Config) *Builder {
+	b.tls = cfg
+	return b
+}
+
+// WithShutdownTimeout limits how long Shutdown waits for the calls in
+// flight before it cancels them.
+func (b *Builder) WithShutdownTimeout(d time.Duration) *Builder {
+	b.shutdownTimeout = d
+	return b
+}
+
+// WithUnaryInterceptors adds interceptors that run inside the built-in ones.
+func (b *Builder) WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) *Builder {
+	b.unary = append(b.unary, interceptors...)
+	return b
+}
+
+// WithStreamInterceptors adds interceptors that run inside the built-in ones.
+func (b *Builder) WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) *Builder {
+	b.stream = append(b.stream, interceptors...)
+	return b
+}
+
+// WithServerOptions adds raw server options.
+func (b *Builder) WithServerOptions(opts ...grpc.ServerOption) *Builder {
+	b.options = append(b.options, opts...)
+	return b
+}
+
+// Build creates the server and registers its Shutdown with the closer. The
+// services of the application are registered on the returned server.
+func (b *Builder) Build() (*Server, error) {
+	const op = "grpc.server.Build"
+
+	// The first interceptor is the outermost one: the request id is in the
+	// context of every log line, and a recovered panic is logged and measured
+	// as an Internal error.
+	unary := []grpc.UnaryServerInterceptor{
+		unaryRequestID(),
+		UnaryLogging(),
+		UnaryMetrics(),
+		UnaryRecovery(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		streamRequestID(),
+		StreamLogging(),
+		StreamMetrics(),
+		StreamRecovery(),
+	}
+	if b.validate {
+		v, err := protovalidate.New()
+		if err != nil {
+			return nil, fmt.Errorf("%s: create validator: %w", op, err)
+		}
+		unary = append(unary, UnaryValidation(v))
+		stream = append(stream, StreamValidation(v))
+	}
+	unary = append(unary, b.unary...)
+	stream = append(stream, b.stream...)
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if b.tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(b.tls)))
+	}
+	opts = append(opts, b.options...)
+
+	s := &Server{
+		Server:  grpc.NewServer(opts...),
+		health:  b.health,
+		timeout: b.shutdownTimeout,
+	}
+	if b.health != nil {
+		grpchealth.RegisterService(s.Server, b.health)
+	}
+	if b.reflection {
+		reflection.Register(s.Server)
+	}
+
+	closer.AddNamed(b.name, s.Shutdown)
+
+	return s, nil
+}
+
+// Server is a gRPC server that stops gracefully on Shutdown.
+type Server struct {
+	*grpc.Server
+
+	health  *health.Health
+	timeout time.Duration
+
+	shutdown sync.Once
+	err      error
+}
+
+// Shutdown reports the service not ready and stops the server: it stops
+// accepting calls and waits for the calls in flight. When they outlast the
+// shutdown timeout or ctx, it cancels them. Only the first call stops the
+// server; the others return its result.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.shutdown.Do(func() {
+		if s.health != nil {
+			s.health.Shutdown()
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			s.Stop()
+			s.err = fmt.Errorf("calls cancelled: %w", ctx.Err())
+		}
+	})
+
+	return s.err
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const echoMethod = "/test.v1.TestService/Echo"
+
+// echoService answers a Request with the request id of its context; an id of
+// "panic" panics.
+var echoService = grpc.ServiceDesc{
+	ServiceName: "test.v1.TestService",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Echo",
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := dynamicpb.NewMessage(testFile.Messages().ByName("Request"))
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				if idOf(req.(*dynamicpb.Message)) == "panic" {
+					panic("echo")
+				}
+				id, _ := ctx.Value(logger.Key("request_id")).(string)
+				return newRequest(id), nil
+			}
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: echoMethod}, handler)
+		},
+	}},
+}
+
+func newTestConn(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	s, err := New("test").WithValidation().Build()
+	require.NoError(t, err)
+	s.RegisterService(&echoService, struct{}{})
+
+	ln := bufconn.Listen(1 << 20)
+	go func() { _ = s.Serve(ln) }()
+	t.Cleanup(func() { _ = s.Shutdown(context.Background()) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestServer(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	conn := newTestConn(t)
+
+	tests := []struct {
+		name      string
+		id        string
+		requestID string // sent by the caller
+		wantCode  codes.Code
+	}{
+		{name: "success: the request id of the caller", id: "abc", requestID: "req-1"},
+		{name: "success: a new request id", id: "abc"},
+		{name: "invalid: a broken rule", id: "ab", requestID: "req-1", wantCode: codes.InvalidArgument},
+		{name: "internal: a panic of the handler", id: "panic", requestID: "req-1", wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.requestID != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, tt.requestID)
+			}
+
+			var header metadata.MD
+			reply := dynamicpb.NewMessage(testFile.Messages().ByName("Request"))
+			err := conn.Invoke(ctx, echoMethod, newRequest(tt.id), reply, grpc.Header(&header))
+
+			// The request id comes back on failures too.
+			ids := header.Get(RequestIDHeader)
+			require.Len(t, ids, 1)
+			if tt.requestID != "" {
+				assert.Equal(t, tt.requestID, ids[0])
+			} else {
+				assert.NoError(t, uuid.Validate(ids[0]), "id %q", ids[0])
+			}
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			// The handler sees the id in its context.
+			assert.Equal(t, ids[0], idOf(reply))
+		})
+	}
+}
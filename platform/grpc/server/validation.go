@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryValidation rejects a request that breaks the rules of its message
+// with InvalidArgument and the broken rules in the message of the status.
+func UnaryValidation(v protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate(v, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidation validates every message the client sends on a stream.
+func StreamValidation(v protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, v: v})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	v protovalidate.Validator
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(s.v, m)
+}
+
+func validate(v protovalidate.Validator, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil || !msg.ProtoReflect().IsValid() {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+
+	err := v.Validate(msg)
+	if err == nil {
+		return nil
+	}
+
+	var verr *protovalidate.ValidationError
+	if errors.As(err, &verr) {
+		return status.Error(codes.InvalidArgument, verr.Error())
+	}
+	// The rules themselves are broken: a bug of the .proto file.
+	return status.Error(codes.Internal, "validate request")
+}
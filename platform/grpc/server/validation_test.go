@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	validatepb "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testFile describes test.v1: Request has an id of at least 3 characters;
+// Broken carries a string rule on an int field, which protovalidate cannot
+// compile.
+var testFile = func() protoreflect.FileDescriptor {
+	rules := func(r *validatepb.FieldRules) *descriptorpb.FieldOptions {
+		opts := &descriptorpb.FieldOptions{}
+		proto.SetExtension(opts, validatepb.E_Field, r)
+		return opts
+	}
+	minLen := &validatepb.FieldRules{Type: &validatepb.FieldRules_String_{
+		String_: &validatepb.StringRules{MinLen: proto.Uint64(3)},
+	}}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/v1/test.proto"),
+		Package:    proto.String("test.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"buf/validate/validate.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("id"),
+					JsonName: proto.String("id"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Options:  rules(minLen),
+				}},
+			},
+			{
+				Name: proto.String("Broken"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("count"),
+					JsonName: proto.String("count"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+					Options:  rules(minLen),
+				}},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+	return fd
+}()
+
+func newRequest(id string) *dynamicpb.Message {
+	md := testFile.Messages().ByName("Request")
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("id"), protoreflect.ValueOfString(id))
+	return msg
+}
+
+func idOf(msg proto.Message) string {
+	return msg.ProtoReflect().Get(testFile.Messages().ByName("Request").Fields().ByName("id")).String()
+}
+
+func newValidator(t *testing.T) protovalidate.Validator {
+	t.Helper()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	return v
+}
+
+func TestUnaryValidation(t *testing.T) {
+	t.Parallel()
+
+	v := newValidator(t)
+
+	tests := []struct {
+		name        string
+		req         any
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{name: "success: a valid request", req: newRequest("abc")},
+		{name: "success: a message without rules", req: &grpc_health_v1.HealthCheckRequest{}},
+		{name: "invalid: a broken rule", req: newRequest("ab"), wantCode: codes.InvalidArgument, wantMessage: "id"},
+		{name: "invalid: a nil message", req: (*grpc_health_v1.HealthCheckRequest)(nil), wantCode: codes.InvalidArgument, wantMessage: "request is nil"},
+		{name: "invalid: not a message", req: "abc", wantCode: codes.InvalidArgument, wantMessage: "request is nil"},
+		{name: "internal: rules that do not compile", req: dynamicpb.NewMessage(testFile.Messages().ByName("Broken")), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			resp, err := UnaryValidation(v)(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/Echo"},
+				func(context.Context, any) (any, error) {
+					called = true
+					return "ok", nil
+				})
+
+			if tt.wantCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, "ok", resp)
+				assert.True(t, called)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.wantMessage)
+			assert.Nil(t, resp)
+			assert.False(t, called, "the handler got an invalid request")
+		})
+	}
+}
+
+// recvStream is a server stream that receives the messages in turn.
+type recvStream struct {
+	grpc.ServerStream
+	msgs []*dynamicpb.Message
+}
+
+func (s *recvStream) Context() context.Context { return context.Background() }
+
+func (s *recvStream) RecvMsg(m any) error {
+	if len(s.msgs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.msgs[0])
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestStreamValidation(t *testing.T) {
+	t.Parallel()
+
+	v := newValidator(t)
+	ss := &recvStream{msgs: []*dynamicpb.Message{newRequest("abc"), newRequest("ab")}}
+
+	var got []string
+	err := StreamValidation(v)(nil, ss, &grpc.StreamServerInfo{FullMethod: "/test.v1.TestService/Stream"},
+		func(_ any, ss grpc.ServerStream) error {
+			for {
+				msg := dynamicpb.NewMessage(testFile.Messages().ByName("Request"))
+				if err := ss.RecvMsg(msg); err != nil {
+					return err
+				}
+				got = append(got, idOf(msg))
+			}
+		})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"abc"}, got)
+}
@@ -0,0 +1,111 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/you-humble/rocket-maintenance/platform/closer"
+)
+
+// Pinger is a connection pool that can ping its server, like *pgxpool.Pool.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Ping checks that p reaches its server.
+func Ping(p Pinger) Check {
+	return p.Ping
+}
+
+// Mongo checks that the client reaches the primary of the replica set.
+func Mongo(client *mongo.Client) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
+
+// Kafka checks that the cluster answers a metadata request and has brokers.
+// cfg is the client config of the service, for its version and credentials;
+// nil uses the defaults. The check keeps a single client across probes and
+// replaces it only after a failure; the closer closes it on shutdown.
+func Kafka(brokers []string, cfg *sarama.Config) Check {
+	k := &kafkaCheck{brokers: brokers, cfg: cfg}
+	closer.AddNamed("kafka health check", k.close)
+
+	return k.check
+}
+
+type kafkaCheck struct {
+	brokers []string
+	cfg     *sarama.Config
+
+	mu     sync.Mutex
+	client sarama.Client
+}
+
+func (k *kafkaCheck) check(context.Context) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.client == nil {
+		// The client fetches the metadata of the cluster when it is created.
+		client, err := sarama.NewClient(k.brokers, k.cfg)
+		if err != nil {
+			return err
+		}
+		k.client = client
+	} else if err := k.client.RefreshMetadata(); err != nil {
+		k.reset()
+		return err
+	}
+
+	if len(k.client.Brokers()) == 0 {
+		k.reset()
+		return errors.New("no brokers in the cluster metadata")
+	}
+	return nil
+}
+
+func (k *kafkaCheck) close(context.Context) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.client == nil {
+		return nil
+	}
+	err := k.client.Close()
+	k.client = nil
+	return err
+}
+
+// reset drops a client that failed, so that the next probe connects anew.
+func (k *kafkaCheck) reset() {
+	_ = k.client.Close()
+	k.client = nil
+}
+
+// GRPC checks that a downstream service reports SERVING over the gRPC
+// Health Checking Protocol. An empty service asks about the server as a
+// whole.
+func GRPC(conn grpc.ClientConnInterface, service string) Check {
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", resp.GetStatus())
+		}
+		return nil
+	}
+}
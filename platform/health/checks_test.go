@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKafkaCheck(t *testing.T) (*kafkaCheck, *sarama.MockBroker) {
+	t.Helper()
+
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()),
+	})
+
+	cfg := sarama.NewConfig()
+	cfg.Metadata.Retry.Max = 0
+	cfg.Net.DialTimeout = 100 * time.Millisecond
+	cfg.Net.ReadTimeout = 100 * time.Millisecond
+	cfg.Net.WriteTimeout = 100 * time.Millisecond
+
+	k := &kafkaCheck{brokers: []string{broker.Addr()}, cfg: cfg}
+	t.Cleanup(func() { _ = k.close(context.Background()) })
+
+	return k, broker
+}
+
+func TestKafka(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success: the client is reused across probes", func(t *testing.T) {
+		t.Parallel()
+
+		k, broker := newKafkaCheck(t)
+		defer broker.Close()
+
+		require.NoError(t, k.check(context.Background()))
+		client := k.client
+		require.NotNil(t, client)
+
+		require.NoError(t, k.check(context.Background()))
+		assert.Same(t, client, k.client)
+	})
+
+	t.Run("down: a failed probe drops the client", func(t *testing.T) {
+		t.Parallel()
+
+		k, broker := newKafkaCheck(t)
+		require.NoError(t, k.check(context.Background()))
+
+		broker.Close()
+		require.Error(t, k.check(context.Background()))
+		assert.Nil(t, k.client)
+	})
+
+	t.Run("down: no cluster to connect to", func(t *testing.T) {
+		t.Parallel()
+
+		k, broker := newKafkaCheck(t)
+		broker.Close()
+
+		require.Error(t, k.check(context.Background()))
+		assert.Nil(t, k.client)
+	})
+
+	t.Run("success: close without a client", func(t *testing.T) {
+		t.Parallel()
+
+		k := &kafkaCheck{}
+		assert.NoError(t, k.close(context.Background()))
+	})
+}
@@ -0,0 +1,197 @@
+// Package health tells whether a service is alive and whether it is ready to
+// serve: the readiness runs named checks of the dependencies of the service,
+// each with a timeout, and caches the result so that frequent probes do not
+// load the dependencies.
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout  = 2 * time.Second
+	defaultCacheTTL = 5 * time.Second
+)
+
+// ErrShuttingDown is the readiness error of a service that is shutting down.
+var ErrShuttingDown = errors.New("shutting down")
+
+// Check checks a dependency; a nil error means it is usable.
+type Check func(ctx context.Context) error
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// Result is the outcome of a single check.
+type Result struct {
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report is the outcome of all checks. The service is up when every check is.
+type Report struct {
+	Status Status            `json:"status"`
+	Error  string            `json:"error,omitempty"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+func (r Report) Up() bool { return r.Status == StatusUp }
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Health runs the readiness checks of a service.
+type Health struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	checks []namedCheck
+
+	shutdown     sync.Once
+	shuttingDown chan struct{}
+
+	// mu is held while the checks run: concurrent probes wait for a single
+	// run and share its report.
+	mu        sync.Mutex
+	report    Report
+	checkedAt time.Time
+}
+
+type Option func(h *Health)
+
+// WithTimeout limits the duration of each check.
+func WithTimeout(d time.Duration) Option {
+	return func(h *Health) { h.timeout = d }
+}
+
+// WithCacheTTL sets how long a report is reused before the checks run again.
+// Zero runs them on every probe.
+func WithCacheTTL(d time.Duration) Option {
+	return func(h *Health) { h.cacheTTL = d }
+}
+
+func New(opts ...Option) *Health {
+	h := &Health{
+		timeout:      defaultTimeout,
+		cacheTTL:     defaultCacheTTL,
+		now:          time.Now,
+		shuttingDown: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Register adds a check under name. Checks must be registered before the
+// first probe.
+func (h *Health) Register(name string, check Check) {
+	h.checks = append(h.checks, namedCheck{name: name, check: check})
+}
+
+// Shutdown makes the service not ready for good, so that it is taken out of
+// rotation while it drains.
+func (h *Health) Shutdown() {
+	h.shutdown.Do(func() { close(h.shuttingDown) })
+}
+
+// ShuttingDown is closed by Shutdown.
+func (h *Health) ShuttingDown() <-chan struct{} {
+	return h.shuttingDown
+}
+
+// Live reports whether the process is alive. It does not depend on the
+// dependencies: restarting the service would not bring them back.
+func (h *Health) Live() Report {
+	return Report{Status: StatusUp}
+}
+
+// Ready runs the checks, or reuses the report of a recent run.
+func (h *Health) Ready(ctx context.Context) Report {
+	select {
+	case <-h.shuttingDown:
+		return Report{Status: StatusDown, Error: ErrShuttingDown.Error()}
+	default:
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.checkedAt.IsZero() && h.now().Sub(h.checkedAt) < h.cacheTTL {
+		return h.report
+	}
+
+	// The report is shared with other probes: a probe that gave up must not
+	// fail the checks of the others.
+	h.report = h.run(context.WithoutCancel(ctx))
+	h.checkedAt = h.now()
+
+	return h.report
+}
+
+func (h *Health) run(ctx context.Context) Report {
+	results := make([]Result, len(h.checks))
+
+	var wg sync.WaitGroup
+	for i, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = h.runCheck(ctx, c.check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]Result, len(h.checks)),
+	}
+	for i, c := range h.checks {
+		report.Checks[c.name] = results[i]
+		if results[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	return report
+}
+
+func (h *Health) runCheck(ctx context.Context, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	// A check that does not honour its context still may not hold up the
+	// report; it finishes in the background.
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() { done <- check(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	res := Result{
+		Status:   StatusUp,
+		Duration: time.Since(start).Round(time.Microsecond).String(),
+	}
+	if err != nil {
+		res.Status, res.Error = StatusDown, err.Error()
+	}
+
+	return res
+}
@@ -0,0 +1,203 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDown = errors.New("connection refused")
+
+func up(context.Context) error { return nil }
+
+func down(context.Context) error { return errDown }
+
+// hang ignores its context and returns only when the test ends.
+func hang(t *testing.T) Check {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	return func(context.Context) error {
+		<-release
+		return nil
+	}
+}
+
+// clock is a time source the test moves by hand.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestReady(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		checks     map[string]Check
+		wantStatus Status
+		wantChecks map[string]Status
+		wantErrs   map[string]string
+	}{
+		{
+			name:       "success: no checks",
+			wantStatus: StatusUp,
+			wantChecks: map[string]Status{},
+		},
+		{
+			name:       "success: every check passes",
+			checks:     map[string]Check{"postgres": up, "kafka": up},
+			wantStatus: StatusUp,
+			wantChecks: map[string]Status{"postgres": StatusUp, "kafka": StatusUp},
+		},
+		{
+			name:       "down: a single check fails",
+			checks:     map[string]Check{"postgres": up, "kafka": down},
+			wantStatus: StatusDown,
+			wantChecks: map[string]Status{"postgres": StatusUp, "kafka": StatusDown},
+			wantErrs:   map[string]string{"kafka": errDown.Error()},
+		},
+		{
+			name:       "down: a check that outlasts its timeout",
+			checks:     map[string]Check{"postgres": up, "mongo": hang(t)},
+			wantStatus: StatusDown,
+			wantChecks: map[string]Status{"postgres": StatusUp, "mongo": StatusDown},
+			wantErrs:   map[string]string{"mongo": context.DeadlineExceeded.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := New(WithTimeout(50 * time.Millisecond))
+			for name, check := range tt.checks {
+				h.Register(name, check)
+			}
+
+			report := h.Ready(context.Background())
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Equal(t, tt.wantStatus == StatusUp, report.Up())
+			require.Len(t, report.Checks, len(tt.wantChecks))
+			for name, want := range tt.wantChecks {
+				got := report.Checks[name]
+				assert.Equal(t, want, got.Status, name)
+				assert.Equal(t, tt.wantErrs[name], got.Error, name)
+				assert.NotEmpty(t, got.Duration, name)
+			}
+		})
+	}
+}
+
+func TestReadyCache(t *testing.T) {
+	t.Parallel()
+
+	const ttl = 5 * time.Second
+
+	t.Run("success: a report is reused within its TTL", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			runs   atomic.Int32
+			failed atomic.Bool
+		)
+		clk := &clock{now: time.Now()}
+		h := New(WithCacheTTL(ttl))
+		h.now = clk.Now
+		h.Register("postgres", func(context.Context) error {
+			runs.Add(1)
+			if failed.Load() {
+				return errDown
+			}
+			return nil
+		})
+
+		require.True(t, h.Ready(context.Background()).Up())
+
+		failed.Store(true)
+		clk.Add(ttl - time.Nanosecond)
+		assert.True(t, h.Ready(context.Background()).Up(), "the cached report was not used")
+		assert.Equal(t, int32(1), runs.Load())
+
+		clk.Add(time.Nanosecond)
+		assert.False(t, h.Ready(context.Background()).Up())
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("success: a zero TTL runs the checks on every probe", func(t *testing.T) {
+		t.Parallel()
+
+		var runs atomic.Int32
+		h := New(WithCacheTTL(0))
+		h.Register("postgres", func(context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		for range 3 {
+			h.Ready(context.Background())
+		}
+		assert.Equal(t, int32(3), runs.Load())
+	})
+
+	t.Run("success: a cancelled probe does not fail the shared checks", func(t *testing.T) {
+		t.Parallel()
+
+		h := New()
+		h.Register("postgres", func(ctx context.Context) error { return ctx.Err() })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.True(t, h.Ready(ctx).Up())
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	var runs atomic.Int32
+	h := New()
+	h.Register("postgres", func(context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+
+	select {
+	case <-h.ShuttingDown():
+		t.Fatal("shutting down before Shutdown")
+	default:
+	}
+
+	h.Shutdown()
+	h.Shutdown()
+
+	report := h.Ready(context.Background())
+	assert.False(t, report.Up())
+	assert.Equal(t, ErrShuttingDown.Error(), report.Error)
+	assert.Zero(t, runs.Load(), "the checks ran during shutdown")
+	assert.True(t, h.Live().Up())
+
+	select {
+	case <-h.ShuttingDown():
+	default:
+		t.Fatal("not shutting down after Shutdown")
+	}
+}
@@ -0,0 +1,52 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+const (
+	LivezPath  = "/livez"
+	ReadyzPath = "/readyz"
+)
+
+// LivezHandler answers 200 while the process serves.
+func (h *Health) LivezHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, r, h.Live())
+	}
+}
+
+// ReadyzHandler answers 200 when every check passes and 503 otherwise, with
+// the result of each check in the body.
+func (h *Health) ReadyzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, r, h.Ready(r.Context()))
+	}
+}
+
+// Handler serves both endpoints, for a service without an HTTP server of
+// its own.
+func (h *Health) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET "+LivezPath, h.LivezHandler())
+	mux.Handle("GET "+ReadyzPath, h.ReadyzHandler())
+
+	return mux
+}
+
+func writeReport(w http.ResponseWriter, r *http.Request, report Report) {
+	code := http.StatusOK
+	if !report.Up() {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.Error(r.Context(), "health report", logger.ErrorF(err))
+	}
+}
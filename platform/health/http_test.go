@@ -0,0 +1,90 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		check      Check
+		shutdown   bool
+		method     string
+		path       string
+		wantCode   int
+		wantStatus Status
+	}{
+		{name: "success: live", check: up, method: http.MethodGet, path: LivezPath, wantCode: http.StatusOK, wantStatus: StatusUp},
+		{name: "success: live with a failing check", check: down, method: http.MethodGet, path: LivezPath, wantCode: http.StatusOK, wantStatus: StatusUp},
+		{name: "success: live while shutting down", check: up, shutdown: true, method: http.MethodGet, path: LivezPath, wantCode: http.StatusOK, wantStatus: StatusUp},
+		{name: "success: ready", check: up, method: http.MethodGet, path: ReadyzPath, wantCode: http.StatusOK, wantStatus: StatusUp},
+		{name: "down: a failing check", check: down, method: http.MethodGet, path: ReadyzPath, wantCode: http.StatusServiceUnavailable, wantStatus: StatusDown},
+		{name: "down: shutting down", check: up, shutdown: true, method: http.MethodGet, path: ReadyzPath, wantCode: http.StatusServiceUnavailable, wantStatus: StatusDown},
+		{name: "error: not a GET", check: up, method: http.MethodPost, path: ReadyzPath, wantCode: http.StatusMethodNotAllowed},
+		{name: "error: unknown path", check: up, method: http.MethodGet, path: "/healthz", wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := New()
+			h.Register("postgres", tt.check)
+			if tt.shutdown {
+				h.Shutdown()
+			}
+
+			rec := httptest.NewRecorder()
+			h.Handler().ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantStatus == "" {
+				return
+			}
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+			var report Report
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+			assert.Equal(t, tt.wantStatus, report.Status)
+		})
+	}
+}
+
+func TestReadyzHandlerBody(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	h.Register("postgres", up)
+	h.Register("kafka", down)
+
+	rec := httptest.NewRecorder()
+	h.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ReadyzPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var body struct {
+		Status string `json:"status"`
+		Checks map[string]struct {
+			Status   string `json:"status"`
+			Error    string `json:"error"`
+			Duration string `json:"duration"`
+		} `json:"checks"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+
+	assert.Equal(t, "down", body.Status)
+	require.Len(t, body.Checks, 2)
+	assert.Equal(t, "up", body.Checks["postgres"].Status)
+	assert.Empty(t, body.Checks["postgres"].Error)
+	assert.Equal(t, "down", body.Checks["kafka"].Status)
+	assert.Equal(t, errDown.Error(), body.Checks["kafka"].Error)
+	assert.NotEmpty(t, body.Checks["kafka"].Duration)
+}
@@ -0,0 +1,102 @@
+// Package security connects sarama clients to brokers that require TLS and
+// SASL/SCRAM authentication.
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// SASL mechanisms.
+const (
+	MechanismSCRAMSHA256 = "SCRAM-SHA-256"
+	MechanismSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+// SASL are the credentials of the client. An empty mechanism turns the
+// authentication off.
+type SASL struct {
+	Mechanism string
+	Username  string
+	Password  string
+}
+
+// Config is how the clients of a service connect to the brokers.
+type Config struct {
+	tls  *tls.Config
+	sasl SASL
+
+	mechanism sarama.SASLMechanism
+	hash      scram.HashGeneratorFcn
+}
+
+// New checks the settings. A nil tlsCfg leaves the connections in plaintext.
+func New(tlsCfg *tls.Config, sasl SASL) (*Config, error) {
+	const op = "kafka.security.New"
+
+	c := &Config{tls: tlsCfg, sasl: sasl}
+	switch sasl.Mechanism {
+	case "":
+		return c, nil
+	case MechanismSCRAMSHA256:
+		c.mechanism, c.hash = sarama.SASLTypeSCRAMSHA256, sha256.New
+	case MechanismSCRAMSHA512:
+		c.mechanism, c.hash = sarama.SASLTypeSCRAMSHA512, sha512.New
+	default:
+		return nil, fmt.Errorf("%s: unknown SASL mechanism %q", op, sasl.Mechanism)
+	}
+	if sasl.Username == "" || sasl.Password == "" {
+		return nil, fmt.Errorf("%s: %w", op, errors.New("SASL needs a username and a password"))
+	}
+
+	return c, nil
+}
+
+// Apply sets up cfg to connect as configured.
+func (c *Config) Apply(cfg *sarama.Config) *sarama.Config {
+	if c.tls != nil {
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = c.tls
+	}
+	if c.mechanism != "" {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.Handshake = true
+		cfg.Net.SASL.Mechanism = c.mechanism
+		cfg.Net.SASL.User = c.sasl.Username
+		cfg.Net.SASL.Password = c.sasl.Password
+		cfg.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hash: c.hash}
+		}
+	}
+
+	return cfg
+}
+
+// scramClient runs a SCRAM exchange for sarama.
+type scramClient struct {
+	hash scram.HashGeneratorFcn
+	conv *scram.ClientConversation
+}
+
+func (c *scramClient) Begin(username, password, authzID string) error {
+	client, err := c.hash.NewClient(username, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conv = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conv.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.conv.Done()
+}
@@ -0,0 +1,141 @@
+package security
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xdg-go/scram"
+)
+
+const (
+	username = "order"
+	password = "secret"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sasl    SASL
+		wantErr bool
+	}{
+		{name: "success: no SASL", sasl: SASL{}},
+		{name: "success: SCRAM-SHA-256", sasl: SASL{Mechanism: MechanismSCRAMSHA256, Username: username, Password: password}},
+		{name: "success: SCRAM-SHA-512", sasl: SASL{Mechanism: MechanismSCRAMSHA512, Username: username, Password: password}},
+		{name: "invalid: unknown mechanism", sasl: SASL{Mechanism: "PLAIN", Username: username, Password: password}, wantErr: true},
+		{name: "invalid: mechanism in lower case", sasl: SASL{Mechanism: "scram-sha-256", Username: username, Password: password}, wantErr: true},
+		{name: "invalid: no username", sasl: SASL{Mechanism: MechanismSCRAMSHA256, Password: password}, wantErr: true},
+		{name: "invalid: no password", sasl: SASL{Mechanism: MechanismSCRAMSHA512, Username: username}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := New(nil, tt.sasl)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, c)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, c)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success: plaintext without SASL leaves the config as is", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := New(nil, SASL{})
+		require.NoError(t, err)
+
+		cfg := c.Apply(sarama.NewConfig())
+		assert.False(t, cfg.Net.TLS.Enable)
+		assert.Nil(t, cfg.Net.TLS.Config)
+		assert.False(t, cfg.Net.SASL.Enable)
+	})
+
+	t.Run("success: TLS", func(t *testing.T) {
+		t.Parallel()
+
+		tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+		c, err := New(tlsCfg, SASL{})
+		require.NoError(t, err)
+
+		cfg := c.Apply(sarama.NewConfig())
+		assert.True(t, cfg.Net.TLS.Enable)
+		assert.Same(t, tlsCfg, cfg.Net.TLS.Config)
+		assert.False(t, cfg.Net.SASL.Enable)
+	})
+
+	for _, tt := range []struct {
+		mechanism string
+		sarama    sarama.SASLMechanism
+		hash      scram.HashGeneratorFcn
+	}{
+		{mechanism: MechanismSCRAMSHA256, sarama: sarama.SASLTypeSCRAMSHA256, hash: scram.SHA256},
+		{mechanism: MechanismSCRAMSHA512, sarama: sarama.SASLTypeSCRAMSHA512, hash: scram.SHA512},
+	} {
+		t.Run("success: "+tt.mechanism, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := New(nil, SASL{Mechanism: tt.mechanism, Username: username, Password: password})
+			require.NoError(t, err)
+
+			cfg := c.Apply(sarama.NewConfig())
+			require.NoError(t, cfg.Validate())
+			assert.True(t, cfg.Net.SASL.Enable)
+			assert.True(t, cfg.Net.SASL.Handshake)
+			assert.Equal(t, tt.sarama, cfg.Net.SASL.Mechanism)
+			assert.Equal(t, username, cfg.Net.SASL.User)
+			assert.Equal(t, password, cfg.Net.SASL.Password)
+
+			// The client runs the exchange with the hash of its mechanism.
+			require.NoError(t, exchange(t, cfg.Net.SASL.SCRAMClientGeneratorFunc(), tt.hash, password))
+			require.Error(t, exchange(t, cfg.Net.SASL.SCRAMClientGeneratorFunc(), tt.hash, "other"))
+		})
+	}
+}
+
+// exchange runs the SCRAM conversation of client with a broker that stores
+// the credentials of storedPassword hashed with hash.
+func exchange(t *testing.T, client sarama.SCRAMClient, hash scram.HashGeneratorFcn, storedPassword string) error {
+	t.Helper()
+
+	stored, err := hash.NewClient(username, storedPassword, "")
+	require.NoError(t, err)
+	creds := stored.GetStoredCredentials(scram.KeyFactors{Salt: "salt", Iters: 4096})
+
+	server, err := hash.NewServer(func(string) (scram.StoredCredentials, error) { return creds, nil })
+	require.NoError(t, err)
+	conv := server.NewConversation()
+
+	require.NoError(t, client.Begin(username, password, ""))
+
+	challenge := ""
+	for !client.Done() {
+		msg, err := client.Step(challenge)
+		if err != nil {
+			return err
+		}
+		if conv.Done() {
+			break
+		}
+		if challenge, err = conv.Step(msg); err != nil {
+			return err
+		}
+	}
+	if !conv.Valid() {
+		return assert.AnError
+	}
+
+	return nil
+}
@@ -13,8 +13,9 @@ import (
 type Key string
 
 const (
-	traceIDKey Key = "trace_id"
-	userIDKey  Key = "user_id"
+	traceIDKey   Key = "trace_id"
+	requestIDKey Key = "request_id"
+	userIDKey    Key = "user_id"
 )
 
 var (
@@ -202,6 +203,21 @@ func parseLevel(levelStr string) zapcore.Level {
 	}
 }
 
+// ContextWithTraceID кладёт trace_id в контекст, чтобы enrich-aware логи его выводили
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+// ContextWithRequestID кладёт request_id в контекст, чтобы enrich-aware логи его выводили
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// ContextWithUserID кладёт user_id в контекст, чтобы enrich-aware логи его выводили
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
 // fieldsFromContext вытаскивает enrich-поля из контекста
 func fieldsFromContext(ctx context.Context) []Field {
 	fields := make([]Field, 0)
@@ -210,6 +226,10 @@ func fieldsFromContext(ctx context.Context) []Field {
 		fields = append(fields, zap.String(string(traceIDKey), traceID))
 	}
 
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok && requestID != "" {
+		fields = append(fields, zap.String(string(requestIDKey), requestID))
+	}
+
 	if userID, ok := ctx.Value(userIDKey).(string); ok && userID != "" {
 		fields = append(fields, zap.String(string(userIDKey), userID))
 	}
@@ -0,0 +1,255 @@
+// Package devca issues certificates for local development and tests: a CA
+// of its own and, for each service, a certificate that both serves and
+// authenticates the service as a client, so that the services can talk over
+// mutual TLS. It is not meant for production.
+package devca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	caValidity   = 5 * 365 * 24 * time.Hour
+	certValidity = 90 * 24 * time.Hour
+
+	organization = "rocket-maintenance dev"
+)
+
+// Names of the files of the CA in the output directory.
+const (
+	CAFile    = "ca.pem"
+	CAKeyFile = "ca-key.pem"
+)
+
+// CertFile and KeyFile name the files of the certificate of a service.
+func CertFile(service string) string { return service + ".pem" }
+func KeyFile(service string) string  { return service + "-key.pem" }
+
+// CA signs the certificates of the services.
+type CA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+// NewCA creates a CA with a new key.
+func NewCA() (*CA, error) {
+	const op = "devca.NewCA"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{organization}, CommonName: organization + " CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &CA{cert: cert, key: key}, nil
+}
+
+// LoadCA reads a CA written by WriteCA.
+func LoadCA(certFile, keyFile string) (*CA, error) {
+	const op = "devca.LoadCA"
+
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, errors.New("key cannot sign"))
+	}
+
+	return &CA{cert: pair.Leaf, key: key}, nil
+}
+
+// WriteCA writes the certificate and the key of the CA.
+func (ca *CA) WriteCA(certFile, keyFile string) error {
+	const op = "devca.WriteCA"
+
+	keyPEM, err := encodeKey(ca.key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.WriteFile(certFile, encodeCert(ca.cert.Raw), 0o644); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// Only this package reads the key of the CA.
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CertPEM is the certificate of the CA, for the CA files of the services.
+func (ca *CA) CertPEM() []byte {
+	return encodeCert(ca.cert.Raw)
+}
+
+// Issue creates a certificate for name, valid for both server and client
+// authentication. The certificate is valid for name and hosts, which may be
+// DNS names or IP addresses.
+func (ca *CA) Issue(name string, hosts ...string) (certPEM, keyPEM []byte, err error) {
+	return ca.IssueFor(name, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, hosts...)
+}
+
+// IssueFor is Issue for the given extended key usages only, e.g. to check
+// that a peer with a client-only certificate cannot serve.
+func (ca *CA) IssueFor(name string, usages []x509.ExtKeyUsage, hosts ...string) (certPEM, keyPEM []byte, err error) {
+	const op = "devca.IssueFor"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{Organization: []string{organization}, CommonName: name},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  usages,
+	}
+	for _, h := range append([]string{name}, hosts...) {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	keyPEM, err = encodeKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return encodeCert(der), keyPEM, nil
+}
+
+// Generate writes to dir a CA and a certificate for each service, valid for
+// the name of the service, localhost and hosts. A CA already in dir is
+// reused, so that generating again rotates the certificates of the services
+// without breaking trust in the running ones.
+func Generate(dir string, services []string, hosts ...string) error {
+	const op = "devca.Generate"
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	caCert, caKey := filepath.Join(dir, CAFile), filepath.Join(dir, CAKeyFile)
+	ca, err := LoadCA(caCert, caKey)
+	if errors.Is(err, os.ErrNotExist) {
+		if ca, err = NewCA(); err == nil {
+			err = ca.WriteCA(caCert, caKey)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	hosts = append([]string{"localhost", "127.0.0.1", "::1"}, hosts...)
+	for _, service := range services {
+		certPEM, keyPEM, err := ca.Issue(service, hosts...)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", op, service, err)
+		}
+		// The key is written before the certificate: a service that reloads in
+		// between keeps its old pair, since the new key does not match it.
+		// The keys are readable by all, since the services run as other users
+		// in their containers.
+		if err := writeFile(filepath.Join(dir, KeyFile(service)), keyPEM, 0o644); err != nil {
+			return fmt.Errorf("%s: %s: %w", op, service, err)
+		}
+		if err := writeFile(filepath.Join(dir, CertFile(service)), certPEM, 0o644); err != nil {
+			return fmt.Errorf("%s: %s: %w", op, service, err)
+		}
+	}
+
+	return nil
+}
+
+// writeFile replaces the file at once, so that a reader never sees it half
+// written.
+func writeFile(name string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck,gosec
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encodeCert(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func encodeKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
@@ -0,0 +1,153 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+)
+
+// DefaultReloadInterval is how often the files are checked for changes by
+// default.
+const DefaultReloadInterval = time.Minute
+
+// reloader holds the certificate and the CA read from the files and reads
+// them again when the files change.
+type reloader struct {
+	files    Files
+	interval time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	roots     *x509.CertPool
+	stamp     string
+	checkedAt time.Time
+}
+
+func newReloader(files Files, interval time.Duration, now func() time.Time) (*reloader, error) {
+	r := &reloader{
+		files:    files,
+		interval: interval,
+		now:      now,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	r.checkedAt = r.now()
+
+	return r, nil
+}
+
+func (r *reloader) certificate() (*tls.Certificate, error) {
+	cert, _ := r.current()
+	if cert == nil {
+		return nil, errors.New("tls: no certificate configured")
+	}
+	return cert, nil
+}
+
+// verify checks that certs, the chain of the peer with its own certificate
+// first, leads to the CA and is issued for name and usage. An empty name is
+// not checked.
+func (r *reloader) verify(certs []*x509.Certificate, name string, usage x509.ExtKeyUsage) error {
+	if len(certs) == 0 {
+		return errors.New("tls: no peer certificate")
+	}
+
+	_, roots := r.current()
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       name,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// current returns the certificate and the CA, reading the files again when
+// they changed since the last check. A nil CA means the CAs of the system.
+func (r *reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now := r.now(); now.Sub(r.checkedAt) >= r.interval {
+		r.checkedAt = now
+		// A file may be caught half written: the old certificate stays in use
+		// and the next check tries again.
+		if err := r.reload(); err != nil {
+			logger.Error(context.Background(), "reload tls files", logger.ErrorF(err))
+		}
+	}
+
+	return r.cert, r.roots
+}
+
+// reload reads the files when their stamp differs from the one of the last
+// successful read.
+func (r *reloader) reload() error {
+	stamp, err := r.files.stamp()
+	if err != nil {
+		return err
+	}
+	if stamp == r.stamp {
+		return nil
+	}
+
+	var cert *tls.Certificate
+	if r.files.CertFile != "" {
+		c, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load certificate: %w", err)
+		}
+		cert = &c
+	}
+
+	var roots *x509.CertPool
+	if r.files.CAFile != "" {
+		pem, err := os.ReadFile(r.files.CAFile)
+		if err != nil {
+			return fmt.Errorf("read CA: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("read CA: no certificates in %s", r.files.CAFile)
+		}
+	}
+
+	r.cert, r.roots, r.stamp = cert, roots, stamp
+	if !r.checkedAt.IsZero() {
+		logger.Info(context.Background(), "tls files reloaded")
+	}
+
+	return nil
+}
+
+// stamp identifies the versions of the files by their sizes and times of
+// modification.
+func (f Files) stamp() (string, error) {
+	var stamp string
+	for _, name := range []string{f.CertFile, f.KeyFile, f.CAFile} {
+		if name == "" {
+			continue
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		stamp += fmt.Sprintf("%s:%d:%d;", name, fi.Size(), fi.ModTime().UnixNano())
+	}
+
+	return stamp, nil
+}
@@ -0,0 +1,350 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/you-humble/rocket-maintenance/platform/logger"
+	"github.com/you-humble/rocket-maintenance/platform/tlsconfig/devca"
+)
+
+var bothUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+
+// withClock makes the reloader read the time from now.
+func withClock(now func() time.Time) Option {
+	return func(o *options) { o.now = now }
+}
+
+// clock is a time source the test moves by hand.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newCA(t *testing.T) *devca.CA {
+	t.Helper()
+
+	ca, err := devca.NewCA()
+	require.NoError(t, err)
+	return ca
+}
+
+// writePeer writes a certificate of name issued by ca for usages and the
+// certificate of trusted as the CA of the peer.
+func writePeer(t *testing.T, dir string, ca, trusted *devca.CA, name string, usages []x509.ExtKeyUsage) Files {
+	t.Helper()
+
+	certPEM, keyPEM, err := ca.IssueFor(name, usages)
+	require.NoError(t, err)
+
+	files := Files{
+		CertFile: filepath.Join(dir, devca.CertFile(name)),
+		KeyFile:  filepath.Join(dir, devca.KeyFile(name)),
+		CAFile:   filepath.Join(dir, name+"-"+devca.CAFile),
+	}
+	writeFile(t, files.CertFile, certPEM)
+	writeFile(t, files.KeyFile, keyPEM)
+	writeFile(t, files.CAFile, trusted.CertPEM())
+
+	return files
+}
+
+// writeFile replaces the file and moves its time of modification forward, so
+// that a rewrite within the same clock tick still changes the stamp.
+func writeFile(t *testing.T, name string, data []byte) {
+	t.Helper()
+
+	var mtime time.Time
+	if fi, err := os.Stat(name); err == nil {
+		mtime = fi.ModTime().Add(time.Second)
+	}
+	require.NoError(t, os.WriteFile(name, data, 0o600))
+	if !mtime.IsZero() {
+		require.NoError(t, os.Chtimes(name, mtime, mtime))
+	}
+}
+
+// handshake runs a TLS handshake between the configs over a loopback
+// connection and returns the errors of both sides.
+func handshake(t *testing.T, server, client *tls.Config) (serverErr, clientErr error) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	done := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.SetDeadline(deadline)
+
+		done <- tls.Server(conn, server).Handshake()
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	_ = conn.SetDeadline(deadline)
+
+	tc := tls.Client(conn, client)
+	clientErr = tc.Handshake()
+	if clientErr == nil {
+		// A TLS 1.3 server sees a rejected client certificate only on
+		// the first read of the client.
+		_, clientErr = tc.Read(make([]byte, 1))
+		if errors.Is(clientErr, io.EOF) {
+			clientErr = nil
+		}
+	}
+	_ = conn.Close()
+
+	return <-done, clientErr
+}
+
+func errorAs[E error](err error) bool {
+	var target E
+	return errors.As(err, &target)
+}
+
+func incompatibleUsage(err error) bool {
+	var invalid x509.CertificateInvalidError
+	return errors.As(err, &invalid) && invalid.Reason == x509.IncompatibleUsage
+}
+
+func clientFor(t *testing.T, files Files, serverName string, opts ...Option) *tls.Config {
+	t.Helper()
+
+	cfg, err := Client(files, append([]Option{WithServerName(serverName)}, opts...)...)
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestHandshake(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	ca := newCA(t)
+
+	tests := []struct {
+		name         string
+		serverUsages []x509.ExtKeyUsage
+		clientUsages []x509.ExtKeyUsage // nil: the client presents no certificate
+		clientCA     *devca.CA
+		serverName   string
+		clientAuth   bool
+		// The side that rejects the handshake and the check of its error;
+		// a nil check accepts any error.
+		rejectedBy string
+		wantErr    func(err error) bool
+	}{
+		{
+			name:         "success: a server signed by the CA",
+			serverUsages: bothUsages,
+			serverName:   "inventory",
+		},
+		{
+			name:         "success: mutual TLS",
+			serverUsages: bothUsages,
+			clientUsages: bothUsages,
+			serverName:   "inventory",
+			clientAuth:   true,
+		},
+		{
+			name:         "rejected: the certificate is for another host",
+			serverUsages: bothUsages,
+			serverName:   "payment",
+			rejectedBy:   "client",
+			wantErr:      errorAs[x509.HostnameError],
+		},
+		{
+			name:         "rejected: the server certificate is not for server auth",
+			serverUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			serverName:   "inventory",
+			rejectedBy:   "client",
+			wantErr:      incompatibleUsage,
+		},
+		{
+			name:         "rejected: the client certificate is not for client auth",
+			serverUsages: bothUsages,
+			clientUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			serverName:   "inventory",
+			clientAuth:   true,
+			rejectedBy:   "server",
+			wantErr:      incompatibleUsage,
+		},
+		{
+			name:         "rejected: mutual TLS without a client certificate",
+			serverUsages: bothUsages,
+			serverName:   "inventory",
+			clientAuth:   true,
+			rejectedBy:   "server",
+		},
+		{
+			name:         "rejected: a server signed by another CA",
+			serverUsages: bothUsages,
+			clientCA:     newCA(t),
+			serverName:   "inventory",
+			rejectedBy:   "client",
+			wantErr:      errorAs[x509.UnknownAuthorityError],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			serverFiles := writePeer(t, dir, ca, ca, "inventory", tt.serverUsages)
+
+			var serverOpts []Option
+			if tt.clientAuth {
+				serverOpts = append(serverOpts, WithClientAuth())
+			}
+			server, err := Server(serverFiles, serverOpts...)
+			require.NoError(t, err)
+
+			clientCA := ca
+			if tt.clientCA != nil {
+				clientCA = tt.clientCA
+			}
+			clientFiles := Files{CAFile: filepath.Join(dir, "client-"+devca.CAFile)}
+			writeFile(t, clientFiles.CAFile, clientCA.CertPEM())
+			if tt.clientUsages != nil {
+				clientFiles = writePeer(t, dir, ca, clientCA, "order", tt.clientUsages)
+			}
+
+			serverErr, clientErr := handshake(t, server, clientFor(t, clientFiles, tt.serverName))
+			switch tt.rejectedBy {
+			case "client":
+				require.Error(t, clientErr)
+				if tt.wantErr != nil {
+					assert.True(t, tt.wantErr(clientErr), "unexpected error: %v", clientErr)
+				}
+			case "server":
+				require.Error(t, serverErr)
+				if tt.wantErr != nil {
+					assert.True(t, tt.wantErr(serverErr), "unexpected error: %v", serverErr)
+				}
+			default:
+				assert.NoError(t, serverErr)
+				assert.NoError(t, clientErr)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	logger.SetNopLogger()
+	t.Parallel()
+
+	const interval = time.Minute
+
+	t.Run("success: a rotated CA and certificate apply after the reload interval", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		clk := &clock{now: time.Now()}
+		oldCA, rotatedCA := newCA(t), newCA(t)
+
+		serverFiles := writePeer(t, dir, oldCA, oldCA, "inventory", bothUsages)
+		server, err := Server(serverFiles, WithReloadInterval(interval), withClock(clk.Now))
+		require.NoError(t, err)
+
+		clientFiles := Files{CAFile: filepath.Join(dir, "client-"+devca.CAFile)}
+		writeFile(t, clientFiles.CAFile, oldCA.CertPEM())
+		client := clientFor(t, clientFiles, "inventory", WithReloadInterval(interval), withClock(clk.Now))
+
+		serverErr, clientErr := handshake(t, server, client)
+		require.NoError(t, serverErr)
+		require.NoError(t, clientErr)
+
+		// Both sides move to the new CA.
+		writePeer(t, dir, rotatedCA, rotatedCA, "inventory", bothUsages)
+		writeFile(t, clientFiles.CAFile, rotatedCA.CertPEM())
+
+		clk.Add(interval / 2)
+		serverErr, clientErr = handshake(t, server, client)
+		require.NoError(t, serverErr, "files were read before the reload interval")
+		require.NoError(t, clientErr, "files were read before the reload interval")
+
+		clk.Add(interval)
+		serverErr, clientErr = handshake(t, server, client)
+		require.NoError(t, serverErr)
+		require.NoError(t, clientErr)
+
+		// The client now trusts the new CA only.
+		oldFiles := writePeer(t, t.TempDir(), oldCA, oldCA, "inventory", bothUsages)
+		oldServer, err := Server(oldFiles)
+		require.NoError(t, err)
+		_, clientErr = handshake(t, oldServer, client)
+		require.Error(t, clientErr)
+	})
+
+	t.Run("success: a half-written file keeps the certificate in use", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		clk := &clock{now: time.Now()}
+		ca := newCA(t)
+
+		serverFiles := writePeer(t, dir, ca, ca, "inventory", bothUsages)
+		server, err := Server(serverFiles, WithReloadInterval(interval), withClock(clk.Now))
+		require.NoError(t, err)
+		client := clientFor(t, Files{CAFile: serverFiles.CAFile}, "inventory")
+
+		writeFile(t, serverFiles.CertFile, []byte("-----BEGIN CERTIFICATE-----\n"))
+		clk.Add(interval)
+
+		serverErr, clientErr := handshake(t, server, client)
+		require.NoError(t, serverErr)
+		require.NoError(t, clientErr)
+	})
+}
+
+func TestConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := newCA(t)
+	files := writePeer(t, dir, ca, ca, "inventory", bothUsages)
+
+	_, err := Server(Files{CAFile: files.CAFile})
+	assert.Error(t, err, "server without a certificate")
+
+	_, err = Server(Files{CertFile: files.CertFile, KeyFile: files.KeyFile}, WithClientAuth())
+	assert.Error(t, err, "client auth without a CA")
+
+	_, err = Client(Files{CertFile: files.CertFile, CAFile: files.CAFile})
+	assert.Error(t, err, "certificate without a key")
+
+	_, err = Client(Files{CAFile: filepath.Join(dir, "missing.pem")})
+	assert.Error(t, err, "missing CA file")
+}
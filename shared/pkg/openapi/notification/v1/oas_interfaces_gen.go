@@ -0,0 +1,26 @@
+// Code generated by ogen, DO NOT EDIT.
+package notificationv1
+
+type CreateWebhookSubscriptionRes interface {
+	createWebhookSubscriptionRes()
+}
+
+type DeleteWebhookSubscriptionRes interface {
+	deleteWebhookSubscriptionRes()
+}
+
+type GetWebhookSubscriptionRes interface {
+	getWebhookSubscriptionRes()
+}
+
+type ListWebhookDeliveriesRes interface {
+	listWebhookDeliveriesRes()
+}
+
+type ListWebhookSubscriptionsRes interface {
+	listWebhookSubscriptionsRes()
+}
+
+type UpdateWebhookSubscriptionRes interface {
+	updateWebhookSubscriptionRes()
+}
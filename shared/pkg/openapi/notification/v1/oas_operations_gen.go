@@ -0,0 +1,15 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package notificationv1
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreateWebhookSubscriptionOperation OperationName = "CreateWebhookSubscription"
+	DeleteWebhookSubscriptionOperation OperationName = "DeleteWebhookSubscription"
+	GetWebhookSubscriptionOperation    OperationName = "GetWebhookSubscription"
+	ListWebhookDeliveriesOperation     OperationName = "ListWebhookDeliveries"
+	ListWebhookSubscriptionsOperation  OperationName = "ListWebhookSubscriptions"
+	UpdateWebhookSubscriptionOperation OperationName = "UpdateWebhookSubscription"
+)
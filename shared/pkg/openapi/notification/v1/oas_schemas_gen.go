@@ -0,0 +1,984 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package notificationv1
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+)
+
+type AdminToken struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *AdminToken) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *AdminToken) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *AdminToken) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *AdminToken) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/bad_request_error
+type BadRequestError struct {
+	// HTTP-error code.
+	Code OptInt32 `json:"code"`
+	// Human-readable description of the error.
+	Message OptString `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *BadRequestError) GetCode() OptInt32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *BadRequestError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *BadRequestError) SetCode(val OptInt32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *BadRequestError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*BadRequestError) createWebhookSubscriptionRes() {}
+func (*BadRequestError) updateWebhookSubscriptionRes() {}
+
+// Request body for creating a webhook subscription.
+// Ref: #/components/schemas/create_webhook_subscription_request
+type CreateWebhookSubscriptionRequest struct {
+	// Endpoint receiving signed POST requests (http or https).
+	URL url.URL `json:"url"`
+	// Shared secret used to sign payloads with HMAC-SHA256 (see the X-Webhook-Signature header).
+	Secret string `json:"secret"`
+	// Event types delivered to the endpoint.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Deliver only events of this user's orders.
+	UserUUID OptUUID `json:"user_uuid"`
+}
+
+// GetURL returns the value of URL.
+func (s *CreateWebhookSubscriptionRequest) GetURL() url.URL {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *CreateWebhookSubscriptionRequest) GetSecret() string {
+	return s.Secret
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *CreateWebhookSubscriptionRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *CreateWebhookSubscriptionRequest) GetUserUUID() OptUUID {
+	return s.UserUUID
+}
+
+// SetURL sets the value of URL.
+func (s *CreateWebhookSubscriptionRequest) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *CreateWebhookSubscriptionRequest) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *CreateWebhookSubscriptionRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *CreateWebhookSubscriptionRequest) SetUserUUID(val OptUUID) {
+	s.UserUUID = val
+}
+
+// DeleteWebhookSubscriptionNoContent is response for DeleteWebhookSubscription operation.
+type DeleteWebhookSubscriptionNoContent struct{}
+
+func (*DeleteWebhookSubscriptionNoContent) deleteWebhookSubscriptionRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/internal_server_error
+type InternalServerError struct {
+	// HTTP-error code.
+	Code OptInt32 `json:"code"`
+	// Human-readable description of the error.
+	Message OptString `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *InternalServerError) GetCode() OptInt32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *InternalServerError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *InternalServerError) SetCode(val OptInt32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InternalServerError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*InternalServerError) createWebhookSubscriptionRes() {}
+func (*InternalServerError) deleteWebhookSubscriptionRes() {}
+func (*InternalServerError) getWebhookSubscriptionRes()    {}
+func (*InternalServerError) listWebhookDeliveriesRes()     {}
+func (*InternalServerError) listWebhookSubscriptionsRes()  {}
+func (*InternalServerError) updateWebhookSubscriptionRes() {}
+
+// Ref: #/components/schemas/list_webhook_deliveries_response
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// GetDeliveries returns the value of Deliveries.
+func (s *ListWebhookDeliveriesResponse) GetDeliveries() []WebhookDelivery {
+	return s.Deliveries
+}
+
+// SetDeliveries sets the value of Deliveries.
+func (s *ListWebhookDeliveriesResponse) SetDeliveries(val []WebhookDelivery) {
+	s.Deliveries = val
+}
+
+func (*ListWebhookDeliveriesResponse) listWebhookDeliveriesRes() {}
+
+// Ref: #/components/schemas/list_webhook_subscriptions_response
+type ListWebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+// GetSubscriptions returns the value of Subscriptions.
+func (s *ListWebhookSubscriptionsResponse) GetSubscriptions() []WebhookSubscription {
+	return s.Subscriptions
+}
+
+// SetSubscriptions sets the value of Subscriptions.
+func (s *ListWebhookSubscriptionsResponse) SetSubscriptions(val []WebhookSubscription) {
+	s.Subscriptions = val
+}
+
+func (*ListWebhookSubscriptionsResponse) listWebhookSubscriptionsRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/not_found_error
+type NotFoundError struct {
+	// HTTP-error code.
+	Code OptInt32 `json:"code"`
+	// Human-readable description of the error.
+	Message OptString `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *NotFoundError) GetCode() OptInt32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *NotFoundError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *NotFoundError) SetCode(val OptInt32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *NotFoundError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*NotFoundError) deleteWebhookSubscriptionRes() {}
+func (*NotFoundError) getWebhookSubscriptionRes()    {}
+func (*NotFoundError) listWebhookDeliveriesRes()     {}
+func (*NotFoundError) updateWebhookSubscriptionRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptURI returns new OptURI with value set to v.
+func NewOptURI(v url.URL) OptURI {
+	return OptURI{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptURI is optional url.URL.
+type OptURI struct {
+	Value url.URL
+	Set   bool
+}
+
+// IsSet returns true if OptURI was set.
+func (o OptURI) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptURI) Reset() {
+	var v url.URL
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptURI) SetTo(v url.URL) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptURI) Get() (v url.URL, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptURI) Or(d url.URL) url.URL {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Merged schema.
+// Ref: #/components/schemas/unauthorized_error
+type UnauthorizedError struct {
+	// HTTP-error code.
+	Code OptInt32 `json:"code"`
+	// Human-readable description of the error.
+	Message OptString `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *UnauthorizedError) GetCode() OptInt32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *UnauthorizedError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *UnauthorizedError) SetCode(val OptInt32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UnauthorizedError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*UnauthorizedError) createWebhookSubscriptionRes() {}
+func (*UnauthorizedError) deleteWebhookSubscriptionRes() {}
+func (*UnauthorizedError) getWebhookSubscriptionRes()    {}
+func (*UnauthorizedError) listWebhookDeliveriesRes()     {}
+func (*UnauthorizedError) listWebhookSubscriptionsRes()  {}
+func (*UnauthorizedError) updateWebhookSubscriptionRes() {}
+
+// Partial update of a webhook subscription. Omitted fields stay unchanged. Setting active to true
+// re-enables a disabled subscription and resets its failure counter.
+// Ref: #/components/schemas/update_webhook_subscription_request
+type UpdateWebhookSubscriptionRequest struct {
+	URL        OptURI             `json:"url"`
+	Secret     OptString          `json:"secret"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	Active     OptBool            `json:"active"`
+}
+
+// GetURL returns the value of URL.
+func (s *UpdateWebhookSubscriptionRequest) GetURL() OptURI {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *UpdateWebhookSubscriptionRequest) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *UpdateWebhookSubscriptionRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetActive returns the value of Active.
+func (s *UpdateWebhookSubscriptionRequest) GetActive() OptBool {
+	return s.Active
+}
+
+// SetURL sets the value of URL.
+func (s *UpdateWebhookSubscriptionRequest) SetURL(val OptURI) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *UpdateWebhookSubscriptionRequest) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *UpdateWebhookSubscriptionRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetActive sets the value of Active.
+func (s *UpdateWebhookSubscriptionRequest) SetActive(val OptBool) {
+	s.Active = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/validation_error
+type ValidationError struct {
+	// HTTP-error code.
+	Code OptInt32 `json:"code"`
+	// Human-readable description of the error.
+	Message OptString `json:"message"`
+	// Detailed validation error descriptions.
+	Details []string `json:"details"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() OptInt32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() OptString {
+	return s.Message
+}
+
+// GetDetails returns the value of Details.
+func (s *ValidationError) GetDetails() []string {
+	return s.Details
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val OptInt32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetDetails sets the value of Details.
+func (s *ValidationError) SetDetails(val []string) {
+	s.Details = val
+}
+
+func (*ValidationError) createWebhookSubscriptionRes() {}
+func (*ValidationError) updateWebhookSubscriptionRes() {}
+
+// Delivery of a single event to a webhook subscription.
+// Ref: #/components/schemas/webhook_delivery
+type WebhookDelivery struct {
+	// Unique delivery identifier, sent as X-Webhook-Id.
+	DeliveryUUID     uuid.UUID             `json:"delivery_uuid"`
+	SubscriptionUUID uuid.UUID             `json:"subscription_uuid"`
+	EventUUID        uuid.UUID             `json:"event_uuid"`
+	EventType        WebhookEventType      `json:"event_type"`
+	Status           WebhookDeliveryStatus `json:"status"`
+	// Number of attempts made so far.
+	Attempts int32 `json:"attempts"`
+	// HTTP status of the last attempt, if the endpoint answered.
+	ResponseCode OptInt32 `json:"response_code"`
+	// Error of the last failed attempt.
+	LastError OptString `json:"last_error"`
+	// When the next attempt is scheduled (PENDING only).
+	NextAttemptAt time.Time   `json:"next_attempt_at"`
+	CreatedAt     time.Time   `json:"created_at"`
+	DeliveredAt   OptDateTime `json:"delivered_at"`
+}
+
+// GetDeliveryUUID returns the value of DeliveryUUID.
+func (s *WebhookDelivery) GetDeliveryUUID() uuid.UUID {
+	return s.DeliveryUUID
+}
+
+// GetSubscriptionUUID returns the value of SubscriptionUUID.
+func (s *WebhookDelivery) GetSubscriptionUUID() uuid.UUID {
+	return s.SubscriptionUUID
+}
+
+// GetEventUUID returns the value of EventUUID.
+func (s *WebhookDelivery) GetEventUUID() uuid.UUID {
+	return s.EventUUID
+}
+
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() WebhookEventType {
+	return s.EventType
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int32 {
+	return s.Attempts
+}
+
+// GetResponseCode returns the value of ResponseCode.
+func (s *WebhookDelivery) GetResponseCode() OptInt32 {
+	return s.ResponseCode
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDelivery) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *WebhookDelivery) GetDeliveredAt() OptDateTime {
+	return s.DeliveredAt
+}
+
+// SetDeliveryUUID sets the value of DeliveryUUID.
+func (s *WebhookDelivery) SetDeliveryUUID(val uuid.UUID) {
+	s.DeliveryUUID = val
+}
+
+// SetSubscriptionUUID sets the value of SubscriptionUUID.
+func (s *WebhookDelivery) SetSubscriptionUUID(val uuid.UUID) {
+	s.SubscriptionUUID = val
+}
+
+// SetEventUUID sets the value of EventUUID.
+func (s *WebhookDelivery) SetEventUUID(val uuid.UUID) {
+	s.EventUUID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val WebhookEventType) {
+	s.EventType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int32) {
+	s.Attempts = val
+}
+
+// SetResponseCode sets the value of ResponseCode.
+func (s *WebhookDelivery) SetResponseCode(val OptInt32) {
+	s.ResponseCode = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDelivery) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *WebhookDelivery) SetDeliveredAt(val OptDateTime) {
+	s.DeliveredAt = val
+}
+
+// Delivery state. PENDING deliveries are retried with exponential backoff, FAILED ones exhausted all
+// attempts.
+// Ref: #/components/schemas/webhook_delivery_status
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPENDING,
+		WebhookDeliveryStatusDELIVERED,
+		WebhookDeliveryStatusFAILED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPENDING:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDELIVERED:
+		return []byte(s), nil
+	case WebhookDeliveryStatusFAILED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPENDING:
+		*s = WebhookDeliveryStatusPENDING
+		return nil
+	case WebhookDeliveryStatusDELIVERED:
+		*s = WebhookDeliveryStatusDELIVERED
+		return nil
+	case WebhookDeliveryStatusFAILED:
+		*s = WebhookDeliveryStatusFAILED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Order lifecycle event a webhook can subscribe to.
+// Ref: #/components/schemas/webhook_event_type
+type WebhookEventType string
+
+const (
+	WebhookEventTypeOrderPaid      WebhookEventType = "order.paid"
+	WebhookEventTypeOrderAssembled WebhookEventType = "order.assembled"
+	WebhookEventTypeOrderCancelled WebhookEventType = "order.cancelled"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeOrderPaid,
+		WebhookEventTypeOrderAssembled,
+		WebhookEventTypeOrderCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeOrderPaid:
+		return []byte(s), nil
+	case WebhookEventTypeOrderAssembled:
+		return []byte(s), nil
+	case WebhookEventTypeOrderCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeOrderPaid:
+		*s = WebhookEventTypeOrderPaid
+		return nil
+	case WebhookEventTypeOrderAssembled:
+		*s = WebhookEventTypeOrderAssembled
+		return nil
+	case WebhookEventTypeOrderCancelled:
+		*s = WebhookEventTypeOrderCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Webhook subscription. The signing secret is never returned.
+// Ref: #/components/schemas/webhook_subscription
+type WebhookSubscription struct {
+	// Unique subscription identifier.
+	SubscriptionUUID uuid.UUID `json:"subscription_uuid"`
+	// Endpoint receiving signed POST requests.
+	URL url.URL `json:"url"`
+	// Event types delivered to the endpoint.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// When set, only events of this user's orders are delivered.
+	UserUUID OptUUID `json:"user_uuid"`
+	// Inactive subscriptions receive nothing. A subscription is deactivated automatically after repeated
+	// delivery failures.
+	Active bool `json:"active"`
+	// Failed delivery attempts since the last successful one.
+	ConsecutiveFailures int32 `json:"consecutive_failures"`
+	// When the subscription was disabled after repeated failures.
+	DisabledAt OptDateTime `json:"disabled_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// GetSubscriptionUUID returns the value of SubscriptionUUID.
+func (s *WebhookSubscription) GetSubscriptionUUID() uuid.UUID {
+	return s.SubscriptionUUID
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookSubscription) GetURL() url.URL {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookSubscription) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *WebhookSubscription) GetUserUUID() OptUUID {
+	return s.UserUUID
+}
+
+// GetActive returns the value of Active.
+func (s *WebhookSubscription) GetActive() bool {
+	return s.Active
+}
+
+// GetConsecutiveFailures returns the value of ConsecutiveFailures.
+func (s *WebhookSubscription) GetConsecutiveFailures() int32 {
+	return s.ConsecutiveFailures
+}
+
+// GetDisabledAt returns the value of DisabledAt.
+func (s *WebhookSubscription) GetDisabledAt() OptDateTime {
+	return s.DisabledAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookSubscription) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *WebhookSubscription) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetSubscriptionUUID sets the value of SubscriptionUUID.
+func (s *WebhookSubscription) SetSubscriptionUUID(val uuid.UUID) {
+	s.SubscriptionUUID = val
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookSubscription) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookSubscription) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *WebhookSubscription) SetUserUUID(val OptUUID) {
+	s.UserUUID = val
+}
+
+// SetActive sets the value of Active.
+func (s *WebhookSubscription) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetConsecutiveFailures sets the value of ConsecutiveFailures.
+func (s *WebhookSubscription) SetConsecutiveFailures(val int32) {
+	s.ConsecutiveFailures = val
+}
+
+// SetDisabledAt sets the value of DisabledAt.
+func (s *WebhookSubscription) SetDisabledAt(val OptDateTime) {
+	s.DisabledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookSubscription) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *WebhookSubscription) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*WebhookSubscription) createWebhookSubscriptionRes() {}
+func (*WebhookSubscription) getWebhookSubscriptionRes()    {}
+func (*WebhookSubscription) updateWebhookSubscriptionRes() {}
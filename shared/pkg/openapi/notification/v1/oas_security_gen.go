@@ -0,0 +1,76 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package notificationv1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleAdminToken handles AdminToken security.
+	// Static admin token configured in the Notification Service.
+	HandleAdminToken(ctx context.Context, operationName OperationName, t AdminToken) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesAdminToken = map[string][]string{
+	CreateWebhookSubscriptionOperation: {},
+	DeleteWebhookSubscriptionOperation: {},
+	GetWebhookSubscriptionOperation:    {},
+	ListWebhookDeliveriesOperation:     {},
+	ListWebhookSubscriptionsOperation:  {},
+	UpdateWebhookSubscriptionOperation: {},
+}
+
+func (s *Server) securityAdminToken(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t AdminToken
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesAdminToken[operationName]
+	rctx, err := s.sec.HandleAdminToken(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// AdminToken provides AdminToken security value.
+	// Static admin token configured in the Notification Service.
+	AdminToken(ctx context.Context, operationName OperationName) (AdminToken, error)
+}
+
+func (s *Client) securityAdminToken(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.AdminToken(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"AdminToken\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
@@ -0,0 +1,72 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package notificationv1
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreateWebhookSubscription implements CreateWebhookSubscription operation.
+	//
+	// Registers an endpoint for the given event types. Every event is POSTed as JSON and signed with
+	// HMAC-SHA256 over "<X-Webhook-Timestamp>.<body>" using the subscription secret; the hex digest is
+	// sent as "X-Webhook-Signature: sha256=<digest>". Failed deliveries are retried with exponential
+	// backoff.
+	//
+	// POST /api/v1/webhooks
+	CreateWebhookSubscription(ctx context.Context, req *CreateWebhookSubscriptionRequest) (CreateWebhookSubscriptionRes, error)
+	// DeleteWebhookSubscription implements DeleteWebhookSubscription operation.
+	//
+	// Deletes the subscription together with its delivery history.
+	//
+	// DELETE /api/v1/webhooks/{subscription_uuid}
+	DeleteWebhookSubscription(ctx context.Context, params DeleteWebhookSubscriptionParams) (DeleteWebhookSubscriptionRes, error)
+	// GetWebhookSubscription implements GetWebhookSubscription operation.
+	//
+	// Get webhook subscription by UUID.
+	//
+	// GET /api/v1/webhooks/{subscription_uuid}
+	GetWebhookSubscription(ctx context.Context, params GetWebhookSubscriptionParams) (GetWebhookSubscriptionRes, error)
+	// ListWebhookDeliveries implements ListWebhookDeliveries operation.
+	//
+	// Returns the delivery history of the subscription, newest first.
+	//
+	// GET /api/v1/webhooks/{subscription_uuid}/deliveries
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (ListWebhookDeliveriesRes, error)
+	// ListWebhookSubscriptions implements ListWebhookSubscriptions operation.
+	//
+	// Returns all webhook subscriptions, including disabled ones.
+	//
+	// GET /api/v1/webhooks
+	ListWebhookSubscriptions(ctx context.Context) (ListWebhookSubscriptionsRes, error)
+	// UpdateWebhookSubscription implements UpdateWebhookSubscription operation.
+	//
+	// Changes URL, secret, event types or the active flag. Re-enabling a subscription resets its failure
+	// counter and resumes pending deliveries.
+	//
+	// PATCH /api/v1/webhooks/{subscription_uuid}
+	UpdateWebhookSubscription(ctx context.Context, req *UpdateWebhookSubscriptionRequest, params UpdateWebhookSubscriptionParams) (UpdateWebhookSubscriptionRes, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h   Handler
+	sec SecurityHandler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		sec:        sec,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package notificationv1
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreateWebhookSubscription implements CreateWebhookSubscription operation.
+//
+// Registers an endpoint for the given event types. Every event is POSTed as JSON and signed with
+// HMAC-SHA256 over "<X-Webhook-Timestamp>.<body>" using the subscription secret; the hex digest is
+// sent as "X-Webhook-Signature: sha256=<digest>". Failed deliveries are retried with exponential
+// backoff.
+//
+// POST /api/v1/webhooks
+func (UnimplementedHandler) CreateWebhookSubscription(ctx context.Context, req *CreateWebhookSubscriptionRequest) (r CreateWebhookSubscriptionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhookSubscription implements DeleteWebhookSubscription operation.
+//
+// Deletes the subscription together with its delivery history.
+//
+// DELETE /api/v1/webhooks/{subscription_uuid}
+func (UnimplementedHandler) DeleteWebhookSubscription(ctx context.Context, params DeleteWebhookSubscriptionParams) (r DeleteWebhookSubscriptionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhookSubscription implements GetWebhookSubscription operation.
+//
+// Get webhook subscription by UUID.
+//
+// GET /api/v1/webhooks/{subscription_uuid}
+func (UnimplementedHandler) GetWebhookSubscription(ctx context.Context, params GetWebhookSubscriptionParams) (r GetWebhookSubscriptionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeliveries implements ListWebhookDeliveries operation.
+//
+// Returns the delivery history of the subscription, newest first.
+//
+// GET /api/v1/webhooks/{subscription_uuid}/deliveries
+func (UnimplementedHandler) ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (r ListWebhookDeliveriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookSubscriptions implements ListWebhookSubscriptions operation.
+//
+// Returns all webhook subscriptions, including disabled ones.
+//
+// GET /api/v1/webhooks
+func (UnimplementedHandler) ListWebhookSubscriptions(ctx context.Context) (r ListWebhookSubscriptionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateWebhookSubscription implements UpdateWebhookSubscription operation.
+//
+// Changes URL, secret, event types or the active flag. Re-enabling a subscription resets its failure
+// counter and resumes pending deliveries.
+//
+// PATCH /api/v1/webhooks/{subscription_uuid}
+func (UnimplementedHandler) UpdateWebhookSubscription(ctx context.Context, req *UpdateWebhookSubscriptionRequest, params UpdateWebhookSubscriptionParams) (r UpdateWebhookSubscriptionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -1,6 +1,26 @@
 // Code generated by ogen, DO NOT EDIT.
 package orderv1
 
+type AdminCancelOrderRes interface {
+	adminCancelOrderRes()
+}
+
+type AdminCompleteOrderRes interface {
+	adminCompleteOrderRes()
+}
+
+type AdminGetOrderRes interface {
+	adminGetOrderRes()
+}
+
+type AdminListOrdersRes interface {
+	adminListOrdersRes()
+}
+
+type AdminListStuckSagasRes interface {
+	adminListStuckSagasRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
@@ -13,6 +33,10 @@ type GetOrderByUUIDRes interface {
 	getOrderByUUIDRes()
 }
 
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
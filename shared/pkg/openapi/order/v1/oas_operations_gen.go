@@ -6,8 +6,14 @@ package orderv1
 type OperationName = string
 
 const (
-	CancelOrderOperation    OperationName = "CancelOrder"
-	CreateOrderOperation    OperationName = "CreateOrder"
-	GetOrderByUUIDOperation OperationName = "GetOrderByUUID"
-	PayOrderOperation       OperationName = "PayOrder"
+	AdminCancelOrderOperation    OperationName = "AdminCancelOrder"
+	AdminCompleteOrderOperation  OperationName = "AdminCompleteOrder"
+	AdminGetOrderOperation       OperationName = "AdminGetOrder"
+	AdminListOrdersOperation     OperationName = "AdminListOrders"
+	AdminListStuckSagasOperation OperationName = "AdminListStuckSagas"
+	CancelOrderOperation         OperationName = "CancelOrder"
+	CreateOrderOperation         OperationName = "CreateOrder"
+	GetOrderByUUIDOperation      OperationName = "GetOrderByUUID"
+	ListOrdersOperation          OperationName = "ListOrders"
+	PayOrderOperation            OperationName = "PayOrder"
 )
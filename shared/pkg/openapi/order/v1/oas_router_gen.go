@@ -49,113 +49,272 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/orders"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/orders"); len(elem) >= l && elem[0:l] == "/api/v1/orders" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				switch r.Method {
-				case "POST":
-					s.handleCreateOrderRequest([0]string{}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "POST")
-				}
-
-				return
+				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'a': // Prefix: "admin/"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "order_uuid"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetOrderByUUIDRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'o': // Prefix: "orders"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "GET":
+							s.handleAdminListOrdersRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "cancel"
+					case '/': // Prefix: "/"
 
-						if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "order_uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
-							case "POST":
-								s.handleCancelOrderRequest([1]string{
+							case "GET":
+								s.handleAdminGetOrderRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "GET")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/c"
+
+							if l := len("/c"); len(elem) >= l && elem[0:l] == "/c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAdminCancelOrderRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "omplete"
+
+								if l := len("omplete"); len(elem) >= l && elem[0:l] == "omplete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAdminCompleteOrderRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+						}
+					}
+
+				case 's': // Prefix: "sagas/stuck"
+
+					if l := len("sagas/stuck"); len(elem) >= l && elem[0:l] == "sagas/stuck" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAdminListStuckSagasRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'o': // Prefix: "orders"
+
+				if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListOrdersRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateOrderRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "order_uuid"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetOrderByUUIDRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-					case 'p': // Prefix: "pay"
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-						if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handlePayOrderRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "cancel"
+
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCancelOrderRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'p': // Prefix: "pay"
+
+							if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handlePayOrderRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
 
+						}
 					}
 				}
+
 			}
 		}
 	}
@@ -243,92 +402,79 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/orders"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/orders"); len(elem) >= l && elem[0:l] == "/api/v1/orders" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				switch method {
-				case "POST":
-					r.name = CreateOrderOperation
-					r.summary = "Create an order"
-					r.operationID = "CreateOrder"
-					r.operationGroup = ""
-					r.pathPattern = "/api/v1/orders"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
-				}
+				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'a': // Prefix: "admin/"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "order_uuid"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetOrderByUUIDOperation
-						r.summary = "Get order by UUID"
-						r.operationID = "GetOrderByUUID"
-						r.operationGroup = ""
-						r.pathPattern = "/api/v1/orders/{order_uuid}"
-						r.args = args
-						r.count = 1
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'o': // Prefix: "orders"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch method {
+						case "GET":
+							r.name = AdminListOrdersOperation
+							r.summary = "List all orders"
+							r.operationID = "AdminListOrders"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/admin/orders"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "cancel"
+					case '/': // Prefix: "/"
 
-						if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "order_uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
-							case "POST":
-								r.name = CancelOrderOperation
-								r.summary = "Cancel an order"
-								r.operationID = "CancelOrder"
+							case "GET":
+								r.name = AdminGetOrderOperation
+								r.summary = "Get any order by UUID"
+								r.operationID = "AdminGetOrder"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/orders/{order_uuid}/cancel"
+								r.pathPattern = "/api/v1/admin/orders/{order_uuid}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -336,34 +482,232 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/c"
+
+							if l := len("/c"); len(elem) >= l && elem[0:l] == "/c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AdminCancelOrderOperation
+										r.summary = "Force-cancel an order"
+										r.operationID = "AdminCancelOrder"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/orders/{order_uuid}/cancel"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "omplete"
+
+								if l := len("omplete"); len(elem) >= l && elem[0:l] == "omplete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AdminCompleteOrderOperation
+										r.summary = "Force-complete an order"
+										r.operationID = "AdminCompleteOrder"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/orders/{order_uuid}/complete"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+						}
+					}
+
+				case 's': // Prefix: "sagas/stuck"
+
+					if l := len("sagas/stuck"); len(elem) >= l && elem[0:l] == "sagas/stuck" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = AdminListStuckSagasOperation
+							r.summary = "List stuck sagas"
+							r.operationID = "AdminListStuckSagas"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/admin/sagas/stuck"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'o': // Prefix: "orders"
 
-					case 'p': // Prefix: "pay"
+				if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-						if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = ListOrdersOperation
+						r.summary = "List orders of the current user"
+						r.operationID = "ListOrders"
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/orders"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = CreateOrderOperation
+						r.summary = "Create an order"
+						r.operationID = "CreateOrder"
+						r.operationGroup = ""
+						r.pathPattern = "/api/v1/orders"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "order_uuid"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetOrderByUUIDOperation
+							r.summary = "Get order by UUID"
+							r.operationID = "GetOrderByUUID"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/orders/{order_uuid}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = PayOrderOperation
-								r.summary = "Pay for an order"
-								r.operationID = "PayOrder"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/orders/{order_uuid}/pay"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
+							break
 						}
+						switch elem[0] {
+						case 'c': // Prefix: "cancel"
 
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CancelOrderOperation
+									r.summary = "Cancel an order"
+									r.operationID = "CancelOrder"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/orders/{order_uuid}/cancel"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'p': // Prefix: "pay"
+
+							if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = PayOrderOperation
+									r.summary = "Pay for an order"
+									r.operationID = "PayOrder"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/orders/{order_uuid}/pay"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
 					}
 				}
+
 			}
 		}
 	}
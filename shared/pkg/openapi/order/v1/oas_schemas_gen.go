@@ -3,10 +3,39 @@
 package orderv1
 
 import (
+	"time"
+
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
 
+// AdminCancelOrderNoContent is response for AdminCancelOrder operation.
+type AdminCancelOrderNoContent struct{}
+
+func (*AdminCancelOrderNoContent) adminCancelOrderRes() {}
+
+// AdminCompleteOrderNoContent is response for AdminCompleteOrder operation.
+type AdminCompleteOrderNoContent struct{}
+
+func (*AdminCompleteOrderNoContent) adminCompleteOrderRes() {}
+
+// Request body of a forced order transition made by staff.
+// Ref: #/components/schemas/admin_order_action_request
+type AdminOrderActionRequest struct {
+	// Why the order is changed; it is stored in the audit log.
+	Reason string `json:"reason"`
+}
+
+// GetReason returns the value of Reason.
+func (s *AdminOrderActionRequest) GetReason() string {
+	return s.Reason
+}
+
+// SetReason sets the value of Reason.
+func (s *AdminOrderActionRequest) SetReason(val string) {
+	s.Reason = val
+}
+
 // Merged schema.
 // Ref: #/components/schemas/bad_gateway_error
 type BadGatewayError struct {
@@ -68,16 +97,163 @@ func (s *BadRequestError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes()    {}
-func (*BadRequestError) createOrderRes()    {}
-func (*BadRequestError) getOrderByUUIDRes() {}
-func (*BadRequestError) payOrderRes()       {}
+func (*BadRequestError) adminCancelOrderRes()    {}
+func (*BadRequestError) adminCompleteOrderRes()  {}
+func (*BadRequestError) adminGetOrderRes()       {}
+func (*BadRequestError) adminListOrdersRes()     {}
+func (*BadRequestError) adminListStuckSagasRes() {}
+func (*BadRequestError) cancelOrderRes()         {}
+func (*BadRequestError) createOrderRes()         {}
+func (*BadRequestError) getOrderByUUIDRes()      {}
+func (*BadRequestError) listOrdersRes()          {}
+func (*BadRequestError) payOrderRes()            {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
 
 func (*CancelOrderNoContent) cancelOrderRes() {}
 
+// Kind of a compatibility rule between parts.
+// Ref: #/components/schemas/compatibility_rule
+type CompatibilityRule string
+
+const (
+	CompatibilityRuleREQUIRES       CompatibilityRule = "REQUIRES"
+	CompatibilityRuleCONFLICTSWITH  CompatibilityRule = "CONFLICTS_WITH"
+	CompatibilityRuleCOMPATIBLEWITH CompatibilityRule = "COMPATIBLE_WITH"
+)
+
+// AllValues returns all CompatibilityRule values.
+func (CompatibilityRule) AllValues() []CompatibilityRule {
+	return []CompatibilityRule{
+		CompatibilityRuleREQUIRES,
+		CompatibilityRuleCONFLICTSWITH,
+		CompatibilityRuleCOMPATIBLEWITH,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CompatibilityRule) MarshalText() ([]byte, error) {
+	switch s {
+	case CompatibilityRuleREQUIRES:
+		return []byte(s), nil
+	case CompatibilityRuleCONFLICTSWITH:
+		return []byte(s), nil
+	case CompatibilityRuleCOMPATIBLEWITH:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CompatibilityRule) UnmarshalText(data []byte) error {
+	switch CompatibilityRule(data) {
+	case CompatibilityRuleREQUIRES:
+		*s = CompatibilityRuleREQUIRES
+		return nil
+	case CompatibilityRuleCONFLICTSWITH:
+		*s = CompatibilityRuleCONFLICTSWITH
+		return nil
+	case CompatibilityRuleCOMPATIBLEWITH:
+		*s = CompatibilityRuleCOMPATIBLEWITH
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// A compatibility rule of a part that the ordered parts break, as reported by InventoryService.
+// ValidateConfiguration.
+// Ref: #/components/schemas/configuration_violation
+type ConfigurationViolation struct {
+	// UUID of the part whose rule is broken.
+	PartUUID uuid.UUID         `json:"part_uuid"`
+	Rule     CompatibilityRule `json:"rule"`
+	// Part the violation is about: the missing part of a REQUIRES rule, or the ordered part that breaks
+	// a CONFLICTS_WITH or COMPATIBLE_WITH rule.
+	TargetPartUUID OptUUID `json:"target_part_uuid"`
+	// Missing category of a REQUIRES rule, e.g. "ENGINE".
+	TargetCategory OptString `json:"target_category"`
+	// Human-readable description of the violation.
+	Message string `json:"message"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *ConfigurationViolation) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetRule returns the value of Rule.
+func (s *ConfigurationViolation) GetRule() CompatibilityRule {
+	return s.Rule
+}
+
+// GetTargetPartUUID returns the value of TargetPartUUID.
+func (s *ConfigurationViolation) GetTargetPartUUID() OptUUID {
+	return s.TargetPartUUID
+}
+
+// GetTargetCategory returns the value of TargetCategory.
+func (s *ConfigurationViolation) GetTargetCategory() OptString {
+	return s.TargetCategory
+}
+
+// GetMessage returns the value of Message.
+func (s *ConfigurationViolation) GetMessage() string {
+	return s.Message
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *ConfigurationViolation) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetRule sets the value of Rule.
+func (s *ConfigurationViolation) SetRule(val CompatibilityRule) {
+	s.Rule = val
+}
+
+// SetTargetPartUUID sets the value of TargetPartUUID.
+func (s *ConfigurationViolation) SetTargetPartUUID(val OptUUID) {
+	s.TargetPartUUID = val
+}
+
+// SetTargetCategory sets the value of TargetCategory.
+func (s *ConfigurationViolation) SetTargetCategory(val OptString) {
+	s.TargetCategory = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ConfigurationViolation) SetMessage(val string) {
+	s.Message = val
+}
+
 // Merged schema.
 // Ref: #/components/schemas/conflict_error
 type ConflictError struct {
@@ -107,33 +283,25 @@ func (s *ConflictError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
-func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) adminCancelOrderRes()   {}
+func (*ConflictError) adminCompleteOrderRes() {}
+func (*ConflictError) cancelOrderRes()        {}
+func (*ConflictError) createOrderRes()        {}
+func (*ConflictError) payOrderRes()           {}
 
-// Request body for creating a new spacecraft build order.
+// Request body for creating a new spacecraft build order. The order is placed for the authenticated
+// user.
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
-	// UUID of the user placing the spacecraft build order.
-	UserUUID uuid.UUID `json:"user_uuid"`
 	// List of UUIDs of spacecraft parts included in the order.
 	PartUuids []uuid.UUID `json:"part_uuids"`
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
-	return s.UserUUID
-}
-
 // GetPartUuids returns the value of PartUuids.
 func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
-}
-
 // SetPartUuids sets the value of PartUuids.
 func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
@@ -199,10 +367,16 @@ func (s *ForbiddenError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*ForbiddenError) cancelOrderRes()    {}
-func (*ForbiddenError) createOrderRes()    {}
-func (*ForbiddenError) getOrderByUUIDRes() {}
-func (*ForbiddenError) payOrderRes()       {}
+func (*ForbiddenError) adminCancelOrderRes()    {}
+func (*ForbiddenError) adminCompleteOrderRes()  {}
+func (*ForbiddenError) adminGetOrderRes()       {}
+func (*ForbiddenError) adminListOrdersRes()     {}
+func (*ForbiddenError) adminListStuckSagasRes() {}
+func (*ForbiddenError) cancelOrderRes()         {}
+func (*ForbiddenError) createOrderRes()         {}
+func (*ForbiddenError) getOrderByUUIDRes()      {}
+func (*ForbiddenError) listOrdersRes()          {}
+func (*ForbiddenError) payOrderRes()            {}
 
 // Merged schema.
 // Ref: #/components/schemas/internal_server_error
@@ -233,10 +407,53 @@ func (s *InternalServerError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes()    {}
-func (*InternalServerError) createOrderRes()    {}
-func (*InternalServerError) getOrderByUUIDRes() {}
-func (*InternalServerError) payOrderRes()       {}
+func (*InternalServerError) adminCancelOrderRes()    {}
+func (*InternalServerError) adminCompleteOrderRes()  {}
+func (*InternalServerError) adminGetOrderRes()       {}
+func (*InternalServerError) adminListOrdersRes()     {}
+func (*InternalServerError) adminListStuckSagasRes() {}
+func (*InternalServerError) cancelOrderRes()         {}
+func (*InternalServerError) createOrderRes()         {}
+func (*InternalServerError) getOrderByUUIDRes()      {}
+func (*InternalServerError) listOrdersRes()          {}
+func (*InternalServerError) payOrderRes()            {}
+
+// Orders of a user, the most recent first.
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	Orders []Order `json:"orders"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []Order {
+	return s.Orders
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []Order) {
+	s.Orders = val
+}
+
+func (*ListOrdersResponse) adminListOrdersRes() {}
+func (*ListOrdersResponse) listOrdersRes()      {}
+
+// Sagas, the oldest first.
+// Ref: #/components/schemas/list_sagas_response
+type ListSagasResponse struct {
+	Sagas []Saga `json:"sagas"`
+}
+
+// GetSagas returns the value of Sagas.
+func (s *ListSagasResponse) GetSagas() []Saga {
+	return s.Sagas
+}
+
+// SetSagas sets the value of Sagas.
+func (s *ListSagasResponse) SetSagas(val []Saga) {
+	s.Sagas = val
+}
+
+func (*ListSagasResponse) adminListStuckSagasRes() {}
 
 // Merged schema.
 // Ref: #/components/schemas/not_found_error
@@ -267,10 +484,59 @@ func (s *NotFoundError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes()    {}
-func (*NotFoundError) createOrderRes()    {}
-func (*NotFoundError) getOrderByUUIDRes() {}
-func (*NotFoundError) payOrderRes()       {}
+func (*NotFoundError) adminCancelOrderRes()   {}
+func (*NotFoundError) adminCompleteOrderRes() {}
+func (*NotFoundError) adminGetOrderRes()      {}
+func (*NotFoundError) cancelOrderRes()        {}
+func (*NotFoundError) createOrderRes()        {}
+func (*NotFoundError) getOrderByUUIDRes()     {}
+func (*NotFoundError) payOrderRes()           {}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptInt32 returns new OptInt32 with value set to v.
 func NewOptInt32(v int32) OptInt32 {
@@ -318,6 +584,69 @@ func (o OptInt32) Or(d int32) int32 {
 	return d
 }
 
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilPaymentMethod returns new OptNilPaymentMethod with value set to v.
 func NewOptNilPaymentMethod(v PaymentMethod) OptNilPaymentMethod {
 	return OptNilPaymentMethod{
@@ -444,6 +773,52 @@ func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -490,6 +865,52 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Full representation of a spacecraft build order stored in internal storage.
 // Ref: #/components/schemas/order
 type Order struct {
@@ -501,11 +922,19 @@ type Order struct {
 	PartUuids []uuid.UUID `json:"part_uuids"`
 	// Total price formatted with 2 fraction digits (e.g. "123.45").
 	TotalPrice string `json:"total_price"`
+	// Catalog price of each part of part_uuids, in the same order, at the time the order was created;
+	// their sum is total_price. Absent for orders created before unit prices were kept.
+	UnitPrices []string `json:"unit_prices"`
 	// UUID of the payment transaction (present if the order is paid).
 	TransactionUUID OptNilUUID `json:"transaction_uuid"`
 	// Payment method used to pay for the order (present if the order is paid).
 	PaymentMethod OptNilPaymentMethod `json:"payment_method"`
 	Status        OrderStatus         `json:"status"`
+	// Time the order was created.
+	CreatedAt OptDateTime `json:"created_at"`
+	// Payment deadline. An order still PENDING_PAYMENT at this time is cancelled. Absent for orders
+	// created before deadlines were kept.
+	ExpiresAt OptNilDateTime `json:"expires_at"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -528,6 +957,11 @@ func (s *Order) GetTotalPrice() string {
 	return s.TotalPrice
 }
 
+// GetUnitPrices returns the value of UnitPrices.
+func (s *Order) GetUnitPrices() []string {
+	return s.UnitPrices
+}
+
 // GetTransactionUUID returns the value of TransactionUUID.
 func (s *Order) GetTransactionUUID() OptNilUUID {
 	return s.TransactionUUID
@@ -543,6 +977,16 @@ func (s *Order) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Order) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Order) GetExpiresAt() OptNilDateTime {
+	return s.ExpiresAt
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *Order) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -563,6 +1007,11 @@ func (s *Order) SetTotalPrice(val string) {
 	s.TotalPrice = val
 }
 
+// SetUnitPrices sets the value of UnitPrices.
+func (s *Order) SetUnitPrices(val []string) {
+	s.UnitPrices = val
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *Order) SetTransactionUUID(val OptNilUUID) {
 	s.TransactionUUID = val
@@ -578,6 +1027,17 @@ func (s *Order) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Order) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Order) SetExpiresAt(val OptNilDateTime) {
+	s.ExpiresAt = val
+}
+
+func (*Order) adminGetOrderRes()  {}
 func (*Order) getOrderByUUIDRes() {}
 
 // Status of the spacecraft build order.
@@ -765,10 +1225,257 @@ func (s *RateLimitError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*RateLimitError) cancelOrderRes()    {}
-func (*RateLimitError) createOrderRes()    {}
-func (*RateLimitError) getOrderByUUIDRes() {}
-func (*RateLimitError) payOrderRes()       {}
+func (*RateLimitError) adminCancelOrderRes()    {}
+func (*RateLimitError) adminCompleteOrderRes()  {}
+func (*RateLimitError) adminGetOrderRes()       {}
+func (*RateLimitError) adminListOrdersRes()     {}
+func (*RateLimitError) adminListStuckSagasRes() {}
+func (*RateLimitError) cancelOrderRes()         {}
+func (*RateLimitError) createOrderRes()         {}
+func (*RateLimitError) getOrderByUUIDRes()      {}
+func (*RateLimitError) listOrdersRes()          {}
+func (*RateLimitError) payOrderRes()            {}
+
+// Progress of a transition of an order that spans other services.
+// Ref: #/components/schemas/saga
+type Saga struct {
+	// Unique identifier of the saga.
+	SagaUUID uuid.UUID `json:"saga_uuid"`
+	Type     SagaType  `json:"type"`
+	// UUID of the order the saga acts on.
+	OrderUUID uuid.UUID  `json:"order_uuid"`
+	Status    SagaStatus `json:"status"`
+	// Step to run next while RUNNING, or to undo next while COMPENSATING.
+	Step string `json:"step"`
+	// Failed tries of the current step.
+	Attempts int32 `json:"attempts"`
+	// Error of the last failed try, or the one that made the saga compensate.
+	LastError OptString `json:"last_error"`
+	// Time the saga is tried again if it is still unfinished.
+	NextRunAt time.Time `json:"next_run_at"`
+	// Time the saga started.
+	CreatedAt time.Time `json:"created_at"`
+	// Time the saga last made progress or was last tried.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetSagaUUID returns the value of SagaUUID.
+func (s *Saga) GetSagaUUID() uuid.UUID {
+	return s.SagaUUID
+}
+
+// GetType returns the value of Type.
+func (s *Saga) GetType() SagaType {
+	return s.Type
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Saga) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *Saga) GetStatus() SagaStatus {
+	return s.Status
+}
+
+// GetStep returns the value of Step.
+func (s *Saga) GetStep() string {
+	return s.Step
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *Saga) GetAttempts() int32 {
+	return s.Attempts
+}
+
+// GetLastError returns the value of LastError.
+func (s *Saga) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetNextRunAt returns the value of NextRunAt.
+func (s *Saga) GetNextRunAt() time.Time {
+	return s.NextRunAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Saga) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Saga) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetSagaUUID sets the value of SagaUUID.
+func (s *Saga) SetSagaUUID(val uuid.UUID) {
+	s.SagaUUID = val
+}
+
+// SetType sets the value of Type.
+func (s *Saga) SetType(val SagaType) {
+	s.Type = val
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Saga) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Saga) SetStatus(val SagaStatus) {
+	s.Status = val
+}
+
+// SetStep sets the value of Step.
+func (s *Saga) SetStep(val string) {
+	s.Step = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *Saga) SetAttempts(val int32) {
+	s.Attempts = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *Saga) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetNextRunAt sets the value of NextRunAt.
+func (s *Saga) SetNextRunAt(val time.Time) {
+	s.NextRunAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Saga) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Saga) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// How far the saga got. RUNNING runs its steps forward, COMPENSATING undoes the steps done after one
+// failed.
+// Ref: #/components/schemas/saga_status
+type SagaStatus string
+
+const (
+	SagaStatusRUNNING      SagaStatus = "RUNNING"
+	SagaStatusCOMPENSATING SagaStatus = "COMPENSATING"
+	SagaStatusCOMPLETED    SagaStatus = "COMPLETED"
+	SagaStatusCOMPENSATED  SagaStatus = "COMPENSATED"
+)
+
+// AllValues returns all SagaStatus values.
+func (SagaStatus) AllValues() []SagaStatus {
+	return []SagaStatus{
+		SagaStatusRUNNING,
+		SagaStatusCOMPENSATING,
+		SagaStatusCOMPLETED,
+		SagaStatusCOMPENSATED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SagaStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SagaStatusRUNNING:
+		return []byte(s), nil
+	case SagaStatusCOMPENSATING:
+		return []byte(s), nil
+	case SagaStatusCOMPLETED:
+		return []byte(s), nil
+	case SagaStatusCOMPENSATED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SagaStatus) UnmarshalText(data []byte) error {
+	switch SagaStatus(data) {
+	case SagaStatusRUNNING:
+		*s = SagaStatusRUNNING
+		return nil
+	case SagaStatusCOMPENSATING:
+		*s = SagaStatusCOMPENSATING
+		return nil
+	case SagaStatusCOMPLETED:
+		*s = SagaStatusCOMPLETED
+		return nil
+	case SagaStatusCOMPENSATED:
+		*s = SagaStatusCOMPENSATED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Transition of the order the saga runs. CREATE_ORDER reserves stock and creates the order,
+// PAY_ORDER charges, marks the order paid and announces it, RELEASE_ORDER gives back the stock of a
+// cancelled order, refunds it if it was paid and announces that it was cancelled, EXPIRE_ORDER does
+// the same for an order not paid in time and announces that it expired instead.
+// Ref: #/components/schemas/saga_type
+type SagaType string
+
+const (
+	SagaTypeCREATEORDER  SagaType = "CREATE_ORDER"
+	SagaTypePAYORDER     SagaType = "PAY_ORDER"
+	SagaTypeRELEASEORDER SagaType = "RELEASE_ORDER"
+	SagaTypeEXPIREORDER  SagaType = "EXPIRE_ORDER"
+)
+
+// AllValues returns all SagaType values.
+func (SagaType) AllValues() []SagaType {
+	return []SagaType{
+		SagaTypeCREATEORDER,
+		SagaTypePAYORDER,
+		SagaTypeRELEASEORDER,
+		SagaTypeEXPIREORDER,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SagaType) MarshalText() ([]byte, error) {
+	switch s {
+	case SagaTypeCREATEORDER:
+		return []byte(s), nil
+	case SagaTypePAYORDER:
+		return []byte(s), nil
+	case SagaTypeRELEASEORDER:
+		return []byte(s), nil
+	case SagaTypeEXPIREORDER:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SagaType) UnmarshalText(data []byte) error {
+	switch SagaType(data) {
+	case SagaTypeCREATEORDER:
+		*s = SagaTypeCREATEORDER
+		return nil
+	case SagaTypePAYORDER:
+		*s = SagaTypePAYORDER
+		return nil
+	case SagaTypeRELEASEORDER:
+		*s = SagaTypeRELEASEORDER
+		return nil
+	case SagaTypeEXPIREORDER:
+		*s = SagaTypeEXPIREORDER
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 // Merged schema.
 // Ref: #/components/schemas/service_unavailable_error
@@ -802,6 +1509,31 @@ func (s *ServiceUnavailableError) SetMessage(val OptString) {
 func (*ServiceUnavailableError) createOrderRes() {}
 func (*ServiceUnavailableError) payOrderRes()    {}
 
+type SessionCookie struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *SessionCookie) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *SessionCookie) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *SessionCookie) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *SessionCookie) SetRoles(val []string) {
+	s.Roles = val
+}
+
 // Merged schema.
 // Ref: #/components/schemas/unauthorized_error
 type UnauthorizedError struct {
@@ -831,10 +1563,16 @@ func (s *UnauthorizedError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-func (*UnauthorizedError) cancelOrderRes()    {}
-func (*UnauthorizedError) createOrderRes()    {}
-func (*UnauthorizedError) getOrderByUUIDRes() {}
-func (*UnauthorizedError) payOrderRes()       {}
+func (*UnauthorizedError) adminCancelOrderRes()    {}
+func (*UnauthorizedError) adminCompleteOrderRes()  {}
+func (*UnauthorizedError) adminGetOrderRes()       {}
+func (*UnauthorizedError) adminListOrdersRes()     {}
+func (*UnauthorizedError) adminListStuckSagasRes() {}
+func (*UnauthorizedError) cancelOrderRes()         {}
+func (*UnauthorizedError) createOrderRes()         {}
+func (*UnauthorizedError) getOrderByUUIDRes()      {}
+func (*UnauthorizedError) listOrdersRes()          {}
+func (*UnauthorizedError) payOrderRes()            {}
 
 // Merged schema.
 // Ref: #/components/schemas/validation_error
@@ -845,6 +1583,8 @@ type ValidationError struct {
 	Message OptString `json:"message"`
 	// Detailed validation error descriptions.
 	Details []string `json:"details"`
+	// Compatibility rules broken by the ordered parts.
+	Violations []ConfigurationViolation `json:"violations"`
 }
 
 // GetCode returns the value of Code.
@@ -862,6 +1602,11 @@ func (s *ValidationError) GetDetails() []string {
 	return s.Details
 }
 
+// GetViolations returns the value of Violations.
+func (s *ValidationError) GetViolations() []ConfigurationViolation {
+	return s.Violations
+}
+
 // SetCode sets the value of Code.
 func (s *ValidationError) SetCode(val OptInt32) {
 	s.Code = val
@@ -877,5 +1622,10 @@ func (s *ValidationError) SetDetails(val []string) {
 	s.Details = val
 }
 
+// SetViolations sets the value of Violations.
+func (s *ValidationError) SetViolations(val []ConfigurationViolation) {
+	s.Violations = val
+}
+
 func (*ValidationError) createOrderRes() {}
 func (*ValidationError) payOrderRes()    {}
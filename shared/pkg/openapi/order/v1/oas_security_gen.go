@@ -0,0 +1,134 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package orderv1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleBearerAuth handles BearerAuth security.
+	// Session token issued by the IAM Service.
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
+	// HandleSessionCookie handles SessionCookie security.
+	// Session token issued by the IAM Service, sent as a cookie.
+	HandleSessionCookie(ctx context.Context, operationName OperationName, t SessionCookie) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesBearerAuth = map[string][]string{
+	AdminCancelOrderOperation:    {},
+	AdminCompleteOrderOperation:  {},
+	AdminGetOrderOperation:       {},
+	AdminListOrdersOperation:     {},
+	AdminListStuckSagasOperation: {},
+	CancelOrderOperation:         {},
+	CreateOrderOperation:         {},
+	GetOrderByUUIDOperation:      {},
+	ListOrdersOperation:          {},
+	PayOrderOperation:            {},
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+var operationRolesSessionCookie = map[string][]string{
+	AdminCancelOrderOperation:    {},
+	AdminCompleteOrderOperation:  {},
+	AdminGetOrderOperation:       {},
+	AdminListOrdersOperation:     {},
+	AdminListStuckSagasOperation: {},
+	CancelOrderOperation:         {},
+	CreateOrderOperation:         {},
+	GetOrderByUUIDOperation:      {},
+	ListOrdersOperation:          {},
+	PayOrderOperation:            {},
+}
+
+func (s *Server) securitySessionCookie(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t SessionCookie
+	const parameterName = "session"
+	var value string
+	switch cookie, err := req.Cookie(parameterName); {
+	case err == nil: // if NO error
+		value = cookie.Value
+	case errors.Is(err, http.ErrNoCookie):
+		return ctx, false, nil
+	default:
+		return nil, false, errors.Wrap(err, "get cookie value")
+	}
+	t.APIKey = value
+	t.Roles = operationRolesSessionCookie[operationName]
+	rctx, err := s.sec.HandleSessionCookie(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// BearerAuth provides BearerAuth security value.
+	// Session token issued by the IAM Service.
+	BearerAuth(ctx context.Context, operationName OperationName) (BearerAuth, error)
+	// SessionCookie provides SessionCookie security value.
+	// Session token issued by the IAM Service, sent as a cookie.
+	SessionCookie(ctx context.Context, operationName OperationName) (SessionCookie, error)
+}
+
+func (s *Client) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BearerAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BearerAuth\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
+
+func (s *Client) securitySessionCookie(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.SessionCookie(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"SessionCookie\"")
+	}
+	req.AddCookie(&http.Cookie{
+		Name:  "session",
+		Value: t.APIKey,
+	})
+	return nil
+}
@@ -8,6 +8,43 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AdminCancelOrder implements AdminCancelOrder operation.
+	//
+	// Cancels an order of any user that is PENDING_PAYMENT or PAID and emits OrderCancelled. Requires
+	// the support or admin role. The reason is stored in the audit log together with the caller.
+	//
+	// POST /api/v1/admin/orders/{order_uuid}/cancel
+	AdminCancelOrder(ctx context.Context, req *AdminOrderActionRequest, params AdminCancelOrderParams) (AdminCancelOrderRes, error)
+	// AdminCompleteOrder implements AdminCompleteOrder operation.
+	//
+	// Marks a PAID order of any user as COMPLETED without waiting for the assembly. Requires the admin
+	// role. The reason is stored in the audit log together with the caller.
+	//
+	// POST /api/v1/admin/orders/{order_uuid}/complete
+	AdminCompleteOrder(ctx context.Context, req *AdminOrderActionRequest, params AdminCompleteOrderParams) (AdminCompleteOrderRes, error)
+	// AdminGetOrder implements AdminGetOrder operation.
+	//
+	// Returns an order of any user. Requires the support or admin role. The request is recorded in the
+	// audit log.
+	//
+	// GET /api/v1/admin/orders/{order_uuid}
+	AdminGetOrder(ctx context.Context, params AdminGetOrderParams) (AdminGetOrderRes, error)
+	// AdminListOrders implements AdminListOrders operation.
+	//
+	// Returns the most recent orders of all users, newest first, optionally filtered by user and status.
+	// Requires the support or admin role. The request is recorded in the audit log.
+	//
+	// GET /api/v1/admin/orders
+	AdminListOrders(ctx context.Context, params AdminListOrdersParams) (AdminListOrdersRes, error)
+	// AdminListStuckSagas implements AdminListStuckSagas operation.
+	//
+	// Returns the sagas still unfinished long after they started, oldest first. A saga runs a transition
+	// of an order that spans other services, such as creating or paying it; one that is stuck keeps
+	// being retried in the background and may need a look. Requires the support or admin role. The
+	// request is recorded in the audit log.
+	//
+	// GET /api/v1/admin/sagas/stuck
+	AdminListStuckSagas(ctx context.Context, params AdminListStuckSagasParams) (AdminListStuckSagasRes, error)
 	// CancelOrder implements CancelOrder operation.
 	//
 	// Cancels an existing order.   If the order is in PENDING_PAYMENT status, it is changed to CANCELLED.
@@ -17,9 +54,10 @@ type Handler interface {
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
 	// CreateOrder implements CreateOrder operation.
 	//
-	// Creates a new order based on the provided user UUID and list of part UUIDs. The service fetches
-	// parts via InventoryService.ListParts, verifies that all parts exist, calculates the total price,
-	// generates order_uuid, and saves the order with status PENDING_PAYMENT.
+	// Creates a new order of the authenticated user from the list of part UUIDs. The service fetches
+	// parts via InventoryService.ListParts, verifies that all parts exist and are in stock, checks the
+	// parts against their compatibility rules via InventoryService.ValidateConfiguration, calculates the
+	// total price, generates order_uuid, and saves the order with status PENDING_PAYMENT.
 	//
 	// POST /api/v1/orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
@@ -31,6 +69,12 @@ type Handler interface {
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrderByUUID(ctx context.Context, params GetOrderByUUIDParams) (GetOrderByUUIDRes, error)
+	// ListOrders implements ListOrders operation.
+	//
+	// Returns the most recent orders of the authenticated user, newest first.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 	// PayOrder implements PayOrder operation.
 	//
 	// Processes payment for a previously created order.   The service looks up the order by order_uuid.
@@ -45,18 +89,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
@@ -13,6 +13,58 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AdminCancelOrder implements AdminCancelOrder operation.
+//
+// Cancels an order of any user that is PENDING_PAYMENT or PAID and emits OrderCancelled. Requires
+// the support or admin role. The reason is stored in the audit log together with the caller.
+//
+// POST /api/v1/admin/orders/{order_uuid}/cancel
+func (UnimplementedHandler) AdminCancelOrder(ctx context.Context, req *AdminOrderActionRequest, params AdminCancelOrderParams) (r AdminCancelOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminCompleteOrder implements AdminCompleteOrder operation.
+//
+// Marks a PAID order of any user as COMPLETED without waiting for the assembly. Requires the admin
+// role. The reason is stored in the audit log together with the caller.
+//
+// POST /api/v1/admin/orders/{order_uuid}/complete
+func (UnimplementedHandler) AdminCompleteOrder(ctx context.Context, req *AdminOrderActionRequest, params AdminCompleteOrderParams) (r AdminCompleteOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetOrder implements AdminGetOrder operation.
+//
+// Returns an order of any user. Requires the support or admin role. The request is recorded in the
+// audit log.
+//
+// GET /api/v1/admin/orders/{order_uuid}
+func (UnimplementedHandler) AdminGetOrder(ctx context.Context, params AdminGetOrderParams) (r AdminGetOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminListOrders implements AdminListOrders operation.
+//
+// Returns the most recent orders of all users, newest first, optionally filtered by user and status.
+// Requires the support or admin role. The request is recorded in the audit log.
+//
+// GET /api/v1/admin/orders
+func (UnimplementedHandler) AdminListOrders(ctx context.Context, params AdminListOrdersParams) (r AdminListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminListStuckSagas implements AdminListStuckSagas operation.
+//
+// Returns the sagas still unfinished long after they started, oldest first. A saga runs a transition
+// of an order that spans other services, such as creating or paying it; one that is stuck keeps
+// being retried in the background and may need a look. Requires the support or admin role. The
+// request is recorded in the audit log.
+//
+// GET /api/v1/admin/sagas/stuck
+func (UnimplementedHandler) AdminListStuckSagas(ctx context.Context, params AdminListStuckSagasParams) (r AdminListStuckSagasRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements CancelOrder operation.
 //
 // Cancels an existing order.   If the order is in PENDING_PAYMENT status, it is changed to CANCELLED.
@@ -26,9 +78,10 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 
 // CreateOrder implements CreateOrder operation.
 //
-// Creates a new order based on the provided user UUID and list of part UUIDs. The service fetches
-// parts via InventoryService.ListParts, verifies that all parts exist, calculates the total price,
-// generates order_uuid, and saves the order with status PENDING_PAYMENT.
+// Creates a new order of the authenticated user from the list of part UUIDs. The service fetches
+// parts via InventoryService.ListParts, verifies that all parts exist and are in stock, checks the
+// parts against their compatibility rules via InventoryService.ValidateConfiguration, calculates the
+// total price, generates order_uuid, and saves the order with status PENDING_PAYMENT.
 //
 // POST /api/v1/orders
 func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
@@ -46,6 +99,15 @@ func (UnimplementedHandler) GetOrderByUUID(ctx context.Context, params GetOrderB
 	return r, ht.ErrNotImplemented
 }
 
+// ListOrders implements ListOrders operation.
+//
+// Returns the most recent orders of the authenticated user, newest first.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
 // Processes payment for a previously created order.   The service looks up the order by order_uuid.
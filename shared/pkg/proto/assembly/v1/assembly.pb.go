@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -181,11 +182,155 @@ func (x *AssembledShipRecord) GetBuildTimeSec() int64 {
 	return 0
 }
 
+// OrderCancelledRecord represents the Kafka event "OrderCancelled" published by
+// OrderService when an unpaid order is cancelled.
+//
+// Fields:
+// - event_uuid: Unique event identifier for idempotency.
+// - order_uuid: Identifier of the cancelled order.
+// - user_uuid: Identifier of the user who owns the order.
+type OrderCancelledRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelledRecord) Reset() {
+	*x = OrderCancelledRecord{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelledRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelledRecord) ProtoMessage() {}
+
+func (x *OrderCancelledRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelledRecord.ProtoReflect.Descriptor instead.
+func (*OrderCancelledRecord) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderCancelledRecord) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelledRecord) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelledRecord) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// OrderExpiredRecord represents the Kafka event "OrderExpired" published by
+// OrderService when an order is cancelled because it was not paid before its
+// payment deadline.
+//
+// Fields:
+// - event_uuid: Unique event identifier for idempotency.
+// - order_uuid: Identifier of the expired order.
+// - user_uuid: Identifier of the user who owns the order.
+// - expires_at: Payment deadline the order missed.
+type OrderExpiredRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderExpiredRecord) Reset() {
+	*x = OrderExpiredRecord{}
+	mi := &file_assembly_v1_assembly_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderExpiredRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderExpiredRecord) ProtoMessage() {}
+
+func (x *OrderExpiredRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_assembly_v1_assembly_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderExpiredRecord.ProtoReflect.Descriptor instead.
+func (*OrderExpiredRecord) Descriptor() ([]byte, []int) {
+	return file_assembly_v1_assembly_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderExpiredRecord) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredRecord) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredRecord) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderExpiredRecord) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 var File_assembly_v1_assembly_proto protoreflect.FileDescriptor
 
 const file_assembly_v1_assembly_proto_rawDesc = "" +
 	"\n" +
-	"\x1aassembly/v1/assembly.proto\x12\vassembly.v1\"\xbe\x01\n" +
+	"\x1aassembly/v1/assembly.proto\x12\vassembly.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
 	"\x0fPaidOrderRecord\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -200,7 +345,21 @@ const file_assembly_v1_assembly_proto_rawDesc = "" +
 	"\n" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12$\n" +
-	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSecBTZRgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/assembly/v1;assemblypbv1b\x06proto3"
+	"\x0ebuild_time_sec\x18\x04 \x01(\x03R\fbuildTimeSec\"q\n" +
+	"\x14OrderCancelledRecord\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\"\xaa\x01\n" +
+	"\x12OrderExpiredRecord\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtBTZRgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/assembly/v1;assemblypbv1b\x06proto3"
 
 var (
 	file_assembly_v1_assembly_proto_rawDescOnce sync.Once
@@ -215,19 +374,23 @@ func file_assembly_v1_assembly_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_assembly_v1_assembly_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+	file_assembly_v1_assembly_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 	file_assembly_v1_assembly_proto_goTypes  = []any{
-		(*PaidOrderRecord)(nil),     // 0: assembly.v1.PaidOrderRecord
-		(*AssembledShipRecord)(nil), // 1: assembly.v1.AssembledShipRecord
+		(*PaidOrderRecord)(nil),       // 0: assembly.v1.PaidOrderRecord
+		(*AssembledShipRecord)(nil),   // 1: assembly.v1.AssembledShipRecord
+		(*OrderCancelledRecord)(nil),  // 2: assembly.v1.OrderCancelledRecord
+		(*OrderExpiredRecord)(nil),    // 3: assembly.v1.OrderExpiredRecord
+		(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 	}
 )
 
 var file_assembly_v1_assembly_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: assembly.v1.OrderExpiredRecord.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_assembly_v1_assembly_proto_init() }
@@ -241,7 +404,7 @@ func file_assembly_v1_assembly_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_assembly_v1_assembly_proto_rawDesc), len(file_assembly_v1_assembly_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
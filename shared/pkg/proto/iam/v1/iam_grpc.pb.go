@@ -0,0 +1,428 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: iam/v1/iam.proto
+
+package iampbv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IAMService_Register_FullMethodName        = "/iam.v1.IAMService/Register"
+	IAMService_Login_FullMethodName           = "/iam.v1.IAMService/Login"
+	IAMService_ValidateSession_FullMethodName = "/iam.v1.IAMService/ValidateSession"
+	IAMService_RefreshSession_FullMethodName  = "/iam.v1.IAMService/RefreshSession"
+	IAMService_RevokeSession_FullMethodName   = "/iam.v1.IAMService/RevokeSession"
+	IAMService_IssueLinkToken_FullMethodName  = "/iam.v1.IAMService/IssueLinkToken"
+	IAMService_RedeemLinkToken_FullMethodName = "/iam.v1.IAMService/RedeemLinkToken"
+)
+
+// IAMServiceClient is the client API for IAMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IAM service.
+// Registers users and manages their sessions. A session has two opaque
+// tokens: a short-lived `session_token` that other services validate on
+// every request and a long-lived `refresh_token` that is exchanged for new
+// tokens. Only hashes of the tokens are stored.
+//
+// Every user has a role. Registered users are customers; roles are assigned
+// with iamctl.
+type IAMServiceClient interface {
+	// Register
+	// Creates a user with a unique login and email.
+	// Returns ALREADY_EXISTS if the login or the email is taken.
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// Login
+	// Checks the credentials and creates a session.
+	// Returns UNAUTHENTICATED for an unknown login or a wrong password.
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// ValidateSession
+	// Returns the user and the session of a session token. The role of the
+	// user is read on every call, so a role change applies at once.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or revoked.
+	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error)
+	// RefreshSession
+	// Exchanges a refresh token for new session and refresh tokens; the old
+	// tokens stop working. Reusing the refresh token replaced by the last exchange
+	// revokes the session.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or revoked.
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	// RevokeSession
+	// Ends the session of a session token. Revoking an ended session succeeds.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// IssueLinkToken
+	// Returns a short-lived link token for the user of the session token. The
+	// user hands it to another client, e.g. the Telegram bot in the deep link
+	// https://t.me/<bot>?start=<link_token>, which redeems it with
+	// RedeemLinkToken to act on behalf of the user.
+	// Returns UNAUTHENTICATED if the session token is unknown, expired or revoked.
+	IssueLinkToken(ctx context.Context, in *IssueLinkTokenRequest, opts ...grpc.CallOption) (*IssueLinkTokenResponse, error)
+	// RedeemLinkToken
+	// Exchanges a link token for a new session of its user. A link token can
+	// be redeemed once.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or redeemed.
+	RedeemLinkToken(ctx context.Context, in *RedeemLinkTokenRequest, opts ...grpc.CallOption) (*RedeemLinkTokenResponse, error)
+}
+
+type iAMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIAMServiceClient(cc grpc.ClientConnInterface) IAMServiceClient {
+	return &iAMServiceClient{cc}
+}
+
+func (c *iAMServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, IAMService_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, IAMService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateSessionResponse)
+	err := c.cc.Invoke(ctx, IAMService_ValidateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, IAMService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, IAMService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) IssueLinkToken(ctx context.Context, in *IssueLinkTokenRequest, opts ...grpc.CallOption) (*IssueLinkTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueLinkTokenResponse)
+	err := c.cc.Invoke(ctx, IAMService_IssueLinkToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) RedeemLinkToken(ctx context.Context, in *RedeemLinkTokenRequest, opts ...grpc.CallOption) (*RedeemLinkTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemLinkTokenResponse)
+	err := c.cc.Invoke(ctx, IAMService_RedeemLinkToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IAMServiceServer is the server API for IAMService service.
+// All implementations must embed UnimplementedIAMServiceServer
+// for forward compatibility.
+//
+// IAM service.
+// Registers users and manages their sessions. A session has two opaque
+// tokens: a short-lived `session_token` that other services validate on
+// every request and a long-lived `refresh_token` that is exchanged for new
+// tokens. Only hashes of the tokens are stored.
+//
+// Every user has a role. Registered users are customers; roles are assigned
+// with iamctl.
+type IAMServiceServer interface {
+	// Register
+	// Creates a user with a unique login and email.
+	// Returns ALREADY_EXISTS if the login or the email is taken.
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Login
+	// Checks the credentials and creates a session.
+	// Returns UNAUTHENTICATED for an unknown login or a wrong password.
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// ValidateSession
+	// Returns the user and the session of a session token. The role of the
+	// user is read on every call, so a role change applies at once.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or revoked.
+	ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error)
+	// RefreshSession
+	// Exchanges a refresh token for new session and refresh tokens; the old
+	// tokens stop working. Reusing the refresh token replaced by the last exchange
+	// revokes the session.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or revoked.
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	// RevokeSession
+	// Ends the session of a session token. Revoking an ended session succeeds.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// IssueLinkToken
+	// Returns a short-lived link token for the user of the session token. The
+	// user hands it to another client, e.g. the Telegram bot in the deep link
+	// https://t.me/<bot>?start=<link_token>, which redeems it with
+	// RedeemLinkToken to act on behalf of the user.
+	// Returns UNAUTHENTICATED if the session token is unknown, expired or revoked.
+	IssueLinkToken(context.Context, *IssueLinkTokenRequest) (*IssueLinkTokenResponse, error)
+	// RedeemLinkToken
+	// Exchanges a link token for a new session of its user. A link token can
+	// be redeemed once.
+	// Returns UNAUTHENTICATED if the token is unknown, expired or redeemed.
+	RedeemLinkToken(context.Context, *RedeemLinkTokenRequest) (*RedeemLinkTokenResponse, error)
+	mustEmbedUnimplementedIAMServiceServer()
+}
+
+// UnimplementedIAMServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIAMServiceServer struct{}
+
+func (UnimplementedIAMServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Register not implemented")
+}
+
+func (UnimplementedIAMServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+
+func (UnimplementedIAMServiceServer) ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateSession not implemented")
+}
+
+func (UnimplementedIAMServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshSession not implemented")
+}
+
+func (UnimplementedIAMServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+
+func (UnimplementedIAMServiceServer) IssueLinkToken(context.Context, *IssueLinkTokenRequest) (*IssueLinkTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueLinkToken not implemented")
+}
+
+func (UnimplementedIAMServiceServer) RedeemLinkToken(context.Context, *RedeemLinkTokenRequest) (*RedeemLinkTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemLinkToken not implemented")
+}
+func (UnimplementedIAMServiceServer) mustEmbedUnimplementedIAMServiceServer() {}
+func (UnimplementedIAMServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeIAMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IAMServiceServer will
+// result in compilation errors.
+type UnsafeIAMServiceServer interface {
+	mustEmbedUnimplementedIAMServiceServer()
+}
+
+func RegisterIAMServiceServer(s grpc.ServiceRegistrar, srv IAMServiceServer) {
+	// If the following call panics, it indicates UnimplementedIAMServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IAMService_ServiceDesc, srv)
+}
+
+func _IAMService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ValidateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ValidateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_ValidateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ValidateSession(ctx, req.(*ValidateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_IssueLinkToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueLinkTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).IssueLinkToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_IssueLinkToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).IssueLinkToken(ctx, req.(*IssueLinkTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RedeemLinkToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemLinkTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RedeemLinkToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMService_RedeemLinkToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RedeemLinkToken(ctx, req.(*RedeemLinkTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IAMService_ServiceDesc is the grpc.ServiceDesc for IAMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IAMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iam.v1.IAMService",
+	HandlerType: (*IAMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _IAMService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _IAMService_Login_Handler,
+		},
+		{
+			MethodName: "ValidateSession",
+			Handler:    _IAMService_ValidateSession_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _IAMService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _IAMService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "IssueLinkToken",
+			Handler:    _IAMService_IssueLinkToken_Handler,
+		},
+		{
+			MethodName: "RedeemLinkToken",
+			Handler:    _IAMService_RedeemLinkToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iam/v1/iam.proto",
+}
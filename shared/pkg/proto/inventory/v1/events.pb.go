@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: inventory/v1/events.proto
+
+package inventorypbv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PartChangeType is the kind of change recorded by a PartChanged event.
+type PartChangeType int32
+
+const (
+	PartChangeType_PART_CHANGE_TYPE_UNSPECIFIED PartChangeType = 0
+	// The part was added to the catalog; before is unset.
+	PartChangeType_PART_CHANGE_TYPE_CREATED PartChangeType = 1
+	// Any PartInfo fields of the part were changed.
+	PartChangeType_PART_CHANGE_TYPE_UPDATED PartChangeType = 2
+	// Only the stock quantity of the part was changed.
+	PartChangeType_PART_CHANGE_TYPE_STOCK_CHANGED PartChangeType = 3
+	// The part was archived.
+	PartChangeType_PART_CHANGE_TYPE_ARCHIVED PartChangeType = 4
+	// The part was restored from the archive.
+	PartChangeType_PART_CHANGE_TYPE_RESTORED PartChangeType = 5
+)
+
+// Enum value maps for PartChangeType.
+var (
+	PartChangeType_name = map[int32]string{
+		0: "PART_CHANGE_TYPE_UNSPECIFIED",
+		1: "PART_CHANGE_TYPE_CREATED",
+		2: "PART_CHANGE_TYPE_UPDATED",
+		3: "PART_CHANGE_TYPE_STOCK_CHANGED",
+		4: "PART_CHANGE_TYPE_ARCHIVED",
+		5: "PART_CHANGE_TYPE_RESTORED",
+	}
+	PartChangeType_value = map[string]int32{
+		"PART_CHANGE_TYPE_UNSPECIFIED":   0,
+		"PART_CHANGE_TYPE_CREATED":       1,
+		"PART_CHANGE_TYPE_UPDATED":       2,
+		"PART_CHANGE_TYPE_STOCK_CHANGED": 3,
+		"PART_CHANGE_TYPE_ARCHIVED":      4,
+		"PART_CHANGE_TYPE_RESTORED":      5,
+	}
+)
+
+func (x PartChangeType) Enum() *PartChangeType {
+	p := new(PartChangeType)
+	*p = x
+	return p
+}
+
+func (x PartChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (PartChangeType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_events_proto_enumTypes[0]
+}
+
+func (x PartChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartChangeType.Descriptor instead.
+func (PartChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+// PartChangedRecord represents the outgoing Kafka event "PartChanged".
+//
+// Fields:
+// - event_uuid: Unique event identifier for idempotency.
+// - part_uuid: Identifier of the changed part.
+// - type: Kind of the change.
+// - before: The part before the change; unset for CREATED.
+// - after: The part after the change.
+// - occurred_at: Time of the change.
+type PartChangedRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Type          PartChangeType         `protobuf:"varint,3,opt,name=type,proto3,enum=inventory.v1.PartChangeType" json:"type,omitempty"`
+	Before        *Part                  `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Part                  `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartChangedRecord) Reset() {
+	*x = PartChangedRecord{}
+	mi := &file_inventory_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartChangedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartChangedRecord) ProtoMessage() {}
+
+func (x *PartChangedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartChangedRecord.ProtoReflect.Descriptor instead.
+func (*PartChangedRecord) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PartChangedRecord) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartChangedRecord) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartChangedRecord) GetType() PartChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return PartChangeType_PART_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *PartChangedRecord) GetBefore() *Part {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PartChangedRecord) GetAfter() *Part {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PartChangedRecord) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_inventory_v1_events_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x19inventory/v1/events.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cinventory/v1/inventory.proto\"\x94\x02\n" +
+	"\x11PartChangedRecord\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.inventory.v1.PartChangeTypeR\x04type\x12*\n" +
+	"\x06before\x18\x04 \x01(\v2\x12.inventory.v1.PartR\x06before\x12(\n" +
+	"\x05after\x18\x05 \x01(\v2\x12.inventory.v1.PartR\x05after\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*\xd0\x01\n" +
+	"\x0ePartChangeType\x12 \n" +
+	"\x1cPART_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PART_CHANGE_TYPE_CREATED\x10\x01\x12\x1c\n" +
+	"\x18PART_CHANGE_TYPE_UPDATED\x10\x02\x12\"\n" +
+	"\x1ePART_CHANGE_TYPE_STOCK_CHANGED\x10\x03\x12\x1d\n" +
+	"\x19PART_CHANGE_TYPE_ARCHIVED\x10\x04\x12\x1d\n" +
+	"\x19PART_CHANGE_TYPE_RESTORED\x10\x05BVZTgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1;inventorypbv1b\x06proto3"
+
+var (
+	file_inventory_v1_events_proto_rawDescOnce sync.Once
+	file_inventory_v1_events_proto_rawDescData []byte
+)
+
+func file_inventory_v1_events_proto_rawDescGZIP() []byte {
+	file_inventory_v1_events_proto_rawDescOnce.Do(func() {
+		file_inventory_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_v1_events_proto_rawDesc), len(file_inventory_v1_events_proto_rawDesc)))
+	})
+	return file_inventory_v1_events_proto_rawDescData
+}
+
+var (
+	file_inventory_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+	file_inventory_v1_events_proto_msgTypes  = make([]protoimpl.MessageInfo, 1)
+	file_inventory_v1_events_proto_goTypes   = []any{
+		(PartChangeType)(0),           // 0: inventory.v1.PartChangeType
+		(*PartChangedRecord)(nil),     // 1: inventory.v1.PartChangedRecord
+		(*Part)(nil),                  // 2: inventory.v1.Part
+		(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	}
+)
+
+var file_inventory_v1_events_proto_depIdxs = []int32{
+	0, // 0: inventory.v1.PartChangedRecord.type:type_name -> inventory.v1.PartChangeType
+	2, // 1: inventory.v1.PartChangedRecord.before:type_name -> inventory.v1.Part
+	2, // 2: inventory.v1.PartChangedRecord.after:type_name -> inventory.v1.Part
+	3, // 3: inventory.v1.PartChangedRecord.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_inventory_v1_events_proto_init() }
+func file_inventory_v1_events_proto_init() {
+	if File_inventory_v1_events_proto != nil {
+		return
+	}
+	file_inventory_v1_inventory_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_events_proto_rawDesc), len(file_inventory_v1_events_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inventory_v1_events_proto_goTypes,
+		DependencyIndexes: file_inventory_v1_events_proto_depIdxs,
+		EnumInfos:         file_inventory_v1_events_proto_enumTypes,
+		MessageInfos:      file_inventory_v1_events_proto_msgTypes,
+	}.Build()
+	File_inventory_v1_events_proto = out.File
+	file_inventory_v1_events_proto_goTypes = nil
+	file_inventory_v1_events_proto_depIdxs = nil
+}
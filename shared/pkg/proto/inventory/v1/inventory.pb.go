@@ -11,8 +11,10 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -79,6 +81,241 @@ func (Category) EnumDescriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
 }
 
+// CompatibilityRuleKind is the kind of a compatibility rule.
+type CompatibilityRuleKind int32
+
+const (
+	CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_UNSPECIFIED CompatibilityRuleKind = 0
+	// A configuration with the part must also have the target.
+	CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_REQUIRES CompatibilityRuleKind = 1
+	// A configuration with the part must not have the target.
+	CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_CONFLICTS_WITH CompatibilityRuleKind = 2
+	// The part only works with its COMPATIBLE_WITH targets: other parts of
+	// the categories of these targets are not allowed next to it.
+	CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH CompatibilityRuleKind = 3
+)
+
+// Enum value maps for CompatibilityRuleKind.
+var (
+	CompatibilityRuleKind_name = map[int32]string{
+		0: "COMPATIBILITY_RULE_KIND_UNSPECIFIED",
+		1: "COMPATIBILITY_RULE_KIND_REQUIRES",
+		2: "COMPATIBILITY_RULE_KIND_CONFLICTS_WITH",
+		3: "COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH",
+	}
+	CompatibilityRuleKind_value = map[string]int32{
+		"COMPATIBILITY_RULE_KIND_UNSPECIFIED":     0,
+		"COMPATIBILITY_RULE_KIND_REQUIRES":        1,
+		"COMPATIBILITY_RULE_KIND_CONFLICTS_WITH":  2,
+		"COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH": 3,
+	}
+)
+
+func (x CompatibilityRuleKind) Enum() *CompatibilityRuleKind {
+	p := new(CompatibilityRuleKind)
+	*p = x
+	return p
+}
+
+func (x CompatibilityRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompatibilityRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (CompatibilityRuleKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x CompatibilityRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompatibilityRuleKind.Descriptor instead.
+func (CompatibilityRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// PartSortField enumerates the fields ListParts can order by.
+type PartSortField int32
+
+const (
+	PartSortField_PART_SORT_FIELD_UNSPECIFIED    PartSortField = 0
+	PartSortField_PART_SORT_FIELD_CREATED_AT     PartSortField = 1
+	PartSortField_PART_SORT_FIELD_PRICE          PartSortField = 2
+	PartSortField_PART_SORT_FIELD_NAME           PartSortField = 3
+	PartSortField_PART_SORT_FIELD_STOCK_QUANTITY PartSortField = 4
+)
+
+// Enum value maps for PartSortField.
+var (
+	PartSortField_name = map[int32]string{
+		0: "PART_SORT_FIELD_UNSPECIFIED",
+		1: "PART_SORT_FIELD_CREATED_AT",
+		2: "PART_SORT_FIELD_PRICE",
+		3: "PART_SORT_FIELD_NAME",
+		4: "PART_SORT_FIELD_STOCK_QUANTITY",
+	}
+	PartSortField_value = map[string]int32{
+		"PART_SORT_FIELD_UNSPECIFIED":    0,
+		"PART_SORT_FIELD_CREATED_AT":     1,
+		"PART_SORT_FIELD_PRICE":          2,
+		"PART_SORT_FIELD_NAME":           3,
+		"PART_SORT_FIELD_STOCK_QUANTITY": 4,
+	}
+)
+
+func (x PartSortField) Enum() *PartSortField {
+	p := new(PartSortField)
+	*p = x
+	return p
+}
+
+func (x PartSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (PartSortField) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x PartSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartSortField.Descriptor instead.
+func (PartSortField) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// MetadataOperator enumerates comparisons of MetadataPredicate.
+type MetadataOperator int32
+
+const (
+	MetadataOperator_METADATA_OPERATOR_UNSPECIFIED MetadataOperator = 0
+	MetadataOperator_METADATA_OPERATOR_EQ          MetadataOperator = 1
+	MetadataOperator_METADATA_OPERATOR_NE          MetadataOperator = 2
+	MetadataOperator_METADATA_OPERATOR_GT          MetadataOperator = 3
+	MetadataOperator_METADATA_OPERATOR_GTE         MetadataOperator = 4
+	MetadataOperator_METADATA_OPERATOR_LT          MetadataOperator = 5
+	MetadataOperator_METADATA_OPERATOR_LTE         MetadataOperator = 6
+)
+
+// Enum value maps for MetadataOperator.
+var (
+	MetadataOperator_name = map[int32]string{
+		0: "METADATA_OPERATOR_UNSPECIFIED",
+		1: "METADATA_OPERATOR_EQ",
+		2: "METADATA_OPERATOR_NE",
+		3: "METADATA_OPERATOR_GT",
+		4: "METADATA_OPERATOR_GTE",
+		5: "METADATA_OPERATOR_LT",
+		6: "METADATA_OPERATOR_LTE",
+	}
+	MetadataOperator_value = map[string]int32{
+		"METADATA_OPERATOR_UNSPECIFIED": 0,
+		"METADATA_OPERATOR_EQ":          1,
+		"METADATA_OPERATOR_NE":          2,
+		"METADATA_OPERATOR_GT":          3,
+		"METADATA_OPERATOR_GTE":         4,
+		"METADATA_OPERATOR_LT":          5,
+		"METADATA_OPERATOR_LTE":         6,
+	}
+)
+
+func (x MetadataOperator) Enum() *MetadataOperator {
+	p := new(MetadataOperator)
+	*p = x
+	return p
+}
+
+func (x MetadataOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (MetadataOperator) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x MetadataOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataOperator.Descriptor instead.
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// WatchEventType is the kind of a WatchParts message.
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED WatchEventType = 0
+	// A part that matched the filter when the watch started.
+	WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT WatchEventType = 1
+	// The snapshot is complete; changes follow.
+	WatchEventType_WATCH_EVENT_TYPE_CURRENT WatchEventType = 2
+	// A part was created or changed and matches the filter.
+	WatchEventType_WATCH_EVENT_TYPE_UPSERTED WatchEventType = 3
+	// A part no longer matches the filter.
+	WatchEventType_WATCH_EVENT_TYPE_REMOVED WatchEventType = 4
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_TYPE_UNSPECIFIED",
+		1: "WATCH_EVENT_TYPE_SNAPSHOT",
+		2: "WATCH_EVENT_TYPE_CURRENT",
+		3: "WATCH_EVENT_TYPE_UPSERTED",
+		4: "WATCH_EVENT_TYPE_REMOVED",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_TYPE_UNSPECIFIED": 0,
+		"WATCH_EVENT_TYPE_SNAPSHOT":    1,
+		"WATCH_EVENT_TYPE_CURRENT":     2,
+		"WATCH_EVENT_TYPE_UPSERTED":    3,
+		"WATCH_EVENT_TYPE_REMOVED":     4,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
 // Part represents a single inventory item (e.g., a rocket component).
 type Part struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -90,7 +327,7 @@ type Part struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Unit price of the part in cents.
 	PriceCents int64 `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	// Quantity of this part currently available in stock.
+	// Quantity of this part currently available in stock, the sum of stock_levels.
 	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
 	// Category of the part.
 	Category Category `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
@@ -106,7 +343,16 @@ type Part struct {
 	// Timestamp when the part was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the part was last updated.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Timestamp when the part was archived; unset for active parts.
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// Rules a configuration with this part must follow.
+	Compatibility []*CompatibilityRule `protobuf:"bytes,14,rep,name=compatibility,proto3" json:"compatibility,omitempty"`
+	// Version of the part. It is 1 for a new part and grows by one with
+	// every change, including archiving and restoring.
+	Version int64 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	// Stock of the part in each warehouse that has any.
+	StockLevels   []*StockLevel `protobuf:"bytes,16,rep,name=stock_levels,json=stockLevels,proto3" json:"stock_levels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -225,36 +471,82 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Value represents a flexible typed value used in the Part.metadata map.
-type Value struct {
+func (x *Part) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Part) GetCompatibility() []*CompatibilityRule {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Part) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Part) GetStockLevels() []*StockLevel {
+	if x != nil {
+		return x.StockLevels
+	}
+	return nil
+}
+
+// PartInfo holds the fields of a part that can be written by CreatePart and UpdatePart.
+type PartInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Exactly one of the following fields must be set.
-	//
-	// Types that are valid to be assigned to Value:
-	//
-	//	*Value_StringValue
-	//	*Value_Int64Value
-	//	*Value_DoubleValue
-	//	*Value_BoolValue
-	Value         isValue_Value `protobuf_oneof:"value"`
+	// Human-readable part name. Must be non-empty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Detailed description of the part.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Unit price of the part in cents. Must be non-negative.
+	PriceCents int64 `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Quantity of this part currently available in stock. Must be non-negative.
+	// Without stock_levels, the whole stock is put in the default warehouse;
+	// with them, it must be zero or their sum.
+	StockQuantity int64 `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// Category of the part. CATEGORY_UNKNOWN is rejected.
+	Category Category `protobuf:"varint,5,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Physical dimensions and weight of the part.
+	Dimensions *Dimensions `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Manufacturer information for this part.
+	Manufacturer *Manufacturer `protobuf:"bytes,7,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// Free-form tags used for quick search and classification.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Flexible key–value metadata. Every value must have one of its fields set.
+	Metadata map[string]*Value `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Compatibility rules of the part. Every rule must have a kind and a
+	// target; a part target must be a valid UUID.
+	Compatibility []*CompatibilityRule `protobuf:"bytes,10,rep,name=compatibility,proto3" json:"compatibility,omitempty"`
+	// Stock of the part per warehouse. Warehouses must exist and be listed
+	// once; quantities must be non-negative. The "stock_quantity" and
+	// "stock_levels" update paths both replace the whole stock of the part.
+	StockLevels   []*StockLevel `protobuf:"bytes,11,rep,name=stock_levels,json=stockLevels,proto3" json:"stock_levels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Value) String() string {
+func (x *PartInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*PartInfo) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -266,116 +558,2387 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Value) GetValue() isValue_Value {
+func (x *PartInfo) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Value) GetStringValue() string {
+func (x *PartInfo) GetDescription() string {
 	if x != nil {
-		if x, ok := x.Value.(*Value_StringValue); ok {
-			return x.StringValue
-		}
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Value) GetInt64Value() int64 {
+func (x *PartInfo) GetPriceCents() int64 {
 	if x != nil {
-		if x, ok := x.Value.(*Value_Int64Value); ok {
-			return x.Int64Value
-		}
+		return x.PriceCents
 	}
 	return 0
 }
 
-func (x *Value) GetDoubleValue() float64 {
+func (x *PartInfo) GetStockQuantity() int64 {
 	if x != nil {
-		if x, ok := x.Value.(*Value_DoubleValue); ok {
-			return x.DoubleValue
-		}
+		return x.StockQuantity
 	}
 	return 0
 }
 
-func (x *Value) GetBoolValue() bool {
+func (x *PartInfo) GetCategory() Category {
 	if x != nil {
-		if x, ok := x.Value.(*Value_BoolValue); ok {
-			return x.BoolValue
-		}
+		return x.Category
 	}
-	return false
+	return Category_CATEGORY_UNKNOWN
 }
 
-type isValue_Value interface {
-	isValue_Value()
+func (x *PartInfo) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
 }
 
-type Value_StringValue struct {
-	// String value.
-	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+func (x *PartInfo) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
 }
 
-type Value_Int64Value struct {
-	// 64-bit integer value.
-	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+func (x *PartInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-type Value_DoubleValue struct {
-	// Double-precision floating-point value.
-	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+func (x *PartInfo) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-type Value_BoolValue struct {
-	// Boolean value.
-	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+func (x *PartInfo) GetCompatibility() []*CompatibilityRule {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
 }
 
-func (*Value_StringValue) isValue_Value() {}
-
-func (*Value_Int64Value) isValue_Value() {}
-
-func (*Value_DoubleValue) isValue_Value() {}
-
-func (*Value_BoolValue) isValue_Value() {}
+func (x *PartInfo) GetStockLevels() []*StockLevel {
+	if x != nil {
+		return x.StockLevels
+	}
+	return nil
+}
 
-// Dimensions describes the physical size and weight of a part.
-type Dimensions struct {
+// StockLevel is the stock of a part in one warehouse.
+type StockLevel struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Length in centimeters.
-	Length float64 `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
-	// Width in centimeters.
-	Width float64 `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
-	// Height in centimeters.
-	Height float64 `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
-	// Weight in kilograms.
-	Weight        float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// ID of the warehouse.
+	WarehouseId string `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// Quantity of the part in the warehouse.
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Dimensions) Reset() {
-	*x = Dimensions{}
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Dimensions) String() string {
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StockLevel) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *StockLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Warehouse is a place, usually at a spaceport, where parts are stocked.
+type Warehouse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Short identifier, e.g. "baikonur".
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Human-readable warehouse name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Spaceport the warehouse is at.
+	Spaceport string `protobuf:"bytes,3,opt,name=spaceport,proto3" json:"spaceport,omitempty"`
+	// Timestamp when the warehouse was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Warehouse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetSpaceport() string {
+	if x != nil {
+		return x.Spaceport
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Value represents a flexible typed value used in the Part.metadata map.
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of the following fields must be set.
+	//
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Value_StringValue
+	//	*Value_Int64Value
+	//	*Value_DoubleValue
+	//	*Value_BoolValue
+	Value         isValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Value) GetValue() isValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetInt64Value() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*Value_Int64Value); ok {
+			return x.Int64Value
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*Value_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+type isValue_Value interface {
+	isValue_Value()
+}
+
+type Value_StringValue struct {
+	// String value.
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_Int64Value struct {
+	// 64-bit integer value.
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type Value_DoubleValue struct {
+	// Double-precision floating-point value.
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	// Boolean value.
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*Value_StringValue) isValue_Value() {}
+
+func (*Value_Int64Value) isValue_Value() {}
+
+func (*Value_DoubleValue) isValue_Value() {}
+
+func (*Value_BoolValue) isValue_Value() {}
+
+// Dimensions describes the physical size and weight of a part.
+type Dimensions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Length in centimeters.
+	Length float64 `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
+	// Width in centimeters.
+	Width float64 `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	// Height in centimeters.
+	Height float64 `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Weight in kilograms.
+	Weight        float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Dimensions) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Manufacturer contains information about the part manufacturer.
+type Manufacturer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Manufacturer name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Country of origin of the manufacturer.
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// Official website of the manufacturer.
+	Website       string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Manufacturer) Reset() {
+	*x = Manufacturer{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Manufacturer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manufacturer) ProtoMessage() {}
+
+func (x *Manufacturer) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
+func (*Manufacturer) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Manufacturer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+// CompatibilityTarget is a part or every part of a category.
+type CompatibilityTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of the following fields must be set.
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*CompatibilityTarget_PartUuid
+	//	*CompatibilityTarget_Category
+	Target        isCompatibilityTarget_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityTarget) Reset() {
+	*x = CompatibilityTarget{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityTarget) ProtoMessage() {}
+
+func (x *CompatibilityTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityTarget.ProtoReflect.Descriptor instead.
+func (*CompatibilityTarget) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompatibilityTarget) GetTarget() isCompatibilityTarget_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CompatibilityTarget) GetPartUuid() string {
+	if x != nil {
+		if x, ok := x.Target.(*CompatibilityTarget_PartUuid); ok {
+			return x.PartUuid
+		}
+	}
+	return ""
+}
+
+func (x *CompatibilityTarget) GetCategory() Category {
+	if x != nil {
+		if x, ok := x.Target.(*CompatibilityTarget_Category); ok {
+			return x.Category
+		}
+	}
+	return Category_CATEGORY_UNKNOWN
+}
+
+type isCompatibilityTarget_Target interface {
+	isCompatibilityTarget_Target()
+}
+
+type CompatibilityTarget_PartUuid struct {
+	// UUID of the part.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3,oneof"`
+}
+
+type CompatibilityTarget_Category struct {
+	// Category of the parts.
+	Category Category `protobuf:"varint,2,opt,name=category,proto3,enum=inventory.v1.Category,oneof"`
+}
+
+func (*CompatibilityTarget_PartUuid) isCompatibilityTarget_Target() {}
+
+func (*CompatibilityTarget_Category) isCompatibilityTarget_Target() {}
+
+// CompatibilityRule relates a part to other parts or categories.
+type CompatibilityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the rule.
+	Kind CompatibilityRuleKind `protobuf:"varint,1,opt,name=kind,proto3,enum=inventory.v1.CompatibilityRuleKind" json:"kind,omitempty"`
+	// Parts the rule is about.
+	Target        *CompatibilityTarget `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityRule) Reset() {
+	*x = CompatibilityRule{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityRule) ProtoMessage() {}
+
+func (x *CompatibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityRule.ProtoReflect.Descriptor instead.
+func (*CompatibilityRule) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CompatibilityRule) GetKind() CompatibilityRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_UNSPECIFIED
+}
+
+func (x *CompatibilityRule) GetTarget() *CompatibilityTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+// GetPartRequest contains parameters to retrieve a single part by UUID.
+type GetPartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the part to retrieve.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartRequest) Reset() {
+	*x = GetPartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartRequest) ProtoMessage() {}
+
+func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// GetPartResponse returns detailed information about a single part.
+type GetPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part information.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// ListPartsRequest contains a filter used to select parts.
+type ListPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter applied to returned parts.
+	// All fields are optional. Empty filter means "no filtering".
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of parts in the response.
+	// 0 means the default of 50; values above 500 are reduced to 500.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous response; empty for the first page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Field to order parts by. Defaults to created_at.
+	SortBy PartSortField `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=inventory.v1.PartSortField" json:"sort_by,omitempty"`
+	// Order parts in descending order of sort_by.
+	SortDesc bool `protobuf:"varint,5,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	// Also count all parts matching the filter.
+	IncludeTotal  bool `protobuf:"varint,6,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetSortBy() PartSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return PartSortField_PART_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListPartsRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *ListPartsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+// ListPartsResponse contains a page of parts that matched the filter.
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of parts found by the filter.
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Token of the next page; empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of parts matching the filter across all pages.
+	// Set only if include_total was requested.
+	TotalCount    *int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPartsResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+// PartsFilter describes filtering criteria for selecting parts.
+//
+// All repeated fields act as "inclusive filters":
+// - An empty list means "do not filter by this field".
+// - A non-empty list means "keep only records matching at least one of the values" in that field.
+//
+// Logical behavior:
+// - OR within a single repeated field (e.g., name is "main" OR "main booster").
+// - AND between different fields (e.g., category is ENGINE AND manufacturer country is "Germany").
+type PartsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of part UUIDs.
+	// Empty list — do not filter by UUID.
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// List of part names.
+	// Empty list — do not filter by name.
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// List of categories.
+	// Empty list — do not filter by category.
+	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
+	// List of manufacturer countries.
+	// Empty list — do not filter by country.
+	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	// List of tags.
+	// Empty list — do not filter by tags.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Also return archived parts. Archived parts are skipped by default.
+	IncludeArchived bool `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// Full-text query over name, description and tags.
+	// A part matches if it contains any of the words; "-word" excludes
+	// parts with the word and a "quoted phrase" must match exactly.
+	// Empty — no full-text search.
+	Query string `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	// Inclusive range of price_cents. Unset — do not filter by price.
+	PriceCents *Int64Range `protobuf:"bytes,8,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Inclusive range of stock_quantity. Unset — do not filter by stock.
+	StockQuantity *Int64Range `protobuf:"bytes,9,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// Inclusive ranges of dimensions and weight.
+	// Unset — do not filter by dimensions.
+	Dimensions *DimensionsRange `protobuf:"bytes,10,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Predicates on metadata values; a part must satisfy all of them.
+	// Empty list — do not filter by metadata.
+	Metadata      []*MetadataPredicate `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsFilter) Reset() {
+	*x = PartsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsFilter) ProtoMessage() {}
+
+func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
+func (*PartsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PartsFilter) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerCountries() []string {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *PartsFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PartsFilter) GetPriceCents() *Int64Range {
+	if x != nil {
+		return x.PriceCents
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetStockQuantity() *Int64Range {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetDimensions() *DimensionsRange {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataPredicate {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Int64Range is an inclusive range; an unset bound is open.
+type Int64Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int64                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int64                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// DoubleRange is an inclusive range; an unset bound is open.
+type DoubleRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// DimensionsRange restricts physical dimensions of a part.
+// Each unset range does not filter by its dimension.
+type DimensionsRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Length in centimeters.
+	Length *DoubleRange `protobuf:"bytes,1,opt,name=length,proto3" json:"length,omitempty"`
+	// Width in centimeters.
+	Width *DoubleRange `protobuf:"bytes,2,opt,name=width,proto3" json:"width,omitempty"`
+	// Height in centimeters.
+	Height *DoubleRange `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Weight in kilograms.
+	Weight        *DoubleRange `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DimensionsRange) Reset() {
+	*x = DimensionsRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DimensionsRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DimensionsRange) ProtoMessage() {}
+
+func (x *DimensionsRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DimensionsRange.ProtoReflect.Descriptor instead.
+func (*DimensionsRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DimensionsRange) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *DimensionsRange) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+// MetadataPredicate compares the metadata value under key, e.g.
+// military_grade EQ true or max_thrust_kn GTE 800.
+// Parts without the key never match, except for NE.
+type MetadataPredicate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata key. Must not be empty, contain "." or start with "$".
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Comparison operator.
+	Operator MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=inventory.v1.MetadataOperator" json:"operator,omitempty"`
+	// Value to compare with. Ordering operators accept numbers and strings;
+	// integers and doubles compare by numeric value.
+	Value         *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataPredicate) Reset() {
+	*x = MetadataPredicate{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataPredicate) ProtoMessage() {}
+
+func (x *MetadataPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataPredicate.ProtoReflect.Descriptor instead.
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MetadataPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataPredicate) GetOperator() MetadataOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return MetadataOperator_METADATA_OPERATOR_UNSPECIFIED
+}
+
+func (x *MetadataPredicate) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// CreatePartRequest contains the part to create.
+type CreatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fields of the new part.
+	Part          *PartInfo `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreatePartRequest) GetPart() *PartInfo {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// CreatePartResponse returns the created part.
+type CreatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Created part with its generated UUID.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// UpdatePartRequest contains the part changes.
+type UpdatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the part to update.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// New values of the fields listed in update_mask.
+	Part *PartInfo `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`
+	// PartInfo fields to update. Empty mask means all fields.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version of the part the changes are based on. Must be positive.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetPart() *PartInfo {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdatePartResponse returns the updated part.
+type UpdatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part after the update.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// ArchivePartRequest identifies the part to archive.
+type ArchivePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the part to archive.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePartRequest) Reset() {
+	*x = ArchivePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePartRequest) ProtoMessage() {}
+
+func (x *ArchivePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePartRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArchivePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// ArchivePartResponse returns the archived part.
+type ArchivePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part after archiving.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePartResponse) Reset() {
+	*x = ArchivePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePartResponse) ProtoMessage() {}
+
+func (x *ArchivePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePartResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArchivePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// RestorePartRequest identifies the part to restore.
+type RestorePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the part to restore.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePartRequest) Reset() {
+	*x = RestorePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePartRequest) ProtoMessage() {}
+
+func (x *RestorePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePartRequest.ProtoReflect.Descriptor instead.
+func (*RestorePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestorePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// RestorePartResponse returns the restored part.
+type RestorePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part after restoring.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePartResponse) Reset() {
+	*x = RestorePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePartResponse) ProtoMessage() {}
+
+func (x *RestorePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePartResponse.ProtoReflect.Descriptor instead.
+func (*RestorePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestorePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// WatchPartsRequest selects the parts to watch.
+type WatchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter of the watched parts; the same as in ListParts.
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Token of the last received message to continue after; empty starts with a snapshot.
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchPartsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// WatchPartsResponse is one message of the WatchParts stream.
+type WatchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the message.
+	Type WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.WatchEventType" json:"type,omitempty"`
+	// UUID of the part; empty for CURRENT.
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// State of the part; unset for CURRENT and for deleted parts.
+	Part *Part `protobuf:"bytes,3,opt,name=part,proto3" json:"part,omitempty"`
+	// Token to resume the stream after this message; empty for SNAPSHOT.
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsResponse) Reset() {
+	*x = WatchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsResponse) ProtoMessage() {}
+
+func (x *WatchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchPartsResponse) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPartsResponse) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *WatchPartsResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *WatchPartsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// ValidateConfigurationRequest lists the parts of a configuration.
+type ValidateConfigurationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUIDs of the parts.
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ValidateConfigurationRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// ValidateConfigurationResponse reports the broken compatibility rules.
+type ValidateConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when no rule is broken.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Broken rules, in the order of part_uuids.
+	Violations    []*ConfigurationViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ValidateConfigurationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateConfigurationResponse) GetViolations() []*ConfigurationViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// ConfigurationViolation is a compatibility rule broken by a configuration.
+type ConfigurationViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the part whose rule is broken.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Kind of the broken rule.
+	Kind CompatibilityRuleKind `protobuf:"varint,2,opt,name=kind,proto3,enum=inventory.v1.CompatibilityRuleKind" json:"kind,omitempty"`
+	// For REQUIRES, the missing target of the rule. For CONFLICTS_WITH and
+	// COMPATIBLE_WITH, the part of the configuration that breaks the rule.
+	Target *CompatibilityTarget `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Human-readable description of the violation.
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationViolation) Reset() {
+	*x = ConfigurationViolation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationViolation) ProtoMessage() {}
+
+func (x *ConfigurationViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationViolation.ProtoReflect.Descriptor instead.
+func (*ConfigurationViolation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfigurationViolation) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ConfigurationViolation) GetKind() CompatibilityRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CompatibilityRuleKind_COMPATIBILITY_RULE_KIND_UNSPECIFIED
+}
+
+func (x *ConfigurationViolation) GetTarget() *CompatibilityTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ConfigurationViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetPriceHistoryRequest selects the price history of a part.
+type GetPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the part.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Time range of the changes; unset returns the whole history.
+	Range         *TimeRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPriceHistoryRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+// GetPriceHistoryResponse lists the price changes of a part.
+type GetPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes, oldest first.
+	Changes       []*PriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPriceHistoryResponse) GetChanges() []*PriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// PriceChange is one price of a part and the time it was set.
+type PriceChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Price in cents since changed_at.
+	PriceCents int64 `protobuf:"varint,1,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Version of the part that the change made.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Time of the change.
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PriceChange) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *PriceChange) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PriceChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// TimeRange is a half-open range [from, to); an unset bound is open.
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TimeRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimeRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// CreateWarehouseRequest contains the warehouse to create.
+type CreateWarehouseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Warehouse to create; created_at is ignored.
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateWarehouseRequest) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// CreateWarehouseResponse returns the created warehouse.
+type CreateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+// ListWarehousesResponse lists the warehouses.
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// TransferStockRequest describes a move of stock between warehouses.
+type TransferStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the part.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Warehouse to take the stock from.
+	FromWarehouseId string `protobuf:"bytes,2,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	// Warehouse to put the stock in.
+	ToWarehouseId string `protobuf:"bytes,3,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	// Quantity to move. Must be positive.
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Version of the part the transfer is based on. Must be positive.
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dimensions) ProtoMessage() {}
+func (*TransferStockRequest) ProtoMessage() {}
 
-func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,67 +2949,69 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
-func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Dimensions) GetLength() float64 {
+func (x *TransferStockRequest) GetPartUuid() string {
 	if x != nil {
-		return x.Length
+		return x.PartUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *Dimensions) GetWidth() float64 {
+func (x *TransferStockRequest) GetFromWarehouseId() string {
 	if x != nil {
-		return x.Width
+		return x.FromWarehouseId
 	}
-	return 0
+	return ""
 }
 
-func (x *Dimensions) GetHeight() float64 {
+func (x *TransferStockRequest) GetToWarehouseId() string {
 	if x != nil {
-		return x.Height
+		return x.ToWarehouseId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Dimensions) GetWeight() float64 {
+func (x *TransferStockRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.Weight
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-// Manufacturer contains information about the part manufacturer.
-type Manufacturer struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Manufacturer name.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Country of origin of the manufacturer.
-	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
-	// Official website of the manufacturer.
-	Website       string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+// TransferStockResponse returns the part after the transfer.
+type TransferStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Manufacturer) Reset() {
-	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Manufacturer) String() string {
+func (x *TransferStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Manufacturer) ProtoMessage() {}
+func (*TransferStockResponse) ProtoMessage() {}
 
-func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,56 +3022,48 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
-func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Manufacturer) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Manufacturer) GetCountry() string {
-	if x != nil {
-		return x.Country
-	}
-	return ""
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Manufacturer) GetWebsite() string {
+func (x *TransferStockResponse) GetPart() *Part {
 	if x != nil {
-		return x.Website
+		return x.Part
 	}
-	return ""
+	return nil
 }
 
-// GetPartRequest contains parameters to retrieve a single part by UUID.
-type GetPartRequest struct {
+// StockReservation is stock taken out of the warehouses for an order.
+type StockReservation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the part to retrieve.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// ID chosen by the caller.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reserved parts.
+	Items []*ReservedItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// When the reservation was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the reservation was released; unset while the stock is reserved.
+	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartRequest) Reset() {
-	*x = GetPartRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+func (x *StockReservation) Reset() {
+	*x = StockReservation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartRequest) String() string {
+func (x *StockReservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartRequest) ProtoMessage() {}
+func (*StockReservation) ProtoMessage() {}
 
-func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+func (x *StockReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,42 +3074,72 @@ func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use StockReservation.ProtoReflect.Descriptor instead.
+func (*StockReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPartRequest) GetUuid() string {
+func (x *StockReservation) GetId() string {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
 	return ""
 }
 
-// GetPartResponse returns detailed information about a single part.
-type GetPartResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Part information.
-	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StockReservation) GetItems() []*ReservedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+func (x *StockReservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockReservation) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+// ReservedItem is the stock of one part in a reservation.
+type ReservedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the part.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Reserved quantity. Must be positive.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Warehouse to take the stock from. When empty the stock is taken from any
+	// warehouse of the part.
+	WarehouseId string `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// How much of quantity was taken from each warehouse; set by the service.
+	Taken []*StockLevel `protobuf:"bytes,4,rep,name=taken,proto3" json:"taken,omitempty"`
+	// Version of the part the reservation is based on. Must be positive.
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReservedItem) Reset() {
+	*x = ReservedItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *ReservedItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*ReservedItem) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+func (x *ReservedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,43 +3150,72 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReservedItem.ProtoReflect.Descriptor instead.
+func (*ReservedItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *ReservedItem) GetPartUuid() string {
 	if x != nil {
-		return x.Part
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservedItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReservedItem) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ReservedItem) GetTaken() []*StockLevel {
+	if x != nil {
+		return x.Taken
 	}
 	return nil
 }
 
-// ListPartsRequest contains a filter used to select parts.
-type ListPartsRequest struct {
+func (x *ReservedItem) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// ReserveStockRequest lists the parts to reserve.
+type ReserveStockRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Filter applied to returned parts.
-	// All fields are optional. Empty filter means "no filtering".
-	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// ID of the reservation.
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Parts to reserve.
+	Items         []*ReservedItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *ReserveStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*ReserveStockRequest) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,42 +3226,48 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *ReserveStockRequest) GetReservationId() string {
 	if x != nil {
-		return x.Filter
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*ReservedItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-// ListPartsResponse contains a list of parts that matched the filter.
-type ListPartsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of parts found by the filter.
-	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+// ReserveStockResponse returns the reservation.
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *StockReservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *ReserveStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*ReserveStockResponse) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,63 +3278,42 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *ReserveStockResponse) GetReservation() *StockReservation {
 	if x != nil {
-		return x.Parts
+		return x.Reservation
 	}
 	return nil
 }
 
-// PartsFilter describes filtering criteria for selecting parts.
-//
-// All repeated fields act as "inclusive filters":
-// - An empty list means "do not filter by this field".
-// - A non-empty list means "keep only records matching at least one of the values" in that field.
-//
-// Logical behavior:
-// - OR within a single repeated field (e.g., name is "main" OR "main booster").
-// - AND between different fields (e.g., category is ENGINE AND manufacturer country is "Germany").
-type PartsFilter struct {
+// ReleaseStockRequest names the reservation to release.
+type ReleaseStockRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of part UUIDs.
-	// Empty list — do not filter by UUID.
-	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	// List of part names.
-	// Empty list — do not filter by name.
-	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	// List of categories.
-	// Empty list — do not filter by category.
-	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
-	// List of manufacturer countries.
-	// Empty list — do not filter by country.
-	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
-	// List of tags.
-	// Empty list — do not filter by tags.
-	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ID of the reservation.
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartsFilter) Reset() {
-	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) String() string {
+func (x *ReleaseStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartsFilter) ProtoMessage() {}
+func (*ReleaseStockRequest) ProtoMessage() {}
 
-func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,51 +3324,60 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
-func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *PartsFilter) GetUuids() []string {
+func (x *ReleaseStockRequest) GetReservationId() string {
 	if x != nil {
-		return x.Uuids
+		return x.ReservationId
 	}
-	return nil
+	return ""
 }
 
-func (x *PartsFilter) GetNames() []string {
-	if x != nil {
-		return x.Names
-	}
-	return nil
+// ReleaseStockResponse is empty.
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartsFilter) GetCategories() []Category {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) GetManufacturerCountries() []string {
-	if x != nil {
-		return x.ManufacturerCountries
-	}
-	return nil
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PartsFilter) GetTags() []string {
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
 	if x != nil {
-		return x.Tags
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
 }
 
 var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe0\x04\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x06\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -786,10 +3396,44 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\varchived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12E\n" +
+	"\rcompatibility\x18\x0e \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\rcompatibility\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\x12;\n" +
+	"\fstock_levels\x18\x10 \x03(\v2\x18.inventory.v1.StockLevelR\vstockLevels\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xe2\x04\n" +
+	"\bPartInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vprice_cents\x18\x03 \x01(\x03R\n" +
+	"priceCents\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03R\rstockQuantity\x122\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\a \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12@\n" +
+	"\bmetadata\x18\t \x03(\v2$.inventory.v1.PartInfo.MetadataEntryR\bmetadata\x12E\n" +
+	"\rcompatibility\x18\n" +
+	" \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\rcompatibility\x12;\n" +
+	"\fstock_levels\x18\v \x03(\v2\x18.inventory.v1.StockLevelR\vstockLevels\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\x9e\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"K\n" +
+	"\n" +
+	"StockLevel\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x88\x01\n" +
+	"\tWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tspaceport\x18\x03 \x01(\tR\tspaceport\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9e\x01\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
 	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
@@ -807,15 +3451,32 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fManufacturer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
-	"\awebsite\x18\x03 \x01(\tR\awebsite\"$\n" +
-	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\"t\n" +
+	"\x13CompatibilityTarget\x12\x1d\n" +
+	"\tpart_uuid\x18\x01 \x01(\tH\x00R\bpartUuid\x124\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x16.inventory.v1.CategoryH\x00R\bcategoryB\b\n" +
+	"\x06target\"\x87\x01\n" +
+	"\x11CompatibilityRule\x127\n" +
+	"\x04kind\x18\x01 \x01(\x0e2#.inventory.v1.CompatibilityRuleKindR\x04kind\x129\n" +
+	"\x06target\x18\x02 \x01(\v2!.inventory.v1.CompatibilityTargetR\x06target\"-\n" +
+	"\x0eGetPartRequest\x12\x1b\n" +
+	"\x04uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04uuid\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\x82\x02\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x124\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x1b.inventory.v1.PartSortFieldR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\x05 \x01(\bR\bsortDesc\x12#\n" +
+	"\rinclude_total\x18\x06 \x01(\bR\fincludeTotal\"\x9b\x01\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xbc\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\xf5\x03\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -823,16 +3484,179 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags*r\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\x12\x14\n" +
+	"\x05query\x18\a \x01(\tR\x05query\x129\n" +
+	"\vprice_cents\x18\b \x01(\v2\x18.inventory.v1.Int64RangeR\n" +
+	"priceCents\x12?\n" +
+	"\x0estock_quantity\x18\t \x01(\v2\x18.inventory.v1.Int64RangeR\rstockQuantity\x12=\n" +
+	"\n" +
+	"dimensions\x18\n" +
+	" \x01(\v2\x1d.inventory.v1.DimensionsRangeR\n" +
+	"dimensions\x12;\n" +
+	"\bmetadata\x18\v \x03(\v2\x1f.inventory.v1.MetadataPredicateR\bmetadata\"J\n" +
+	"\n" +
+	"Int64Range\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xdb\x01\n" +
+	"\x0fDimensionsRange\x121\n" +
+	"\x06length\x18\x01 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\x02 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\x03 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\x04 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\"\x8c\x01\n" +
+	"\x11MetadataPredicate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x1e.inventory.v1.MetadataOperatorR\boperator\x12)\n" +
+	"\x05value\x18\x03 \x01(\v2\x13.inventory.v1.ValueR\x05value\"?\n" +
+	"\x11CreatePartRequest\x12*\n" +
+	"\x04part\x18\x01 \x01(\v2\x16.inventory.v1.PartInfoR\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xcd\x01\n" +
+	"\x11UpdatePartRequest\x12\x1b\n" +
+	"\x04uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04uuid\x12*\n" +
+	"\x04part\x18\x02 \x01(\v2\x16.inventory.v1.PartInfoR\x04part\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x122\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fexpectedVersion\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"1\n" +
+	"\x12ArchivePartRequest\x12\x1b\n" +
+	"\x04uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04uuid\"=\n" +
+	"\x13ArchivePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"1\n" +
+	"\x12RestorePartRequest\x12\x1b\n" +
+	"\x04uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04uuid\"=\n" +
+	"\x13RestorePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"i\n" +
+	"\x11WatchPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xae\x01\n" +
+	"\x12WatchPartsResponse\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.inventory.v1.WatchEventTypeR\x04type\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12&\n" +
+	"\x04part\x18\x03 \x01(\v2\x12.inventory.v1.PartR\x04part\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"G\n" +
+	"\x1cValidateConfigurationRequest\x12'\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\tpartUuids\"{\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12D\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2$.inventory.v1.ConfigurationViolationR\n" +
+	"violations\"\xc3\x01\n" +
+	"\x16ConfigurationViolation\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x127\n" +
+	"\x04kind\x18\x02 \x01(\x0e2#.inventory.v1.CompatibilityRuleKindR\x04kind\x129\n" +
+	"\x06target\x18\x03 \x01(\v2!.inventory.v1.CompatibilityTargetR\x06target\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"m\n" +
+	"\x16GetPriceHistoryRequest\x12$\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bpartUuid\x12-\n" +
+	"\x05range\x18\x02 \x01(\v2\x17.inventory.v1.TimeRangeR\x05range\"N\n" +
+	"\x17GetPriceHistoryResponse\x123\n" +
+	"\achanges\x18\x01 \x03(\v2\x19.inventory.v1.PriceChangeR\achanges\"\x83\x01\n" +
+	"\vPriceChange\x12\x1f\n" +
+	"\vprice_cents\x18\x01 \x01(\x03R\n" +
+	"priceCents\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"g\n" +
+	"\tTimeRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"O\n" +
+	"\x16CreateWarehouseRequest\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"P\n" +
+	"\x17CreateWarehouseResponse\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"\x17\n" +
+	"\x15ListWarehousesRequest\"Q\n" +
+	"\x16ListWarehousesResponse\x127\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x17.inventory.v1.WarehouseR\n" +
+	"warehouses\"\xe9\x01\n" +
+	"\x14TransferStockRequest\x12$\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bpartUuid\x12*\n" +
+	"\x11from_warehouse_id\x18\x02 \x01(\tR\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x03 \x01(\tR\rtoWarehouseId\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\x122\n" +
+	"\x10expected_version\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fexpectedVersion\"?\n" +
+	"\x15TransferStockResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xcc\x01\n" +
+	"\x10StockReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.inventory.v1.ReservedItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreleased_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\"\xe0\x01\n" +
+	"\fReservedItem\x12$\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bpartUuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\tR\vwarehouseId\x12.\n" +
+	"\x05taken\x18\x04 \x03(\v2\x18.inventory.v1.StockLevelR\x05taken\x122\n" +
+	"\x10expected_version\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fexpectedVersion\"\x81\x01\n" +
+	"\x13ReserveStockRequest\x12.\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\rreservationId\x12:\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.inventory.v1.ReservedItemB\b\xbaH\x05\x92\x01\x02\b\x01R\x05items\"X\n" +
+	"\x14ReserveStockResponse\x12@\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1e.inventory.v1.StockReservationR\vreservation\"E\n" +
+	"\x13ReleaseStockRequest\x12.\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\rreservationId\"\x16\n" +
+	"\x14ReleaseStockResponse*r\n" +
 	"\bCategory\x12\x14\n" +
 	"\x10CATEGORY_UNKNOWN\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x04*\xbf\x01\n" +
+	"\x15CompatibilityRuleKind\x12'\n" +
+	"#COMPATIBILITY_RULE_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" COMPATIBILITY_RULE_KIND_REQUIRES\x10\x01\x12*\n" +
+	"&COMPATIBILITY_RULE_KIND_CONFLICTS_WITH\x10\x02\x12+\n" +
+	"'COMPATIBILITY_RULE_KIND_COMPATIBLE_WITH\x10\x03*\xa9\x01\n" +
+	"\rPartSortField\x12\x1f\n" +
+	"\x1bPART_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPART_SORT_FIELD_CREATED_AT\x10\x01\x12\x19\n" +
+	"\x15PART_SORT_FIELD_PRICE\x10\x02\x12\x18\n" +
+	"\x14PART_SORT_FIELD_NAME\x10\x03\x12\"\n" +
+	"\x1ePART_SORT_FIELD_STOCK_QUANTITY\x10\x04*\xd3\x01\n" +
+	"\x10MetadataOperator\x12!\n" +
+	"\x1dMETADATA_OPERATOR_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METADATA_OPERATOR_EQ\x10\x01\x12\x18\n" +
+	"\x14METADATA_OPERATOR_NE\x10\x02\x12\x18\n" +
+	"\x14METADATA_OPERATOR_GT\x10\x03\x12\x19\n" +
+	"\x15METADATA_OPERATOR_GTE\x10\x04\x12\x18\n" +
+	"\x14METADATA_OPERATOR_LT\x10\x05\x12\x19\n" +
+	"\x15METADATA_OPERATOR_LTE\x10\x06*\xac\x01\n" +
+	"\x0eWatchEventType\x12 \n" +
+	"\x1cWATCH_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19WATCH_EVENT_TYPE_SNAPSHOT\x10\x01\x12\x1c\n" +
+	"\x18WATCH_EVENT_TYPE_CURRENT\x10\x02\x12\x1d\n" +
+	"\x19WATCH_EVENT_TYPE_UPSERTED\x10\x03\x12\x1c\n" +
+	"\x18WATCH_EVENT_TYPE_REMOVED\x10\x042\xdc\t\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBVZTgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1;inventorypbv1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12R\n" +
+	"\vArchivePart\x12 .inventory.v1.ArchivePartRequest\x1a!.inventory.v1.ArchivePartResponse\x12R\n" +
+	"\vRestorePart\x12 .inventory.v1.RestorePartRequest\x1a!.inventory.v1.RestorePartResponse\x12Q\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a .inventory.v1.WatchPartsResponse0\x01\x12p\n" +
+	"\x15ValidateConfiguration\x12*.inventory.v1.ValidateConfigurationRequest\x1a+.inventory.v1.ValidateConfigurationResponse\x12^\n" +
+	"\x0fGetPriceHistory\x12$.inventory.v1.GetPriceHistoryRequest\x1a%.inventory.v1.GetPriceHistoryResponse\x12^\n" +
+	"\x0fCreateWarehouse\x12$.inventory.v1.CreateWarehouseRequest\x1a%.inventory.v1.CreateWarehouseResponse\x12[\n" +
+	"\x0eListWarehouses\x12#.inventory.v1.ListWarehousesRequest\x1a$.inventory.v1.ListWarehousesResponse\x12X\n" +
+	"\rTransferStock\x12\".inventory.v1.TransferStockRequest\x1a#.inventory.v1.TransferStockResponse\x12U\n" +
+	"\fReserveStock\x12!.inventory.v1.ReserveStockRequest\x1a\".inventory.v1.ReserveStockResponse\x12U\n" +
+	"\fReleaseStock\x12!.inventory.v1.ReleaseStockRequest\x1a\".inventory.v1.ReleaseStockResponseBVZTgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/inventory/v1;inventorypbv1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -847,45 +3671,166 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_inventory_v1_inventory_proto_msgTypes  = make([]protoimpl.MessageInfo, 10)
+	file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+	file_inventory_v1_inventory_proto_msgTypes  = make([]protoimpl.MessageInfo, 49)
 	file_inventory_v1_inventory_proto_goTypes   = []any{
-		(Category)(0),                 // 0: inventory.v1.Category
-		(*Part)(nil),                  // 1: inventory.v1.Part
-		(*Value)(nil),                 // 2: inventory.v1.Value
-		(*Dimensions)(nil),            // 3: inventory.v1.Dimensions
-		(*Manufacturer)(nil),          // 4: inventory.v1.Manufacturer
-		(*GetPartRequest)(nil),        // 5: inventory.v1.GetPartRequest
-		(*GetPartResponse)(nil),       // 6: inventory.v1.GetPartResponse
-		(*ListPartsRequest)(nil),      // 7: inventory.v1.ListPartsRequest
-		(*ListPartsResponse)(nil),     // 8: inventory.v1.ListPartsResponse
-		(*PartsFilter)(nil),           // 9: inventory.v1.PartsFilter
-		nil,                           // 10: inventory.v1.Part.MetadataEntry
-		(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+		(Category)(0),                         // 0: inventory.v1.Category
+		(CompatibilityRuleKind)(0),            // 1: inventory.v1.CompatibilityRuleKind
+		(PartSortField)(0),                    // 2: inventory.v1.PartSortField
+		(MetadataOperator)(0),                 // 3: inventory.v1.MetadataOperator
+		(WatchEventType)(0),                   // 4: inventory.v1.WatchEventType
+		(*Part)(nil),                          // 5: inventory.v1.Part
+		(*PartInfo)(nil),                      // 6: inventory.v1.PartInfo
+		(*StockLevel)(nil),                    // 7: inventory.v1.StockLevel
+		(*Warehouse)(nil),                     // 8: inventory.v1.Warehouse
+		(*Value)(nil),                         // 9: inventory.v1.Value
+		(*Dimensions)(nil),                    // 10: inventory.v1.Dimensions
+		(*Manufacturer)(nil),                  // 11: inventory.v1.Manufacturer
+		(*CompatibilityTarget)(nil),           // 12: inventory.v1.CompatibilityTarget
+		(*CompatibilityRule)(nil),             // 13: inventory.v1.CompatibilityRule
+		(*GetPartRequest)(nil),                // 14: inventory.v1.GetPartRequest
+		(*GetPartResponse)(nil),               // 15: inventory.v1.GetPartResponse
+		(*ListPartsRequest)(nil),              // 16: inventory.v1.ListPartsRequest
+		(*ListPartsResponse)(nil),             // 17: inventory.v1.ListPartsResponse
+		(*PartsFilter)(nil),                   // 18: inventory.v1.PartsFilter
+		(*Int64Range)(nil),                    // 19: inventory.v1.Int64Range
+		(*DoubleRange)(nil),                   // 20: inventory.v1.DoubleRange
+		(*DimensionsRange)(nil),               // 21: inventory.v1.DimensionsRange
+		(*MetadataPredicate)(nil),             // 22: inventory.v1.MetadataPredicate
+		(*CreatePartRequest)(nil),             // 23: inventory.v1.CreatePartRequest
+		(*CreatePartResponse)(nil),            // 24: inventory.v1.CreatePartResponse
+		(*UpdatePartRequest)(nil),             // 25: inventory.v1.UpdatePartRequest
+		(*UpdatePartResponse)(nil),            // 26: inventory.v1.UpdatePartResponse
+		(*ArchivePartRequest)(nil),            // 27: inventory.v1.ArchivePartRequest
+		(*ArchivePartResponse)(nil),           // 28: inventory.v1.ArchivePartResponse
+		(*RestorePartRequest)(nil),            // 29: inventory.v1.RestorePartRequest
+		(*RestorePartResponse)(nil),           // 30: inventory.v1.RestorePartResponse
+		(*WatchPartsRequest)(nil),             // 31: inventory.v1.WatchPartsRequest
+		(*WatchPartsResponse)(nil),            // 32: inventory.v1.WatchPartsResponse
+		(*ValidateConfigurationRequest)(nil),  // 33: inventory.v1.ValidateConfigurationRequest
+		(*ValidateConfigurationResponse)(nil), // 34: inventory.v1.ValidateConfigurationResponse
+		(*ConfigurationViolation)(nil),        // 35: inventory.v1.ConfigurationViolation
+		(*GetPriceHistoryRequest)(nil),        // 36: inventory.v1.GetPriceHistoryRequest
+		(*GetPriceHistoryResponse)(nil),       // 37: inventory.v1.GetPriceHistoryResponse
+		(*PriceChange)(nil),                   // 38: inventory.v1.PriceChange
+		(*TimeRange)(nil),                     // 39: inventory.v1.TimeRange
+		(*CreateWarehouseRequest)(nil),        // 40: inventory.v1.CreateWarehouseRequest
+		(*CreateWarehouseResponse)(nil),       // 41: inventory.v1.CreateWarehouseResponse
+		(*ListWarehousesRequest)(nil),         // 42: inventory.v1.ListWarehousesRequest
+		(*ListWarehousesResponse)(nil),        // 43: inventory.v1.ListWarehousesResponse
+		(*TransferStockRequest)(nil),          // 44: inventory.v1.TransferStockRequest
+		(*TransferStockResponse)(nil),         // 45: inventory.v1.TransferStockResponse
+		(*StockReservation)(nil),              // 46: inventory.v1.StockReservation
+		(*ReservedItem)(nil),                  // 47: inventory.v1.ReservedItem
+		(*ReserveStockRequest)(nil),           // 48: inventory.v1.ReserveStockRequest
+		(*ReserveStockResponse)(nil),          // 49: inventory.v1.ReserveStockResponse
+		(*ReleaseStockRequest)(nil),           // 50: inventory.v1.ReleaseStockRequest
+		(*ReleaseStockResponse)(nil),          // 51: inventory.v1.ReleaseStockResponse
+		nil,                                   // 52: inventory.v1.Part.MetadataEntry
+		nil,                                   // 53: inventory.v1.PartInfo.MetadataEntry
+		(*timestamppb.Timestamp)(nil),         // 54: google.protobuf.Timestamp
+		(*fieldmaskpb.FieldMask)(nil),         // 55: google.protobuf.FieldMask
 	}
 )
 
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
 	0,  // 0: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	3,  // 1: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	4,  // 2: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 3: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 4: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 5: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	9,  // 7: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	1,  // 8: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 9: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	2,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	5,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	7,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	6,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	8,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 1: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	11, // 2: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	52, // 3: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	54, // 4: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	54, // 5: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 6: inventory.v1.Part.archived_at:type_name -> google.protobuf.Timestamp
+	13, // 7: inventory.v1.Part.compatibility:type_name -> inventory.v1.CompatibilityRule
+	7,  // 8: inventory.v1.Part.stock_levels:type_name -> inventory.v1.StockLevel
+	0,  // 9: inventory.v1.PartInfo.category:type_name -> inventory.v1.Category
+	10, // 10: inventory.v1.PartInfo.dimensions:type_name -> inventory.v1.Dimensions
+	11, // 11: inventory.v1.PartInfo.manufacturer:type_name -> inventory.v1.Manufacturer
+	53, // 12: inventory.v1.PartInfo.metadata:type_name -> inventory.v1.PartInfo.MetadataEntry
+	13, // 13: inventory.v1.PartInfo.compatibility:type_name -> inventory.v1.CompatibilityRule
+	7,  // 14: inventory.v1.PartInfo.stock_levels:type_name -> inventory.v1.StockLevel
+	54, // 15: inventory.v1.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 16: inventory.v1.CompatibilityTarget.category:type_name -> inventory.v1.Category
+	1,  // 17: inventory.v1.CompatibilityRule.kind:type_name -> inventory.v1.CompatibilityRuleKind
+	12, // 18: inventory.v1.CompatibilityRule.target:type_name -> inventory.v1.CompatibilityTarget
+	5,  // 19: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	18, // 20: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	2,  // 21: inventory.v1.ListPartsRequest.sort_by:type_name -> inventory.v1.PartSortField
+	5,  // 22: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	0,  // 23: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	19, // 24: inventory.v1.PartsFilter.price_cents:type_name -> inventory.v1.Int64Range
+	19, // 25: inventory.v1.PartsFilter.stock_quantity:type_name -> inventory.v1.Int64Range
+	21, // 26: inventory.v1.PartsFilter.dimensions:type_name -> inventory.v1.DimensionsRange
+	22, // 27: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataPredicate
+	20, // 28: inventory.v1.DimensionsRange.length:type_name -> inventory.v1.DoubleRange
+	20, // 29: inventory.v1.DimensionsRange.width:type_name -> inventory.v1.DoubleRange
+	20, // 30: inventory.v1.DimensionsRange.height:type_name -> inventory.v1.DoubleRange
+	20, // 31: inventory.v1.DimensionsRange.weight:type_name -> inventory.v1.DoubleRange
+	3,  // 32: inventory.v1.MetadataPredicate.operator:type_name -> inventory.v1.MetadataOperator
+	9,  // 33: inventory.v1.MetadataPredicate.value:type_name -> inventory.v1.Value
+	6,  // 34: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.PartInfo
+	5,  // 35: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	6,  // 36: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.PartInfo
+	55, // 37: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 38: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	5,  // 39: inventory.v1.ArchivePartResponse.part:type_name -> inventory.v1.Part
+	5,  // 40: inventory.v1.RestorePartResponse.part:type_name -> inventory.v1.Part
+	18, // 41: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	4,  // 42: inventory.v1.WatchPartsResponse.type:type_name -> inventory.v1.WatchEventType
+	5,  // 43: inventory.v1.WatchPartsResponse.part:type_name -> inventory.v1.Part
+	35, // 44: inventory.v1.ValidateConfigurationResponse.violations:type_name -> inventory.v1.ConfigurationViolation
+	1,  // 45: inventory.v1.ConfigurationViolation.kind:type_name -> inventory.v1.CompatibilityRuleKind
+	12, // 46: inventory.v1.ConfigurationViolation.target:type_name -> inventory.v1.CompatibilityTarget
+	39, // 47: inventory.v1.GetPriceHistoryRequest.range:type_name -> inventory.v1.TimeRange
+	38, // 48: inventory.v1.GetPriceHistoryResponse.changes:type_name -> inventory.v1.PriceChange
+	54, // 49: inventory.v1.PriceChange.changed_at:type_name -> google.protobuf.Timestamp
+	54, // 50: inventory.v1.TimeRange.from:type_name -> google.protobuf.Timestamp
+	54, // 51: inventory.v1.TimeRange.to:type_name -> google.protobuf.Timestamp
+	8,  // 52: inventory.v1.CreateWarehouseRequest.warehouse:type_name -> inventory.v1.Warehouse
+	8,  // 53: inventory.v1.CreateWarehouseResponse.warehouse:type_name -> inventory.v1.Warehouse
+	8,  // 54: inventory.v1.ListWarehousesResponse.warehouses:type_name -> inventory.v1.Warehouse
+	5,  // 55: inventory.v1.TransferStockResponse.part:type_name -> inventory.v1.Part
+	47, // 56: inventory.v1.StockReservation.items:type_name -> inventory.v1.ReservedItem
+	54, // 57: inventory.v1.StockReservation.created_at:type_name -> google.protobuf.Timestamp
+	54, // 58: inventory.v1.StockReservation.released_at:type_name -> google.protobuf.Timestamp
+	7,  // 59: inventory.v1.ReservedItem.taken:type_name -> inventory.v1.StockLevel
+	47, // 60: inventory.v1.ReserveStockRequest.items:type_name -> inventory.v1.ReservedItem
+	46, // 61: inventory.v1.ReserveStockResponse.reservation:type_name -> inventory.v1.StockReservation
+	9,  // 62: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	9,  // 63: inventory.v1.PartInfo.MetadataEntry.value:type_name -> inventory.v1.Value
+	14, // 64: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	16, // 65: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	23, // 66: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	25, // 67: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	27, // 68: inventory.v1.InventoryService.ArchivePart:input_type -> inventory.v1.ArchivePartRequest
+	29, // 69: inventory.v1.InventoryService.RestorePart:input_type -> inventory.v1.RestorePartRequest
+	31, // 70: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	33, // 71: inventory.v1.InventoryService.ValidateConfiguration:input_type -> inventory.v1.ValidateConfigurationRequest
+	36, // 72: inventory.v1.InventoryService.GetPriceHistory:input_type -> inventory.v1.GetPriceHistoryRequest
+	40, // 73: inventory.v1.InventoryService.CreateWarehouse:input_type -> inventory.v1.CreateWarehouseRequest
+	42, // 74: inventory.v1.InventoryService.ListWarehouses:input_type -> inventory.v1.ListWarehousesRequest
+	44, // 75: inventory.v1.InventoryService.TransferStock:input_type -> inventory.v1.TransferStockRequest
+	48, // 76: inventory.v1.InventoryService.ReserveStock:input_type -> inventory.v1.ReserveStockRequest
+	50, // 77: inventory.v1.InventoryService.ReleaseStock:input_type -> inventory.v1.ReleaseStockRequest
+	15, // 78: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	17, // 79: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	24, // 80: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	26, // 81: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	28, // 82: inventory.v1.InventoryService.ArchivePart:output_type -> inventory.v1.ArchivePartResponse
+	30, // 83: inventory.v1.InventoryService.RestorePart:output_type -> inventory.v1.RestorePartResponse
+	32, // 84: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.WatchPartsResponse
+	34, // 85: inventory.v1.InventoryService.ValidateConfiguration:output_type -> inventory.v1.ValidateConfigurationResponse
+	37, // 86: inventory.v1.InventoryService.GetPriceHistory:output_type -> inventory.v1.GetPriceHistoryResponse
+	41, // 87: inventory.v1.InventoryService.CreateWarehouse:output_type -> inventory.v1.CreateWarehouseResponse
+	43, // 88: inventory.v1.InventoryService.ListWarehouses:output_type -> inventory.v1.ListWarehousesResponse
+	45, // 89: inventory.v1.InventoryService.TransferStock:output_type -> inventory.v1.TransferStockResponse
+	49, // 90: inventory.v1.InventoryService.ReserveStock:output_type -> inventory.v1.ReserveStockResponse
+	51, // 91: inventory.v1.InventoryService.ReleaseStock:output_type -> inventory.v1.ReleaseStockResponse
+	78, // [78:92] is the sub-list for method output_type
+	64, // [64:78] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -893,19 +3838,26 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[1].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[4].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
 		(*Value_BoolValue)(nil),
 	}
+	file_inventory_v1_inventory_proto_msgTypes[7].OneofWrappers = []any{
+		(*CompatibilityTarget_PartUuid)(nil),
+		(*CompatibilityTarget_Category)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[12].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[14].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      5,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,15 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName               = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName             = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_CreatePart_FullMethodName            = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName            = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_ArchivePart_FullMethodName           = "/inventory.v1.InventoryService/ArchivePart"
+	InventoryService_RestorePart_FullMethodName           = "/inventory.v1.InventoryService/RestorePart"
+	InventoryService_WatchParts_FullMethodName            = "/inventory.v1.InventoryService/WatchParts"
+	InventoryService_ValidateConfiguration_FullMethodName = "/inventory.v1.InventoryService/ValidateConfiguration"
+	InventoryService_GetPriceHistory_FullMethodName       = "/inventory.v1.InventoryService/GetPriceHistory"
+	InventoryService_CreateWarehouse_FullMethodName       = "/inventory.v1.InventoryService/CreateWarehouse"
+	InventoryService_ListWarehouses_FullMethodName        = "/inventory.v1.InventoryService/ListWarehouses"
+	InventoryService_TransferStock_FullMethodName         = "/inventory.v1.InventoryService/TransferStock"
+	InventoryService_ReserveStock_FullMethodName          = "/inventory.v1.InventoryService/ReserveStock"
+	InventoryService_ReleaseStock_FullMethodName          = "/inventory.v1.InventoryService/ReleaseStock"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// InventoryService provides read-only information operations for parts in the inventory.
+// InventoryService provides information and administration operations for parts in the inventory.
 type InventoryServiceClient interface {
 	// GetPart returns detailed information about a part by its UUID.
 	//
@@ -36,10 +48,13 @@ type InventoryServiceClient interface {
 	// - Looks up the part by UUID in the storage.
 	// - If the part is not found, returns a NotFound error.
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
-	// ListParts returns a list of parts that match the provided filter.
+	// ListParts returns a page of parts that match the provided filter.
 	//
 	// Behavior:
 	// - If all filter fields are empty, all parts are returned.
+	// - Parts are returned in pages of at most page_size items ordered by
+	//   sort_by and then by UUID; next_page_token fetches the following page.
+	// - A page token is only valid with the filter and sort it was issued for.
 	// - Filtering logic:
 	//     - Logical OR within a single filter field
 	//       (e.g., name is "main" OR "main booster").
@@ -52,6 +67,125 @@ type InventoryServiceClient interface {
 	//     4. Then by manufacturer countries,
 	//     5. Then by tags.
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// CreatePart adds a new part to the catalog.
+	//
+	// Behavior:
+	// - Generates the part UUID and sets created_at and updated_at.
+	// - Returns InvalidArgument if the name is empty, the price or stock is
+	//   negative, the category is unknown or a metadata value is not set.
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// UpdatePart changes the fields of a part listed in update_mask. Stock
+	// changes are updates with the "stock_quantity" path.
+	//
+	// Behavior:
+	// - Paths are top-level PartInfo field names, e.g. "price_cents" or "metadata".
+	// - An empty mask replaces all PartInfo fields.
+	// - Validation rules are the same as for CreatePart.
+	// - expected_version is required; the part is only updated if its version
+	//   is still expected_version. Otherwise Aborted is returned with the
+	//   current version in an ErrorInfo detail (reason VERSION_MISMATCH,
+	//   metadata key "current_version"); the client should read the part and retry.
+	// - Returns NotFound if the part does not exist and InvalidArgument for
+	//   an unknown path or a missing expected_version.
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// ArchivePart hides a part from ListParts without deleting it.
+	//
+	// Behavior:
+	// - Archiving an archived part keeps its original archived_at.
+	// - Returns NotFound if the part does not exist.
+	ArchivePart(ctx context.Context, in *ArchivePartRequest, opts ...grpc.CallOption) (*ArchivePartResponse, error)
+	// RestorePart returns an archived part to the catalog.
+	//
+	// Behavior:
+	// - Restoring a part that is not archived is a no-op.
+	// - Returns NotFound if the part does not exist.
+	RestorePart(ctx context.Context, in *RestorePartRequest, opts ...grpc.CallOption) (*RestorePartResponse, error)
+	// WatchParts streams the parts that match the filter and then their changes.
+	//
+	// Behavior:
+	// - Without resume_token, every matching part is sent as SNAPSHOT, followed
+	//   by one CURRENT message; changes are sent after it.
+	// - A changed part that matches the filter is sent as UPSERTED. A part that
+	//   stops matching, e.g. because it was archived, is sent as REMOVED.
+	// - CURRENT and change messages carry a resume_token. Watching again with
+	//   the last one continues after it, without a snapshot.
+	// - Returns InvalidArgument for a malformed resume token and
+	//   FailedPrecondition if the token is too old to resume from; the client
+	//   should then watch again without it.
+	// - The stream ends with Unavailable when the server shuts down.
+	WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error)
+	// ValidateConfiguration checks a set of parts, e.g. a ship build, against
+	// the compatibility rules of its parts.
+	//
+	// Behavior:
+	// - Rules of every listed part are checked; a repeated UUID counts once.
+	// - Archived parts are checked as well.
+	// - Every broken rule is returned as a violation; valid is true when
+	//   there are none.
+	// - Returns InvalidArgument if part_uuids is empty and NotFound if a part
+	//   does not exist.
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// GetPriceHistory returns the price changes of a part.
+	//
+	// Behavior:
+	// - A change is recorded whenever price_cents of a part changes, including
+	//   the price a part is created or imported with.
+	// - Changes are ordered by time. With range.from set, the first change is
+	//   the last one made before from, i.e. the price in effect at from.
+	// - Archived parts have a history as well.
+	// - Returns NotFound if the part does not exist and InvalidArgument if
+	//   range.from is not before range.to.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// CreateWarehouse adds a warehouse where parts can be stocked.
+	//
+	// Behavior:
+	// - The "default" warehouse always exists; it holds the stock written
+	//   with stock_quantity only.
+	// - Returns InvalidArgument if the id is not 1 to 32 lowercase letters,
+	//   digits and dashes or the name is empty, and AlreadyExists if a
+	//   warehouse with the id exists.
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	// ListWarehouses returns every warehouse ordered by id.
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+	// TransferStock moves stock of a part from one warehouse to another.
+	//
+	// Behavior:
+	// - stock_quantity of the part stays the same; the version of the part
+	//   grows as with UpdatePart, and expected_version is checked the same way.
+	// - A warehouse left without stock of the part is removed from its stock_levels.
+	// - Returns NotFound if the part does not exist, FailedPrecondition if the
+	//   source warehouse has less than quantity, and InvalidArgument if the
+	//   quantity is not positive, the warehouses are the same or the target
+	//   warehouse does not exist.
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// ReserveStock takes stock of parts out of the warehouses for an order
+	// until it is released.
+	//
+	// Behavior:
+	// - Either every item is reserved or none is. The stock of an item is taken
+	//   from its warehouse_id if it names one, otherwise from the warehouses of
+	//   the part in the order of its stock_levels; the version of each part
+	//   grows as with TransferStock.
+	// - The returned reservation lists in taken how much of each item came
+	//   from each warehouse; ReleaseStock puts it back there.
+	// - expected_version is required on every item and checked as with
+	//   UpdatePart: if a part changed since, nothing is reserved and Aborted is
+	//   returned with the current version of that part.
+	// - The reservation_id is chosen by the caller, e.g. the UUID of the order.
+	//   Reserving again with the same id returns the existing reservation and
+	//   takes nothing, so the call is safe to retry.
+	// - Returns NotFound if a part does not exist, FailedPrecondition if a part
+	//   is archived or has less stock than the quantity (in warehouse_id if
+	//   set) or the reservation was released, and InvalidArgument if a part is listed more than once.
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	// ReleaseStock puts the stock of a reservation back into the warehouses it
+	// was taken from.
+	//
+	// Behavior:
+	// - Releasing a released reservation changes nothing.
+	// - Releasing an unknown reservation records it as released, so that a
+	//   ReserveStock with the same id that arrives late takes nothing.
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -82,11 +216,140 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ArchivePart(ctx context.Context, in *ArchivePartRequest, opts ...grpc.CallOption) (*ArchivePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ArchivePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestorePart(ctx context.Context, in *RestorePartRequest, opts ...grpc.CallOption) (*RestorePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestorePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPartsRequest, WatchPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsClient = grpc.ServerStreamingClient[WatchPartsResponse]
+
+func (c *inventoryServiceClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWarehouseResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehousesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
 //
-// InventoryService provides read-only information operations for parts in the inventory.
+// InventoryService provides information and administration operations for parts in the inventory.
 type InventoryServiceServer interface {
 	// GetPart returns detailed information about a part by its UUID.
 	//
@@ -94,10 +357,13 @@ type InventoryServiceServer interface {
 	// - Looks up the part by UUID in the storage.
 	// - If the part is not found, returns a NotFound error.
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
-	// ListParts returns a list of parts that match the provided filter.
+	// ListParts returns a page of parts that match the provided filter.
 	//
 	// Behavior:
 	// - If all filter fields are empty, all parts are returned.
+	// - Parts are returned in pages of at most page_size items ordered by
+	//   sort_by and then by UUID; next_page_token fetches the following page.
+	// - A page token is only valid with the filter and sort it was issued for.
 	// - Filtering logic:
 	//     - Logical OR within a single filter field
 	//       (e.g., name is "main" OR "main booster").
@@ -110,6 +376,125 @@ type InventoryServiceServer interface {
 	//     4. Then by manufacturer countries,
 	//     5. Then by tags.
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// CreatePart adds a new part to the catalog.
+	//
+	// Behavior:
+	// - Generates the part UUID and sets created_at and updated_at.
+	// - Returns InvalidArgument if the name is empty, the price or stock is
+	//   negative, the category is unknown or a metadata value is not set.
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// UpdatePart changes the fields of a part listed in update_mask. Stock
+	// changes are updates with the "stock_quantity" path.
+	//
+	// Behavior:
+	// - Paths are top-level PartInfo field names, e.g. "price_cents" or "metadata".
+	// - An empty mask replaces all PartInfo fields.
+	// - Validation rules are the same as for CreatePart.
+	// - expected_version is required; the part is only updated if its version
+	//   is still expected_version. Otherwise Aborted is returned with the
+	//   current version in an ErrorInfo detail (reason VERSION_MISMATCH,
+	//   metadata key "current_version"); the client should read the part and retry.
+	// - Returns NotFound if the part does not exist and InvalidArgument for
+	//   an unknown path or a missing expected_version.
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// ArchivePart hides a part from ListParts without deleting it.
+	//
+	// Behavior:
+	// - Archiving an archived part keeps its original archived_at.
+	// - Returns NotFound if the part does not exist.
+	ArchivePart(context.Context, *ArchivePartRequest) (*ArchivePartResponse, error)
+	// RestorePart returns an archived part to the catalog.
+	//
+	// Behavior:
+	// - Restoring a part that is not archived is a no-op.
+	// - Returns NotFound if the part does not exist.
+	RestorePart(context.Context, *RestorePartRequest) (*RestorePartResponse, error)
+	// WatchParts streams the parts that match the filter and then their changes.
+	//
+	// Behavior:
+	// - Without resume_token, every matching part is sent as SNAPSHOT, followed
+	//   by one CURRENT message; changes are sent after it.
+	// - A changed part that matches the filter is sent as UPSERTED. A part that
+	//   stops matching, e.g. because it was archived, is sent as REMOVED.
+	// - CURRENT and change messages carry a resume_token. Watching again with
+	//   the last one continues after it, without a snapshot.
+	// - Returns InvalidArgument for a malformed resume token and
+	//   FailedPrecondition if the token is too old to resume from; the client
+	//   should then watch again without it.
+	// - The stream ends with Unavailable when the server shuts down.
+	WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error
+	// ValidateConfiguration checks a set of parts, e.g. a ship build, against
+	// the compatibility rules of its parts.
+	//
+	// Behavior:
+	// - Rules of every listed part are checked; a repeated UUID counts once.
+	// - Archived parts are checked as well.
+	// - Every broken rule is returned as a violation; valid is true when
+	//   there are none.
+	// - Returns InvalidArgument if part_uuids is empty and NotFound if a part
+	//   does not exist.
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// GetPriceHistory returns the price changes of a part.
+	//
+	// Behavior:
+	// - A change is recorded whenever price_cents of a part changes, including
+	//   the price a part is created or imported with.
+	// - Changes are ordered by time. With range.from set, the first change is
+	//   the last one made before from, i.e. the price in effect at from.
+	// - Archived parts have a history as well.
+	// - Returns NotFound if the part does not exist and InvalidArgument if
+	//   range.from is not before range.to.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// CreateWarehouse adds a warehouse where parts can be stocked.
+	//
+	// Behavior:
+	// - The "default" warehouse always exists; it holds the stock written
+	//   with stock_quantity only.
+	// - Returns InvalidArgument if the id is not 1 to 32 lowercase letters,
+	//   digits and dashes or the name is empty, and AlreadyExists if a
+	//   warehouse with the id exists.
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	// ListWarehouses returns every warehouse ordered by id.
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// TransferStock moves stock of a part from one warehouse to another.
+	//
+	// Behavior:
+	// - stock_quantity of the part stays the same; the version of the part
+	//   grows as with UpdatePart, and expected_version is checked the same way.
+	// - A warehouse left without stock of the part is removed from its stock_levels.
+	// - Returns NotFound if the part does not exist, FailedPrecondition if the
+	//   source warehouse has less than quantity, and InvalidArgument if the
+	//   quantity is not positive, the warehouses are the same or the target
+	//   warehouse does not exist.
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// ReserveStock takes stock of parts out of the warehouses for an order
+	// until it is released.
+	//
+	// Behavior:
+	// - Either every item is reserved or none is. The stock of an item is taken
+	//   from its warehouse_id if it names one, otherwise from the warehouses of
+	//   the part in the order of its stock_levels; the version of each part
+	//   grows as with TransferStock.
+	// - The returned reservation lists in taken how much of each item came
+	//   from each warehouse; ReleaseStock puts it back there.
+	// - expected_version is required on every item and checked as with
+	//   UpdatePart: if a part changed since, nothing is reserved and Aborted is
+	//   returned with the current version of that part.
+	// - The reservation_id is chosen by the caller, e.g. the UUID of the order.
+	//   Reserving again with the same id returns the existing reservation and
+	//   takes nothing, so the call is safe to retry.
+	// - Returns NotFound if a part does not exist, FailedPrecondition if a part
+	//   is archived or has less stock than the quantity (in warehouse_id if
+	//   set) or the reservation was released, and InvalidArgument if a part is listed more than once.
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	// ReleaseStock puts the stock of a reservation back into the warehouses it
+	// was taken from.
+	//
+	// Behavior:
+	// - Releasing a released reservation changes nothing.
+	// - Releasing an unknown reservation records it as released, so that a
+	//   ReserveStock with the same id that arrives late takes nothing.
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -127,6 +512,54 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListParts not implemented")
 }
+
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePart not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePart not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) ArchivePart(context.Context, *ArchivePartRequest) (*ArchivePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchivePart not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) RestorePart(context.Context, *RestorePartRequest) (*RestorePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestorePart not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[WatchPartsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchParts not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferStock not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveStock not implemented")
+}
+
+func (UnimplementedInventoryServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseStock not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -184,6 +617,215 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ArchivePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ArchivePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ArchivePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ArchivePart(ctx, req.(*ArchivePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestorePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestorePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestorePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestorePart(ctx, req.(*RestorePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WatchParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchParts(m, &grpc.GenericServerStream[WatchPartsRequest, WatchPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsServer = grpc.ServerStreamingServer[WatchPartsResponse]
+
+func _InventoryService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,7 +841,57 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "ArchivePart",
+			Handler:    _InventoryService_ArchivePart_Handler,
+		},
+		{
+			MethodName: "RestorePart",
+			Handler:    _InventoryService_RestorePart_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _InventoryService_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _InventoryService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _InventoryService_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "ListWarehouses",
+			Handler:    _InventoryService_ListWarehouses_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _InventoryService_TransferStock_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _InventoryService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _InventoryService_ReleaseStock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchParts",
+			Handler:       _InventoryService_WatchParts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
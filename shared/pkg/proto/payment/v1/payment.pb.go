@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -203,27 +204,156 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
+// Request to refund the payment of an order.
+//
+// Fields:
+//   - `order_uuid` — UUID of the paid order.
+//   - `user_uuid` — UUID of the user who paid.
+//   - `transaction_uuid` — UUID of the payment transaction, empty when the
+//     payment may have happened but its transaction is unknown.
+type RefundPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the paid order.
+	OrderUuid string `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID of the user who paid.
+	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// UUID of the payment transaction, if known.
+	TransactionUuid string `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundPaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// Response to a refund command.
+//
+// Fields:
+// - `refund_uuid` — UUID of the refund, the same for every refund of the order.
+type RefundPaymentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the refund.
+	RefundUuid    string `protobuf:"bytes,1,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentResponse) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
 var File_payment_v1_payment_proto protoreflect.FileDescriptor
 
 const file_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
+	"payment.v1\x1a\x1bbuf/validate/validate.proto\"\xad\x01\n" +
+	"\x0fPayOrderRequest\x12&\n" +
 	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
+	"order_uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\torderUuid\x12$\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\buserUuid\x12L\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\rpaymentMethod\"=\n" +
 	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\x9f\x01\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\x8f\x01\n" +
+	"\x14RefundPaymentRequest\x12&\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\torderUuid\x12$\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\"8\n" +
+	"\x15RefundPaymentResponse\x12\x1f\n" +
+	"\vrefund_uuid\x18\x01 \x01(\tR\n" +
+	"refundUuid*\x9f\x01\n" +
 	"\rPaymentMethod\x12\x1a\n" +
 	"\x16PAYMENT_METHOD_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042W\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042\xad\x01\n" +
 	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseBRZPgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/payment/v1;paymentpbv1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12T\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponseBRZPgithub.com/you-humble/rocket-maintenance/shared/pkg/proto/payment/v1;paymentpbv1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -239,20 +369,24 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 
 var (
 	file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_payment_v1_payment_proto_msgTypes  = make([]protoimpl.MessageInfo, 2)
+	file_payment_v1_payment_proto_msgTypes  = make([]protoimpl.MessageInfo, 4)
 	file_payment_v1_payment_proto_goTypes   = []any{
-		(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-		(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-		(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+		(PaymentMethod)(0),            // 0: payment.v1.PaymentMethod
+		(*PayOrderRequest)(nil),       // 1: payment.v1.PayOrderRequest
+		(*PayOrderResponse)(nil),      // 2: payment.v1.PayOrderResponse
+		(*RefundPaymentRequest)(nil),  // 3: payment.v1.RefundPaymentRequest
+		(*RefundPaymentResponse)(nil), // 4: payment.v1.RefundPaymentResponse
 	}
 )
 
 var file_payment_v1_payment_proto_depIdxs = []int32{
 	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
 	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
+	3, // 2: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	2, // 3: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	4, // 4: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -269,7 +403,7 @@ func file_payment_v1_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},